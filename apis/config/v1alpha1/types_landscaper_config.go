@@ -45,6 +45,9 @@ type LandscaperConfiguration struct {
 	HPAMainConfiguration *HPAMainConfiguration `json:"hpaMain,omitempty"`
 	// SignatureVerificationEnforcementPolicy defines how the landscaper handles signature verification.
 	SignatureVerificationEnforcementPolicy SignatureVerificationEnforcementPolicy `json:"signatureVerificationEnforcementPolicy,omitempty"`
+	// TemplatePlugins configures out-of-process template executors for additional template types.
+	// +optional
+	TemplatePlugins []TemplatePluginConfiguration `json:"templatePlugins,omitempty"`
 }
 
 // LsDeployments contains the names of the landscaper deployments.
@@ -215,6 +218,10 @@ type OCICacheConfiguration struct {
 type MetricsConfiguration struct {
 	// Port specifies the port on which metrics are published
 	Port int32 `json:"port"`
+	// ObjectPhaseInterval specifies the interval in which the number of installations, executions and deploy items
+	// per phase is computed. Defaults to 1 minute.
+	// +optional
+	ObjectPhaseInterval *metav1.Duration `json:"objectPhaseInterval,omitempty"`
 }
 
 // CrdManagementConfiguration contains the configuration of the CRD management
@@ -291,3 +298,20 @@ const (
 	// Disabled explcitly disables signature verification. Enabling the verification on installation level will not have an effect and the verification will still be disabled.
 	Disabled SignatureVerificationEnforcementPolicy = "Disabled"
 )
+
+// TemplatePluginConfiguration configures an out-of-process template executor.
+// The plugin is an executable that is called for every template execution of its template type.
+type TemplatePluginConfiguration struct {
+	// Type is the template type that is handled by the plugin.
+	// The built-in template types cannot be overwritten.
+	Type lsv1alpha1.TemplateType `json:"type"`
+	// Command is the path to the executable of the plugin.
+	Command string `json:"command"`
+	// Args are additional arguments that are passed to the executable.
+	// +optional
+	Args []string `json:"args,omitempty"`
+	// Timeout defines how long a template execution of the plugin may take.
+	// Defaults to 30 seconds.
+	// +optional
+	Timeout *lsv1alpha1.Duration `json:"timeout,omitempty"`
+}
@@ -238,6 +238,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*TemplatePluginConfiguration)(nil), (*config.TemplatePluginConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TemplatePluginConfiguration_To_config_TemplatePluginConfiguration(a.(*TemplatePluginConfiguration), b.(*config.TemplatePluginConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.TemplatePluginConfiguration)(nil), (*TemplatePluginConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_TemplatePluginConfiguration_To_v1alpha1_TemplatePluginConfiguration(a.(*config.TemplatePluginConfiguration), b.(*TemplatePluginConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -622,6 +632,7 @@ func autoConvert_v1alpha1_LandscaperConfiguration_To_config_LandscaperConfigurat
 	out.LsDeployments = (*config.LsDeployments)(unsafe.Pointer(in.LsDeployments))
 	out.HPAMainConfiguration = (*config.HPAMainConfiguration)(unsafe.Pointer(in.HPAMainConfiguration))
 	out.SignatureVerificationEnforcementPolicy = config.SignatureVerificationEnforcementPolicy(in.SignatureVerificationEnforcementPolicy)
+	out.TemplatePlugins = *(*[]config.TemplatePluginConfiguration)(unsafe.Pointer(&in.TemplatePlugins))
 	return nil
 }
 
@@ -649,6 +660,7 @@ func autoConvert_config_LandscaperConfiguration_To_v1alpha1_LandscaperConfigurat
 	out.LsDeployments = (*LsDeployments)(unsafe.Pointer(in.LsDeployments))
 	out.HPAMainConfiguration = (*HPAMainConfiguration)(unsafe.Pointer(in.HPAMainConfiguration))
 	out.SignatureVerificationEnforcementPolicy = SignatureVerificationEnforcementPolicy(in.SignatureVerificationEnforcementPolicy)
+	out.TemplatePlugins = *(*[]TemplatePluginConfiguration)(unsafe.Pointer(&in.TemplatePlugins))
 	return nil
 }
 
@@ -709,6 +721,7 @@ func Convert_config_LsDeployments_To_v1alpha1_LsDeployments(in *config.LsDeploym
 
 func autoConvert_v1alpha1_MetricsConfiguration_To_config_MetricsConfiguration(in *MetricsConfiguration, out *config.MetricsConfiguration, s conversion.Scope) error {
 	out.Port = in.Port
+	out.ObjectPhaseInterval = (*v1.Duration)(unsafe.Pointer(in.ObjectPhaseInterval))
 	return nil
 }
 
@@ -719,6 +732,7 @@ func Convert_v1alpha1_MetricsConfiguration_To_config_MetricsConfiguration(in *Me
 
 func autoConvert_config_MetricsConfiguration_To_v1alpha1_MetricsConfiguration(in *config.MetricsConfiguration, out *MetricsConfiguration, s conversion.Scope) error {
 	out.Port = in.Port
+	out.ObjectPhaseInterval = (*v1.Duration)(unsafe.Pointer(in.ObjectPhaseInterval))
 	return nil
 }
 
@@ -796,3 +810,29 @@ func autoConvert_config_RegistryConfiguration_To_v1alpha1_RegistryConfiguration(
 func Convert_config_RegistryConfiguration_To_v1alpha1_RegistryConfiguration(in *config.RegistryConfiguration, out *RegistryConfiguration, s conversion.Scope) error {
 	return autoConvert_config_RegistryConfiguration_To_v1alpha1_RegistryConfiguration(in, out, s)
 }
+
+func autoConvert_v1alpha1_TemplatePluginConfiguration_To_config_TemplatePluginConfiguration(in *TemplatePluginConfiguration, out *config.TemplatePluginConfiguration, s conversion.Scope) error {
+	out.Type = core.TemplateType(in.Type)
+	out.Command = in.Command
+	out.Args = *(*[]string)(unsafe.Pointer(&in.Args))
+	out.Timeout = (*core.Duration)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_v1alpha1_TemplatePluginConfiguration_To_config_TemplatePluginConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_TemplatePluginConfiguration_To_config_TemplatePluginConfiguration(in *TemplatePluginConfiguration, out *config.TemplatePluginConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TemplatePluginConfiguration_To_config_TemplatePluginConfiguration(in, out, s)
+}
+
+func autoConvert_config_TemplatePluginConfiguration_To_v1alpha1_TemplatePluginConfiguration(in *config.TemplatePluginConfiguration, out *TemplatePluginConfiguration, s conversion.Scope) error {
+	out.Type = corev1alpha1.TemplateType(in.Type)
+	out.Command = in.Command
+	out.Args = *(*[]string)(unsafe.Pointer(&in.Args))
+	out.Timeout = (*corev1alpha1.Duration)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_config_TemplatePluginConfiguration_To_v1alpha1_TemplatePluginConfiguration is an autogenerated conversion function.
+func Convert_config_TemplatePluginConfiguration_To_v1alpha1_TemplatePluginConfiguration(in *config.TemplatePluginConfiguration, out *TemplatePluginConfiguration, s conversion.Scope) error {
+	return autoConvert_config_TemplatePluginConfiguration_To_v1alpha1_TemplatePluginConfiguration(in, out, s)
+}
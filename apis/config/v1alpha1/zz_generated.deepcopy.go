@@ -313,7 +313,7 @@ func (in *LandscaperConfiguration) DeepCopyInto(out *LandscaperConfiguration) {
 	if in.Metrics != nil {
 		in, out := &in.Metrics, &out.Metrics
 		*out = new(MetricsConfiguration)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	in.CrdManagement.DeepCopyInto(&out.CrdManagement)
 	if in.DeployItemTimeouts != nil {
@@ -331,6 +331,13 @@ func (in *LandscaperConfiguration) DeepCopyInto(out *LandscaperConfiguration) {
 		*out = new(HPAMainConfiguration)
 		**out = **in
 	}
+	if in.TemplatePlugins != nil {
+		in, out := &in.TemplatePlugins, &out.TemplatePlugins
+		*out = make([]TemplatePluginConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -392,6 +399,11 @@ func (in *LsDeployments) DeepCopy() *LsDeployments {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MetricsConfiguration) DeepCopyInto(out *MetricsConfiguration) {
 	*out = *in
+	if in.ObjectPhaseInterval != nil {
+		in, out := &in.ObjectPhaseInterval, &out.ObjectPhaseInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -472,3 +484,29 @@ func (in *RegistryConfiguration) DeepCopy() *RegistryConfiguration {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TemplatePluginConfiguration) DeepCopyInto(out *TemplatePluginConfiguration) {
+	*out = *in
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(corev1alpha1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TemplatePluginConfiguration.
+func (in *TemplatePluginConfiguration) DeepCopy() *TemplatePluginConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(TemplatePluginConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -58,6 +58,10 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&TargetSyncList{},
 		&CriticalProblems{},
 		&CriticalProblemsList{},
+		&LandscapeStatus{},
+		&LandscapeStatusList{},
+		&InstallationRevision{},
+		&InstallationRevisionList{},
 	)
 	return nil
 }
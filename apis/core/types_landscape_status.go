@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: 2026 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package core
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// LandscapeStatusList contains a list of LandscapeStatus objects
+type LandscapeStatusList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LandscapeStatus `json:"items"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// The LandscapeStatus aggregates the status of the installation trees of a namespace.
+type LandscapeStatus struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec contains the specification
+	Spec LandscapeStatusSpec `json:"spec"`
+
+	// Status contains the status
+	// +optional
+	Status LandscapeStatusStatus `json:"status"`
+}
+
+// LandscapeStatusSpec contains the specification for a LandscapeStatus.
+type LandscapeStatusSpec struct {
+	// InstallationSelector selects the root installations of the namespace whose installation trees are aggregated.
+	// If not set, all root installations of the namespace are aggregated.
+	// +optional
+	InstallationSelector *metav1.LabelSelector `json:"installationSelector,omitempty"`
+
+	// MaxItems is the maximal number of entries of the lists of failed and longest running items.
+	// Defaults to 10.
+	// +optional
+	MaxItems *int32 `json:"maxItems,omitempty"`
+
+	// RefreshInterval defines how often the status is aggregated. Defaults to 1m.
+	// +optional
+	RefreshInterval *Duration `json:"refreshInterval,omitempty"`
+}
+
+// LandscapeStatusStatus contains the status of a LandscapeStatus.
+type LandscapeStatusStatus struct {
+	// ObservedGeneration is the most recent generation observed.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration"`
+
+	// Last time the status was updated
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+
+	// LastError describes the last error that occurred during the aggregation of the status.
+	// +optional
+	LastError *Error `json:"lastError,omitempty"`
+
+	// RootInstallations contains the names of the aggregated root installations.
+	// +optional
+	RootInstallations []string `json:"rootInstallations,omitempty"`
+
+	// Installations contains the number of installations per phase.
+	// +optional
+	Installations PhaseCounts `json:"installations"`
+
+	// Executions contains the number of executions per phase.
+	// +optional
+	Executions PhaseCounts `json:"executions"`
+
+	// DeployItems contains the number of deploy items per phase.
+	// +optional
+	DeployItems PhaseCounts `json:"deployItems"`
+
+	// FailedItemCount is the number of failed leaves, i.e. failed objects without failed child objects.
+	// +optional
+	FailedItemCount int32 `json:"failedItemCount"`
+
+	// FailedItems contains the failed leaves of the installation trees, i.e. the failed objects without failed child objects.
+	// The list is limited to spec.maxItems entries.
+	// +optional
+	FailedItems []LandscapeStatusItem `json:"failedItems,omitempty"`
+
+	// LongestRunningItems contains the objects that have been processing their current job for the longest time.
+	// The list is limited to spec.maxItems entries.
+	// +optional
+	LongestRunningItems []LandscapeStatusItem `json:"longestRunningItems,omitempty"`
+}
+
+// PhaseCounts contains the number of objects of one kind.
+type PhaseCounts struct {
+	// Total is the number of objects.
+	Total int32 `json:"total"`
+
+	// Phases maps the phases to the number of objects in the phase.
+	// +optional
+	Phases map[string]int32 `json:"phases,omitempty"`
+}
+
+// LandscapeStatusItem describes an installation, execution or deploy item of an installation tree.
+type LandscapeStatusItem struct {
+	// Kind is the kind of the object, i.e. Installation, Execution or DeployItem.
+	Kind string `json:"kind"`
+
+	// Name is the name of the object.
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the object.
+	Namespace string `json:"namespace"`
+
+	// RootInstallation is the name of the root installation of the installation tree that contains the object.
+	RootInstallation string `json:"rootInstallation"`
+
+	// Path contains the names of the installations from the root installation to the object.
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// Phase is the current phase of the object.
+	// +optional
+	Phase string `json:"phase,omitempty"`
+
+	// JobID is the ID of the current job of the object.
+	// +optional
+	JobID string `json:"jobID,omitempty"`
+
+	// StartTime is the time when the current job of the object has been triggered.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// LastError describes the last error of the object.
+	// +optional
+	LastError *Error `json:"lastError,omitempty"`
+}
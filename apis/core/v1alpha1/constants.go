@@ -41,6 +41,15 @@ const (
 	// ReconcileReasonAnnotation can be used to specify a reason for a reconcile operation, for example a retry.
 	ReconcileReasonAnnotation = LandscaperDomain + "/reconcile-reason"
 
+	// RollbackRevisionAnnotation specifies the revision to which a root installation is rolled back
+	// by the rollback operation.
+	RollbackRevisionAnnotation = LandscaperDomain + "/rollback-revision"
+
+	// CaptureTemplateOutputAnnotation specifies that the input and the rendered output of all template executions of
+	// an installation are stored in its template diagnostics secret, not only those of failed template executions.
+	// Will only have an effect if set to 'true'.
+	CaptureTemplateOutputAnnotation = LandscaperDomain + "/capture-template-output"
+
 	// ReconcileIfChangedAnnotation can be used to automatically trigger a reconcile operation if the spec has changed
 	ReconcileIfChangedAnnotation = LandscaperDomain + "/reconcile-if-changed"
 
@@ -60,6 +69,10 @@ const (
 	// DeployerTypeAnnotation is the annotation that specifies the type of the deployer.
 	DeployerTypeAnnotation = LandscaperDomain + "/deployer-type"
 
+	// TraceParentAnnotation is the annotation that contains the trace context (W3C traceparent) of the span
+	// in which the object has been created or updated by its parent. It links the spans of the object to the trace of the job.
+	TraceParentAnnotation = LandscaperDomain + "/traceparent"
+
 	// DeployerTargetNameAnnotation is the annotation that specifies the name of the target.
 	DeployerTargetNameAnnotation = LandscaperDomain + "/deployer-target-name"
 	NoTargetNameValue            = ".noTargetName"
@@ -255,6 +255,11 @@ func DeleteCacheHelmChartsAnnotation(obj *metav1.ObjectMeta) {
 	delete(obj.GetAnnotations(), v1alpha1.CacheHelmChartsAnnotation)
 }
 
+func HasCaptureTemplateOutputAnnotation(obj *metav1.ObjectMeta) bool {
+	v, ok := obj.GetAnnotations()[v1alpha1.CaptureTemplateOutputAnnotation]
+	return ok && v == "true"
+}
+
 // SetDeployItemToFailed sets status.phase of the DeployItem to a failure phase
 // If the DeployItem has a DeletionTimestamp, 'DeleteFailed' is used, otherwise it will be set to 'Failed'.
 // Afterwards, the set phase is returned.
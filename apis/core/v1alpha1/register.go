@@ -61,6 +61,10 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&TargetSyncList{},
 		&CriticalProblems{},
 		&CriticalProblemsList{},
+		&LandscapeStatus{},
+		&LandscapeStatusList{},
+		&InstallationRevision{},
+		&InstallationRevisionList{},
 	)
 	if err := RegisterConversions(scheme); err != nil {
 		return err
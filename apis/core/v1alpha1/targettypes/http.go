@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package targettypes
+
+import (
+	"github.com/gardener/landscaper/apis/core"
+	"github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+// HTTPTargetType defines the landscaper target for an http api.
+const HTTPTargetType v1alpha1.TargetType = core.GroupName + "/http"
+
+// HTTPTargetConfig defines the landscaper http target config.
+type HTTPTargetConfig struct {
+	// URL is the base url of the api.
+	// Relative urls of requests are resolved against this url.
+	URL string `json:"url,omitempty"`
+
+	// Headers are added to every request.
+	Headers map[string]string `json:"headers,omitempty"`
+
+	// BasicAuth defines the credentials for basic authentication.
+	BasicAuth *HTTPBasicAuth `json:"basicAuth,omitempty"`
+
+	// BearerToken defines a token that is sent in the authorization header.
+	BearerToken string `json:"bearerToken,omitempty"`
+
+	// CAData contains PEM-encoded certificate authorities that are trusted in addition to the system ones.
+	CAData []byte `json:"caData,omitempty"`
+
+	// InsecureSkipVerify disables the verification of the server certificate.
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+}
+
+// HTTPBasicAuth defines the credentials for basic authentication.
+type HTTPBasicAuth struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
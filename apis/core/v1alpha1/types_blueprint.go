@@ -174,6 +174,9 @@ const GOTemplateType TemplateType = "GoTemplate"
 // SpiffTemplateType describes the spiff templating type.
 const SpiffTemplateType TemplateType = "Spiff"
 
+// CUETemplateType describes the CUE templating type.
+const CUETemplateType TemplateType = "CUE"
+
 // TemplateExecutor describes a templating mechanism and configuration.
 type TemplateExecutor struct {
 	// Name is the unique name of the template
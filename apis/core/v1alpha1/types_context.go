@@ -70,6 +70,25 @@ type ContextConfiguration struct {
 	// VerificationSignatures maps a signature name to the trusted verification information
 	// +optional
 	VerificationSignatures map[string]VerificationSignature `json:"verificationSignatures,omitempty"`
+
+	// MaintenanceWindow restricts the times at which new jobs of root installations referencing this context
+	// are started. It is only used for installations without an own maintenance window.
+	// +optional
+	MaintenanceWindow *MaintenanceWindow `json:"maintenanceWindow,omitempty"`
+
+	// TemplateLookups defines the kinds of objects that the templates of installations referencing this context
+	// are allowed to read from target clusters with the lookup template functions.
+	// The lookup functions are disabled if no kind is defined.
+	// +optional
+	TemplateLookups []TemplateLookupResource `json:"templateLookups,omitempty"`
+}
+
+// TemplateLookupResource defines a kind of objects that can be read by the lookup template functions.
+type TemplateLookupResource struct {
+	// APIVersion is the api version of the objects, e.g. "v1" or "apps/v1".
+	APIVersion string `json:"apiVersion"`
+	// Kind is the kind of the objects, e.g. "ConfigMap".
+	Kind string `json:"kind"`
 }
 
 // VerificationSignatures contains the trusted verification information
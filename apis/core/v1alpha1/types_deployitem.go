@@ -13,6 +13,10 @@ import (
 // DeployItemValidationCondition is the Conditions type to indicate the deploy items configuration validation status.
 const DeployItemValidationCondition ConditionType = "DeployItemValidation"
 
+// DeployItemDriftCondition is the Conditions type to indicate whether the managed resources of a deploy item
+// have been modified or deleted on the target cluster by someone else than the deployer.
+const DeployItemDriftCondition ConditionType = "ResourcesDrifted"
+
 // DeployItemType defines the type of the deploy item
 type DeployItemType string
 
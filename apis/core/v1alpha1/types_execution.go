@@ -30,6 +30,10 @@ const ExecutionManagedNameLabel = "execution.landscaper.gardener.cloud/name"
 // todo: add conversion
 const ExecutionDependsOnAnnotation = "execution.landscaper.gardener.cloud/dependsOn"
 
+// ExecutionWaveAnnotation is name of the annotation that holds the wave of the deploy item defined in the execution.
+// It is used to delete orphaned deploy items that are not part of the execution anymore in the order of their waves.
+const ExecutionWaveAnnotation = "execution.landscaper.gardener.cloud/wave"
+
 // ReconcileDeployItemsCondition is the Conditions type to indicate the deploy items status.
 const ReconcileDeployItemsCondition ConditionType = "ReconcileDeployItems"
 
@@ -129,6 +133,11 @@ type ExecutionSpec struct {
 
 	// DeployItemsCompressed as zipped byte array
 	DeployItemsCompressed []byte `json:"deployItemsCompressed,omitempty"`
+
+	// MaxParallel limits the number of deploy items of the execution that are processed at the same time.
+	// All runnable deploy items are started at once if not set.
+	// +optional
+	MaxParallel *int32 `json:"maxParallel,omitempty"`
 }
 
 // ExecutionStatus contains the current status of a execution.
@@ -200,6 +209,13 @@ type DeployItemTemplate struct {
 	// DependsOn lists deploy items that need to be executed before this one
 	DependsOn []string `json:"dependsOn,omitempty"`
 
+	// Wave defines the rollout wave of the deploy item.
+	// A deploy item is only started when all deploy items of lower waves have succeeded,
+	// and it is only deleted when all deploy items of higher waves are gone.
+	// Defaults to 0.
+	// +optional
+	Wave int32 `json:"wave,omitempty"`
+
 	// Timeout specifies how long the deployer may take to apply the deploy item.
 	// When the time is exceeded, the deploy item fails.
 	// Value has to be parsable by time.ParseDuration (or 'none' to deactivate the timeout).
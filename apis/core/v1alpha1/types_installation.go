@@ -193,6 +193,23 @@ type InstallationSpec struct {
 	// Optimization contains settings to improve execution performance.
 	// +optional
 	Optimization *Optimization `json:"optimization,omitempty"`
+
+	// MaxParallelDeployItems limits the number of deploy items of the installation that are processed at the same time.
+	// All runnable deploy items are started at once if not set.
+	// +optional
+	MaxParallelDeployItems *int32 `json:"maxParallelDeployItems,omitempty"`
+
+	// RevisionHistoryLimit is the number of revisions of a root installation that are kept.
+	// A revision is created for every successfully finished job of the installation.
+	// Defaults to 10. Set it to 0 to disable the creation of revisions.
+	// +optional
+	RevisionHistoryLimit *int32 `json:"revisionHistoryLimit,omitempty"`
+
+	// MaintenanceWindow restricts the times at which new jobs of a root installation are started after a reconcile
+	// has been requested. Requested jobs are deferred until the next window opens. Deletions are never deferred.
+	// If not set, the maintenance window of the referenced context is used, if any.
+	// +optional
+	MaintenanceWindow *MaintenanceWindow `json:"maintenanceWindow,omitempty"`
 }
 
 // Verification defines the necessary data to verify the signature of the refered component
@@ -288,6 +305,88 @@ type InstallationStatus struct {
 	// TransitionTimes contains timestamps of status transitions
 	// +optional
 	TransitionTimes *TransitionTimes `json:"transitionTimes,omitempty"`
+
+	// Plan contains the deploy items and subinstallations that a reconcile of the installation would create, update
+	// or delete. It is computed if the installation is annotated with the plan operation.
+	// +optional
+	Plan *InstallationPlan `json:"plan,omitempty"`
+
+	// PendingJob describes a requested job which is deferred until the next maintenance window opens.
+	// +optional
+	PendingJob *PendingJob `json:"pendingJob,omitempty"`
+}
+
+// PlannedAction describes what a reconcile would do with a planned object.
+type PlannedAction string
+
+const (
+	// PlannedActionCreate means that the object does not exist yet and would be created.
+	PlannedActionCreate PlannedAction = "Create"
+	// PlannedActionUpdate means that the object exists and would be changed.
+	PlannedActionUpdate PlannedAction = "Update"
+	// PlannedActionUnchanged means that the object exists and would not be changed.
+	PlannedActionUnchanged PlannedAction = "Unchanged"
+	// PlannedActionDelete means that the object exists but is no longer rendered and would be deleted.
+	PlannedActionDelete PlannedAction = "Delete"
+)
+
+// InstallationPlan contains the objects that a reconcile of an installation would create, update or delete.
+type InstallationPlan struct {
+	// CreationTime is the time when the plan was computed.
+	CreationTime metav1.Time `json:"creationTime"`
+
+	// ObservedGeneration is the generation of the installation for which the plan was computed.
+	ObservedGeneration int64 `json:"observedGeneration"`
+
+	// ImportsHash is the hash of the import data with which the plan was computed.
+	// +optional
+	ImportsHash string `json:"importsHash,omitempty"`
+
+	// DeployItems contains the planned deploy items of the execution of the installation.
+	// +optional
+	DeployItems []PlannedDeployItem `json:"deployItems,omitempty"`
+
+	// SubInstallations contains the planned subinstallations of the installation.
+	// +optional
+	SubInstallations []PlannedSubInstallation `json:"subInstallations,omitempty"`
+
+	// Error describes the error that prevented the computation of the plan.
+	// +optional
+	Error *Error `json:"error,omitempty"`
+}
+
+// PlannedDeployItem describes a deploy item of a plan.
+type PlannedDeployItem struct {
+	// Name is the name of the deploy item as defined in the deploy execution of the blueprint.
+	Name string `json:"name"`
+
+	// Action describes what a reconcile would do with the deploy item.
+	Action PlannedAction `json:"action"`
+
+	// ChangedFields contains the paths of the fields that would be changed by an update.
+	// +optional
+	ChangedFields []string `json:"changedFields,omitempty"`
+
+	// Template is the rendered deploy item template. It is not set for deploy items that would be deleted.
+	// +optional
+	Template *DeployItemTemplate `json:"template,omitempty"`
+}
+
+// PlannedSubInstallation describes a subinstallation of a plan.
+type PlannedSubInstallation struct {
+	// Name is the name of the subinstallation as defined in the blueprint.
+	Name string `json:"name"`
+
+	// Action describes what a reconcile would do with the subinstallation.
+	Action PlannedAction `json:"action"`
+
+	// ChangedFields contains the paths of the fields that would be changed by an update.
+	// +optional
+	ChangedFields []string `json:"changedFields,omitempty"`
+
+	// Spec is the rendered specification of the subinstallation. It is not set for subinstallations that would be deleted.
+	// +optional
+	Spec *InstallationSpec `json:"spec,omitempty"`
 }
 
 type DependentToTrigger struct {
@@ -312,6 +411,16 @@ type AutomaticReconcileStatus struct {
 	OnFailed bool `json:"onFailed,omitempty"`
 }
 
+// PendingJob describes a requested job of an installation which is deferred by a maintenance window.
+type PendingJob struct {
+	// Generation is the generation of the installation for which the job has been requested.
+	Generation int64 `json:"generation"`
+	// RequestTime is the time when the deferred job was first detected.
+	RequestTime metav1.Time `json:"requestTime"`
+	// NextWindowStart is the time when the next maintenance window opens and the job is started.
+	NextWindowStart metav1.Time `json:"nextWindowStart"`
+}
+
 // InstallationImports defines import of data objects and targets.
 type InstallationImports struct {
 	// Data defines all data object imports.
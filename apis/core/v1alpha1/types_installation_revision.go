@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2026 "SAP SE or an SAP affiliate company and Gardener contributors"
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// InstallationRevisionOfLabel is the label of an installation revision that contains the name of the installation.
+const InstallationRevisionOfLabel = "landscaper.gardener.cloud/revision-of"
+
+// DefaultRevisionHistoryLimit is the default number of revisions that are kept for a root installation.
+const DefaultRevisionHistoryLimit int32 = 10
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// InstallationRevisionList contains a list of InstallationRevision objects
+type InstallationRevisionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []InstallationRevision `json:"items"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:shortName=instrev
+// +kubebuilder:printcolumn:name="Installation",type=string,JSONPath=`.spec.installationName`
+// +kubebuilder:printcolumn:name="Revision",type=integer,JSONPath=`.spec.revision`
+// +kubebuilder:printcolumn:name="JobID",type=string,JSONPath=`.spec.jobID`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// The InstallationRevision is a snapshot of a successfully finished job of a root installation.
+// It is created by the landscaper and can be used to roll back the installation to a previous state.
+type InstallationRevision struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec contains the specification
+	Spec InstallationRevisionSpec `json:"spec"`
+}
+
+// InstallationRevisionSpec contains the snapshot of an installation.
+type InstallationRevisionSpec struct {
+	// InstallationName is the name of the installation.
+	InstallationName string `json:"installationName"`
+
+	// Revision is the number of the revision. It is increased with every successful job of the installation.
+	Revision int64 `json:"revision"`
+
+	// JobID is the ID of the job of the installation which has been snapshotted.
+	JobID string `json:"jobID"`
+
+	// ImportsHash is the hash of the import data with which the job has been processed.
+	// +optional
+	ImportsHash string `json:"importsHash,omitempty"`
+
+	// InstallationSpec is the specification of the installation with which the job has been processed.
+	// It contains in particular the component version, the blueprint reference and the imports.
+	InstallationSpec InstallationSpec `json:"installationSpec"`
+
+	// DeployItems are the rendered deploy item templates of the execution of the installation.
+	// +optional
+	DeployItems DeployItemTemplateList `json:"deployItems,omitempty"`
+}
@@ -217,6 +217,10 @@ type Error struct {
 	// Well-defined error codes in case the condition reports a problem.
 	// +optional
 	Codes []ErrorCode `json:"codes,omitempty"`
+	// DebugSecretRef references a secret with diagnostic data about the error,
+	// e.g. the source location and the redacted input of a failed template execution.
+	// +optional
+	DebugSecretRef *ObjectReference `json:"debugSecretRef,omitempty"`
 }
 
 type Operation string
@@ -234,6 +238,16 @@ const (
 	// deployer could do some cleanup.
 	InterruptOperation Operation = "interrupt"
 
+	// PlanOperation is the annotation to let the landscaper compute the plan of an installation, i.e. the
+	// deploy items and subinstallations that a reconcile would create, update or delete. The plan is written to the
+	// status of the installation without applying any changes.
+	PlanOperation Operation = "plan"
+
+	// RollbackOperation is the annotation to let the landscaper roll back a root installation to a previous
+	// revision. The revision is specified by the annotation "landscaper.gardener.cloud/rollback-revision" and
+	// defaults to the revision before the current one.
+	RollbackOperation Operation = "rollback"
+
 	// TestReconcileOperation is only used for test purposes. If set at a DeployItem, it triggers a reconciliation
 	// of that DeployItem. It must not be used in a productive scenario.
 	TestReconcileOperation Operation = "test-reconcile"
@@ -388,3 +402,15 @@ type Optimization struct {
 	// set this on true if the installation does not export data to its siblings or has no siblings at all
 	HasNoSiblingExports bool `json:"hasNoSiblingExports,omitempty"`
 }
+
+// MaintenanceWindow defines recurring time windows during which changes are applied.
+type MaintenanceWindow struct {
+	// CronSpec describes the start times of the windows according to the cron syntax "https://pkg.go.dev/github.com/robfig/cron#hdr-CRON_Expression_Format".
+	CronSpec string `json:"cronSpec"`
+	// Duration is the length of every window.
+	Duration Duration `json:"duration"`
+	// TimeZone is the IANA name of the time zone in which the cron spec is evaluated, for example "Europe/Berlin".
+	// Defaults to UTC.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+}
@@ -540,6 +540,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*InstallationPlan)(nil), (*core.InstallationPlan)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InstallationPlan_To_core_InstallationPlan(a.(*InstallationPlan), b.(*core.InstallationPlan), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.InstallationPlan)(nil), (*InstallationPlan)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_InstallationPlan_To_v1alpha1_InstallationPlan(a.(*core.InstallationPlan), b.(*InstallationPlan), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*InstallationRevision)(nil), (*core.InstallationRevision)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InstallationRevision_To_core_InstallationRevision(a.(*InstallationRevision), b.(*core.InstallationRevision), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.InstallationRevision)(nil), (*InstallationRevision)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_InstallationRevision_To_v1alpha1_InstallationRevision(a.(*core.InstallationRevision), b.(*InstallationRevision), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*InstallationRevisionList)(nil), (*core.InstallationRevisionList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InstallationRevisionList_To_core_InstallationRevisionList(a.(*InstallationRevisionList), b.(*core.InstallationRevisionList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.InstallationRevisionList)(nil), (*InstallationRevisionList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_InstallationRevisionList_To_v1alpha1_InstallationRevisionList(a.(*core.InstallationRevisionList), b.(*InstallationRevisionList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*InstallationRevisionSpec)(nil), (*core.InstallationRevisionSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InstallationRevisionSpec_To_core_InstallationRevisionSpec(a.(*InstallationRevisionSpec), b.(*core.InstallationRevisionSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.InstallationRevisionSpec)(nil), (*InstallationRevisionSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_InstallationRevisionSpec_To_v1alpha1_InstallationRevisionSpec(a.(*core.InstallationRevisionSpec), b.(*InstallationRevisionSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*InstallationSpec)(nil), (*core.InstallationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_InstallationSpec_To_core_InstallationSpec(a.(*InstallationSpec), b.(*core.InstallationSpec), scope)
 	}); err != nil {
@@ -590,6 +630,56 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*LandscapeStatus)(nil), (*core.LandscapeStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LandscapeStatus_To_core_LandscapeStatus(a.(*LandscapeStatus), b.(*core.LandscapeStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.LandscapeStatus)(nil), (*LandscapeStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_LandscapeStatus_To_v1alpha1_LandscapeStatus(a.(*core.LandscapeStatus), b.(*LandscapeStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LandscapeStatusItem)(nil), (*core.LandscapeStatusItem)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LandscapeStatusItem_To_core_LandscapeStatusItem(a.(*LandscapeStatusItem), b.(*core.LandscapeStatusItem), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.LandscapeStatusItem)(nil), (*LandscapeStatusItem)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_LandscapeStatusItem_To_v1alpha1_LandscapeStatusItem(a.(*core.LandscapeStatusItem), b.(*LandscapeStatusItem), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LandscapeStatusList)(nil), (*core.LandscapeStatusList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LandscapeStatusList_To_core_LandscapeStatusList(a.(*LandscapeStatusList), b.(*core.LandscapeStatusList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.LandscapeStatusList)(nil), (*LandscapeStatusList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_LandscapeStatusList_To_v1alpha1_LandscapeStatusList(a.(*core.LandscapeStatusList), b.(*LandscapeStatusList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LandscapeStatusSpec)(nil), (*core.LandscapeStatusSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LandscapeStatusSpec_To_core_LandscapeStatusSpec(a.(*LandscapeStatusSpec), b.(*core.LandscapeStatusSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.LandscapeStatusSpec)(nil), (*LandscapeStatusSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_LandscapeStatusSpec_To_v1alpha1_LandscapeStatusSpec(a.(*core.LandscapeStatusSpec), b.(*LandscapeStatusSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LandscapeStatusStatus)(nil), (*core.LandscapeStatusStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LandscapeStatusStatus_To_core_LandscapeStatusStatus(a.(*LandscapeStatusStatus), b.(*core.LandscapeStatusStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.LandscapeStatusStatus)(nil), (*LandscapeStatusStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_LandscapeStatusStatus_To_v1alpha1_LandscapeStatusStatus(a.(*core.LandscapeStatusStatus), b.(*LandscapeStatusStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*LocalConfigMapReference)(nil), (*core.LocalConfigMapReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_LocalConfigMapReference_To_core_LocalConfigMapReference(a.(*LocalConfigMapReference), b.(*core.LocalConfigMapReference), scope)
 	}); err != nil {
@@ -630,6 +720,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MaintenanceWindow)(nil), (*core.MaintenanceWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MaintenanceWindow_To_core_MaintenanceWindow(a.(*MaintenanceWindow), b.(*core.MaintenanceWindow), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MaintenanceWindow)(nil), (*MaintenanceWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MaintenanceWindow_To_v1alpha1_MaintenanceWindow(a.(*core.MaintenanceWindow), b.(*MaintenanceWindow), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NamedObjectReference)(nil), (*core.NamedObjectReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_NamedObjectReference_To_core_NamedObjectReference(a.(*NamedObjectReference), b.(*core.NamedObjectReference), scope)
 	}); err != nil {
@@ -670,6 +770,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PendingJob)(nil), (*core.PendingJob)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PendingJob_To_core_PendingJob(a.(*PendingJob), b.(*core.PendingJob), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.PendingJob)(nil), (*PendingJob)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_PendingJob_To_v1alpha1_PendingJob(a.(*core.PendingJob), b.(*PendingJob), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PhaseCounts)(nil), (*core.PhaseCounts)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PhaseCounts_To_core_PhaseCounts(a.(*PhaseCounts), b.(*core.PhaseCounts), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.PhaseCounts)(nil), (*PhaseCounts)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_PhaseCounts_To_v1alpha1_PhaseCounts(a.(*core.PhaseCounts), b.(*PhaseCounts), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PlannedDeployItem)(nil), (*core.PlannedDeployItem)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PlannedDeployItem_To_core_PlannedDeployItem(a.(*PlannedDeployItem), b.(*core.PlannedDeployItem), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.PlannedDeployItem)(nil), (*PlannedDeployItem)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_PlannedDeployItem_To_v1alpha1_PlannedDeployItem(a.(*core.PlannedDeployItem), b.(*PlannedDeployItem), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PlannedSubInstallation)(nil), (*core.PlannedSubInstallation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PlannedSubInstallation_To_core_PlannedSubInstallation(a.(*PlannedSubInstallation), b.(*core.PlannedSubInstallation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.PlannedSubInstallation)(nil), (*PlannedSubInstallation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_PlannedSubInstallation_To_v1alpha1_PlannedSubInstallation(a.(*core.PlannedSubInstallation), b.(*PlannedSubInstallation), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*RemoteBlueprintReference)(nil), (*core.RemoteBlueprintReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_RemoteBlueprintReference_To_core_RemoteBlueprintReference(a.(*RemoteBlueprintReference), b.(*core.RemoteBlueprintReference), scope)
 	}); err != nil {
@@ -950,6 +1090,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*TemplateLookupResource)(nil), (*core.TemplateLookupResource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TemplateLookupResource_To_core_TemplateLookupResource(a.(*TemplateLookupResource), b.(*core.TemplateLookupResource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.TemplateLookupResource)(nil), (*TemplateLookupResource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_TemplateLookupResource_To_v1alpha1_TemplateLookupResource(a.(*core.TemplateLookupResource), b.(*TemplateLookupResource), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*TokenRotation)(nil), (*core.TokenRotation)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_TokenRotation_To_core_TokenRotation(a.(*TokenRotation), b.(*core.TokenRotation), scope)
 	}); err != nil {
@@ -1462,6 +1612,8 @@ func autoConvert_v1alpha1_ContextConfiguration_To_core_ContextConfiguration(in *
 	out.Configurations = *(*map[string]core.AnyJSON)(unsafe.Pointer(&in.Configurations))
 	out.ComponentVersionOverwritesReference = in.ComponentVersionOverwritesReference
 	out.VerificationSignatures = *(*map[string]core.VerificationSignature)(unsafe.Pointer(&in.VerificationSignatures))
+	out.MaintenanceWindow = (*core.MaintenanceWindow)(unsafe.Pointer(in.MaintenanceWindow))
+	out.TemplateLookups = *(*[]core.TemplateLookupResource)(unsafe.Pointer(&in.TemplateLookups))
 	return nil
 }
 
@@ -1477,6 +1629,8 @@ func autoConvert_core_ContextConfiguration_To_v1alpha1_ContextConfiguration(in *
 	out.Configurations = *(*map[string]AnyJSON)(unsafe.Pointer(&in.Configurations))
 	out.ComponentVersionOverwritesReference = in.ComponentVersionOverwritesReference
 	out.VerificationSignatures = *(*map[string]VerificationSignature)(unsafe.Pointer(&in.VerificationSignatures))
+	out.MaintenanceWindow = (*MaintenanceWindow)(unsafe.Pointer(in.MaintenanceWindow))
+	out.TemplateLookups = *(*[]TemplateLookupResource)(unsafe.Pointer(&in.TemplateLookups))
 	return nil
 }
 
@@ -1932,6 +2086,7 @@ func autoConvert_v1alpha1_DeployItemTemplate_To_core_DeployItemTemplate(in *Depl
 	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
 	out.Configuration = (*runtime.RawExtension)(unsafe.Pointer(in.Configuration))
 	out.DependsOn = *(*[]string)(unsafe.Pointer(&in.DependsOn))
+	out.Wave = in.Wave
 	out.Timeout = (*core.Duration)(unsafe.Pointer(in.Timeout))
 	out.UpdateOnChangeOnly = in.UpdateOnChangeOnly
 	out.OnDelete = (*core.OnDeleteConfig)(unsafe.Pointer(in.OnDelete))
@@ -1950,6 +2105,7 @@ func autoConvert_core_DeployItemTemplate_To_v1alpha1_DeployItemTemplate(in *core
 	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
 	out.Configuration = (*runtime.RawExtension)(unsafe.Pointer(in.Configuration))
 	out.DependsOn = *(*[]string)(unsafe.Pointer(&in.DependsOn))
+	out.Wave = in.Wave
 	out.Timeout = (*Duration)(unsafe.Pointer(in.Timeout))
 	out.UpdateOnChangeOnly = in.UpdateOnChangeOnly
 	out.OnDelete = (*OnDeleteConfig)(unsafe.Pointer(in.OnDelete))
@@ -2034,6 +2190,7 @@ func autoConvert_v1alpha1_Error_To_core_Error(in *Error, out *core.Error, s conv
 	out.Reason = in.Reason
 	out.Message = in.Message
 	out.Codes = *(*[]core.ErrorCode)(unsafe.Pointer(&in.Codes))
+	out.DebugSecretRef = (*core.ObjectReference)(unsafe.Pointer(in.DebugSecretRef))
 	return nil
 }
 
@@ -2049,6 +2206,7 @@ func autoConvert_core_Error_To_v1alpha1_Error(in *core.Error, out *Error, s conv
 	out.Reason = in.Reason
 	out.Message = in.Message
 	out.Codes = *(*[]ErrorCode)(unsafe.Pointer(&in.Codes))
+	out.DebugSecretRef = (*ObjectReference)(unsafe.Pointer(in.DebugSecretRef))
 	return nil
 }
 
@@ -2135,6 +2293,7 @@ func autoConvert_v1alpha1_ExecutionSpec_To_core_ExecutionSpec(in *ExecutionSpec,
 	out.Context = in.Context
 	out.DeployItems = *(*core.DeployItemTemplateList)(unsafe.Pointer(&in.DeployItems))
 	out.DeployItemsCompressed = *(*[]byte)(unsafe.Pointer(&in.DeployItemsCompressed))
+	out.MaxParallel = (*int32)(unsafe.Pointer(in.MaxParallel))
 	return nil
 }
 
@@ -2142,6 +2301,7 @@ func autoConvert_core_ExecutionSpec_To_v1alpha1_ExecutionSpec(in *core.Execution
 	out.Context = in.Context
 	out.DeployItems = *(*DeployItemTemplateList)(unsafe.Pointer(&in.DeployItems))
 	out.DeployItemsCompressed = *(*[]byte)(unsafe.Pointer(&in.DeployItemsCompressed))
+	out.MaxParallel = (*int32)(unsafe.Pointer(in.MaxParallel))
 	return nil
 }
 
@@ -2415,6 +2575,138 @@ func Convert_core_InstallationList_To_v1alpha1_InstallationList(in *core.Install
 	return autoConvert_core_InstallationList_To_v1alpha1_InstallationList(in, out, s)
 }
 
+func autoConvert_v1alpha1_InstallationPlan_To_core_InstallationPlan(in *InstallationPlan, out *core.InstallationPlan, s conversion.Scope) error {
+	out.CreationTime = in.CreationTime
+	out.ObservedGeneration = in.ObservedGeneration
+	out.ImportsHash = in.ImportsHash
+	out.DeployItems = *(*[]core.PlannedDeployItem)(unsafe.Pointer(&in.DeployItems))
+	out.SubInstallations = *(*[]core.PlannedSubInstallation)(unsafe.Pointer(&in.SubInstallations))
+	out.Error = (*core.Error)(unsafe.Pointer(in.Error))
+	return nil
+}
+
+// Convert_v1alpha1_InstallationPlan_To_core_InstallationPlan is an autogenerated conversion function.
+func Convert_v1alpha1_InstallationPlan_To_core_InstallationPlan(in *InstallationPlan, out *core.InstallationPlan, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InstallationPlan_To_core_InstallationPlan(in, out, s)
+}
+
+func autoConvert_core_InstallationPlan_To_v1alpha1_InstallationPlan(in *core.InstallationPlan, out *InstallationPlan, s conversion.Scope) error {
+	out.CreationTime = in.CreationTime
+	out.ObservedGeneration = in.ObservedGeneration
+	out.ImportsHash = in.ImportsHash
+	out.DeployItems = *(*[]PlannedDeployItem)(unsafe.Pointer(&in.DeployItems))
+	out.SubInstallations = *(*[]PlannedSubInstallation)(unsafe.Pointer(&in.SubInstallations))
+	out.Error = (*Error)(unsafe.Pointer(in.Error))
+	return nil
+}
+
+// Convert_core_InstallationPlan_To_v1alpha1_InstallationPlan is an autogenerated conversion function.
+func Convert_core_InstallationPlan_To_v1alpha1_InstallationPlan(in *core.InstallationPlan, out *InstallationPlan, s conversion.Scope) error {
+	return autoConvert_core_InstallationPlan_To_v1alpha1_InstallationPlan(in, out, s)
+}
+
+func autoConvert_v1alpha1_InstallationRevision_To_core_InstallationRevision(in *InstallationRevision, out *core.InstallationRevision, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_InstallationRevisionSpec_To_core_InstallationRevisionSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_InstallationRevision_To_core_InstallationRevision is an autogenerated conversion function.
+func Convert_v1alpha1_InstallationRevision_To_core_InstallationRevision(in *InstallationRevision, out *core.InstallationRevision, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InstallationRevision_To_core_InstallationRevision(in, out, s)
+}
+
+func autoConvert_core_InstallationRevision_To_v1alpha1_InstallationRevision(in *core.InstallationRevision, out *InstallationRevision, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_core_InstallationRevisionSpec_To_v1alpha1_InstallationRevisionSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_InstallationRevision_To_v1alpha1_InstallationRevision is an autogenerated conversion function.
+func Convert_core_InstallationRevision_To_v1alpha1_InstallationRevision(in *core.InstallationRevision, out *InstallationRevision, s conversion.Scope) error {
+	return autoConvert_core_InstallationRevision_To_v1alpha1_InstallationRevision(in, out, s)
+}
+
+func autoConvert_v1alpha1_InstallationRevisionList_To_core_InstallationRevisionList(in *InstallationRevisionList, out *core.InstallationRevisionList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]core.InstallationRevision, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_InstallationRevision_To_core_InstallationRevision(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_InstallationRevisionList_To_core_InstallationRevisionList is an autogenerated conversion function.
+func Convert_v1alpha1_InstallationRevisionList_To_core_InstallationRevisionList(in *InstallationRevisionList, out *core.InstallationRevisionList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InstallationRevisionList_To_core_InstallationRevisionList(in, out, s)
+}
+
+func autoConvert_core_InstallationRevisionList_To_v1alpha1_InstallationRevisionList(in *core.InstallationRevisionList, out *InstallationRevisionList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]InstallationRevision, len(*in))
+		for i := range *in {
+			if err := Convert_core_InstallationRevision_To_v1alpha1_InstallationRevision(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_core_InstallationRevisionList_To_v1alpha1_InstallationRevisionList is an autogenerated conversion function.
+func Convert_core_InstallationRevisionList_To_v1alpha1_InstallationRevisionList(in *core.InstallationRevisionList, out *InstallationRevisionList, s conversion.Scope) error {
+	return autoConvert_core_InstallationRevisionList_To_v1alpha1_InstallationRevisionList(in, out, s)
+}
+
+func autoConvert_v1alpha1_InstallationRevisionSpec_To_core_InstallationRevisionSpec(in *InstallationRevisionSpec, out *core.InstallationRevisionSpec, s conversion.Scope) error {
+	out.InstallationName = in.InstallationName
+	out.Revision = in.Revision
+	out.JobID = in.JobID
+	out.ImportsHash = in.ImportsHash
+	if err := Convert_v1alpha1_InstallationSpec_To_core_InstallationSpec(&in.InstallationSpec, &out.InstallationSpec, s); err != nil {
+		return err
+	}
+	out.DeployItems = *(*core.DeployItemTemplateList)(unsafe.Pointer(&in.DeployItems))
+	return nil
+}
+
+// Convert_v1alpha1_InstallationRevisionSpec_To_core_InstallationRevisionSpec is an autogenerated conversion function.
+func Convert_v1alpha1_InstallationRevisionSpec_To_core_InstallationRevisionSpec(in *InstallationRevisionSpec, out *core.InstallationRevisionSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InstallationRevisionSpec_To_core_InstallationRevisionSpec(in, out, s)
+}
+
+func autoConvert_core_InstallationRevisionSpec_To_v1alpha1_InstallationRevisionSpec(in *core.InstallationRevisionSpec, out *InstallationRevisionSpec, s conversion.Scope) error {
+	out.InstallationName = in.InstallationName
+	out.Revision = in.Revision
+	out.JobID = in.JobID
+	out.ImportsHash = in.ImportsHash
+	if err := Convert_core_InstallationSpec_To_v1alpha1_InstallationSpec(&in.InstallationSpec, &out.InstallationSpec, s); err != nil {
+		return err
+	}
+	out.DeployItems = *(*DeployItemTemplateList)(unsafe.Pointer(&in.DeployItems))
+	return nil
+}
+
+// Convert_core_InstallationRevisionSpec_To_v1alpha1_InstallationRevisionSpec is an autogenerated conversion function.
+func Convert_core_InstallationRevisionSpec_To_v1alpha1_InstallationRevisionSpec(in *core.InstallationRevisionSpec, out *InstallationRevisionSpec, s conversion.Scope) error {
+	return autoConvert_core_InstallationRevisionSpec_To_v1alpha1_InstallationRevisionSpec(in, out, s)
+}
+
 func autoConvert_v1alpha1_InstallationSpec_To_core_InstallationSpec(in *InstallationSpec, out *core.InstallationSpec, s conversion.Scope) error {
 	out.Context = in.Context
 	out.Verification = (*core.Verification)(unsafe.Pointer(in.Verification))
@@ -2432,6 +2724,9 @@ func autoConvert_v1alpha1_InstallationSpec_To_core_InstallationSpec(in *Installa
 	out.ExportDataMappings = *(*map[string]core.AnyJSON)(unsafe.Pointer(&in.ExportDataMappings))
 	out.AutomaticReconcile = (*core.AutomaticReconcile)(unsafe.Pointer(in.AutomaticReconcile))
 	out.Optimization = (*core.Optimization)(unsafe.Pointer(in.Optimization))
+	out.MaxParallelDeployItems = (*int32)(unsafe.Pointer(in.MaxParallelDeployItems))
+	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
+	out.MaintenanceWindow = (*core.MaintenanceWindow)(unsafe.Pointer(in.MaintenanceWindow))
 	return nil
 }
 
@@ -2457,6 +2752,9 @@ func autoConvert_core_InstallationSpec_To_v1alpha1_InstallationSpec(in *core.Ins
 	out.ExportDataMappings = *(*map[string]AnyJSON)(unsafe.Pointer(&in.ExportDataMappings))
 	out.AutomaticReconcile = (*AutomaticReconcile)(unsafe.Pointer(in.AutomaticReconcile))
 	out.Optimization = (*Optimization)(unsafe.Pointer(in.Optimization))
+	out.MaxParallelDeployItems = (*int32)(unsafe.Pointer(in.MaxParallelDeployItems))
+	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
+	out.MaintenanceWindow = (*MaintenanceWindow)(unsafe.Pointer(in.MaintenanceWindow))
 	return nil
 }
 
@@ -2479,6 +2777,8 @@ func autoConvert_v1alpha1_InstallationStatus_To_core_InstallationStatus(in *Inst
 	out.AutomaticReconcileStatus = (*core.AutomaticReconcileStatus)(unsafe.Pointer(in.AutomaticReconcileStatus))
 	out.DependentsToTrigger = *(*[]core.DependentToTrigger)(unsafe.Pointer(&in.DependentsToTrigger))
 	out.TransitionTimes = (*core.TransitionTimes)(unsafe.Pointer(in.TransitionTimes))
+	out.Plan = (*core.InstallationPlan)(unsafe.Pointer(in.Plan))
+	out.PendingJob = (*core.PendingJob)(unsafe.Pointer(in.PendingJob))
 	return nil
 }
 
@@ -2501,6 +2801,8 @@ func autoConvert_core_InstallationStatus_To_v1alpha1_InstallationStatus(in *core
 	out.AutomaticReconcileStatus = (*AutomaticReconcileStatus)(unsafe.Pointer(in.AutomaticReconcileStatus))
 	out.DependentsToTrigger = *(*[]DependentToTrigger)(unsafe.Pointer(&in.DependentsToTrigger))
 	out.TransitionTimes = (*TransitionTimes)(unsafe.Pointer(in.TransitionTimes))
+	out.Plan = (*InstallationPlan)(unsafe.Pointer(in.Plan))
+	out.PendingJob = (*PendingJob)(unsafe.Pointer(in.PendingJob))
 	return nil
 }
 
@@ -2599,6 +2901,170 @@ func Convert_core_JSONSchemaDefinition_To_v1alpha1_JSONSchemaDefinition(in *core
 	return autoConvert_core_JSONSchemaDefinition_To_v1alpha1_JSONSchemaDefinition(in, out, s)
 }
 
+func autoConvert_v1alpha1_LandscapeStatus_To_core_LandscapeStatus(in *LandscapeStatus, out *core.LandscapeStatus, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_LandscapeStatusSpec_To_core_LandscapeStatusSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_LandscapeStatusStatus_To_core_LandscapeStatusStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_LandscapeStatus_To_core_LandscapeStatus is an autogenerated conversion function.
+func Convert_v1alpha1_LandscapeStatus_To_core_LandscapeStatus(in *LandscapeStatus, out *core.LandscapeStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LandscapeStatus_To_core_LandscapeStatus(in, out, s)
+}
+
+func autoConvert_core_LandscapeStatus_To_v1alpha1_LandscapeStatus(in *core.LandscapeStatus, out *LandscapeStatus, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_core_LandscapeStatusSpec_To_v1alpha1_LandscapeStatusSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_core_LandscapeStatusStatus_To_v1alpha1_LandscapeStatusStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_LandscapeStatus_To_v1alpha1_LandscapeStatus is an autogenerated conversion function.
+func Convert_core_LandscapeStatus_To_v1alpha1_LandscapeStatus(in *core.LandscapeStatus, out *LandscapeStatus, s conversion.Scope) error {
+	return autoConvert_core_LandscapeStatus_To_v1alpha1_LandscapeStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_LandscapeStatusItem_To_core_LandscapeStatusItem(in *LandscapeStatusItem, out *core.LandscapeStatusItem, s conversion.Scope) error {
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	out.RootInstallation = in.RootInstallation
+	out.Path = in.Path
+	out.Phase = in.Phase
+	out.JobID = in.JobID
+	out.StartTime = (*metav1.Time)(unsafe.Pointer(in.StartTime))
+	out.LastError = (*core.Error)(unsafe.Pointer(in.LastError))
+	return nil
+}
+
+// Convert_v1alpha1_LandscapeStatusItem_To_core_LandscapeStatusItem is an autogenerated conversion function.
+func Convert_v1alpha1_LandscapeStatusItem_To_core_LandscapeStatusItem(in *LandscapeStatusItem, out *core.LandscapeStatusItem, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LandscapeStatusItem_To_core_LandscapeStatusItem(in, out, s)
+}
+
+func autoConvert_core_LandscapeStatusItem_To_v1alpha1_LandscapeStatusItem(in *core.LandscapeStatusItem, out *LandscapeStatusItem, s conversion.Scope) error {
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	out.RootInstallation = in.RootInstallation
+	out.Path = in.Path
+	out.Phase = in.Phase
+	out.JobID = in.JobID
+	out.StartTime = (*metav1.Time)(unsafe.Pointer(in.StartTime))
+	out.LastError = (*Error)(unsafe.Pointer(in.LastError))
+	return nil
+}
+
+// Convert_core_LandscapeStatusItem_To_v1alpha1_LandscapeStatusItem is an autogenerated conversion function.
+func Convert_core_LandscapeStatusItem_To_v1alpha1_LandscapeStatusItem(in *core.LandscapeStatusItem, out *LandscapeStatusItem, s conversion.Scope) error {
+	return autoConvert_core_LandscapeStatusItem_To_v1alpha1_LandscapeStatusItem(in, out, s)
+}
+
+func autoConvert_v1alpha1_LandscapeStatusList_To_core_LandscapeStatusList(in *LandscapeStatusList, out *core.LandscapeStatusList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]core.LandscapeStatus)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_LandscapeStatusList_To_core_LandscapeStatusList is an autogenerated conversion function.
+func Convert_v1alpha1_LandscapeStatusList_To_core_LandscapeStatusList(in *LandscapeStatusList, out *core.LandscapeStatusList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LandscapeStatusList_To_core_LandscapeStatusList(in, out, s)
+}
+
+func autoConvert_core_LandscapeStatusList_To_v1alpha1_LandscapeStatusList(in *core.LandscapeStatusList, out *LandscapeStatusList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]LandscapeStatus)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_core_LandscapeStatusList_To_v1alpha1_LandscapeStatusList is an autogenerated conversion function.
+func Convert_core_LandscapeStatusList_To_v1alpha1_LandscapeStatusList(in *core.LandscapeStatusList, out *LandscapeStatusList, s conversion.Scope) error {
+	return autoConvert_core_LandscapeStatusList_To_v1alpha1_LandscapeStatusList(in, out, s)
+}
+
+func autoConvert_v1alpha1_LandscapeStatusSpec_To_core_LandscapeStatusSpec(in *LandscapeStatusSpec, out *core.LandscapeStatusSpec, s conversion.Scope) error {
+	out.InstallationSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.InstallationSelector))
+	out.MaxItems = (*int32)(unsafe.Pointer(in.MaxItems))
+	out.RefreshInterval = (*core.Duration)(unsafe.Pointer(in.RefreshInterval))
+	return nil
+}
+
+// Convert_v1alpha1_LandscapeStatusSpec_To_core_LandscapeStatusSpec is an autogenerated conversion function.
+func Convert_v1alpha1_LandscapeStatusSpec_To_core_LandscapeStatusSpec(in *LandscapeStatusSpec, out *core.LandscapeStatusSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LandscapeStatusSpec_To_core_LandscapeStatusSpec(in, out, s)
+}
+
+func autoConvert_core_LandscapeStatusSpec_To_v1alpha1_LandscapeStatusSpec(in *core.LandscapeStatusSpec, out *LandscapeStatusSpec, s conversion.Scope) error {
+	out.InstallationSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.InstallationSelector))
+	out.MaxItems = (*int32)(unsafe.Pointer(in.MaxItems))
+	out.RefreshInterval = (*Duration)(unsafe.Pointer(in.RefreshInterval))
+	return nil
+}
+
+// Convert_core_LandscapeStatusSpec_To_v1alpha1_LandscapeStatusSpec is an autogenerated conversion function.
+func Convert_core_LandscapeStatusSpec_To_v1alpha1_LandscapeStatusSpec(in *core.LandscapeStatusSpec, out *LandscapeStatusSpec, s conversion.Scope) error {
+	return autoConvert_core_LandscapeStatusSpec_To_v1alpha1_LandscapeStatusSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_LandscapeStatusStatus_To_core_LandscapeStatusStatus(in *LandscapeStatusStatus, out *core.LandscapeStatusStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.LastUpdateTime = (*metav1.Time)(unsafe.Pointer(in.LastUpdateTime))
+	out.LastError = (*core.Error)(unsafe.Pointer(in.LastError))
+	out.RootInstallations = *(*[]string)(unsafe.Pointer(&in.RootInstallations))
+	if err := Convert_v1alpha1_PhaseCounts_To_core_PhaseCounts(&in.Installations, &out.Installations, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_PhaseCounts_To_core_PhaseCounts(&in.Executions, &out.Executions, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_PhaseCounts_To_core_PhaseCounts(&in.DeployItems, &out.DeployItems, s); err != nil {
+		return err
+	}
+	out.FailedItemCount = in.FailedItemCount
+	out.FailedItems = *(*[]core.LandscapeStatusItem)(unsafe.Pointer(&in.FailedItems))
+	out.LongestRunningItems = *(*[]core.LandscapeStatusItem)(unsafe.Pointer(&in.LongestRunningItems))
+	return nil
+}
+
+// Convert_v1alpha1_LandscapeStatusStatus_To_core_LandscapeStatusStatus is an autogenerated conversion function.
+func Convert_v1alpha1_LandscapeStatusStatus_To_core_LandscapeStatusStatus(in *LandscapeStatusStatus, out *core.LandscapeStatusStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LandscapeStatusStatus_To_core_LandscapeStatusStatus(in, out, s)
+}
+
+func autoConvert_core_LandscapeStatusStatus_To_v1alpha1_LandscapeStatusStatus(in *core.LandscapeStatusStatus, out *LandscapeStatusStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.LastUpdateTime = (*metav1.Time)(unsafe.Pointer(in.LastUpdateTime))
+	out.LastError = (*Error)(unsafe.Pointer(in.LastError))
+	out.RootInstallations = *(*[]string)(unsafe.Pointer(&in.RootInstallations))
+	if err := Convert_core_PhaseCounts_To_v1alpha1_PhaseCounts(&in.Installations, &out.Installations, s); err != nil {
+		return err
+	}
+	if err := Convert_core_PhaseCounts_To_v1alpha1_PhaseCounts(&in.Executions, &out.Executions, s); err != nil {
+		return err
+	}
+	if err := Convert_core_PhaseCounts_To_v1alpha1_PhaseCounts(&in.DeployItems, &out.DeployItems, s); err != nil {
+		return err
+	}
+	out.FailedItemCount = in.FailedItemCount
+	out.FailedItems = *(*[]LandscapeStatusItem)(unsafe.Pointer(&in.FailedItems))
+	out.LongestRunningItems = *(*[]LandscapeStatusItem)(unsafe.Pointer(&in.LongestRunningItems))
+	return nil
+}
+
+// Convert_core_LandscapeStatusStatus_To_v1alpha1_LandscapeStatusStatus is an autogenerated conversion function.
+func Convert_core_LandscapeStatusStatus_To_v1alpha1_LandscapeStatusStatus(in *core.LandscapeStatusStatus, out *LandscapeStatusStatus, s conversion.Scope) error {
+	return autoConvert_core_LandscapeStatusStatus_To_v1alpha1_LandscapeStatusStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_LocalConfigMapReference_To_core_LocalConfigMapReference(in *LocalConfigMapReference, out *core.LocalConfigMapReference, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Key = in.Key
@@ -2691,6 +3157,34 @@ func Convert_core_LsHealthCheckList_To_v1alpha1_LsHealthCheckList(in *core.LsHea
 	return autoConvert_core_LsHealthCheckList_To_v1alpha1_LsHealthCheckList(in, out, s)
 }
 
+func autoConvert_v1alpha1_MaintenanceWindow_To_core_MaintenanceWindow(in *MaintenanceWindow, out *core.MaintenanceWindow, s conversion.Scope) error {
+	out.CronSpec = in.CronSpec
+	if err := Convert_v1alpha1_Duration_To_core_Duration(&in.Duration, &out.Duration, s); err != nil {
+		return err
+	}
+	out.TimeZone = in.TimeZone
+	return nil
+}
+
+// Convert_v1alpha1_MaintenanceWindow_To_core_MaintenanceWindow is an autogenerated conversion function.
+func Convert_v1alpha1_MaintenanceWindow_To_core_MaintenanceWindow(in *MaintenanceWindow, out *core.MaintenanceWindow, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MaintenanceWindow_To_core_MaintenanceWindow(in, out, s)
+}
+
+func autoConvert_core_MaintenanceWindow_To_v1alpha1_MaintenanceWindow(in *core.MaintenanceWindow, out *MaintenanceWindow, s conversion.Scope) error {
+	out.CronSpec = in.CronSpec
+	if err := Convert_core_Duration_To_v1alpha1_Duration(&in.Duration, &out.Duration, s); err != nil {
+		return err
+	}
+	out.TimeZone = in.TimeZone
+	return nil
+}
+
+// Convert_core_MaintenanceWindow_To_v1alpha1_MaintenanceWindow is an autogenerated conversion function.
+func Convert_core_MaintenanceWindow_To_v1alpha1_MaintenanceWindow(in *core.MaintenanceWindow, out *MaintenanceWindow, s conversion.Scope) error {
+	return autoConvert_core_MaintenanceWindow_To_v1alpha1_MaintenanceWindow(in, out, s)
+}
+
 func autoConvert_v1alpha1_NamedObjectReference_To_core_NamedObjectReference(in *NamedObjectReference, out *core.NamedObjectReference, s conversion.Scope) error {
 	out.Name = in.Name
 	if err := Convert_v1alpha1_ObjectReference_To_core_ObjectReference(&in.Reference, &out.Reference, s); err != nil {
@@ -2781,6 +3275,104 @@ func Convert_core_Optimization_To_v1alpha1_Optimization(in *core.Optimization, o
 	return autoConvert_core_Optimization_To_v1alpha1_Optimization(in, out, s)
 }
 
+func autoConvert_v1alpha1_PendingJob_To_core_PendingJob(in *PendingJob, out *core.PendingJob, s conversion.Scope) error {
+	out.Generation = in.Generation
+	out.RequestTime = in.RequestTime
+	out.NextWindowStart = in.NextWindowStart
+	return nil
+}
+
+// Convert_v1alpha1_PendingJob_To_core_PendingJob is an autogenerated conversion function.
+func Convert_v1alpha1_PendingJob_To_core_PendingJob(in *PendingJob, out *core.PendingJob, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PendingJob_To_core_PendingJob(in, out, s)
+}
+
+func autoConvert_core_PendingJob_To_v1alpha1_PendingJob(in *core.PendingJob, out *PendingJob, s conversion.Scope) error {
+	out.Generation = in.Generation
+	out.RequestTime = in.RequestTime
+	out.NextWindowStart = in.NextWindowStart
+	return nil
+}
+
+// Convert_core_PendingJob_To_v1alpha1_PendingJob is an autogenerated conversion function.
+func Convert_core_PendingJob_To_v1alpha1_PendingJob(in *core.PendingJob, out *PendingJob, s conversion.Scope) error {
+	return autoConvert_core_PendingJob_To_v1alpha1_PendingJob(in, out, s)
+}
+
+func autoConvert_v1alpha1_PhaseCounts_To_core_PhaseCounts(in *PhaseCounts, out *core.PhaseCounts, s conversion.Scope) error {
+	out.Total = in.Total
+	out.Phases = *(*map[string]int32)(unsafe.Pointer(&in.Phases))
+	return nil
+}
+
+// Convert_v1alpha1_PhaseCounts_To_core_PhaseCounts is an autogenerated conversion function.
+func Convert_v1alpha1_PhaseCounts_To_core_PhaseCounts(in *PhaseCounts, out *core.PhaseCounts, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PhaseCounts_To_core_PhaseCounts(in, out, s)
+}
+
+func autoConvert_core_PhaseCounts_To_v1alpha1_PhaseCounts(in *core.PhaseCounts, out *PhaseCounts, s conversion.Scope) error {
+	out.Total = in.Total
+	out.Phases = *(*map[string]int32)(unsafe.Pointer(&in.Phases))
+	return nil
+}
+
+// Convert_core_PhaseCounts_To_v1alpha1_PhaseCounts is an autogenerated conversion function.
+func Convert_core_PhaseCounts_To_v1alpha1_PhaseCounts(in *core.PhaseCounts, out *PhaseCounts, s conversion.Scope) error {
+	return autoConvert_core_PhaseCounts_To_v1alpha1_PhaseCounts(in, out, s)
+}
+
+func autoConvert_v1alpha1_PlannedDeployItem_To_core_PlannedDeployItem(in *PlannedDeployItem, out *core.PlannedDeployItem, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Action = core.PlannedAction(in.Action)
+	out.ChangedFields = *(*[]string)(unsafe.Pointer(&in.ChangedFields))
+	out.Template = (*core.DeployItemTemplate)(unsafe.Pointer(in.Template))
+	return nil
+}
+
+// Convert_v1alpha1_PlannedDeployItem_To_core_PlannedDeployItem is an autogenerated conversion function.
+func Convert_v1alpha1_PlannedDeployItem_To_core_PlannedDeployItem(in *PlannedDeployItem, out *core.PlannedDeployItem, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PlannedDeployItem_To_core_PlannedDeployItem(in, out, s)
+}
+
+func autoConvert_core_PlannedDeployItem_To_v1alpha1_PlannedDeployItem(in *core.PlannedDeployItem, out *PlannedDeployItem, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Action = PlannedAction(in.Action)
+	out.ChangedFields = *(*[]string)(unsafe.Pointer(&in.ChangedFields))
+	out.Template = (*DeployItemTemplate)(unsafe.Pointer(in.Template))
+	return nil
+}
+
+// Convert_core_PlannedDeployItem_To_v1alpha1_PlannedDeployItem is an autogenerated conversion function.
+func Convert_core_PlannedDeployItem_To_v1alpha1_PlannedDeployItem(in *core.PlannedDeployItem, out *PlannedDeployItem, s conversion.Scope) error {
+	return autoConvert_core_PlannedDeployItem_To_v1alpha1_PlannedDeployItem(in, out, s)
+}
+
+func autoConvert_v1alpha1_PlannedSubInstallation_To_core_PlannedSubInstallation(in *PlannedSubInstallation, out *core.PlannedSubInstallation, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Action = core.PlannedAction(in.Action)
+	out.ChangedFields = *(*[]string)(unsafe.Pointer(&in.ChangedFields))
+	out.Spec = (*core.InstallationSpec)(unsafe.Pointer(in.Spec))
+	return nil
+}
+
+// Convert_v1alpha1_PlannedSubInstallation_To_core_PlannedSubInstallation is an autogenerated conversion function.
+func Convert_v1alpha1_PlannedSubInstallation_To_core_PlannedSubInstallation(in *PlannedSubInstallation, out *core.PlannedSubInstallation, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PlannedSubInstallation_To_core_PlannedSubInstallation(in, out, s)
+}
+
+func autoConvert_core_PlannedSubInstallation_To_v1alpha1_PlannedSubInstallation(in *core.PlannedSubInstallation, out *PlannedSubInstallation, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Action = PlannedAction(in.Action)
+	out.ChangedFields = *(*[]string)(unsafe.Pointer(&in.ChangedFields))
+	out.Spec = (*InstallationSpec)(unsafe.Pointer(in.Spec))
+	return nil
+}
+
+// Convert_core_PlannedSubInstallation_To_v1alpha1_PlannedSubInstallation is an autogenerated conversion function.
+func Convert_core_PlannedSubInstallation_To_v1alpha1_PlannedSubInstallation(in *core.PlannedSubInstallation, out *PlannedSubInstallation, s conversion.Scope) error {
+	return autoConvert_core_PlannedSubInstallation_To_v1alpha1_PlannedSubInstallation(in, out, s)
+}
+
 func autoConvert_v1alpha1_RemoteBlueprintReference_To_core_RemoteBlueprintReference(in *RemoteBlueprintReference, out *core.RemoteBlueprintReference, s conversion.Scope) error {
 	out.ResourceName = in.ResourceName
 	return nil
@@ -3475,6 +4067,28 @@ func Convert_core_TemplateExecutor_To_v1alpha1_TemplateExecutor(in *core.Templat
 	return autoConvert_core_TemplateExecutor_To_v1alpha1_TemplateExecutor(in, out, s)
 }
 
+func autoConvert_v1alpha1_TemplateLookupResource_To_core_TemplateLookupResource(in *TemplateLookupResource, out *core.TemplateLookupResource, s conversion.Scope) error {
+	out.APIVersion = in.APIVersion
+	out.Kind = in.Kind
+	return nil
+}
+
+// Convert_v1alpha1_TemplateLookupResource_To_core_TemplateLookupResource is an autogenerated conversion function.
+func Convert_v1alpha1_TemplateLookupResource_To_core_TemplateLookupResource(in *TemplateLookupResource, out *core.TemplateLookupResource, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TemplateLookupResource_To_core_TemplateLookupResource(in, out, s)
+}
+
+func autoConvert_core_TemplateLookupResource_To_v1alpha1_TemplateLookupResource(in *core.TemplateLookupResource, out *TemplateLookupResource, s conversion.Scope) error {
+	out.APIVersion = in.APIVersion
+	out.Kind = in.Kind
+	return nil
+}
+
+// Convert_core_TemplateLookupResource_To_v1alpha1_TemplateLookupResource is an autogenerated conversion function.
+func Convert_core_TemplateLookupResource_To_v1alpha1_TemplateLookupResource(in *core.TemplateLookupResource, out *TemplateLookupResource, s conversion.Scope) error {
+	return autoConvert_core_TemplateLookupResource_To_v1alpha1_TemplateLookupResource(in, out, s)
+}
+
 func autoConvert_v1alpha1_TokenRotation_To_core_TokenRotation(in *TokenRotation, out *core.TokenRotation, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	return nil
@@ -12,6 +12,7 @@ import (
 	json "encoding/json"
 
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 
 	v2 "github.com/gardener/landscaper/legacy-component-spec/bindings-go/apis/v2"
@@ -503,6 +504,16 @@ func (in *ContextConfiguration) DeepCopyInto(out *ContextConfiguration) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(MaintenanceWindow)
+		**out = **in
+	}
+	if in.TemplateLookups != nil {
+		in, out := &in.TemplateLookups, &out.TemplateLookups
+		*out = make([]TemplateLookupResource, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1125,6 +1136,11 @@ func (in *Error) DeepCopyInto(out *Error) {
 		*out = make([]ErrorCode, len(*in))
 		copy(*out, *in)
 	}
+	if in.DebugSecretRef != nil {
+		in, out := &in.DebugSecretRef, &out.DebugSecretRef
+		*out = new(ObjectReference)
+		**out = **in
+	}
 	return
 }
 
@@ -1214,6 +1230,11 @@ func (in *ExecutionSpec) DeepCopyInto(out *ExecutionSpec) {
 		*out = make([]byte, len(*in))
 		copy(*out, *in)
 	}
+	if in.MaxParallel != nil {
+		in, out := &in.MaxParallel, &out.MaxParallel
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -1546,6 +1567,126 @@ func (in *InstallationList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstallationPlan) DeepCopyInto(out *InstallationPlan) {
+	*out = *in
+	in.CreationTime.DeepCopyInto(&out.CreationTime)
+	if in.DeployItems != nil {
+		in, out := &in.DeployItems, &out.DeployItems
+		*out = make([]PlannedDeployItem, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SubInstallations != nil {
+		in, out := &in.SubInstallations, &out.SubInstallations
+		*out = make([]PlannedSubInstallation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Error != nil {
+		in, out := &in.Error, &out.Error
+		*out = new(Error)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstallationPlan.
+func (in *InstallationPlan) DeepCopy() *InstallationPlan {
+	if in == nil {
+		return nil
+	}
+	out := new(InstallationPlan)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstallationRevision) DeepCopyInto(out *InstallationRevision) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstallationRevision.
+func (in *InstallationRevision) DeepCopy() *InstallationRevision {
+	if in == nil {
+		return nil
+	}
+	out := new(InstallationRevision)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InstallationRevision) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstallationRevisionList) DeepCopyInto(out *InstallationRevisionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]InstallationRevision, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstallationRevisionList.
+func (in *InstallationRevisionList) DeepCopy() *InstallationRevisionList {
+	if in == nil {
+		return nil
+	}
+	out := new(InstallationRevisionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InstallationRevisionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstallationRevisionSpec) DeepCopyInto(out *InstallationRevisionSpec) {
+	*out = *in
+	in.InstallationSpec.DeepCopyInto(&out.InstallationSpec)
+	if in.DeployItems != nil {
+		in, out := &in.DeployItems, &out.DeployItems
+		*out = make(DeployItemTemplateList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstallationRevisionSpec.
+func (in *InstallationRevisionSpec) DeepCopy() *InstallationRevisionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InstallationRevisionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InstallationSpec) DeepCopyInto(out *InstallationSpec) {
 	*out = *in
@@ -1586,6 +1727,21 @@ func (in *InstallationSpec) DeepCopyInto(out *InstallationSpec) {
 		*out = new(Optimization)
 		**out = **in
 	}
+	if in.MaxParallelDeployItems != nil {
+		in, out := &in.MaxParallelDeployItems, &out.MaxParallelDeployItems
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RevisionHistoryLimit != nil {
+		in, out := &in.RevisionHistoryLimit, &out.RevisionHistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(MaintenanceWindow)
+		**out = **in
+	}
 	return
 }
 
@@ -1643,6 +1799,16 @@ func (in *InstallationStatus) DeepCopyInto(out *InstallationStatus) {
 		*out = new(TransitionTimes)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Plan != nil {
+		in, out := &in.Plan, &out.Plan
+		*out = new(InstallationPlan)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PendingJob != nil {
+		in, out := &in.PendingJob, &out.PendingJob
+		*out = new(PendingJob)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1767,6 +1933,170 @@ func (in *JSONSchemaDefinition) DeepCopy() *JSONSchemaDefinition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LandscapeStatus) DeepCopyInto(out *LandscapeStatus) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LandscapeStatus.
+func (in *LandscapeStatus) DeepCopy() *LandscapeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LandscapeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LandscapeStatus) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LandscapeStatusItem) DeepCopyInto(out *LandscapeStatusItem) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastError != nil {
+		in, out := &in.LastError, &out.LastError
+		*out = new(Error)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LandscapeStatusItem.
+func (in *LandscapeStatusItem) DeepCopy() *LandscapeStatusItem {
+	if in == nil {
+		return nil
+	}
+	out := new(LandscapeStatusItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LandscapeStatusList) DeepCopyInto(out *LandscapeStatusList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LandscapeStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LandscapeStatusList.
+func (in *LandscapeStatusList) DeepCopy() *LandscapeStatusList {
+	if in == nil {
+		return nil
+	}
+	out := new(LandscapeStatusList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LandscapeStatusList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LandscapeStatusSpec) DeepCopyInto(out *LandscapeStatusSpec) {
+	*out = *in
+	if in.InstallationSelector != nil {
+		in, out := &in.InstallationSelector, &out.InstallationSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxItems != nil {
+		in, out := &in.MaxItems, &out.MaxItems
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RefreshInterval != nil {
+		in, out := &in.RefreshInterval, &out.RefreshInterval
+		*out = new(Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LandscapeStatusSpec.
+func (in *LandscapeStatusSpec) DeepCopy() *LandscapeStatusSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LandscapeStatusSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LandscapeStatusStatus) DeepCopyInto(out *LandscapeStatusStatus) {
+	*out = *in
+	if in.LastUpdateTime != nil {
+		in, out := &in.LastUpdateTime, &out.LastUpdateTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastError != nil {
+		in, out := &in.LastError, &out.LastError
+		*out = new(Error)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RootInstallations != nil {
+		in, out := &in.RootInstallations, &out.RootInstallations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Installations.DeepCopyInto(&out.Installations)
+	in.Executions.DeepCopyInto(&out.Executions)
+	in.DeployItems.DeepCopyInto(&out.DeployItems)
+	if in.FailedItems != nil {
+		in, out := &in.FailedItems, &out.FailedItems
+		*out = make([]LandscapeStatusItem, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LongestRunningItems != nil {
+		in, out := &in.LongestRunningItems, &out.LongestRunningItems
+		*out = make([]LandscapeStatusItem, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LandscapeStatusStatus.
+func (in *LandscapeStatusStatus) DeepCopy() *LandscapeStatusStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LandscapeStatusStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LocalConfigMapReference) DeepCopyInto(out *LocalConfigMapReference) {
 	*out = *in
@@ -1859,6 +2189,23 @@ func (in *LsHealthCheckList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+	out.Duration = in.Duration
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NamedObjectReference) DeepCopyInto(out *NamedObjectReference) {
 	*out = *in
@@ -1924,6 +2271,99 @@ func (in *Optimization) DeepCopy() *Optimization {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PendingJob) DeepCopyInto(out *PendingJob) {
+	*out = *in
+	in.RequestTime.DeepCopyInto(&out.RequestTime)
+	in.NextWindowStart.DeepCopyInto(&out.NextWindowStart)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PendingJob.
+func (in *PendingJob) DeepCopy() *PendingJob {
+	if in == nil {
+		return nil
+	}
+	out := new(PendingJob)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PhaseCounts) DeepCopyInto(out *PhaseCounts) {
+	*out = *in
+	if in.Phases != nil {
+		in, out := &in.Phases, &out.Phases
+		*out = make(map[string]int32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PhaseCounts.
+func (in *PhaseCounts) DeepCopy() *PhaseCounts {
+	if in == nil {
+		return nil
+	}
+	out := new(PhaseCounts)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlannedDeployItem) DeepCopyInto(out *PlannedDeployItem) {
+	*out = *in
+	if in.ChangedFields != nil {
+		in, out := &in.ChangedFields, &out.ChangedFields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(DeployItemTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlannedDeployItem.
+func (in *PlannedDeployItem) DeepCopy() *PlannedDeployItem {
+	if in == nil {
+		return nil
+	}
+	out := new(PlannedDeployItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlannedSubInstallation) DeepCopyInto(out *PlannedSubInstallation) {
+	*out = *in
+	if in.ChangedFields != nil {
+		in, out := &in.ChangedFields, &out.ChangedFields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(InstallationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlannedSubInstallation.
+func (in *PlannedSubInstallation) DeepCopy() *PlannedSubInstallation {
+	if in == nil {
+		return nil
+	}
+	out := new(PlannedSubInstallation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RemoteBlueprintReference) DeepCopyInto(out *RemoteBlueprintReference) {
 	*out = *in
@@ -2611,6 +3051,22 @@ func (in *TemplateExecutor) DeepCopy() *TemplateExecutor {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TemplateLookupResource) DeepCopyInto(out *TemplateLookupResource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TemplateLookupResource.
+func (in *TemplateLookupResource) DeepCopy() *TemplateLookupResource {
+	if in == nil {
+		return nil
+	}
+	out := new(TemplateLookupResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TokenRotation) DeepCopyInto(out *TokenRotation) {
 	*out = *in
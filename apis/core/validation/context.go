@@ -0,0 +1,18 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/landscaper/apis/core"
+)
+
+// ValidateContext validates a Context
+func ValidateContext(lsContext *core.Context) field.ErrorList {
+	allErrs := field.ErrorList{}
+	allErrs = append(allErrs, ValidateMaintenanceWindow(lsContext.MaintenanceWindow, field.NewPath("maintenanceWindow"))...)
+	return allErrs
+}
@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/landscaper/apis/core"
+	"github.com/gardener/landscaper/apis/core/validation"
+)
+
+var _ = Describe("Context", func() {
+
+	It("should accept a Context without a maintenance window", func() {
+		allErrs := validation.ValidateContext(&core.Context{})
+		Expect(allErrs).To(BeEmpty())
+	})
+
+	It("should accept a Context with a valid maintenance window", func() {
+		lsContext := &core.Context{}
+		lsContext.MaintenanceWindow = &core.MaintenanceWindow{
+			CronSpec: "0 2 * * 6",
+			Duration: core.Duration{Duration: 2 * time.Hour},
+			TimeZone: "Europe/Berlin",
+		}
+
+		allErrs := validation.ValidateContext(lsContext)
+		Expect(allErrs).To(BeEmpty())
+	})
+
+	It("should reject a Context with an invalid maintenance window", func() {
+		lsContext := &core.Context{}
+		lsContext.MaintenanceWindow = &core.MaintenanceWindow{
+			CronSpec: "every saturday",
+			Duration: core.Duration{Duration: 2 * time.Hour},
+		}
+
+		allErrs := validation.ValidateContext(lsContext)
+		Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeInvalid),
+			"Field": Equal("maintenanceWindow.cronSpec"),
+		}))))
+	})
+
+})
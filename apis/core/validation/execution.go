@@ -5,6 +5,8 @@
 package validation
 
 import (
+	"fmt"
+
 	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -23,6 +25,16 @@ func ValidateExecution(execution *core.Execution) field.ErrorList {
 func ValidateExecutionSpec(fldpath *field.Path, spec core.ExecutionSpec) field.ErrorList {
 	allErrs := field.ErrorList{}
 	allErrs = append(allErrs, ValidateDeployItemTemplateList(fldpath.Child("deployItems"), spec.DeployItems)...)
+	allErrs = append(allErrs, ValidateMaxParallel(fldpath.Child("maxParallel"), spec.MaxParallel)...)
+	return allErrs
+}
+
+// ValidateMaxParallel validates the maximal number of deploy items that are processed at the same time.
+func ValidateMaxParallel(fldPath *field.Path, maxParallel *int32) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if maxParallel != nil && *maxParallel < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath, *maxParallel, "must be greater than 0"))
+	}
 	return allErrs
 }
 
@@ -61,6 +73,16 @@ func ValidateDeployItemTemplateList(fldPath *field.Path, list core.DeployItemTem
 			idx, _ := getDeployItemTemplateByName(list, u.Source)
 			allErrs = append(allErrs, field.Invalid(fldPath.Index(idx).Key(u.Source), u.Target, "depends on undefined deploy item"))
 		}
+
+		// a deploy item must not depend on a deploy item of a later wave, as this would block the rollout
+		for i, tmpl := range list {
+			for _, do := range tmpl.DependsOn {
+				if idx, dependency := getDeployItemTemplateByName(list, do); idx >= 0 && dependency.Wave > tmpl.Wave {
+					allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Key(tmpl.Name).Child("dependsOn"), do,
+						fmt.Sprintf("depends on deploy item of the later wave %d", dependency.Wave)))
+				}
+			}
+		}
 	}
 
 	return allErrs
@@ -173,5 +195,9 @@ func ValidateDeployItemTemplate(fldPath *field.Path, tmpl core.DeployItemTemplat
 		allErrs = append(allErrs, metav1validation.ValidateLabels(tmpl.Labels, fldPath.Child("labels"))...)
 	}
 
+	if tmpl.Wave < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("wave"), tmpl.Wave, "must not be negative"))
+	}
+
 	return allErrs
 }
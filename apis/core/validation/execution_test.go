@@ -9,6 +9,7 @@ import (
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/landscaper/apis/core"
 	"github.com/gardener/landscaper/apis/core/validation"
@@ -45,6 +46,19 @@ var _ = Describe("Execution", func() {
 				"Field": Equal("b.type"),
 			}))))
 		})
+
+		It("should fail if DeployItemTemplate.wave is negative", func() {
+			tmpl := core.DeployItemTemplate{}
+			tmpl.Name = "my-import"
+			tmpl.Type = "mytype"
+			tmpl.Wave = -1
+
+			allErrs := validation.ValidateDeployItemTemplate(field.NewPath("b"), tmpl)
+			Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("b.wave"),
+			}))))
+		})
 	})
 
 	Context("ValidateDeployItemTemplateList", func() {
@@ -148,6 +162,48 @@ var _ = Describe("Execution", func() {
 			Expect(allErrs).To(HaveLen(5)) // no other validation errors except from the ones specified above
 		})
 
+		It("should fail if a DeployItemTemplate depends on a DeployItemTemplate of a later wave", func() {
+			templates := []core.DeployItemTemplate{
+				{
+					Name: "a",
+					Type: "mytype",
+					Wave: 1,
+				},
+				{
+					Name:      "b",
+					Type:      "mytype",
+					DependsOn: []string{"a"},
+				},
+				{
+					Name:      "c",
+					Type:      "mytype",
+					DependsOn: []string{"a"},
+					Wave:      2,
+				},
+			}
+
+			allErrs := validation.ValidateDeployItemTemplateList(field.NewPath("x"), templates)
+			Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":     Equal(field.ErrorTypeInvalid),
+				"Field":    Equal("x[1][b].dependsOn"),
+				"BadValue": Equal("a"),
+			}))))
+		})
+
+	})
+
+	Context("ValidateExecutionSpec", func() {
+		It("should fail if maxParallel is less than 1", func() {
+			spec := core.ExecutionSpec{
+				MaxParallel: ptr.To[int32](0),
+			}
+
+			allErrs := validation.ValidateExecutionSpec(field.NewPath("spec"), spec)
+			Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.maxParallel"),
+			}))))
+		})
 	})
 
 })
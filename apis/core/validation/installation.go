@@ -6,6 +6,8 @@ package validation
 
 import (
 	"regexp"
+	"strings"
+	"time"
 
 	"github.com/robfig/cron/v3"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
@@ -60,6 +62,11 @@ func ValidateInstallationSpec(spec *core.InstallationSpec, fldPath *field.Path)
 	allErrs = append(allErrs, ValidateInstallationComponentDescriptor(spec.ComponentDescriptor, fldPath.Child("componentDescriptor"))...)
 
 	allErrs = append(allErrs, ValidateInstallationAutomaticReconcile(spec.AutomaticReconcile, fldPath.Child("automaticReconcile"))...)
+	allErrs = append(allErrs, ValidateMaxParallel(fldPath.Child("maxParallelDeployItems"), spec.MaxParallelDeployItems)...)
+	if spec.RevisionHistoryLimit != nil && *spec.RevisionHistoryLimit < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("revisionHistoryLimit"), *spec.RevisionHistoryLimit, "must not be negative"))
+	}
+	allErrs = append(allErrs, ValidateMaintenanceWindow(spec.MaintenanceWindow, fldPath.Child("maintenanceWindow"))...)
 
 	return allErrs
 }
@@ -129,6 +136,36 @@ func ValidateInstallationSucceededReconcile(succeededReconcile *core.SucceededRe
 	return allErrs
 }
 
+// ValidateMaintenanceWindow validates a maintenance window of an Installation or Context
+func ValidateMaintenanceWindow(window *core.MaintenanceWindow, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if window != nil {
+		trimmedSpec := strings.TrimSpace(window.CronSpec)
+		if strings.HasPrefix(trimmedSpec, "CRON_TZ=") || strings.HasPrefix(trimmedSpec, "TZ=") {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("cronSpec"), window.CronSpec,
+				"field must not contain a time zone, use the field timeZone instead"))
+		} else if schedule, err := cron.ParseStandard(window.CronSpec); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("cronSpec"), window.CronSpec,
+				"field must be a valid cron spec"))
+		} else if schedule.Next(time.Now()).IsZero() {
+			// the schedule does not fire within the next years, e.g. "0 0 30 2 *"
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("cronSpec"), window.CronSpec,
+				"field must be a cron spec that fires"))
+		}
+		if window.Duration.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("duration"), window.Duration.String(),
+				"field must be a positive duration"))
+		}
+		if _, err := time.LoadLocation(window.TimeZone); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("timeZone"), window.TimeZone,
+				"field must be a valid time zone"))
+		}
+	}
+
+	return allErrs
+}
+
 // ValidateInstallationImports validates the imports of an Installation
 func ValidateInstallationImports(imports core.InstallationImports, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -5,6 +5,8 @@
 package validation_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
@@ -204,6 +206,61 @@ var _ = Describe("Installation", func() {
 		})
 	})
 
+	Context("MaintenanceWindow", func() {
+		It("should accept a valid maintenance window", func() {
+			window := &core.MaintenanceWindow{
+				CronSpec: "0 22 * * 6",
+				Duration: core.Duration{Duration: 4 * time.Hour},
+				TimeZone: "Europe/Berlin",
+			}
+
+			allErrs := validation.ValidateMaintenanceWindow(window, field.NewPath("maintenanceWindow"))
+			Expect(allErrs).To(HaveLen(0))
+		})
+
+		It("should reject an invalid cron spec, duration and time zone", func() {
+			window := &core.MaintenanceWindow{
+				CronSpec: "every saturday",
+				TimeZone: "Mars/Olympus",
+			}
+
+			allErrs := validation.ValidateMaintenanceWindow(window, field.NewPath("maintenanceWindow"))
+			Expect(allErrs).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("maintenanceWindow.cronSpec"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("maintenanceWindow.duration"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("maintenanceWindow.timeZone"),
+				})),
+			))
+		})
+
+		DescribeTable("should reject a cron spec", func(cronSpec string) {
+			window := &core.MaintenanceWindow{
+				CronSpec: cronSpec,
+				Duration: core.Duration{Duration: 4 * time.Hour},
+			}
+
+			allErrs := validation.ValidateMaintenanceWindow(window, field.NewPath("maintenanceWindow"))
+			Expect(allErrs).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("maintenanceWindow.cronSpec"),
+				})),
+			))
+		},
+			Entry("that never fires", "0 0 30 2 *"),
+			Entry("with a CRON_TZ prefix", "CRON_TZ=Europe/Berlin 0 22 * * 6"),
+			Entry("with a TZ prefix", "TZ=Europe/Berlin 0 22 * * 6"),
+		)
+	})
+
 	Context("InstallationImports", func() {
 		It("should pass if imports are valid", func() {
 			imp := core.InstallationImports{
@@ -7,6 +7,7 @@ package v1alpha1
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 )
 
 func addDefaultingFuncs(scheme *runtime.Scheme) error {
@@ -22,6 +23,7 @@ func SetDefaults_Configuration(obj *Configuration) {
 		obj.DefaultImage.Image = "ubuntu:18.04"
 	}
 	SetDefaults_GarbageCollection(&obj.GarbageCollection)
+	SetDefaults_Execution(&obj.Execution)
 }
 
 // SetDefaults_GarbageCollection sets the defaults for the container deployer configuration.
@@ -33,3 +35,18 @@ func SetDefaults_GarbageCollection(obj *GarbageCollection) {
 		obj.RequeueTimeSeconds = 60 * 60
 	}
 }
+
+// SetDefaults_Execution sets the defaults for the execution of the containers.
+func SetDefaults_Execution(obj *Execution) {
+	if len(obj.Mode) == 0 {
+		obj.Mode = ExecutionModePod
+	}
+	if obj.Mode == ExecutionModeJob {
+		if obj.Job == nil {
+			obj.Job = &JobConfiguration{}
+		}
+		if obj.Job.BackoffLimit == nil {
+			obj.Job.BackoffLimit = ptr.To[int32](0)
+		}
+	}
+}
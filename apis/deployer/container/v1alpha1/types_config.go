@@ -52,6 +52,15 @@ type Configuration struct {
 	// GarbageCollection configures the container deployer garbage collector.
 	GarbageCollection GarbageCollection `json:"garbageCollection"`
 
+	// Execution configures how the containers of the deploy items are executed.
+	// +optional
+	Execution Execution `json:"execution,omitempty"`
+
+	// HostAccess restricts the resources in the namespace of the pods in the host cluster
+	// that can be used by the containers of the deploy items.
+	// +optional
+	HostAccess HostAccess `json:"hostAccess,omitempty"`
+
 	// DebugOptions configure additional debug options.
 	DebugOptions *DebugOptions `json:"debug,omitempty"`
 
@@ -109,6 +118,56 @@ type GarbageCollection struct {
 	RequeueTimeSeconds int `json:"requeueTimeSeconds"`
 }
 
+// ExecutionMode defines how the containers of a deploy item are executed.
+type ExecutionMode string
+
+const (
+	// ExecutionModePod executes the containers in a pod that is managed by the container deployer.
+	ExecutionModePod ExecutionMode = "Pod"
+	// ExecutionModeJob executes the containers in a pod that is managed by a batch/v1 job.
+	ExecutionModeJob ExecutionMode = "Job"
+)
+
+// Execution defines how the containers of the deploy items are executed.
+type Execution struct {
+	// Mode defines whether the containers are executed in a bare pod or in a job.
+	// Defaults to Pod.
+	// +optional
+	Mode ExecutionMode `json:"mode,omitempty"`
+	// Job configures the jobs that are created in the Job mode.
+	// +optional
+	Job *JobConfiguration `json:"job,omitempty"`
+}
+
+// HostAccess defines the resources in the namespace of the pods in the host cluster
+// that can be used by the containers of the deploy items.
+// Resources that are not listed cannot be used.
+type HostAccess struct {
+	// ServiceAccountNames are the names of the service accounts that can be used to run the pods.
+	// +optional
+	ServiceAccountNames []string `json:"serviceAccountNames,omitempty"`
+	// SecretNames are the names of the secrets that can be referenced in the env, envFrom and volumes
+	// of the provider configuration.
+	// +optional
+	SecretNames []string `json:"secretNames,omitempty"`
+}
+
+// JobConfiguration defines the configuration of the jobs that execute the containers.
+type JobConfiguration struct {
+	// BackoffLimit is the number of retries of a failed pod before the job is considered as failed.
+	// The state of a failed pod is restored in the next pod.
+	// Defaults to 0.
+	// +optional
+	BackoffLimit *int32 `json:"backoffLimit,omitempty"`
+	// ActiveDeadlineSeconds is the duration in seconds, after which a running job is terminated and considered as failed.
+	// +optional
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
+	// TTLSecondsAfterFinished is the duration in seconds, after which a finished job that is kept with debug.keepPod
+	// is deleted by the cluster. It is set when the deployer has collected the result of the job.
+	// +optional
+	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
+}
+
 // DebugOptions defines optional debug options.
 type DebugOptions struct {
 	// KeepPod will only remove the finalizer on the pod but will not delete the pod.
@@ -45,6 +45,47 @@ type ProviderConfiguration struct {
 	// More info: https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#running-a-command-in-a-shell
 	// +optional
 	Args []string `json:"args,omitempty"`
+	// Resources are the compute resources that are required by the main container.
+	// More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+	// +optional
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+	// Env is a list of additional environment variables of the main container.
+	// Values can be taken from secrets in the namespace of the pod in the host cluster
+	// that are allowed by the container deployer, from fields of the pod and from the resources of the container.
+	// The environment variables that are set by the container deployer cannot be overwritten.
+	// +optional
+	Env []corev1.EnvVar `json:"env,omitempty"`
+	// EnvFrom is a list of secrets in the namespace of the pod in the host cluster,
+	// whose keys are added as environment variables to the main container.
+	// Only secrets that are allowed by the container deployer can be referenced.
+	// +optional
+	EnvFrom []corev1.EnvFromSource `json:"envFrom,omitempty"`
+	// Volumes are additional volumes of the pod.
+	// Only emptyDir, secret, configMap, downwardAPI, projected, persistentVolumeClaim and ephemeral volumes are allowed.
+	// Secrets must be allowed by the container deployer and service account tokens must not be projected.
+	// +optional
+	Volumes []corev1.Volume `json:"volumes,omitempty"`
+	// VolumeMounts are additional volume mounts of the main container.
+	// The volumes must not be mounted into the directory of the container deployer data.
+	// +optional
+	VolumeMounts []corev1.VolumeMount `json:"volumeMounts,omitempty"`
+	// SecurityContext is the security context of the main container.
+	// Privileged containers, privilege escalation, added capabilities and running as root are not allowed.
+	// More info: https://kubernetes.io/docs/tasks/configure-pod-container/security-context/
+	// +optional
+	SecurityContext *corev1.SecurityContext `json:"securityContext,omitempty"`
+	// NodeSelector is a selector which must match the labels of a node for the pod to be scheduled on that node.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// Tolerations are the tolerations of the pod.
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+	// ServiceAccountName is the name of a service account in the namespace of the pod in the host cluster
+	// that is used to run the pod.
+	// The service account must be allowed by the container deployer.
+	// Its token is not mounted into the main container.
+	// +optional
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
 	// ImportValues contains the import values for the container.
 	// +optional
 	ImportValues json.RawMessage `json:"importValues,omitempty"`
@@ -81,6 +122,10 @@ type ProviderStatus struct {
 type PodStatus struct {
 	// PodName is the name of the created pod.
 	PodName string `json:"podName"`
+	// JobName is the name of the job that manages the pod.
+	// It is only set if the containers are executed in a job.
+	// +optional
+	JobName string `json:"jobName,omitempty"`
 	// LastRun is the time when the pod was executed the last time.
 	LastRun *metav1.Time `json:"lastRun,omitempty"`
 	// LastSuccessfulJobID is set to the current JobID when the pod successfully finished.
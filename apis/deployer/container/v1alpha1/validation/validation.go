@@ -5,11 +5,22 @@
 package validation
 
 import (
+	"fmt"
+	"path"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/gardener/landscaper/apis/core"
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	"github.com/gardener/landscaper/apis/core/validation"
+	"github.com/gardener/landscaper/apis/deployer/container"
 	containerv1alpha1 "github.com/gardener/landscaper/apis/deployer/container/v1alpha1"
 	crval "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile/validation"
 )
@@ -25,6 +36,416 @@ func ValidateProviderConfiguration(config *containerv1alpha1.ProviderConfigurati
 		allErrs = append(allErrs, validation.ValidateObjectReference(coreSecretRef, field.NewPath("registryPullSecrets").Index(i))...)
 	}
 
+	allErrs = append(allErrs, ValidateResources(field.NewPath("resources"), config.Resources)...)
+	allErrs = append(allErrs, ValidateEnv(field.NewPath("env"), config.Env)...)
+	allErrs = append(allErrs, ValidateEnvFrom(field.NewPath("envFrom"), config.EnvFrom)...)
+	allErrs = append(allErrs, ValidateVolumes(field.NewPath("volumes"), config.Volumes)...)
+	allErrs = append(allErrs, ValidateVolumeMounts(field.NewPath("volumeMounts"), config.VolumeMounts, config.Volumes)...)
+	allErrs = append(allErrs, ValidateSecurityContext(field.NewPath("securityContext"), config.SecurityContext)...)
+	allErrs = append(allErrs, metav1validation.ValidateLabels(config.NodeSelector, field.NewPath("nodeSelector"))...)
+	allErrs = append(allErrs, ValidateTolerations(field.NewPath("tolerations"), config.Tolerations)...)
+	if len(config.ServiceAccountName) != 0 {
+		for _, msg := range apivalidation.NameIsDNSSubdomain(config.ServiceAccountName, false) {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("serviceAccountName"), config.ServiceAccountName, msg))
+		}
+	}
+
 	allErrs = append(allErrs, crval.ValidateContinuousReconcileSpec(field.NewPath("continuousReconcile"), config.ContinuousReconcile)...)
 	return allErrs.ToAggregate()
 }
+
+// reservedVolumeNames are the names of the volumes of the pod that are created by the container deployer.
+var reservedVolumeNames = sets.New[string](
+	"shared-volume",
+	"serviceaccount-init",
+	"serviceaccount-wait",
+	"configuration",
+	"ocm-configuration",
+	"target",
+	"blueprint-pull-secret",
+	"cd-pull-secret",
+)
+
+// allowedSELinuxTypes are the SELinux types that are allowed by the baseline pod security standard.
+var allowedSELinuxTypes = sets.New[string](
+	"container_t",
+	"container_init_t",
+	"container_kvm_t",
+)
+
+// ValidateResources validates that the requests of the main container do not exceed its limits.
+func ValidateResources(fldPath *field.Path, resources corev1.ResourceRequirements) field.ErrorList {
+	var allErrs field.ErrorList
+	for name, request := range resources.Requests {
+		limit, ok := resources.Limits[name]
+		if ok && request.Cmp(limit) > 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("requests").Key(string(name)), request.String(),
+				fmt.Sprintf("must be less than or equal to %s limit of %s", name, limit.String())))
+		}
+	}
+	return allErrs
+}
+
+// ValidateEnv validates the additional environment variables of the main container.
+func ValidateEnv(fldPath *field.Path, env []corev1.EnvVar) field.ErrorList {
+	var allErrs field.ErrorList
+	reserved := sets.New[string](container.OperationName)
+	for _, envVar := range container.DefaultEnvVars {
+		reserved.Insert(envVar.Name)
+	}
+
+	for i, envVar := range env {
+		idxPath := fldPath.Index(i)
+		if len(envVar.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "name must not be empty"))
+		} else if reserved.Has(envVar.Name) {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("name"), fmt.Sprintf("%s is set by the container deployer", envVar.Name)))
+		}
+
+		if envVar.ValueFrom == nil {
+			continue
+		}
+		valueFromPath := idxPath.Child("valueFrom")
+		if len(envVar.Value) != 0 {
+			allErrs = append(allErrs, field.Invalid(valueFromPath, "", "must not be set if value is set"))
+		}
+		if envVar.ValueFrom.ConfigMapKeyRef != nil {
+			allErrs = append(allErrs, field.Forbidden(valueFromPath.Child("configMapKeyRef"), "only secrets are supported"))
+		}
+		if envVar.ValueFrom.FileKeyRef != nil {
+			allErrs = append(allErrs, field.Forbidden(valueFromPath.Child("fileKeyRef"), "only secrets are supported"))
+		}
+		if ref := envVar.ValueFrom.SecretKeyRef; ref != nil {
+			if len(ref.Name) == 0 {
+				allErrs = append(allErrs, field.Required(valueFromPath.Child("secretKeyRef", "name"), "name must not be empty"))
+			}
+			if len(ref.Key) == 0 {
+				allErrs = append(allErrs, field.Required(valueFromPath.Child("secretKeyRef", "key"), "key must not be empty"))
+			}
+		}
+	}
+	return allErrs
+}
+
+// ValidateEnvFrom validates that the environment variables of the main container are only read from secrets.
+func ValidateEnvFrom(fldPath *field.Path, envFrom []corev1.EnvFromSource) field.ErrorList {
+	var allErrs field.ErrorList
+	for i, source := range envFrom {
+		idxPath := fldPath.Index(i)
+		if source.ConfigMapRef != nil {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("configMapRef"), "only secrets are supported"))
+		}
+		if source.SecretRef == nil {
+			allErrs = append(allErrs, field.Required(idxPath.Child("secretRef"), "a secret must be referenced"))
+			continue
+		}
+		if len(source.SecretRef.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("secretRef", "name"), "name must not be empty"))
+		}
+	}
+	return allErrs
+}
+
+// ValidateVolumes validates the additional volumes of the pod.
+func ValidateVolumes(fldPath *field.Path, volumes []corev1.Volume) field.ErrorList {
+	var allErrs field.ErrorList
+	names := sets.New[string]()
+	for i, volume := range volumes {
+		idxPath := fldPath.Index(i)
+		namePath := idxPath.Child("name")
+		if len(volume.Name) == 0 {
+			allErrs = append(allErrs, field.Required(namePath, "name must not be empty"))
+		} else {
+			for _, msg := range utilvalidation.IsDNS1123Label(volume.Name) {
+				allErrs = append(allErrs, field.Invalid(namePath, volume.Name, msg))
+			}
+			if reservedVolumeNames.Has(volume.Name) {
+				allErrs = append(allErrs, field.Forbidden(namePath, fmt.Sprintf("volume %s is created by the container deployer", volume.Name)))
+			}
+			if names.Has(volume.Name) {
+				allErrs = append(allErrs, field.Duplicate(namePath, volume.Name))
+			}
+			names.Insert(volume.Name)
+		}
+		if volume.HostPath != nil {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("hostPath"), "host path volumes are not allowed"))
+			continue
+		}
+		if !isAllowedVolumeSource(volume.VolumeSource) {
+			allErrs = append(allErrs, field.Forbidden(idxPath, "only emptyDir, secret, configMap, downwardAPI, projected, persistentVolumeClaim and ephemeral volumes are allowed"))
+		}
+		if volume.Projected != nil {
+			for j, source := range volume.Projected.Sources {
+				sourcePath := idxPath.Child("projected", "sources").Index(j)
+				if source.ServiceAccountToken != nil {
+					allErrs = append(allErrs, field.Forbidden(sourcePath.Child("serviceAccountToken"),
+						"service account tokens must not be mounted"))
+					continue
+				}
+				if !isAllowedVolumeProjection(source) {
+					allErrs = append(allErrs, field.Forbidden(sourcePath, "only secret, configMap and downwardAPI projections are allowed"))
+				}
+			}
+		}
+	}
+	return allErrs
+}
+
+// isAllowedVolumeProjection checks whether the projection does not read credentials or resources
+// of the cluster, like cluster trust bundles or pod certificates.
+func isAllowedVolumeProjection(source corev1.VolumeProjection) bool {
+	allowed := corev1.VolumeProjection{
+		Secret:      source.Secret,
+		ConfigMap:   source.ConfigMap,
+		DownwardAPI: source.DownwardAPI,
+	}
+	return equality.Semantic.DeepEqual(allowed, source)
+}
+
+// isAllowedVolumeSource checks whether the volume does not use a volume plugin
+// that may read secrets or resources of the node.
+// A volume without source is defaulted to an emptyDir.
+func isAllowedVolumeSource(source corev1.VolumeSource) bool {
+	allowed := corev1.VolumeSource{
+		EmptyDir:              source.EmptyDir,
+		Secret:                source.Secret,
+		ConfigMap:             source.ConfigMap,
+		DownwardAPI:           source.DownwardAPI,
+		Projected:             source.Projected,
+		PersistentVolumeClaim: source.PersistentVolumeClaim,
+		Ephemeral:             source.Ephemeral,
+	}
+	return equality.Semantic.DeepEqual(allowed, source)
+}
+
+// ValidateSecurityContext validates that the security context of the main container
+// does not run the container as root or with additional privileges.
+func ValidateSecurityContext(fldPath *field.Path, securityContext *corev1.SecurityContext) field.ErrorList {
+	var allErrs field.ErrorList
+	if securityContext == nil {
+		return allErrs
+	}
+	if securityContext.Privileged != nil && *securityContext.Privileged {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("privileged"), "privileged containers are not allowed"))
+	}
+	if securityContext.AllowPrivilegeEscalation != nil && *securityContext.AllowPrivilegeEscalation {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("allowPrivilegeEscalation"), "privilege escalation is not allowed"))
+	}
+	if securityContext.RunAsUser != nil && *securityContext.RunAsUser == 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("runAsUser"), "the container must not run as root"))
+	}
+	if securityContext.RunAsGroup != nil && *securityContext.RunAsGroup == 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("runAsGroup"), "the container must not run in the root group"))
+	}
+	if securityContext.RunAsNonRoot != nil && !*securityContext.RunAsNonRoot {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("runAsNonRoot"), "the container must not run as root"))
+	}
+	if securityContext.Capabilities != nil && len(securityContext.Capabilities.Add) != 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("capabilities", "add"), "capabilities must not be added"))
+	}
+	if securityContext.ProcMount != nil && *securityContext.ProcMount != corev1.DefaultProcMount {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("procMount"), "only the default proc mount is allowed"))
+	}
+	if securityContext.SeccompProfile != nil && securityContext.SeccompProfile.Type == corev1.SeccompProfileTypeUnconfined {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("seccompProfile", "type"), "unconfined seccomp profiles are not allowed"))
+	}
+	if securityContext.AppArmorProfile != nil && securityContext.AppArmorProfile.Type == corev1.AppArmorProfileTypeUnconfined {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("appArmorProfile", "type"), "unconfined apparmor profiles are not allowed"))
+	}
+	allErrs = append(allErrs, validateSELinuxOptions(fldPath.Child("seLinuxOptions"), securityContext.SELinuxOptions)...)
+	allErrs = append(allErrs, validateWindowsOptions(fldPath.Child("windowsOptions"), securityContext.WindowsOptions)...)
+	return allErrs
+}
+
+// validateSELinuxOptions validates that the SELinux options of a pod or container only use the container types
+// and no custom user or role, as required by the baseline pod security standard.
+func validateSELinuxOptions(fldPath *field.Path, options *corev1.SELinuxOptions) field.ErrorList {
+	var allErrs field.ErrorList
+	if options == nil {
+		return allErrs
+	}
+	if len(options.Type) != 0 && !allowedSELinuxTypes.Has(options.Type) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("type"), options.Type, sets.List(allowedSELinuxTypes)))
+	}
+	if len(options.User) != 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("user"), "custom SELinux users are not allowed"))
+	}
+	if len(options.Role) != 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("role"), "custom SELinux roles are not allowed"))
+	}
+	return allErrs
+}
+
+// validateWindowsOptions validates that a pod or container does not run as a windows host process.
+func validateWindowsOptions(fldPath *field.Path, options *corev1.WindowsSecurityContextOptions) field.ErrorList {
+	var allErrs field.ErrorList
+	if options != nil && options.HostProcess != nil && *options.HostProcess {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("hostProcess"), "host processes are not allowed"))
+	}
+	return allErrs
+}
+
+// ValidateHostAccess validates that the provider configuration only uses the service account and secrets
+// in the host cluster that are allowed by the configuration of the container deployer.
+func ValidateHostAccess(config *containerv1alpha1.ProviderConfiguration, hostAccess containerv1alpha1.HostAccess) error {
+	var allErrs field.ErrorList
+	serviceAccounts := sets.New[string](hostAccess.ServiceAccountNames...)
+	secrets := sets.New[string](hostAccess.SecretNames...)
+
+	if len(config.ServiceAccountName) != 0 && !serviceAccounts.Has(config.ServiceAccountName) {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("serviceAccountName"),
+			fmt.Sprintf("service account %s is not allowed by the container deployer", config.ServiceAccountName)))
+	}
+
+	validateSecretName := func(fldPath *field.Path, name string) {
+		if len(name) != 0 && !secrets.Has(name) {
+			allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("secret %s is not allowed by the container deployer", name)))
+		}
+	}
+	envPath := field.NewPath("env")
+	for i, envVar := range config.Env {
+		if envVar.ValueFrom != nil && envVar.ValueFrom.SecretKeyRef != nil {
+			validateSecretName(envPath.Index(i).Child("valueFrom", "secretKeyRef", "name"), envVar.ValueFrom.SecretKeyRef.Name)
+		}
+	}
+	envFromPath := field.NewPath("envFrom")
+	for i, source := range config.EnvFrom {
+		if source.SecretRef != nil {
+			validateSecretName(envFromPath.Index(i).Child("secretRef", "name"), source.SecretRef.Name)
+		}
+	}
+	volumesPath := field.NewPath("volumes")
+	for i, volume := range config.Volumes {
+		if volume.Secret != nil {
+			validateSecretName(volumesPath.Index(i).Child("secret", "secretName"), volume.Secret.SecretName)
+		}
+		if volume.Projected != nil {
+			for j, source := range volume.Projected.Sources {
+				if source.Secret != nil {
+					validateSecretName(volumesPath.Index(i).Child("projected", "sources").Index(j).Child("secret", "name"), source.Secret.Name)
+				}
+			}
+		}
+	}
+	return allErrs.ToAggregate()
+}
+
+// ValidateVolumeMounts validates the additional volume mounts of the main container.
+// The mounts must reference one of the given volumes.
+func ValidateVolumeMounts(fldPath *field.Path, mounts []corev1.VolumeMount, volumes []corev1.Volume) field.ErrorList {
+	var allErrs field.ErrorList
+	volumeNames := sets.New[string]()
+	for _, volume := range volumes {
+		volumeNames.Insert(volume.Name)
+	}
+
+	mountPaths := sets.New[string]()
+	for i, mount := range mounts {
+		idxPath := fldPath.Index(i)
+		if len(mount.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "name must not be empty"))
+		} else if !volumeNames.Has(mount.Name) {
+			allErrs = append(allErrs, field.NotFound(idxPath.Child("name"), mount.Name))
+		}
+
+		mountPathPath := idxPath.Child("mountPath")
+		if len(mount.MountPath) == 0 {
+			allErrs = append(allErrs, field.Required(mountPathPath, "mount path must not be empty"))
+			continue
+		}
+		if !path.IsAbs(mount.MountPath) {
+			allErrs = append(allErrs, field.Invalid(mountPathPath, mount.MountPath, "must be an absolute path"))
+			continue
+		}
+		cleaned := path.Clean(mount.MountPath)
+		if cleaned == container.BasePath || strings.HasPrefix(cleaned, container.BasePath+"/") {
+			allErrs = append(allErrs, field.Forbidden(mountPathPath, fmt.Sprintf("must not be in the directory %s of the container deployer", container.BasePath)))
+		}
+		if mountPaths.Has(cleaned) {
+			allErrs = append(allErrs, field.Duplicate(mountPathPath, mount.MountPath))
+		}
+		mountPaths.Insert(cleaned)
+	}
+	return allErrs
+}
+
+// ValidateTolerations validates the tolerations of the pod.
+func ValidateTolerations(fldPath *field.Path, tolerations []corev1.Toleration) field.ErrorList {
+	var allErrs field.ErrorList
+	for i, toleration := range tolerations {
+		idxPath := fldPath.Index(i)
+		if len(toleration.Key) != 0 {
+			for _, msg := range utilvalidation.IsQualifiedName(toleration.Key) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("key"), toleration.Key, msg))
+			}
+		}
+
+		switch toleration.Operator {
+		case corev1.TolerationOpEqual, "":
+			if len(toleration.Key) == 0 {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("operator"), toleration.Operator,
+					"operator must be Exists when key is empty"))
+			}
+		case corev1.TolerationOpExists:
+			if len(toleration.Value) != 0 {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("value"), toleration.Value,
+					"value must be empty when operator is Exists"))
+			}
+		default:
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("operator"), toleration.Operator,
+				[]corev1.TolerationOperator{corev1.TolerationOpEqual, corev1.TolerationOpExists}))
+		}
+
+		switch toleration.Effect {
+		case "", corev1.TaintEffectNoSchedule, corev1.TaintEffectPreferNoSchedule, corev1.TaintEffectNoExecute:
+		default:
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("effect"), toleration.Effect,
+				[]corev1.TaintEffect{corev1.TaintEffectNoSchedule, corev1.TaintEffectPreferNoSchedule, corev1.TaintEffectNoExecute}))
+		}
+	}
+	return allErrs
+}
+
+// ValidateConfiguration validates the configuration of the container deployer.
+func ValidateConfiguration(config *containerv1alpha1.Configuration) error {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, ValidateExecution(field.NewPath("execution"), config.Execution)...)
+
+	hostAccessPath := field.NewPath("hostAccess")
+	for i, name := range config.HostAccess.ServiceAccountNames {
+		for _, msg := range apivalidation.NameIsDNSSubdomain(name, false) {
+			allErrs = append(allErrs, field.Invalid(hostAccessPath.Child("serviceAccountNames").Index(i), name, msg))
+		}
+	}
+	for i, name := range config.HostAccess.SecretNames {
+		for _, msg := range apivalidation.NameIsDNSSubdomain(name, false) {
+			allErrs = append(allErrs, field.Invalid(hostAccessPath.Child("secretNames").Index(i), name, msg))
+		}
+	}
+	return allErrs.ToAggregate()
+}
+
+// ValidateExecution validates the execution configuration of the container deployer.
+func ValidateExecution(fldPath *field.Path, execution containerv1alpha1.Execution) field.ErrorList {
+	var allErrs field.ErrorList
+	switch execution.Mode {
+	case "", containerv1alpha1.ExecutionModePod, containerv1alpha1.ExecutionModeJob:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("mode"), execution.Mode,
+			[]containerv1alpha1.ExecutionMode{containerv1alpha1.ExecutionModePod, containerv1alpha1.ExecutionModeJob}))
+	}
+
+	if execution.Job != nil {
+		jobPath := fldPath.Child("job")
+		if execution.Job.BackoffLimit != nil && *execution.Job.BackoffLimit < 0 {
+			allErrs = append(allErrs, field.Invalid(jobPath.Child("backoffLimit"), *execution.Job.BackoffLimit, "must not be negative"))
+		}
+		if execution.Job.ActiveDeadlineSeconds != nil && *execution.Job.ActiveDeadlineSeconds <= 0 {
+			allErrs = append(allErrs, field.Invalid(jobPath.Child("activeDeadlineSeconds"), *execution.Job.ActiveDeadlineSeconds, "must be greater than 0"))
+		}
+		if execution.Job.TTLSecondsAfterFinished != nil && *execution.Job.TTLSecondsAfterFinished < 0 {
+			allErrs = append(allErrs, field.Invalid(jobPath.Child("ttlSecondsAfterFinished"), *execution.Job.TTLSecondsAfterFinished, "must not be negative"))
+		}
+	}
+	return allErrs
+}
@@ -0,0 +1,336 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/landscaper/apis/deployer/container"
+	containerv1alpha1 "github.com/gardener/landscaper/apis/deployer/container/v1alpha1"
+	"github.com/gardener/landscaper/apis/deployer/container/v1alpha1/validation"
+)
+
+var _ = Describe("ProviderConfiguration", func() {
+
+	Context("ValidateResources", func() {
+		It("should pass if the requests do not exceed the limits", func() {
+			resources := corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+				Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("2Gi")},
+			}
+			Expect(validation.ValidateResources(field.NewPath("resources"), resources)).To(HaveLen(0))
+		})
+
+		It("should fail if a request exceeds its limit", func() {
+			resources := corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("4Gi")},
+				Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("2Gi")},
+			}
+			allErrs := validation.ValidateResources(field.NewPath("resources"), resources)
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("resources.requests[memory]"),
+			}))))
+		})
+	})
+
+	Context("ValidateEnv", func() {
+		It("should pass if values are taken from secrets", func() {
+			env := []corev1.EnvVar{
+				{Name: "FOO", Value: "bar"},
+				{Name: "TOKEN", ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "creds"},
+						Key:                  "token",
+					},
+				}},
+			}
+			Expect(validation.ValidateEnv(field.NewPath("env"), env)).To(HaveLen(0))
+		})
+
+		It("should fail if a variable of the container deployer is overwritten", func() {
+			env := []corev1.EnvVar{{Name: container.OperationName, Value: "DELETE"}}
+			allErrs := validation.ValidateEnv(field.NewPath("env"), env)
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("env[0].name"),
+			}))))
+		})
+
+		It("should fail if a value is taken from a config map", func() {
+			env := []corev1.EnvVar{{Name: "FOO", ValueFrom: &corev1.EnvVarSource{
+				ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "config"},
+					Key:                  "foo",
+				},
+			}}}
+			allErrs := validation.ValidateEnv(field.NewPath("env"), env)
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("env[0].valueFrom.configMapKeyRef"),
+			}))))
+		})
+	})
+
+	Context("ValidateEnvFrom", func() {
+		It("should fail if no secret is referenced", func() {
+			envFrom := []corev1.EnvFromSource{{ConfigMapRef: &corev1.ConfigMapEnvSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "config"},
+			}}}
+			allErrs := validation.ValidateEnvFrom(field.NewPath("envFrom"), envFrom)
+			Expect(allErrs).To(ContainElements(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("envFrom[0].configMapRef"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("envFrom[0].secretRef"),
+				})),
+			))
+		})
+	})
+
+	Context("ValidateVolumes", func() {
+		It("should fail if a volume of the container deployer is redefined", func() {
+			volumes := []corev1.Volume{{Name: "shared-volume"}}
+			allErrs := validation.ValidateVolumes(field.NewPath("volumes"), volumes)
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("volumes[0].name"),
+			}))))
+		})
+
+		It("should fail for host path volumes", func() {
+			volumes := []corev1.Volume{{
+				Name:         "host",
+				VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: "/"}},
+			}}
+			allErrs := validation.ValidateVolumes(field.NewPath("volumes"), volumes)
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("volumes[0].hostPath"),
+			}))))
+		})
+
+		It("should fail for service account token projections", func() {
+			volumes := []corev1.Volume{{
+				Name: "token",
+				VolumeSource: corev1.VolumeSource{Projected: &corev1.ProjectedVolumeSource{
+					Sources: []corev1.VolumeProjection{{ServiceAccountToken: &corev1.ServiceAccountTokenProjection{Path: "token"}}},
+				}},
+			}}
+			allErrs := validation.ValidateVolumes(field.NewPath("volumes"), volumes)
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("volumes[0].projected.sources[0].serviceAccountToken"),
+			}))))
+		})
+
+		It("should fail for projections that are not allowed", func() {
+			volumes := []corev1.Volume{{
+				Name: "bundle",
+				VolumeSource: corev1.VolumeSource{Projected: &corev1.ProjectedVolumeSource{
+					Sources: []corev1.VolumeProjection{
+						{Secret: &corev1.SecretProjection{LocalObjectReference: corev1.LocalObjectReference{Name: "my-secret"}}},
+						{ClusterTrustBundle: &corev1.ClusterTrustBundleProjection{Name: ptr.To("my-bundle"), Path: "ca.crt"}},
+					},
+				}},
+			}}
+			allErrs := validation.ValidateVolumes(field.NewPath("volumes"), volumes)
+			Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("volumes[0].projected.sources[1]"),
+			}))))
+		})
+
+		It("should fail for volume plugins that are not allowed", func() {
+			volumes := []corev1.Volume{{
+				Name:         "csi",
+				VolumeSource: corev1.VolumeSource{CSI: &corev1.CSIVolumeSource{Driver: "secrets-store.csi.k8s.io"}},
+			}}
+			allErrs := validation.ValidateVolumes(field.NewPath("volumes"), volumes)
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("volumes[0]"),
+			}))))
+		})
+
+		It("should fail for duplicated volume names", func() {
+			volumes := []corev1.Volume{{Name: "cache"}, {Name: "cache"}}
+			allErrs := validation.ValidateVolumes(field.NewPath("volumes"), volumes)
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("volumes[1].name"),
+			}))))
+		})
+	})
+
+	Context("ValidateVolumeMounts", func() {
+		volumes := []corev1.Volume{{Name: "cache"}}
+
+		It("should pass if a defined volume is mounted", func() {
+			mounts := []corev1.VolumeMount{{Name: "cache", MountPath: "/cache"}}
+			Expect(validation.ValidateVolumeMounts(field.NewPath("volumeMounts"), mounts, volumes)).To(HaveLen(0))
+		})
+
+		It("should fail if the volume is not defined", func() {
+			mounts := []corev1.VolumeMount{{Name: "unknown", MountPath: "/cache"}}
+			allErrs := validation.ValidateVolumeMounts(field.NewPath("volumeMounts"), mounts, volumes)
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotFound),
+				"Field": Equal("volumeMounts[0].name"),
+			}))))
+		})
+
+		It("should fail if the volume is mounted into the data directory of the container deployer", func() {
+			mounts := []corev1.VolumeMount{{Name: "cache", MountPath: container.ExportsPath}}
+			allErrs := validation.ValidateVolumeMounts(field.NewPath("volumeMounts"), mounts, volumes)
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("volumeMounts[0].mountPath"),
+			}))))
+		})
+	})
+
+	Context("ValidateSecurityContext", func() {
+		It("should pass for a restricted security context", func() {
+			securityContext := &corev1.SecurityContext{
+				RunAsUser:                ptr.To[int64](1000),
+				AllowPrivilegeEscalation: ptr.To(false),
+				Capabilities:             &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}},
+			}
+			Expect(validation.ValidateSecurityContext(field.NewPath("securityContext"), securityContext)).To(HaveLen(0))
+		})
+
+		It("should fail for privileged and root settings", func() {
+			securityContext := &corev1.SecurityContext{
+				Privileged:               ptr.To(true),
+				AllowPrivilegeEscalation: ptr.To(true),
+				RunAsUser:                ptr.To[int64](0),
+				Capabilities:             &corev1.Capabilities{Add: []corev1.Capability{"SYS_ADMIN"}},
+			}
+			allErrs := validation.ValidateSecurityContext(field.NewPath("securityContext"), securityContext)
+			Expect(allErrs).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeForbidden), "Field": Equal("securityContext.privileged")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeForbidden), "Field": Equal("securityContext.allowPrivilegeEscalation")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeForbidden), "Field": Equal("securityContext.runAsUser")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeForbidden), "Field": Equal("securityContext.capabilities.add")})),
+			))
+		})
+
+		It("should pass for the SELinux types of containers", func() {
+			securityContext := &corev1.SecurityContext{
+				SELinuxOptions: &corev1.SELinuxOptions{Type: "container_init_t", Level: "s0:c123,c456"},
+				WindowsOptions: &corev1.WindowsSecurityContextOptions{HostProcess: ptr.To(false)},
+			}
+			Expect(validation.ValidateSecurityContext(field.NewPath("securityContext"), securityContext)).To(HaveLen(0))
+		})
+
+		It("should fail for SELinux options and host processes that are not allowed by the baseline pod security standard", func() {
+			securityContext := &corev1.SecurityContext{
+				SELinuxOptions: &corev1.SELinuxOptions{Type: "spc_t", User: "system_u", Role: "system_r"},
+				WindowsOptions: &corev1.WindowsSecurityContextOptions{HostProcess: ptr.To(true)},
+			}
+			allErrs := validation.ValidateSecurityContext(field.NewPath("securityContext"), securityContext)
+			Expect(allErrs).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeNotSupported), "Field": Equal("securityContext.seLinuxOptions.type")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeForbidden), "Field": Equal("securityContext.seLinuxOptions.user")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeForbidden), "Field": Equal("securityContext.seLinuxOptions.role")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeForbidden), "Field": Equal("securityContext.windowsOptions.hostProcess")})),
+			))
+		})
+	})
+
+	Context("ValidateHostAccess", func() {
+		hostAccess := containerv1alpha1.HostAccess{
+			ServiceAccountNames: []string{"terraform"},
+			SecretNames:         []string{"terraform-creds"},
+		}
+
+		It("should pass if only allowed service accounts and secrets are used", func() {
+			config := &containerv1alpha1.ProviderConfiguration{
+				ServiceAccountName: "terraform",
+				EnvFrom: []corev1.EnvFromSource{{SecretRef: &corev1.SecretEnvSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "terraform-creds"},
+				}}},
+				Volumes: []corev1.Volume{{
+					Name:         "creds",
+					VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "terraform-creds"}},
+				}},
+			}
+			Expect(validation.ValidateHostAccess(config, hostAccess)).To(Succeed())
+		})
+
+		It("should fail if a service account is not allowed", func() {
+			config := &containerv1alpha1.ProviderConfiguration{ServiceAccountName: "container-deployer"}
+			err := validation.ValidateHostAccess(config, hostAccess)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("serviceAccountName"))
+		})
+
+		It("should fail if secrets are not allowed", func() {
+			config := &containerv1alpha1.ProviderConfiguration{
+				Env: []corev1.EnvVar{{Name: "TOKEN", ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "other-item-state"},
+						Key:                  "token",
+					},
+				}}},
+				EnvFrom: []corev1.EnvFromSource{{SecretRef: &corev1.SecretEnvSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "other-item-target"},
+				}}},
+				Volumes: []corev1.Volume{{
+					Name: "creds",
+					VolumeSource: corev1.VolumeSource{Projected: &corev1.ProjectedVolumeSource{
+						Sources: []corev1.VolumeProjection{{Secret: &corev1.SecretProjection{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "other-item-config"},
+						}}},
+					}},
+				}},
+			}
+			err := validation.ValidateHostAccess(config, hostAccess)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("env[0].valueFrom.secretKeyRef.name"))
+			Expect(err.Error()).To(ContainSubstring("envFrom[0].secretRef.name"))
+			Expect(err.Error()).To(ContainSubstring("volumes[0].projected.sources[0].secret.name"))
+		})
+	})
+
+	Context("ValidateTolerations", func() {
+		It("should pass for valid tolerations", func() {
+			tolerations := []corev1.Toleration{
+				{Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "terraform", Effect: corev1.TaintEffectNoSchedule},
+				{Operator: corev1.TolerationOpExists},
+			}
+			Expect(validation.ValidateTolerations(field.NewPath("tolerations"), tolerations)).To(HaveLen(0))
+		})
+
+		It("should fail if a value is set for the exists operator", func() {
+			tolerations := []corev1.Toleration{{Key: "dedicated", Operator: corev1.TolerationOpExists, Value: "terraform"}}
+			allErrs := validation.ValidateTolerations(field.NewPath("tolerations"), tolerations)
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("tolerations[0].value"),
+			}))))
+		})
+
+		It("should fail for an unknown effect", func() {
+			tolerations := []corev1.Toleration{{Key: "dedicated", Effect: "Unknown"}}
+			allErrs := validation.ValidateTolerations(field.NewPath("tolerations"), tolerations)
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("tolerations[0].effect"),
+			}))))
+		})
+	})
+
+})
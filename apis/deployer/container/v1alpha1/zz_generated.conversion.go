@@ -80,6 +80,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*Execution)(nil), (*container.Execution)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Execution_To_container_Execution(a.(*Execution), b.(*container.Execution), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*container.Execution)(nil), (*Execution)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_container_Execution_To_v1alpha1_Execution(a.(*container.Execution), b.(*Execution), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*GarbageCollection)(nil), (*container.GarbageCollection)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_GarbageCollection_To_container_GarbageCollection(a.(*GarbageCollection), b.(*container.GarbageCollection), scope)
 	}); err != nil {
@@ -100,6 +110,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*HostAccess)(nil), (*container.HostAccess)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_HostAccess_To_container_HostAccess(a.(*HostAccess), b.(*container.HostAccess), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*container.HostAccess)(nil), (*HostAccess)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_container_HostAccess_To_v1alpha1_HostAccess(a.(*container.HostAccess), b.(*HostAccess), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*JobConfiguration)(nil), (*container.JobConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_JobConfiguration_To_container_JobConfiguration(a.(*JobConfiguration), b.(*container.JobConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*container.JobConfiguration)(nil), (*JobConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_container_JobConfiguration_To_v1alpha1_JobConfiguration(a.(*container.JobConfiguration), b.(*JobConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*PodStatus)(nil), (*container.PodStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_PodStatus_To_container_PodStatus(a.(*PodStatus), b.(*container.PodStatus), scope)
 	}); err != nil {
@@ -150,6 +180,12 @@ func autoConvert_v1alpha1_Configuration_To_container_Configuration(in *Configura
 	if err := Convert_v1alpha1_GarbageCollection_To_container_GarbageCollection(&in.GarbageCollection, &out.GarbageCollection, s); err != nil {
 		return err
 	}
+	if err := Convert_v1alpha1_Execution_To_container_Execution(&in.Execution, &out.Execution, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_HostAccess_To_container_HostAccess(&in.HostAccess, &out.HostAccess, s); err != nil {
+		return err
+	}
 	out.DebugOptions = (*container.DebugOptions)(unsafe.Pointer(in.DebugOptions))
 	out.HPAConfiguration = (*container.HPAConfiguration)(unsafe.Pointer(in.HPAConfiguration))
 	if err := Convert_v1alpha1_Controller_To_container_Controller(&in.Controller, &out.Controller, s); err != nil {
@@ -180,6 +216,12 @@ func autoConvert_container_Configuration_To_v1alpha1_Configuration(in *container
 	if err := Convert_container_GarbageCollection_To_v1alpha1_GarbageCollection(&in.GarbageCollection, &out.GarbageCollection, s); err != nil {
 		return err
 	}
+	if err := Convert_container_Execution_To_v1alpha1_Execution(&in.Execution, &out.Execution, s); err != nil {
+		return err
+	}
+	if err := Convert_container_HostAccess_To_v1alpha1_HostAccess(&in.HostAccess, &out.HostAccess, s); err != nil {
+		return err
+	}
 	out.DebugOptions = (*DebugOptions)(unsafe.Pointer(in.DebugOptions))
 	out.HPAConfiguration = (*HPAConfiguration)(unsafe.Pointer(in.HPAConfiguration))
 	if err := Convert_container_Controller_To_v1alpha1_Controller(&in.Controller, &out.Controller, s); err != nil {
@@ -289,6 +331,28 @@ func Convert_container_DebugOptions_To_v1alpha1_DebugOptions(in *container.Debug
 	return autoConvert_container_DebugOptions_To_v1alpha1_DebugOptions(in, out, s)
 }
 
+func autoConvert_v1alpha1_Execution_To_container_Execution(in *Execution, out *container.Execution, s conversion.Scope) error {
+	out.Mode = container.ExecutionMode(in.Mode)
+	out.Job = (*container.JobConfiguration)(unsafe.Pointer(in.Job))
+	return nil
+}
+
+// Convert_v1alpha1_Execution_To_container_Execution is an autogenerated conversion function.
+func Convert_v1alpha1_Execution_To_container_Execution(in *Execution, out *container.Execution, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Execution_To_container_Execution(in, out, s)
+}
+
+func autoConvert_container_Execution_To_v1alpha1_Execution(in *container.Execution, out *Execution, s conversion.Scope) error {
+	out.Mode = ExecutionMode(in.Mode)
+	out.Job = (*JobConfiguration)(unsafe.Pointer(in.Job))
+	return nil
+}
+
+// Convert_container_Execution_To_v1alpha1_Execution is an autogenerated conversion function.
+func Convert_container_Execution_To_v1alpha1_Execution(in *container.Execution, out *Execution, s conversion.Scope) error {
+	return autoConvert_container_Execution_To_v1alpha1_Execution(in, out, s)
+}
+
 func autoConvert_v1alpha1_GarbageCollection_To_container_GarbageCollection(in *GarbageCollection, out *container.GarbageCollection, s conversion.Scope) error {
 	out.Disable = in.Disable
 	out.Worker = in.Worker
@@ -333,8 +397,55 @@ func Convert_container_HPAConfiguration_To_v1alpha1_HPAConfiguration(in *contain
 	return autoConvert_container_HPAConfiguration_To_v1alpha1_HPAConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_HostAccess_To_container_HostAccess(in *HostAccess, out *container.HostAccess, s conversion.Scope) error {
+	out.ServiceAccountNames = *(*[]string)(unsafe.Pointer(&in.ServiceAccountNames))
+	out.SecretNames = *(*[]string)(unsafe.Pointer(&in.SecretNames))
+	return nil
+}
+
+// Convert_v1alpha1_HostAccess_To_container_HostAccess is an autogenerated conversion function.
+func Convert_v1alpha1_HostAccess_To_container_HostAccess(in *HostAccess, out *container.HostAccess, s conversion.Scope) error {
+	return autoConvert_v1alpha1_HostAccess_To_container_HostAccess(in, out, s)
+}
+
+func autoConvert_container_HostAccess_To_v1alpha1_HostAccess(in *container.HostAccess, out *HostAccess, s conversion.Scope) error {
+	out.ServiceAccountNames = *(*[]string)(unsafe.Pointer(&in.ServiceAccountNames))
+	out.SecretNames = *(*[]string)(unsafe.Pointer(&in.SecretNames))
+	return nil
+}
+
+// Convert_container_HostAccess_To_v1alpha1_HostAccess is an autogenerated conversion function.
+func Convert_container_HostAccess_To_v1alpha1_HostAccess(in *container.HostAccess, out *HostAccess, s conversion.Scope) error {
+	return autoConvert_container_HostAccess_To_v1alpha1_HostAccess(in, out, s)
+}
+
+func autoConvert_v1alpha1_JobConfiguration_To_container_JobConfiguration(in *JobConfiguration, out *container.JobConfiguration, s conversion.Scope) error {
+	out.BackoffLimit = (*int32)(unsafe.Pointer(in.BackoffLimit))
+	out.ActiveDeadlineSeconds = (*int64)(unsafe.Pointer(in.ActiveDeadlineSeconds))
+	out.TTLSecondsAfterFinished = (*int32)(unsafe.Pointer(in.TTLSecondsAfterFinished))
+	return nil
+}
+
+// Convert_v1alpha1_JobConfiguration_To_container_JobConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_JobConfiguration_To_container_JobConfiguration(in *JobConfiguration, out *container.JobConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_JobConfiguration_To_container_JobConfiguration(in, out, s)
+}
+
+func autoConvert_container_JobConfiguration_To_v1alpha1_JobConfiguration(in *container.JobConfiguration, out *JobConfiguration, s conversion.Scope) error {
+	out.BackoffLimit = (*int32)(unsafe.Pointer(in.BackoffLimit))
+	out.ActiveDeadlineSeconds = (*int64)(unsafe.Pointer(in.ActiveDeadlineSeconds))
+	out.TTLSecondsAfterFinished = (*int32)(unsafe.Pointer(in.TTLSecondsAfterFinished))
+	return nil
+}
+
+// Convert_container_JobConfiguration_To_v1alpha1_JobConfiguration is an autogenerated conversion function.
+func Convert_container_JobConfiguration_To_v1alpha1_JobConfiguration(in *container.JobConfiguration, out *JobConfiguration, s conversion.Scope) error {
+	return autoConvert_container_JobConfiguration_To_v1alpha1_JobConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_PodStatus_To_container_PodStatus(in *PodStatus, out *container.PodStatus, s conversion.Scope) error {
 	out.PodName = in.PodName
+	out.JobName = in.JobName
 	out.LastRun = (*metav1.Time)(unsafe.Pointer(in.LastRun))
 	out.LastSuccessfulJobID = (*string)(unsafe.Pointer(in.LastSuccessfulJobID))
 	if err := Convert_v1alpha1_ContainerStatus_To_container_ContainerStatus(&in.ContainerStatus, &out.ContainerStatus, s); err != nil {
@@ -356,6 +467,7 @@ func Convert_v1alpha1_PodStatus_To_container_PodStatus(in *PodStatus, out *conta
 
 func autoConvert_container_PodStatus_To_v1alpha1_PodStatus(in *container.PodStatus, out *PodStatus, s conversion.Scope) error {
 	out.PodName = in.PodName
+	out.JobName = in.JobName
 	out.LastRun = (*metav1.Time)(unsafe.Pointer(in.LastRun))
 	out.LastSuccessfulJobID = (*string)(unsafe.Pointer(in.LastSuccessfulJobID))
 	if err := Convert_container_ContainerStatus_To_v1alpha1_ContainerStatus(&in.ContainerStatus, &out.ContainerStatus, s); err != nil {
@@ -379,6 +491,15 @@ func autoConvert_v1alpha1_ProviderConfiguration_To_container_ProviderConfigurati
 	out.Image = in.Image
 	out.Command = *(*[]string)(unsafe.Pointer(&in.Command))
 	out.Args = *(*[]string)(unsafe.Pointer(&in.Args))
+	out.Resources = in.Resources
+	out.Env = *(*[]v1.EnvVar)(unsafe.Pointer(&in.Env))
+	out.EnvFrom = *(*[]v1.EnvFromSource)(unsafe.Pointer(&in.EnvFrom))
+	out.Volumes = *(*[]v1.Volume)(unsafe.Pointer(&in.Volumes))
+	out.VolumeMounts = *(*[]v1.VolumeMount)(unsafe.Pointer(&in.VolumeMounts))
+	out.SecurityContext = (*v1.SecurityContext)(unsafe.Pointer(in.SecurityContext))
+	out.NodeSelector = *(*map[string]string)(unsafe.Pointer(&in.NodeSelector))
+	out.Tolerations = *(*[]v1.Toleration)(unsafe.Pointer(&in.Tolerations))
+	out.ServiceAccountName = in.ServiceAccountName
 	out.ImportValues = *(*json.RawMessage)(unsafe.Pointer(&in.ImportValues))
 	out.Blueprint = (*corev1alpha1.BlueprintDefinition)(unsafe.Pointer(in.Blueprint))
 	out.ComponentDescriptor = (*corev1alpha1.ComponentDescriptorDefinition)(unsafe.Pointer(in.ComponentDescriptor))
@@ -396,6 +517,15 @@ func autoConvert_container_ProviderConfiguration_To_v1alpha1_ProviderConfigurati
 	out.Image = in.Image
 	out.Command = *(*[]string)(unsafe.Pointer(&in.Command))
 	out.Args = *(*[]string)(unsafe.Pointer(&in.Args))
+	out.Resources = in.Resources
+	out.Env = *(*[]v1.EnvVar)(unsafe.Pointer(&in.Env))
+	out.EnvFrom = *(*[]v1.EnvFromSource)(unsafe.Pointer(&in.EnvFrom))
+	out.Volumes = *(*[]v1.Volume)(unsafe.Pointer(&in.Volumes))
+	out.VolumeMounts = *(*[]v1.VolumeMount)(unsafe.Pointer(&in.VolumeMounts))
+	out.SecurityContext = (*v1.SecurityContext)(unsafe.Pointer(in.SecurityContext))
+	out.NodeSelector = *(*map[string]string)(unsafe.Pointer(&in.NodeSelector))
+	out.Tolerations = *(*[]v1.Toleration)(unsafe.Pointer(&in.Tolerations))
+	out.ServiceAccountName = in.ServiceAccountName
 	out.ImportValues = *(*json.RawMessage)(unsafe.Pointer(&in.ImportValues))
 	out.Blueprint = (*corev1alpha1.BlueprintDefinition)(unsafe.Pointer(in.Blueprint))
 	out.ComponentDescriptor = (*corev1alpha1.ComponentDescriptorDefinition)(unsafe.Pointer(in.ComponentDescriptor))
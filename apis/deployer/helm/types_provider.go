@@ -35,6 +35,11 @@ type ProviderConfiguration struct {
 	// +optional
 	UpdateStrategy UpdateStrategy `json:"updateStrategy,omitempty"`
 
+	// ServerSideApply configures the server-side apply of the manifests.
+	// Only relevant if the update strategy is "serverSideApply" and HelmDeployment is false.
+	// +optional
+	ServerSideApply *managedresource.ServerSideApplyConfiguration `json:"serverSideApply,omitempty"`
+
 	// Chart defines helm chart to be templated and applied.
 	Chart Chart `json:"chart"`
 
@@ -63,6 +68,9 @@ type ProviderConfiguration struct {
 	// ContinuousReconcile contains the schedule for continuous reconciliation.
 	// +optional
 	ContinuousReconcile *cr.ContinuousReconcileSpec `json:"continuousReconcile,omitempty"`
+	// DriftDetection configures how the deployer reacts if managed resources are modified or deleted out-of-band.
+	// +optional
+	DriftDetection *managedresource.DriftDetectionSpec `json:"driftDetection,omitempty"`
 
 	// HelmDeployment indicates that helm is used as complete deployment mechanism and not only helm templating.
 	// Default is true.
@@ -88,6 +96,9 @@ type UpdateStrategy string
 const (
 	UpdateStrategyUpdate UpdateStrategy = "update"
 	UpdateStrategyPatch  UpdateStrategy = "patch"
+	// UpdateStrategyServerSideApply applies the manifests with server-side apply,
+	// so that the ownership of the fields is tracked by the api server.
+	UpdateStrategyServerSideApply UpdateStrategy = "serverSideApply"
 )
 
 // Chart defines the helm chart to render and apply.
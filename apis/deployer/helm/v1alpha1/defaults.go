@@ -6,6 +6,8 @@ package v1alpha1
 
 import (
 	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
 )
 
 func addDefaultingFuncs(scheme *runtime.Scheme) error {
@@ -17,4 +19,15 @@ func SetDefaults_ProviderConfiguration(obj *ProviderConfiguration) {
 	if len(obj.UpdateStrategy) == 0 {
 		obj.UpdateStrategy = UpdateStrategyUpdate
 	}
+	if obj.UpdateStrategy == UpdateStrategyServerSideApply {
+		if obj.ServerSideApply == nil {
+			obj.ServerSideApply = &managedresource.ServerSideApplyConfiguration{}
+		}
+		if len(obj.ServerSideApply.FieldManager) == 0 {
+			obj.ServerSideApply.FieldManager = managedresource.DefaultFieldManager
+		}
+		if len(obj.ServerSideApply.ConflictPolicy) == 0 {
+			obj.ServerSideApply.ConflictPolicy = managedresource.ConflictPolicyForce
+		}
+	}
 }
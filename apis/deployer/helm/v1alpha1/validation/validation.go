@@ -34,6 +34,8 @@ func ValidateProviderConfiguration(config *helmv1alpha1.ProviderConfiguration) e
 	allErrs = append(allErrs, ValidateHelmDeploymentConfiguration(field.NewPath("helmDeploymentConfig"), config.HelmDeploymentConfig)...)
 	allErrs = append(allErrs, crval.ValidateContinuousReconcileSpec(field.NewPath("continuousReconcile"), config.ContinuousReconcile)...)
 	allErrs = append(allErrs, validation.ValidateDeletionGroups(field.NewPath("deletionGroups"), config.DeletionGroups)...)
+	allErrs = append(allErrs, validation.ValidateServerSideApplyConfiguration(field.NewPath("serverSideApply"), config.ServerSideApply)...)
+	allErrs = append(allErrs, validation.ValidateDriftDetectionSpec(field.NewPath("driftDetection"), config.DriftDetection)...)
 
 	if len(config.Name) == 0 {
 		allErrs = append(allErrs, field.Required(field.NewPath("name"), "must not be empty"))
@@ -489,6 +489,7 @@ func Convert_helm_HelmUninstallConfiguration_To_v1alpha1_HelmUninstallConfigurat
 
 func autoConvert_v1alpha1_ProviderConfiguration_To_helm_ProviderConfiguration(in *ProviderConfiguration, out *helm.ProviderConfiguration, s conversion.Scope) error {
 	out.UpdateStrategy = helm.UpdateStrategy(in.UpdateStrategy)
+	out.ServerSideApply = (*managedresource.ServerSideApplyConfiguration)(unsafe.Pointer(in.ServerSideApply))
 	out.ReadinessChecks = in.ReadinessChecks
 	if err := Convert_v1alpha1_Chart_To_helm_Chart(&in.Chart, &out.Chart, s); err != nil {
 		return err
@@ -500,6 +501,7 @@ func autoConvert_v1alpha1_ProviderConfiguration_To_helm_ProviderConfiguration(in
 	out.ExportsFromManifests = *(*[]managedresource.Export)(unsafe.Pointer(&in.ExportsFromManifests))
 	out.Exports = (*managedresource.Exports)(unsafe.Pointer(in.Exports))
 	out.ContinuousReconcile = (*continuousreconcile.ContinuousReconcileSpec)(unsafe.Pointer(in.ContinuousReconcile))
+	out.DriftDetection = (*managedresource.DriftDetectionSpec)(unsafe.Pointer(in.DriftDetection))
 	out.HelmDeployment = (*bool)(unsafe.Pointer(in.HelmDeployment))
 	out.HelmDeploymentConfig = (*helm.HelmDeploymentConfiguration)(unsafe.Pointer(in.HelmDeploymentConfig))
 	out.DeletionGroups = *(*[]managedresource.DeletionGroupDefinition)(unsafe.Pointer(&in.DeletionGroups))
@@ -515,6 +517,7 @@ func Convert_v1alpha1_ProviderConfiguration_To_helm_ProviderConfiguration(in *Pr
 func autoConvert_helm_ProviderConfiguration_To_v1alpha1_ProviderConfiguration(in *helm.ProviderConfiguration, out *ProviderConfiguration, s conversion.Scope) error {
 	out.ReadinessChecks = in.ReadinessChecks
 	out.UpdateStrategy = UpdateStrategy(in.UpdateStrategy)
+	out.ServerSideApply = (*managedresource.ServerSideApplyConfiguration)(unsafe.Pointer(in.ServerSideApply))
 	if err := Convert_helm_Chart_To_v1alpha1_Chart(&in.Chart, &out.Chart, s); err != nil {
 		return err
 	}
@@ -525,6 +528,7 @@ func autoConvert_helm_ProviderConfiguration_To_v1alpha1_ProviderConfiguration(in
 	out.ExportsFromManifests = *(*[]managedresource.Export)(unsafe.Pointer(&in.ExportsFromManifests))
 	out.Exports = (*managedresource.Exports)(unsafe.Pointer(in.Exports))
 	out.ContinuousReconcile = (*continuousreconcile.ContinuousReconcileSpec)(unsafe.Pointer(in.ContinuousReconcile))
+	out.DriftDetection = (*managedresource.DriftDetectionSpec)(unsafe.Pointer(in.DriftDetection))
 	out.HelmDeployment = (*bool)(unsafe.Pointer(in.HelmDeployment))
 	out.HelmDeploymentConfig = (*HelmDeploymentConfiguration)(unsafe.Pointer(in.HelmDeploymentConfig))
 	out.DeletionGroups = *(*[]managedresource.DeletionGroupDefinition)(unsafe.Pointer(&in.DeletionGroups))
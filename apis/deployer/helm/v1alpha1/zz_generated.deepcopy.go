@@ -312,6 +312,11 @@ func (in *HelmUninstallConfiguration) DeepCopy() *HelmUninstallConfiguration {
 func (in *ProviderConfiguration) DeepCopyInto(out *ProviderConfiguration) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
+	if in.ServerSideApply != nil {
+		in, out := &in.ServerSideApply, &out.ServerSideApply
+		*out = new(managedresource.ServerSideApplyConfiguration)
+		**out = **in
+	}
 	in.ReadinessChecks.DeepCopyInto(&out.ReadinessChecks)
 	in.Chart.DeepCopyInto(&out.Chart)
 	if in.Values != nil {
@@ -336,6 +341,11 @@ func (in *ProviderConfiguration) DeepCopyInto(out *ProviderConfiguration) {
 		*out = new(continuousreconcile.ContinuousReconcileSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DriftDetection != nil {
+		in, out := &in.DriftDetection, &out.DriftDetection
+		*out = new(managedresource.DriftDetectionSpec)
+		**out = **in
+	}
 	if in.HelmDeployment != nil {
 		in, out := &in.HelmDeployment, &out.HelmDeployment
 		*out = new(bool)
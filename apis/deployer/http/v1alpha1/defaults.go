@@ -0,0 +1,29 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"net/http"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func addDefaultingFuncs(scheme *runtime.Scheme) error {
+	return RegisterDefaults(scheme)
+}
+
+// SetDefaults_ProviderConfiguration sets the defaults for the http deployer provider configuration.
+func SetDefaults_ProviderConfiguration(obj *ProviderConfiguration) {
+	setDefaultMethod(&obj.Create, http.MethodPost)
+	setDefaultMethod(obj.Read, http.MethodGet)
+	setDefaultMethod(obj.Update, http.MethodPut)
+	setDefaultMethod(obj.Delete, http.MethodDelete)
+}
+
+func setDefaultMethod(req *Request, method string) {
+	if req != nil && len(req.Method) == 0 {
+		req.Method = method
+	}
+}
@@ -0,0 +1,12 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package core is the internal version of the API.
+// +k8s:deepcopy-gen=package
+// +k8s:conversion-gen=github.com/gardener/landscaper/apis/deployer/http
+// +k8s:openapi-gen=true
+// +k8s:defaulter-gen=TypeMeta
+
+// +groupName=http.deployer.landscaper.gardener.cloud
+package v1alpha1
@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"encoding/json"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cr "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ProviderConfiguration is the http deployer configuration that is expected in a DeployItem.
+// The requests describe the lifecycle of one object of an http api.
+// The url, the headers and the body of the requests are go templates,
+// which are rendered with the state of the object as ".state".
+type ProviderConfiguration struct {
+	metav1.TypeMeta `json:",inline"`
+	// Create is the request that creates the object.
+	// It is only sent if the object does not exist.
+	Create Request `json:"create"`
+	// Read is the request that reads the object.
+	// It is used to check whether the object that has been created by the deployer still exists, and to refresh its state.
+	// If it is not set, the object is considered to exist once it has been created.
+	// +optional
+	Read *Request `json:"read,omitempty"`
+	// Update is the request that updates the existing object.
+	// It is only sent if the provider configuration has changed since the object was created or updated.
+	// +optional
+	Update *Request `json:"update,omitempty"`
+	// Delete is the request that deletes the object when the deploy item is deleted.
+	// If it is not set, the object is kept.
+	// +optional
+	Delete *Request `json:"delete,omitempty"`
+	// Exports describe the values that are exported from the state of the object.
+	// +optional
+	Exports []Export `json:"exports,omitempty"`
+	// ContinuousReconcile contains the schedule for continuous reconciliation.
+	// +optional
+	ContinuousReconcile *cr.ContinuousReconcileSpec `json:"continuousReconcile,omitempty"`
+}
+
+// Request defines an http request.
+type Request struct {
+	// Method is the http method of the request.
+	// Defaults to POST for create, GET for read, PUT for update and DELETE for delete requests.
+	// +optional
+	Method string `json:"method,omitempty"`
+	// URL is the url of the request.
+	// A relative url is resolved against the url of the target.
+	URL string `json:"url"`
+	// Headers are the headers of the request.
+	// They overwrite the headers of the target.
+	// +optional
+	Headers map[string]string `json:"headers,omitempty"`
+	// Body is the json body of the request.
+	// +optional
+	Body json.RawMessage `json:"body,omitempty"`
+	// SuccessCodes are the status codes of a successful response.
+	// Defaults to all 2xx status codes.
+	// +optional
+	SuccessCodes []int `json:"successCodes,omitempty"`
+}
+
+// Export describes one export that is read from the state of the object.
+type Export struct {
+	// Key is the key that the value from JSONPath is exported to.
+	Key string `json:"key"`
+	// JSONPath is the jsonpath to look for a value.
+	// The JSONPath root is the state of the object.
+	JSONPath string `json:"jsonPath"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ProviderStatus is the http provider specific status.
+type ProviderStatus struct {
+	metav1.TypeMeta `json:",inline"`
+	// Created is true if the object has been created by the deployer.
+	// +optional
+	Created bool `json:"created,omitempty"`
+	// StateSecretName is the name of the secret in the namespace of the deploy item that contains the state of the object.
+	// The state is the json body of the last response with a json object or array.
+	// It is kept in a secret, as the response might contain credentials.
+	// +optional
+	StateSecretName string `json:"stateSecretName,omitempty"`
+	// ConfigurationHash is the hash of the provider configuration with which the object was created or updated.
+	// +optional
+	ConfigurationHash string `json:"configurationHash,omitempty"`
+}
@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"encoding/json"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	httpv1alpha1 "github.com/gardener/landscaper/apis/deployer/http/v1alpha1"
+	crval "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile/validation"
+)
+
+// ValidateProviderConfiguration validates a http deployer configuration
+func ValidateProviderConfiguration(config *httpv1alpha1.ProviderConfiguration) error {
+	allErrs := field.ErrorList{}
+	allErrs = append(allErrs, ValidateRequest(field.NewPath("create"), &config.Create)...)
+	if config.Read != nil {
+		allErrs = append(allErrs, ValidateRequest(field.NewPath("read"), config.Read)...)
+	}
+	if config.Update != nil {
+		allErrs = append(allErrs, ValidateRequest(field.NewPath("update"), config.Update)...)
+	}
+	if config.Delete != nil {
+		allErrs = append(allErrs, ValidateRequest(field.NewPath("delete"), config.Delete)...)
+	}
+	allErrs = append(allErrs, ValidateExports(field.NewPath("exports"), config.Exports)...)
+	allErrs = append(allErrs, crval.ValidateContinuousReconcileSpec(field.NewPath("continuousReconcile"), config.ContinuousReconcile)...)
+	return allErrs.ToAggregate()
+}
+
+// ValidateRequest validates a http request
+func ValidateRequest(fldPath *field.Path, req *httpv1alpha1.Request) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if len(req.URL) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("url"), "must not be empty"))
+	}
+	if len(req.Body) != 0 && !json.Valid(req.Body) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("body"), string(req.Body), "must be valid json"))
+	}
+	for i, code := range req.SuccessCodes {
+		if code < 100 || code > 599 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("successCodes").Index(i), code, "must be a http status code"))
+		}
+	}
+	return allErrs
+}
+
+// ValidateExports validates the exports of a http deployer configuration
+func ValidateExports(fldPath *field.Path, exports []httpv1alpha1.Export) field.ErrorList {
+	allErrs := field.ErrorList{}
+	keys := map[string]bool{}
+	for i, export := range exports {
+		expPath := fldPath.Index(i)
+		if len(export.Key) == 0 {
+			allErrs = append(allErrs, field.Required(expPath.Child("key"), "must not be empty"))
+		} else if keys[export.Key] {
+			allErrs = append(allErrs, field.Duplicate(expPath.Child("key"), export.Key))
+		}
+		keys[export.Key] = true
+		if len(export.JSONPath) == 0 {
+			allErrs = append(allErrs, field.Required(expPath.Child("jsonPath"), "must not be empty"))
+		}
+	}
+	return allErrs
+}
@@ -0,0 +1,33 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
+)
+
+func addDefaultingFuncs(scheme *runtime.Scheme) error {
+	return RegisterDefaults(scheme)
+}
+
+// SetDefaults_ProviderConfiguration sets the defaults for the kustomize deployer provider configuration.
+func SetDefaults_ProviderConfiguration(obj *ProviderConfiguration) {
+	if len(obj.UpdateStrategy) == 0 {
+		obj.UpdateStrategy = UpdateStrategyUpdate
+	}
+	if obj.UpdateStrategy == UpdateStrategyServerSideApply {
+		if obj.ServerSideApply == nil {
+			obj.ServerSideApply = &managedresource.ServerSideApplyConfiguration{}
+		}
+		if len(obj.ServerSideApply.FieldManager) == 0 {
+			obj.ServerSideApply.FieldManager = managedresource.DefaultFieldManager
+		}
+		if len(obj.ServerSideApply.ConflictPolicy) == 0 {
+			obj.ServerSideApply.ConflictPolicy = managedresource.ConflictPolicyForce
+		}
+	}
+}
@@ -0,0 +1,112 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
+
+	cr "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile"
+	health "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ProviderConfiguration is the kustomize deployer configuration that is expected in a DeployItem.
+type ProviderConfiguration struct {
+	metav1.TypeMeta `json:",inline"`
+	// UpdateStrategy defines the strategy how the manifests are updated in the cluster.
+	// Defaults to "update".
+	// +optional
+	UpdateStrategy UpdateStrategy `json:"updateStrategy,omitempty"`
+	// ServerSideApply configures the server-side apply of the manifests.
+	// Only relevant if the update strategy is "serverSideApply".
+	// +optional
+	ServerSideApply *managedresource.ServerSideApplyConfiguration `json:"serverSideApply,omitempty"`
+	// ReadinessChecks configures the readiness checks.
+	// +optional
+	ReadinessChecks health.ReadinessCheckConfiguration `json:"readinessChecks,omitempty"`
+	// Kustomization defines the kustomization that is built and applied.
+	Kustomization Kustomization `json:"kustomization"`
+	// Namespace is the namespace of namespaced resources of the built manifests that do not define a namespace.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// Exports describe the exports from the built manifests that should be exported by the kustomize deployer.
+	// +optional
+	Exports *managedresource.Exports `json:"exports,omitempty"`
+	// ContinuousReconcile contains the schedule for continuous reconciliation.
+	// +optional
+	ContinuousReconcile *cr.ContinuousReconcileSpec `json:"continuousReconcile,omitempty"`
+	// DriftDetection configures how the deployer reacts if managed resources are modified or deleted out-of-band.
+	// +optional
+	DriftDetection *managedresource.DriftDetectionSpec `json:"driftDetection,omitempty"`
+	// DeletionGroups defines the order in which objects are deleted.
+	// +optional
+	DeletionGroups []managedresource.DeletionGroupDefinition `json:"deletionGroups,omitempty"`
+	// DeletionGroupsDuringUpdate defines the order in which objects are deleted during an update.
+	// +optional
+	DeletionGroupsDuringUpdate []managedresource.DeletionGroupDefinition `json:"deletionGroupsDuringUpdate,omitempty"`
+}
+
+// UpdateStrategy defines the strategy that is used to apply resources to the cluster.
+type UpdateStrategy string
+
+const (
+	UpdateStrategyUpdate         UpdateStrategy = "update"
+	UpdateStrategyPatch          UpdateStrategy = "patch"
+	UpdateStrategyMerge          UpdateStrategy = "merge"
+	UpdateStrategyMergeOverwrite UpdateStrategy = "mergeOverwrite"
+	// UpdateStrategyServerSideApply applies the manifests with server-side apply,
+	// so that the ownership of the fields is tracked by the api server.
+	UpdateStrategyServerSideApply UpdateStrategy = "serverSideApply"
+)
+
+// Kustomization defines the files of a kustomization.
+// The files of the resource or the archive are combined with the inline files,
+// so that an overlay can be defined inline for a base of a resource.
+type Kustomization struct {
+	// ResourceRef is the key of a resource of a component version that contains the kustomization as tar archive.
+	// The key is created with the template function "getResourceKey".
+	// +optional
+	ResourceRef string `json:"resourceRef,omitempty"`
+	// Archive defines the access to the kustomization as (optionally gzip compressed) tar archive.
+	// +optional
+	Archive *ArchiveAccess `json:"archive,omitempty"`
+	// Files contains inline files of the kustomization, the key is the path of the file.
+	// Inline files overwrite files of the resource or the archive with the same path.
+	// +optional
+	Files map[string]string `json:"files,omitempty"`
+	// Path is the path of the directory of the kustomization file that is built.
+	// Defaults to the root directory.
+	// +optional
+	Path string `json:"path,omitempty"`
+}
+
+// ArchiveAccess defines the access for a kustomization as tar archive.
+type ArchiveAccess struct {
+	// Raw defines a tar archive as base64 encoded string.
+	// +optional
+	Raw string `json:"raw,omitempty"`
+	// Remote defines the remote access for a tar archive.
+	// +optional
+	Remote *RemoteArchiveAccess `json:"remote,omitempty"`
+}
+
+// RemoteArchiveAccess defines the remote access for a tar archive.
+type RemoteArchiveAccess struct {
+	// URL defines a tar archive that is fetched from a url.
+	// +optional
+	URL string `json:"url,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ProviderStatus is the kustomize provider specific status.
+type ProviderStatus struct {
+	metav1.TypeMeta `json:",inline"`
+	// ManagedResources contains all kubernetes resources that are deployed by the deployer.
+	ManagedResources managedresource.ManagedResourceStatusList `json:"managedResources,omitempty"`
+}
@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"path"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	kustomizev1alpha1 "github.com/gardener/landscaper/apis/deployer/kustomize/v1alpha1"
+	crval "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile/validation"
+	"github.com/gardener/landscaper/apis/deployer/utils/managedresource/validation"
+	health "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks/validation"
+)
+
+// ValidateProviderConfiguration validates a kustomize deployer configuration
+func ValidateProviderConfiguration(config *kustomizev1alpha1.ProviderConfiguration) error {
+	allErrs := field.ErrorList{}
+	allErrs = append(allErrs, health.ValidateReadinessCheckConfiguration(field.NewPath("readinessChecks"), &config.ReadinessChecks)...)
+	allErrs = append(allErrs, ValidateKustomization(field.NewPath("kustomization"), config.Kustomization)...)
+	allErrs = append(allErrs, crval.ValidateContinuousReconcileSpec(field.NewPath("continuousReconcile"), config.ContinuousReconcile)...)
+	allErrs = append(allErrs, validation.ValidateDeletionGroups(field.NewPath("deletionGroups"), config.DeletionGroups)...)
+	allErrs = append(allErrs, validation.ValidateDeletionGroups(field.NewPath("deletionGroupsDuringUpdate"), config.DeletionGroupsDuringUpdate)...)
+	allErrs = append(allErrs, validation.ValidateServerSideApplyConfiguration(field.NewPath("serverSideApply"), config.ServerSideApply)...)
+	allErrs = append(allErrs, validation.ValidateDriftDetectionSpec(field.NewPath("driftDetection"), config.DriftDetection)...)
+	return allErrs.ToAggregate()
+}
+
+// ValidateKustomization validates the sources of a kustomization
+func ValidateKustomization(fldPath *field.Path, kustomization kustomizev1alpha1.Kustomization) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if len(kustomization.ResourceRef) == 0 && kustomization.Archive == nil && len(kustomization.Files) == 0 {
+		return append(allErrs, field.Required(fldPath, "must not be empty: either resourceRef, archive or files must be set"))
+	}
+	if len(kustomization.ResourceRef) != 0 && kustomization.Archive != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("archive"), "must not be set together with resourceRef"))
+	}
+
+	if kustomization.Archive != nil {
+		allErrs = append(allErrs, ValidateArchive(fldPath.Child("archive"), kustomization.Archive)...)
+	}
+
+	filesPath := fldPath.Child("files")
+	for filePath := range kustomization.Files {
+		if !isRelativePath(filePath) {
+			allErrs = append(allErrs, field.Invalid(filesPath.Key(filePath), filePath, "must be a relative path within the kustomization"))
+		}
+	}
+	if len(kustomization.Path) != 0 && !isRelativePath(kustomization.Path) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("path"), kustomization.Path, "must be a relative path within the kustomization"))
+	}
+
+	return allErrs
+}
+
+// ValidateArchive validates the archive access for a kustomization.
+func ValidateArchive(fldPath *field.Path, archive *kustomizev1alpha1.ArchiveAccess) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(archive.Raw) == 0 && archive.Remote == nil {
+		return append(allErrs, field.Required(fldPath.Child("raw", "remote"), "must not be empty"))
+	}
+
+	if archive.Remote != nil {
+		remotePath := fldPath.Child("remote")
+		if len(archive.Remote.URL) == 0 {
+			allErrs = append(allErrs, field.Required(remotePath.Child("url"), "must not be empty"))
+		}
+	}
+
+	return allErrs
+}
+
+// isRelativePath checks that a path does not leave the directory of the kustomization.
+func isRelativePath(p string) bool {
+	cleaned := path.Clean(p)
+	return !path.IsAbs(cleaned) && cleaned != ".." && !strings.HasPrefix(cleaned, "../")
+}
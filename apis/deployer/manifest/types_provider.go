@@ -21,6 +21,15 @@ type ProviderConfiguration struct {
 	// UpdateStrategy defines the strategy how the manifest are updated in the cluster.
 	// +optional
 	UpdateStrategy UpdateStrategy `json:"updateStrategy"`
+	// ServerSideApply configures the server-side apply of the manifests.
+	// Only relevant if the update strategy is "serverSideApply".
+	// +optional
+	ServerSideApply *managedresource.ServerSideApplyConfiguration `json:"serverSideApply,omitempty"`
+	// DiffOnly defines that the manifests are not applied.
+	// Instead, the changes that would be applied are computed using server-side dry runs
+	// and recorded in the managed resources of the provider status.
+	// +optional
+	DiffOnly bool `json:"diffOnly,omitempty"`
 	// ReadinessChecks configures the readiness checks.
 	// +optional
 	ReadinessChecks health.ReadinessCheckConfiguration `json:"readiness,omitempty"`
@@ -32,6 +41,9 @@ type ProviderConfiguration struct {
 	// ContinuousReconcile contains the schedule for continuous reconciliation.
 	// +optional
 	ContinuousReconcile *cr.ContinuousReconcileSpec `json:"continuousReconcile,omitempty"`
+	// DriftDetection configures how the deployer reacts if managed resources are modified or deleted out-of-band.
+	// +optional
+	DriftDetection *managedresource.DriftDetectionSpec `json:"driftDetection,omitempty"`
 	// DeletionGroups defines the order in which objects are deleted.
 	// +optional
 	DeletionGroups []managedresource.DeletionGroupDefinition `json:"deletionGroups,omitempty"`
@@ -48,6 +60,9 @@ const (
 	UpdateStrategyPatch          UpdateStrategy = "patch"
 	UpdateStrategyMerge          UpdateStrategy = "merge"
 	UpdateStrategyMergeOverwrite UpdateStrategy = "mergeOverwrite"
+	// UpdateStrategyServerSideApply applies the manifests with server-side apply,
+	// so that the ownership of the fields is tracked by the api server.
+	UpdateStrategyServerSideApply UpdateStrategy = "serverSideApply"
 )
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
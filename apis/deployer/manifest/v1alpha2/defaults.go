@@ -21,6 +21,17 @@ func SetDefaults_ProviderConfiguration(obj *ProviderConfiguration) {
 	if len(obj.UpdateStrategy) == 0 {
 		obj.UpdateStrategy = UpdateStrategyUpdate
 	}
+	if obj.UpdateStrategy == UpdateStrategyServerSideApply {
+		if obj.ServerSideApply == nil {
+			obj.ServerSideApply = &managedresource.ServerSideApplyConfiguration{}
+		}
+		if len(obj.ServerSideApply.FieldManager) == 0 {
+			obj.ServerSideApply.FieldManager = managedresource.DefaultFieldManager
+		}
+		if len(obj.ServerSideApply.ConflictPolicy) == 0 {
+			obj.ServerSideApply.ConflictPolicy = managedresource.ConflictPolicyForce
+		}
+	}
 	for i := range obj.Manifests {
 		if len(obj.Manifests[i].Policy) == 0 {
 			obj.Manifests[i].Policy = managedresource.ManagePolicy
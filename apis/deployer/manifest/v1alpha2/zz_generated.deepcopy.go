@@ -113,6 +113,11 @@ func (in *HPAConfiguration) DeepCopy() *HPAConfiguration {
 func (in *ProviderConfiguration) DeepCopyInto(out *ProviderConfiguration) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
+	if in.ServerSideApply != nil {
+		in, out := &in.ServerSideApply, &out.ServerSideApply
+		*out = new(managedresource.ServerSideApplyConfiguration)
+		**out = **in
+	}
 	in.ReadinessChecks.DeepCopyInto(&out.ReadinessChecks)
 	if in.Manifests != nil {
 		in, out := &in.Manifests, &out.Manifests
@@ -131,6 +136,11 @@ func (in *ProviderConfiguration) DeepCopyInto(out *ProviderConfiguration) {
 		*out = new(continuousreconcile.ContinuousReconcileSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DriftDetection != nil {
+		in, out := &in.DriftDetection, &out.DriftDetection
+		*out = new(managedresource.DriftDetectionSpec)
+		**out = **in
+	}
 	if in.DeletionGroups != nil {
 		in, out := &in.DeletionGroups, &out.DeletionGroups
 		*out = make([]managedresource.DeletionGroupDefinition, len(*in))
@@ -21,6 +21,8 @@ func ValidateProviderConfiguration(config *manifestv1alpha2.ProviderConfiguratio
 	allErrs = append(allErrs, health.ValidateReadinessCheckConfiguration(field.NewPath(""), &config.ReadinessChecks)...)
 	allErrs = append(allErrs, crval.ValidateContinuousReconcileSpec(field.NewPath("continuousReconcile"), config.ContinuousReconcile)...)
 	allErrs = append(allErrs, validation.ValidateDeletionGroups(field.NewPath("deletionGroups"), config.DeletionGroups)...)
+	allErrs = append(allErrs, validation.ValidateServerSideApplyConfiguration(field.NewPath("serverSideApply"), config.ServerSideApply)...)
+	allErrs = append(allErrs, validation.ValidateDriftDetectionSpec(field.NewPath("driftDetection"), config.DriftDetection)...)
 	return allErrs.ToAggregate()
 }
 
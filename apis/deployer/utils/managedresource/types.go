@@ -91,6 +91,38 @@ type ManagedResourceStatus struct {
 	Policy ManifestPolicy `json:"policy,omitempty"`
 	// Resources describes the managed kubernetes resource.
 	Resource corev1.ObjectReference `json:"resource"`
+	// Diff describes the changes of the resource during the last reconcile of the deployer.
+	// +optional
+	Diff *ResourceDiff `json:"diff,omitempty"`
+	// Conflicts contains the fields of the resource that are owned by other field managers
+	// and prevented the resource from being applied with server-side apply.
+	// +optional
+	Conflicts []string `json:"conflicts,omitempty"`
+}
+
+// DiffAction describes how a resource was changed by the deployer.
+type DiffAction string
+
+const (
+	// DiffActionCreated defines that the resource was created.
+	DiffActionCreated DiffAction = "created"
+	// DiffActionChanged defines that at least one field of the resource was changed.
+	DiffActionChanged DiffAction = "changed"
+	// DiffActionUnchanged defines that the resource was applied without changing it.
+	DiffActionUnchanged DiffAction = "unchanged"
+	// DiffActionDeleted defines that the resource is not defined anymore and will be deleted.
+	DiffActionDeleted DiffAction = "deleted"
+)
+
+// ResourceDiff describes the changes of a managed resource.
+// The diff is computed by the api server using the same request that applies the resource,
+// or a server-side dry run of it if the deployer only computes the diff.
+type ResourceDiff struct {
+	// Action describes how the resource was changed.
+	Action DiffAction `json:"action"`
+	// ChangedFields contains the paths of all changed fields of the resource, e.g. "spec.replicas".
+	// +optional
+	ChangedFields []string `json:"changedFields,omitempty"`
 }
 
 // Exports describes one export that is read from a resource.
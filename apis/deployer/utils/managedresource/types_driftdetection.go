@@ -0,0 +1,35 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedresource
+
+// DriftDetectionSpec configures how the deployer reacts if managed resources are modified or deleted
+// on the target cluster by someone else than the deployer.
+type DriftDetectionSpec struct {
+	// Policy defines the action that is performed when a drift of a managed resource is detected.
+	// Defaults to "ignore".
+	// +optional
+	Policy DriftPolicy `json:"policy,omitempty"`
+}
+
+// DriftPolicy defines the action that is performed when a drift of a managed resource is detected.
+type DriftPolicy string
+
+const (
+	// DriftPolicyIgnore disables the drift detection.
+	DriftPolicyIgnore DriftPolicy = "ignore"
+	// DriftPolicyReport records the drift as condition of the deploy item.
+	DriftPolicyReport DriftPolicy = "report"
+	// DriftPolicyReconcile records the drift as condition of the deploy item and
+	// immediately reconciles the deploy item to restore the managed resources.
+	DriftPolicyReconcile DriftPolicy = "reconcile"
+)
+
+// GetPolicy returns the configured drift policy or the default drift policy.
+func (s *DriftDetectionSpec) GetPolicy() DriftPolicy {
+	if s == nil || len(s.Policy) == 0 {
+		return DriftPolicyIgnore
+	}
+	return s.Policy
+}
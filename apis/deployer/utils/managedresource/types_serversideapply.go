@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedresource
+
+// DefaultFieldManager is the field manager that is used for server-side apply if no field manager is configured.
+const DefaultFieldManager = "landscaper"
+
+// ServerSideApplyConfiguration configures how manifests are applied with server-side apply.
+type ServerSideApplyConfiguration struct {
+	// FieldManager is the name of the field manager that owns the applied fields.
+	// Defaults to "landscaper".
+	// +optional
+	FieldManager string `json:"fieldManager,omitempty"`
+	// ConflictPolicy defines how conflicts with fields that are owned by other field managers are handled.
+	// Defaults to "force".
+	// +optional
+	ConflictPolicy ConflictPolicy `json:"conflictPolicy,omitempty"`
+}
+
+// ConflictPolicy defines how server-side apply conflicts are handled.
+type ConflictPolicy string
+
+const (
+	// ConflictPolicyForce takes over the ownership of conflicting fields from other field managers.
+	ConflictPolicyForce ConflictPolicy = "force"
+	// ConflictPolicyFail fails the deployment if a conflict occurs.
+	ConflictPolicyFail ConflictPolicy = "fail"
+	// ConflictPolicyReport does not apply a resource with conflicts, but reports the conflicts
+	// in the status of the managed resource.
+	ConflictPolicyReport ConflictPolicy = "report"
+)
+
+// GetFieldManager returns the configured field manager or the default field manager.
+func (c *ServerSideApplyConfiguration) GetFieldManager() string {
+	if c == nil || len(c.FieldManager) == 0 {
+		return DefaultFieldManager
+	}
+	return c.FieldManager
+}
+
+// GetConflictPolicy returns the configured conflict policy or the default conflict policy.
+func (c *ServerSideApplyConfiguration) GetConflictPolicy() ConflictPolicy {
+	if c == nil || len(c.ConflictPolicy) == 0 {
+		return ConflictPolicyForce
+	}
+	return c.ConflictPolicy
+}
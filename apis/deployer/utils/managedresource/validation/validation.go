@@ -140,3 +140,49 @@ func validateCustomResourceGroup(fldPath *field.Path, c *managedresource.CustomR
 
 	return allErrs
 }
+
+// ValidateServerSideApplyConfiguration validates a server-side apply configuration.
+func ValidateServerSideApplyConfiguration(fldPath *field.Path, config *managedresource.ServerSideApplyConfiguration) field.ErrorList {
+	var allErrs field.ErrorList
+	if config == nil {
+		return allErrs
+	}
+
+	switch config.ConflictPolicy {
+	case "",
+		managedresource.ConflictPolicyForce,
+		managedresource.ConflictPolicyFail,
+		managedresource.ConflictPolicyReport:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("conflictPolicy"), config.ConflictPolicy, []string{
+			string(managedresource.ConflictPolicyForce),
+			string(managedresource.ConflictPolicyFail),
+			string(managedresource.ConflictPolicyReport),
+		}))
+	}
+
+	return allErrs
+}
+
+// ValidateDriftDetectionSpec validates a drift detection spec.
+func ValidateDriftDetectionSpec(fldPath *field.Path, spec *managedresource.DriftDetectionSpec) field.ErrorList {
+	var allErrs field.ErrorList
+	if spec == nil {
+		return allErrs
+	}
+
+	switch spec.Policy {
+	case "",
+		managedresource.DriftPolicyIgnore,
+		managedresource.DriftPolicyReport,
+		managedresource.DriftPolicyReconcile:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("policy"), spec.Policy, []string{
+			string(managedresource.DriftPolicyIgnore),
+			string(managedresource.DriftPolicyReport),
+			string(managedresource.DriftPolicyReconcile),
+		}))
+	}
+
+	return allErrs
+}
@@ -188,4 +188,51 @@ var _ = Describe("Validation", func() {
 		})
 
 	})
+
+	Context("ServerSideApply", func() {
+
+		It("should accept a server-side apply configuration with a supported conflict policy", func() {
+			config := &managedresource.ServerSideApplyConfiguration{
+				FieldManager:   "test",
+				ConflictPolicy: managedresource.ConflictPolicyReport,
+			}
+			allErrs := validation.ValidateServerSideApplyConfiguration(fld, config)
+			Expect(allErrs).To(BeEmpty())
+		})
+
+		It("should reject a server-side apply configuration with an unsupported conflict policy", func() {
+			config := &managedresource.ServerSideApplyConfiguration{
+				ConflictPolicy: "ignore",
+			}
+			allErrs := validation.ValidateServerSideApplyConfiguration(fld, config)
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("a.conflictPolicy"),
+			}))))
+		})
+
+	})
+
+	Context("DriftDetection", func() {
+
+		It("should accept a drift detection spec with a supported policy", func() {
+			spec := &managedresource.DriftDetectionSpec{
+				Policy: managedresource.DriftPolicyReconcile,
+			}
+			allErrs := validation.ValidateDriftDetectionSpec(fld, spec)
+			Expect(allErrs).To(BeEmpty())
+		})
+
+		It("should reject a drift detection spec with an unsupported policy", func() {
+			spec := &managedresource.DriftDetectionSpec{
+				Policy: "heal",
+			}
+			allErrs := validation.ValidateDriftDetectionSpec(fld, spec)
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("a.policy"),
+			}))))
+		})
+
+	})
 })
@@ -68,6 +68,22 @@ func (in *DeletionGroupDefinition) DeepCopy() *DeletionGroupDefinition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DriftDetectionSpec) DeepCopyInto(out *DriftDetectionSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriftDetectionSpec.
+func (in *DriftDetectionSpec) DeepCopy() *DriftDetectionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DriftDetectionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Export) DeepCopyInto(out *Export) {
 	*out = *in
@@ -154,6 +170,16 @@ func (in *ManagedResourceStatus) DeepCopyInto(out *ManagedResourceStatus) {
 		(*in).DeepCopyInto(*out)
 	}
 	out.Resource = in.Resource
+	if in.Diff != nil {
+		in, out := &in.Diff, &out.Diff
+		*out = new(ResourceDiff)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conflicts != nil {
+		in, out := &in.Conflicts, &out.Conflicts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -250,6 +276,27 @@ func (in *PredefinedResourceGroup) DeepCopy() *PredefinedResourceGroup {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceDiff) DeepCopyInto(out *ResourceDiff) {
+	*out = *in
+	if in.ChangedFields != nil {
+		in, out := &in.ChangedFields, &out.ChangedFields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceDiff.
+func (in *ResourceDiff) DeepCopy() *ResourceDiff {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceDiff)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceType) DeepCopyInto(out *ResourceType) {
 	*out = *in
@@ -275,3 +322,19 @@ func (in *ResourceType) DeepCopy() *ResourceType {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerSideApplyConfiguration) DeepCopyInto(out *ServerSideApplyConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerSideApplyConfiguration.
+func (in *ServerSideApplyConfiguration) DeepCopy() *ServerSideApplyConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerSideApplyConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
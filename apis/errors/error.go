@@ -176,7 +176,24 @@ func TryUpdateLsError(lastErr *lsv1alpha1.Error, err LsError) *lsv1alpha1.Error
 	codes := CollectErrorCodes(err)
 
 	errorInfo := err.LandscaperError()
-	return UpdatedError(lastErr, errorInfo.Operation, errorInfo.Reason, errorInfo.Message, codes...)
+	newError := &lsv1alpha1.Error{
+		Operation: errorInfo.Operation,
+		Reason:    errorInfo.Reason,
+		Message:   errorInfo.Message,
+		Codes:     codes,
+	}
+	var debuggableErr DebuggableError
+	if errors.As(err, &debuggableErr) {
+		newError.DebugSecretRef = debuggableErr.DebugSecretRef()
+	}
+	return updatedError(lastErr, newError)
+}
+
+// DebuggableError is implemented by errors that reference a secret with diagnostic data about the error.
+type DebuggableError interface {
+	error
+	// DebugSecretRef returns the reference to the secret with the diagnostic data or nil if no data has been stored.
+	DebugSecretRef() *lsv1alpha1.ObjectReference
 }
 
 func CollectErrorCodes(err error) []lsv1alpha1.ErrorCode {
@@ -210,28 +227,27 @@ func ContainsErrorCode(err error, code lsv1alpha1.ErrorCode) bool {
 
 // UpdatedError updates the properties of a error.
 func UpdatedError(lastError *lsv1alpha1.Error, operation, reason, message string, codes ...lsv1alpha1.ErrorCode) *lsv1alpha1.Error {
+	return updatedError(lastError, &lsv1alpha1.Error{
+		Operation: operation,
+		Reason:    reason,
+		Message:   message,
+		Codes:     codes,
+	})
+}
+
+// updatedError sets the times of the new error depending on the last error.
+func updatedError(lastError, newError *lsv1alpha1.Error) *lsv1alpha1.Error {
 	if lastError == nil {
-		return &lsv1alpha1.Error{
-			Operation:          operation,
-			Reason:             reason,
-			Message:            message,
-			LastTransitionTime: metav1.Now(),
-			LastUpdateTime:     metav1.Now(),
-			Codes:              codes,
-		}
+		newError.LastTransitionTime = metav1.Now()
+		newError.LastUpdateTime = metav1.Now()
+		return newError
 	}
 
-	newError := &lsv1alpha1.Error{
-		Operation:          operation,
-		Reason:             reason,
-		Message:            message,
-		LastTransitionTime: lastError.LastTransitionTime,
-		LastUpdateTime:     lastError.LastUpdateTime,
-		Codes:              codes,
-	}
+	newError.LastTransitionTime = lastError.LastTransitionTime
+	newError.LastUpdateTime = lastError.LastUpdateTime
 
 	// Normalize nil and empty slice
-	if len(lastError.Codes) == 0 && len(codes) == 0 {
+	if len(lastError.Codes) == 0 && len(newError.Codes) == 0 {
 		newError.Codes = lastError.Codes
 	}
 
@@ -239,7 +255,7 @@ func UpdatedError(lastError *lsv1alpha1.Error, operation, reason, message string
 		newError.LastUpdateTime = metav1.Now()
 	}
 
-	if lastError.Operation != operation {
+	if lastError.Operation != newError.Operation {
 		newError.LastTransitionTime = metav1.Now()
 	}
 
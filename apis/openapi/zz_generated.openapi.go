@@ -43,6 +43,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/config.OCICacheConfiguration":                                     schema_gardener_landscaper_apis_config_OCICacheConfiguration(ref),
 		"github.com/gardener/landscaper/apis/config.OCIConfiguration":                                          schema_gardener_landscaper_apis_config_OCIConfiguration(ref),
 		"github.com/gardener/landscaper/apis/config.RegistryConfiguration":                                     schema_gardener_landscaper_apis_config_RegistryConfiguration(ref),
+		"github.com/gardener/landscaper/apis/config.TemplatePluginConfiguration":                               schema_gardener_landscaper_apis_config_TemplatePluginConfiguration(ref),
 		"github.com/gardener/landscaper/apis/config/v1alpha1.AdditionalDeployments":                            schema_landscaper_apis_config_v1alpha1_AdditionalDeployments(ref),
 		"github.com/gardener/landscaper/apis/config/v1alpha1.BlueprintStore":                                   schema_landscaper_apis_config_v1alpha1_BlueprintStore(ref),
 		"github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig":                           schema_landscaper_apis_config_v1alpha1_CommonControllerConfig(ref),
@@ -64,6 +65,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/config/v1alpha1.OCICacheConfiguration":                            schema_landscaper_apis_config_v1alpha1_OCICacheConfiguration(ref),
 		"github.com/gardener/landscaper/apis/config/v1alpha1.OCIConfiguration":                                 schema_landscaper_apis_config_v1alpha1_OCIConfiguration(ref),
 		"github.com/gardener/landscaper/apis/config/v1alpha1.RegistryConfiguration":                            schema_landscaper_apis_config_v1alpha1_RegistryConfiguration(ref),
+		"github.com/gardener/landscaper/apis/config/v1alpha1.TemplatePluginConfiguration":                      schema_landscaper_apis_config_v1alpha1_TemplatePluginConfiguration(ref),
 		"github.com/gardener/landscaper/apis/core.AnyJSON":                                                     schema_gardener_landscaper_apis_core_AnyJSON(ref),
 		"github.com/gardener/landscaper/apis/core.AutomaticReconcile":                                          schema_gardener_landscaper_apis_core_AutomaticReconcile(ref),
 		"github.com/gardener/landscaper/apis/core.AutomaticReconcileStatus":                                    schema_gardener_landscaper_apis_core_AutomaticReconcileStatus(ref),
@@ -116,19 +118,33 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/core.InstallationExports":                                         schema_gardener_landscaper_apis_core_InstallationExports(ref),
 		"github.com/gardener/landscaper/apis/core.InstallationImports":                                         schema_gardener_landscaper_apis_core_InstallationImports(ref),
 		"github.com/gardener/landscaper/apis/core.InstallationList":                                            schema_gardener_landscaper_apis_core_InstallationList(ref),
+		"github.com/gardener/landscaper/apis/core.InstallationPlan":                                            schema_gardener_landscaper_apis_core_InstallationPlan(ref),
+		"github.com/gardener/landscaper/apis/core.InstallationRevision":                                        schema_gardener_landscaper_apis_core_InstallationRevision(ref),
+		"github.com/gardener/landscaper/apis/core.InstallationRevisionList":                                    schema_gardener_landscaper_apis_core_InstallationRevisionList(ref),
+		"github.com/gardener/landscaper/apis/core.InstallationRevisionSpec":                                    schema_gardener_landscaper_apis_core_InstallationRevisionSpec(ref),
 		"github.com/gardener/landscaper/apis/core.InstallationSpec":                                            schema_gardener_landscaper_apis_core_InstallationSpec(ref),
 		"github.com/gardener/landscaper/apis/core.InstallationStatus":                                          schema_gardener_landscaper_apis_core_InstallationStatus(ref),
 		"github.com/gardener/landscaper/apis/core.InstallationTemplate":                                        schema_gardener_landscaper_apis_core_InstallationTemplate(ref),
 		"github.com/gardener/landscaper/apis/core.InstallationTemplateBlueprintDefinition":                     schema_gardener_landscaper_apis_core_InstallationTemplateBlueprintDefinition(ref),
 		"github.com/gardener/landscaper/apis/core.JSONSchemaDefinition":                                        schema_gardener_landscaper_apis_core_JSONSchemaDefinition(ref),
+		"github.com/gardener/landscaper/apis/core.LandscapeStatus":                                             schema_gardener_landscaper_apis_core_LandscapeStatus(ref),
+		"github.com/gardener/landscaper/apis/core.LandscapeStatusItem":                                         schema_gardener_landscaper_apis_core_LandscapeStatusItem(ref),
+		"github.com/gardener/landscaper/apis/core.LandscapeStatusList":                                         schema_gardener_landscaper_apis_core_LandscapeStatusList(ref),
+		"github.com/gardener/landscaper/apis/core.LandscapeStatusSpec":                                         schema_gardener_landscaper_apis_core_LandscapeStatusSpec(ref),
+		"github.com/gardener/landscaper/apis/core.LandscapeStatusStatus":                                       schema_gardener_landscaper_apis_core_LandscapeStatusStatus(ref),
 		"github.com/gardener/landscaper/apis/core.LocalConfigMapReference":                                     schema_gardener_landscaper_apis_core_LocalConfigMapReference(ref),
 		"github.com/gardener/landscaper/apis/core.LocalSecretReference":                                        schema_gardener_landscaper_apis_core_LocalSecretReference(ref),
 		"github.com/gardener/landscaper/apis/core.LsHealthCheck":                                               schema_gardener_landscaper_apis_core_LsHealthCheck(ref),
 		"github.com/gardener/landscaper/apis/core.LsHealthCheckList":                                           schema_gardener_landscaper_apis_core_LsHealthCheckList(ref),
+		"github.com/gardener/landscaper/apis/core.MaintenanceWindow":                                           schema_gardener_landscaper_apis_core_MaintenanceWindow(ref),
 		"github.com/gardener/landscaper/apis/core.NamedObjectReference":                                        schema_gardener_landscaper_apis_core_NamedObjectReference(ref),
 		"github.com/gardener/landscaper/apis/core.ObjectReference":                                             schema_gardener_landscaper_apis_core_ObjectReference(ref),
 		"github.com/gardener/landscaper/apis/core.OnDeleteConfig":                                              schema_gardener_landscaper_apis_core_OnDeleteConfig(ref),
 		"github.com/gardener/landscaper/apis/core.Optimization":                                                schema_gardener_landscaper_apis_core_Optimization(ref),
+		"github.com/gardener/landscaper/apis/core.PendingJob":                                                  schema_gardener_landscaper_apis_core_PendingJob(ref),
+		"github.com/gardener/landscaper/apis/core.PhaseCounts":                                                 schema_gardener_landscaper_apis_core_PhaseCounts(ref),
+		"github.com/gardener/landscaper/apis/core.PlannedDeployItem":                                           schema_gardener_landscaper_apis_core_PlannedDeployItem(ref),
+		"github.com/gardener/landscaper/apis/core.PlannedSubInstallation":                                      schema_gardener_landscaper_apis_core_PlannedSubInstallation(ref),
 		"github.com/gardener/landscaper/apis/core.RemoteBlueprintReference":                                    schema_gardener_landscaper_apis_core_RemoteBlueprintReference(ref),
 		"github.com/gardener/landscaper/apis/core.Requirement":                                                 schema_gardener_landscaper_apis_core_Requirement(ref),
 		"github.com/gardener/landscaper/apis/core.ResolvedTarget":                                              schema_gardener_landscaper_apis_core_ResolvedTarget(ref),
@@ -157,6 +173,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/core.TargetSyncStatus":                                            schema_gardener_landscaper_apis_core_TargetSyncStatus(ref),
 		"github.com/gardener/landscaper/apis/core.TargetTemplate":                                              schema_gardener_landscaper_apis_core_TargetTemplate(ref),
 		"github.com/gardener/landscaper/apis/core.TemplateExecutor":                                            schema_gardener_landscaper_apis_core_TemplateExecutor(ref),
+		"github.com/gardener/landscaper/apis/core.TemplateLookupResource":                                      schema_gardener_landscaper_apis_core_TemplateLookupResource(ref),
 		"github.com/gardener/landscaper/apis/core.TokenRotation":                                               schema_gardener_landscaper_apis_core_TokenRotation(ref),
 		"github.com/gardener/landscaper/apis/core.TransitionTimes":                                             schema_gardener_landscaper_apis_core_TransitionTimes(ref),
 		"github.com/gardener/landscaper/apis/core.TypedObjectReference":                                        schema_gardener_landscaper_apis_core_TypedObjectReference(ref),
@@ -217,19 +234,33 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/core/v1alpha1.InstallationExports":                                schema_landscaper_apis_core_v1alpha1_InstallationExports(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.InstallationImports":                                schema_landscaper_apis_core_v1alpha1_InstallationImports(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.InstallationList":                                   schema_landscaper_apis_core_v1alpha1_InstallationList(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.InstallationPlan":                                   schema_landscaper_apis_core_v1alpha1_InstallationPlan(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.InstallationRevision":                               schema_landscaper_apis_core_v1alpha1_InstallationRevision(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.InstallationRevisionList":                           schema_landscaper_apis_core_v1alpha1_InstallationRevisionList(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.InstallationRevisionSpec":                           schema_landscaper_apis_core_v1alpha1_InstallationRevisionSpec(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.InstallationSpec":                                   schema_landscaper_apis_core_v1alpha1_InstallationSpec(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.InstallationStatus":                                 schema_landscaper_apis_core_v1alpha1_InstallationStatus(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.InstallationTemplate":                               schema_landscaper_apis_core_v1alpha1_InstallationTemplate(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.InstallationTemplateBlueprintDefinition":            schema_landscaper_apis_core_v1alpha1_InstallationTemplateBlueprintDefinition(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition":                               schema_landscaper_apis_core_v1alpha1_JSONSchemaDefinition(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.LandscapeStatus":                                    schema_landscaper_apis_core_v1alpha1_LandscapeStatus(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.LandscapeStatusItem":                                schema_landscaper_apis_core_v1alpha1_LandscapeStatusItem(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.LandscapeStatusList":                                schema_landscaper_apis_core_v1alpha1_LandscapeStatusList(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.LandscapeStatusSpec":                                schema_landscaper_apis_core_v1alpha1_LandscapeStatusSpec(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.LandscapeStatusStatus":                              schema_landscaper_apis_core_v1alpha1_LandscapeStatusStatus(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.LocalConfigMapReference":                            schema_landscaper_apis_core_v1alpha1_LocalConfigMapReference(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference":                               schema_landscaper_apis_core_v1alpha1_LocalSecretReference(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.LsHealthCheck":                                      schema_landscaper_apis_core_v1alpha1_LsHealthCheck(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.LsHealthCheckList":                                  schema_landscaper_apis_core_v1alpha1_LsHealthCheckList(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.MaintenanceWindow":                                  schema_landscaper_apis_core_v1alpha1_MaintenanceWindow(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.NamedObjectReference":                               schema_landscaper_apis_core_v1alpha1_NamedObjectReference(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference":                                    schema_landscaper_apis_core_v1alpha1_ObjectReference(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.OnDeleteConfig":                                     schema_landscaper_apis_core_v1alpha1_OnDeleteConfig(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.Optimization":                                       schema_landscaper_apis_core_v1alpha1_Optimization(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.PendingJob":                                         schema_landscaper_apis_core_v1alpha1_PendingJob(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.PhaseCounts":                                        schema_landscaper_apis_core_v1alpha1_PhaseCounts(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.PlannedDeployItem":                                  schema_landscaper_apis_core_v1alpha1_PlannedDeployItem(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.PlannedSubInstallation":                             schema_landscaper_apis_core_v1alpha1_PlannedSubInstallation(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.RemoteBlueprintReference":                           schema_landscaper_apis_core_v1alpha1_RemoteBlueprintReference(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.Requirement":                                        schema_landscaper_apis_core_v1alpha1_Requirement(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.ResolvedTarget":                                     schema_landscaper_apis_core_v1alpha1_ResolvedTarget(ref),
@@ -258,6 +289,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSyncStatus":                                   schema_landscaper_apis_core_v1alpha1_TargetSyncStatus(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.TargetTemplate":                                     schema_landscaper_apis_core_v1alpha1_TargetTemplate(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.TemplateExecutor":                                   schema_landscaper_apis_core_v1alpha1_TemplateExecutor(ref),
+		"github.com/gardener/landscaper/apis/core/v1alpha1.TemplateLookupResource":                             schema_landscaper_apis_core_v1alpha1_TemplateLookupResource(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.TokenRotation":                                      schema_landscaper_apis_core_v1alpha1_TokenRotation(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.TransitionTimes":                                    schema_landscaper_apis_core_v1alpha1_TransitionTimes(ref),
 		"github.com/gardener/landscaper/apis/core/v1alpha1.TypedObjectReference":                               schema_landscaper_apis_core_v1alpha1_TypedObjectReference(ref),
@@ -271,8 +303,10 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/deployer/container.ContainerStatus":                               schema_landscaper_apis_deployer_container_ContainerStatus(ref),
 		"github.com/gardener/landscaper/apis/deployer/container.Controller":                                    schema_landscaper_apis_deployer_container_Controller(ref),
 		"github.com/gardener/landscaper/apis/deployer/container.DebugOptions":                                  schema_landscaper_apis_deployer_container_DebugOptions(ref),
+		"github.com/gardener/landscaper/apis/deployer/container.Execution":                                     schema_landscaper_apis_deployer_container_Execution(ref),
 		"github.com/gardener/landscaper/apis/deployer/container.GarbageCollection":                             schema_landscaper_apis_deployer_container_GarbageCollection(ref),
 		"github.com/gardener/landscaper/apis/deployer/container.HPAConfiguration":                              schema_landscaper_apis_deployer_container_HPAConfiguration(ref),
+		"github.com/gardener/landscaper/apis/deployer/container.JobConfiguration":                              schema_landscaper_apis_deployer_container_JobConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/container.PodStatus":                                     schema_landscaper_apis_deployer_container_PodStatus(ref),
 		"github.com/gardener/landscaper/apis/deployer/container.ProviderConfiguration":                         schema_landscaper_apis_deployer_container_ProviderConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/container.ProviderStatus":                                schema_landscaper_apis_deployer_container_ProviderStatus(ref),
@@ -281,8 +315,10 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerStatus":                      schema_apis_deployer_container_v1alpha1_ContainerStatus(ref),
 		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.Controller":                           schema_apis_deployer_container_v1alpha1_Controller(ref),
 		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.DebugOptions":                         schema_apis_deployer_container_v1alpha1_DebugOptions(ref),
+		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.Execution":                            schema_apis_deployer_container_v1alpha1_Execution(ref),
 		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.GarbageCollection":                    schema_apis_deployer_container_v1alpha1_GarbageCollection(ref),
 		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.HPAConfiguration":                     schema_apis_deployer_container_v1alpha1_HPAConfiguration(ref),
+		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.JobConfiguration":                     schema_apis_deployer_container_v1alpha1_JobConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.PodStatus":                            schema_apis_deployer_container_v1alpha1_PodStatus(ref),
 		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ProviderConfiguration":                schema_apis_deployer_container_v1alpha1_ProviderConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ProviderStatus":                       schema_apis_deployer_container_v1alpha1_ProviderStatus(ref),
@@ -319,6 +355,38 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.RemoteArchiveAccess":                       schema_apis_deployer_helm_v1alpha1_RemoteArchiveAccess(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.RemoteChartReference":                      schema_apis_deployer_helm_v1alpha1_RemoteChartReference(ref),
 		"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ResourceRef":                               schema_apis_deployer_helm_v1alpha1_ResourceRef(ref),
+		"github.com/gardener/landscaper/apis/deployer/http.Configuration":                                      schema_landscaper_apis_deployer_http_Configuration(ref),
+		"github.com/gardener/landscaper/apis/deployer/http.Controller":                                         schema_landscaper_apis_deployer_http_Controller(ref),
+		"github.com/gardener/landscaper/apis/deployer/http.Export":                                             schema_landscaper_apis_deployer_http_Export(ref),
+		"github.com/gardener/landscaper/apis/deployer/http.HPAConfiguration":                                   schema_landscaper_apis_deployer_http_HPAConfiguration(ref),
+		"github.com/gardener/landscaper/apis/deployer/http.ProviderConfiguration":                              schema_landscaper_apis_deployer_http_ProviderConfiguration(ref),
+		"github.com/gardener/landscaper/apis/deployer/http.ProviderStatus":                                     schema_landscaper_apis_deployer_http_ProviderStatus(ref),
+		"github.com/gardener/landscaper/apis/deployer/http.Request":                                            schema_landscaper_apis_deployer_http_Request(ref),
+		"github.com/gardener/landscaper/apis/deployer/http/v1alpha1.Configuration":                             schema_apis_deployer_http_v1alpha1_Configuration(ref),
+		"github.com/gardener/landscaper/apis/deployer/http/v1alpha1.Controller":                                schema_apis_deployer_http_v1alpha1_Controller(ref),
+		"github.com/gardener/landscaper/apis/deployer/http/v1alpha1.Export":                                    schema_apis_deployer_http_v1alpha1_Export(ref),
+		"github.com/gardener/landscaper/apis/deployer/http/v1alpha1.HPAConfiguration":                          schema_apis_deployer_http_v1alpha1_HPAConfiguration(ref),
+		"github.com/gardener/landscaper/apis/deployer/http/v1alpha1.ProviderConfiguration":                     schema_apis_deployer_http_v1alpha1_ProviderConfiguration(ref),
+		"github.com/gardener/landscaper/apis/deployer/http/v1alpha1.ProviderStatus":                            schema_apis_deployer_http_v1alpha1_ProviderStatus(ref),
+		"github.com/gardener/landscaper/apis/deployer/http/v1alpha1.Request":                                   schema_apis_deployer_http_v1alpha1_Request(ref),
+		"github.com/gardener/landscaper/apis/deployer/kustomize.ArchiveAccess":                                 schema_landscaper_apis_deployer_kustomize_ArchiveAccess(ref),
+		"github.com/gardener/landscaper/apis/deployer/kustomize.Configuration":                                 schema_landscaper_apis_deployer_kustomize_Configuration(ref),
+		"github.com/gardener/landscaper/apis/deployer/kustomize.Controller":                                    schema_landscaper_apis_deployer_kustomize_Controller(ref),
+		"github.com/gardener/landscaper/apis/deployer/kustomize.ExportConfiguration":                           schema_landscaper_apis_deployer_kustomize_ExportConfiguration(ref),
+		"github.com/gardener/landscaper/apis/deployer/kustomize.HPAConfiguration":                              schema_landscaper_apis_deployer_kustomize_HPAConfiguration(ref),
+		"github.com/gardener/landscaper/apis/deployer/kustomize.Kustomization":                                 schema_landscaper_apis_deployer_kustomize_Kustomization(ref),
+		"github.com/gardener/landscaper/apis/deployer/kustomize.ProviderConfiguration":                         schema_landscaper_apis_deployer_kustomize_ProviderConfiguration(ref),
+		"github.com/gardener/landscaper/apis/deployer/kustomize.ProviderStatus":                                schema_landscaper_apis_deployer_kustomize_ProviderStatus(ref),
+		"github.com/gardener/landscaper/apis/deployer/kustomize.RemoteArchiveAccess":                           schema_landscaper_apis_deployer_kustomize_RemoteArchiveAccess(ref),
+		"github.com/gardener/landscaper/apis/deployer/kustomize/v1alpha1.ArchiveAccess":                        schema_apis_deployer_kustomize_v1alpha1_ArchiveAccess(ref),
+		"github.com/gardener/landscaper/apis/deployer/kustomize/v1alpha1.Configuration":                        schema_apis_deployer_kustomize_v1alpha1_Configuration(ref),
+		"github.com/gardener/landscaper/apis/deployer/kustomize/v1alpha1.Controller":                           schema_apis_deployer_kustomize_v1alpha1_Controller(ref),
+		"github.com/gardener/landscaper/apis/deployer/kustomize/v1alpha1.ExportConfiguration":                  schema_apis_deployer_kustomize_v1alpha1_ExportConfiguration(ref),
+		"github.com/gardener/landscaper/apis/deployer/kustomize/v1alpha1.HPAConfiguration":                     schema_apis_deployer_kustomize_v1alpha1_HPAConfiguration(ref),
+		"github.com/gardener/landscaper/apis/deployer/kustomize/v1alpha1.Kustomization":                        schema_apis_deployer_kustomize_v1alpha1_Kustomization(ref),
+		"github.com/gardener/landscaper/apis/deployer/kustomize/v1alpha1.ProviderConfiguration":                schema_apis_deployer_kustomize_v1alpha1_ProviderConfiguration(ref),
+		"github.com/gardener/landscaper/apis/deployer/kustomize/v1alpha1.ProviderStatus":                       schema_apis_deployer_kustomize_v1alpha1_ProviderStatus(ref),
+		"github.com/gardener/landscaper/apis/deployer/kustomize/v1alpha1.RemoteArchiveAccess":                  schema_apis_deployer_kustomize_v1alpha1_RemoteArchiveAccess(ref),
 		"github.com/gardener/landscaper/apis/deployer/manifest.Configuration":                                  schema_landscaper_apis_deployer_manifest_Configuration(ref),
 		"github.com/gardener/landscaper/apis/deployer/manifest.Controller":                                     schema_landscaper_apis_deployer_manifest_Controller(ref),
 		"github.com/gardener/landscaper/apis/deployer/manifest.ExportConfiguration":                            schema_landscaper_apis_deployer_manifest_ExportConfiguration(ref),
@@ -344,13 +412,16 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec":       schema_apis_deployer_utils_continuousreconcile_ContinuousReconcileSpec(ref),
 		"github.com/gardener/landscaper/apis/deployer/utils/managedresource.CustomResourceGroup":               schema_apis_deployer_utils_managedresource_CustomResourceGroup(ref),
 		"github.com/gardener/landscaper/apis/deployer/utils/managedresource.DeletionGroupDefinition":           schema_apis_deployer_utils_managedresource_DeletionGroupDefinition(ref),
+		"github.com/gardener/landscaper/apis/deployer/utils/managedresource.DriftDetectionSpec":                schema_apis_deployer_utils_managedresource_DriftDetectionSpec(ref),
 		"github.com/gardener/landscaper/apis/deployer/utils/managedresource.Export":                            schema_apis_deployer_utils_managedresource_Export(ref),
 		"github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports":                           schema_apis_deployer_utils_managedresource_Exports(ref),
 		"github.com/gardener/landscaper/apis/deployer/utils/managedresource.FromObjectReference":               schema_apis_deployer_utils_managedresource_FromObjectReference(ref),
 		"github.com/gardener/landscaper/apis/deployer/utils/managedresource.ManagedResourceStatus":             schema_apis_deployer_utils_managedresource_ManagedResourceStatus(ref),
 		"github.com/gardener/landscaper/apis/deployer/utils/managedresource.Manifest":                          schema_apis_deployer_utils_managedresource_Manifest(ref),
 		"github.com/gardener/landscaper/apis/deployer/utils/managedresource.PredefinedResourceGroup":           schema_apis_deployer_utils_managedresource_PredefinedResourceGroup(ref),
+		"github.com/gardener/landscaper/apis/deployer/utils/managedresource.ResourceDiff":                      schema_apis_deployer_utils_managedresource_ResourceDiff(ref),
 		"github.com/gardener/landscaper/apis/deployer/utils/managedresource.ResourceType":                      schema_apis_deployer_utils_managedresource_ResourceType(ref),
+		"github.com/gardener/landscaper/apis/deployer/utils/managedresource.ServerSideApplyConfiguration":      schema_apis_deployer_utils_managedresource_ServerSideApplyConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.CustomReadinessCheckConfiguration": schema_apis_deployer_utils_readinesschecks_CustomReadinessCheckConfiguration(ref),
 		"github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.LabelSelectorSpec":                 schema_apis_deployer_utils_readinesschecks_LabelSelectorSpec(ref),
 		"github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration":       schema_apis_deployer_utils_readinesschecks_ReadinessCheckConfiguration(ref),
@@ -1184,12 +1255,26 @@ func schema_gardener_landscaper_apis_config_LandscaperConfiguration(ref common.R
 							Enum:        []interface{}{"Disabled", "DoNotEnforce", "Enforce"},
 						},
 					},
+					"TemplatePlugins": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TemplatePlugins configures out-of-process template executors for additional template types.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/config.TemplatePluginConfiguration"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"TypeMeta", "Controllers", "Registry", "BlueprintStore"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/config.BlueprintStore", "github.com/gardener/landscaper/apis/config.Controllers", "github.com/gardener/landscaper/apis/config.CrdManagementConfiguration", "github.com/gardener/landscaper/apis/config.DeployItemTimeouts", "github.com/gardener/landscaper/apis/config.HPAMainConfiguration", "github.com/gardener/landscaper/apis/config.LsDeployments", "github.com/gardener/landscaper/apis/config.MetricsConfiguration", "github.com/gardener/landscaper/apis/config.RegistryConfiguration", "github.com/gardener/landscaper/legacy-component-spec/bindings-go/apis/v2.UnstructuredTypedObject", "k8s.io/apimachinery/pkg/apis/meta/v1.TypeMeta"},
+			"github.com/gardener/landscaper/apis/config.BlueprintStore", "github.com/gardener/landscaper/apis/config.Controllers", "github.com/gardener/landscaper/apis/config.CrdManagementConfiguration", "github.com/gardener/landscaper/apis/config.DeployItemTimeouts", "github.com/gardener/landscaper/apis/config.HPAMainConfiguration", "github.com/gardener/landscaper/apis/config.LsDeployments", "github.com/gardener/landscaper/apis/config.MetricsConfiguration", "github.com/gardener/landscaper/apis/config.RegistryConfiguration", "github.com/gardener/landscaper/apis/config.TemplatePluginConfiguration", "github.com/gardener/landscaper/legacy-component-spec/bindings-go/apis/v2.UnstructuredTypedObject", "k8s.io/apimachinery/pkg/apis/meta/v1.TypeMeta"},
 	}
 }
 
@@ -1292,10 +1377,18 @@ func schema_gardener_landscaper_apis_config_MetricsConfiguration(ref common.Refe
 							Format:      "int32",
 						},
 					},
+					"ObjectPhaseInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObjectPhaseInterval specifies the interval in which the number of installations, executions and deploy items per phase is computed. Defaults to 1 minute.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
 				},
 				Required: []string{"port"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -1407,6 +1500,59 @@ func schema_gardener_landscaper_apis_config_RegistryConfiguration(ref common.Ref
 	}
 }
 
+func schema_gardener_landscaper_apis_config_TemplatePluginConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TemplatePluginConfiguration configures an out-of-process template executor. The plugin is an executable that is called for every template execution of its template type.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"Type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the template type that is handled by the plugin. The built-in template types cannot be overwritten.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"Command": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Command is the path to the executable of the plugin.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"Args": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Args are additional arguments that are passed to the executable.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"Timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout defines how long a template execution of the plugin may take. Defaults to 30 seconds.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.Duration"),
+						},
+					},
+				},
+				Required: []string{"Type", "Command"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.Duration"},
+	}
+}
+
 func schema_landscaper_apis_config_v1alpha1_AdditionalDeployments(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1935,12 +2081,26 @@ func schema_landscaper_apis_config_v1alpha1_LandscaperConfiguration(ref common.R
 							Enum:        []interface{}{"Disabled", "DoNotEnforce", "Enforce"},
 						},
 					},
+					"templatePlugins": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TemplatePlugins configures out-of-process template executors for additional template types.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/config/v1alpha1.TemplatePluginConfiguration"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"controllers", "registry", "blueprintStore"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/config/v1alpha1.BlueprintStore", "github.com/gardener/landscaper/apis/config/v1alpha1.Controllers", "github.com/gardener/landscaper/apis/config/v1alpha1.CrdManagementConfiguration", "github.com/gardener/landscaper/apis/config/v1alpha1.DeployItemTimeouts", "github.com/gardener/landscaper/apis/config/v1alpha1.HPAMainConfiguration", "github.com/gardener/landscaper/apis/config/v1alpha1.LsDeployments", "github.com/gardener/landscaper/apis/config/v1alpha1.MetricsConfiguration", "github.com/gardener/landscaper/apis/config/v1alpha1.RegistryConfiguration", "github.com/gardener/landscaper/legacy-component-spec/bindings-go/apis/v2.UnstructuredTypedObject"},
+			"github.com/gardener/landscaper/apis/config/v1alpha1.BlueprintStore", "github.com/gardener/landscaper/apis/config/v1alpha1.Controllers", "github.com/gardener/landscaper/apis/config/v1alpha1.CrdManagementConfiguration", "github.com/gardener/landscaper/apis/config/v1alpha1.DeployItemTimeouts", "github.com/gardener/landscaper/apis/config/v1alpha1.HPAMainConfiguration", "github.com/gardener/landscaper/apis/config/v1alpha1.LsDeployments", "github.com/gardener/landscaper/apis/config/v1alpha1.MetricsConfiguration", "github.com/gardener/landscaper/apis/config/v1alpha1.RegistryConfiguration", "github.com/gardener/landscaper/apis/config/v1alpha1.TemplatePluginConfiguration", "github.com/gardener/landscaper/legacy-component-spec/bindings-go/apis/v2.UnstructuredTypedObject"},
 	}
 }
 
@@ -2042,10 +2202,18 @@ func schema_landscaper_apis_config_v1alpha1_MetricsConfiguration(ref common.Refe
 							Format:      "int32",
 						},
 					},
+					"objectPhaseInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObjectPhaseInterval specifies the interval in which the number of installations, executions and deploy items per phase is computed. Defaults to 1 minute.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
 				},
 				Required: []string{"port"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -2157,6 +2325,59 @@ func schema_landscaper_apis_config_v1alpha1_RegistryConfiguration(ref common.Ref
 	}
 }
 
+func schema_landscaper_apis_config_v1alpha1_TemplatePluginConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TemplatePluginConfiguration configures an out-of-process template executor. The plugin is an executable that is called for every template execution of its template type.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the template type that is handled by the plugin. The built-in template types cannot be overwritten.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"command": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Command is the path to the executable of the plugin.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"args": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Args are additional arguments that are passed to the executable.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout defines how long a template execution of the plugin may take. Defaults to 30 seconds.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+						},
+					},
+				},
+				Required: []string{"type", "command"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
+	}
+}
+
 func schema_gardener_landscaper_apis_core_AnyJSON(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2910,11 +3131,31 @@ func schema_gardener_landscaper_apis_core_Context(ref common.ReferenceCallback)
 							},
 						},
 					},
+					"maintenanceWindow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaintenanceWindow restricts the times at which new jobs of root installations referencing this context are started. It is only used for installations without an own maintenance window.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.MaintenanceWindow"),
+						},
+					},
+					"templateLookups": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TemplateLookups defines the kinds of objects that the templates of installations referencing this context are allowed to read from target clusters with the lookup template functions. The lookup functions are disabled if no kind is defined.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.TemplateLookupResource"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.AnyJSON", "github.com/gardener/landscaper/apis/core.VerificationSignature", "github.com/gardener/landscaper/legacy-component-spec/bindings-go/apis/v2.UnstructuredTypedObject", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/landscaper/apis/core.AnyJSON", "github.com/gardener/landscaper/apis/core.MaintenanceWindow", "github.com/gardener/landscaper/apis/core.TemplateLookupResource", "github.com/gardener/landscaper/apis/core.VerificationSignature", "github.com/gardener/landscaper/legacy-component-spec/bindings-go/apis/v2.UnstructuredTypedObject", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
@@ -2987,11 +3228,31 @@ func schema_gardener_landscaper_apis_core_ContextConfiguration(ref common.Refere
 							},
 						},
 					},
+					"maintenanceWindow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaintenanceWindow restricts the times at which new jobs of root installations referencing this context are started. It is only used for installations without an own maintenance window.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.MaintenanceWindow"),
+						},
+					},
+					"templateLookups": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TemplateLookups defines the kinds of objects that the templates of installations referencing this context are allowed to read from target clusters with the lookup template functions. The lookup functions are disabled if no kind is defined.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.TemplateLookupResource"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.AnyJSON", "github.com/gardener/landscaper/apis/core.VerificationSignature", "github.com/gardener/landscaper/legacy-component-spec/bindings-go/apis/v2.UnstructuredTypedObject", "k8s.io/api/core/v1.LocalObjectReference"},
+			"github.com/gardener/landscaper/apis/core.AnyJSON", "github.com/gardener/landscaper/apis/core.MaintenanceWindow", "github.com/gardener/landscaper/apis/core.TemplateLookupResource", "github.com/gardener/landscaper/apis/core.VerificationSignature", "github.com/gardener/landscaper/legacy-component-spec/bindings-go/apis/v2.UnstructuredTypedObject", "k8s.io/api/core/v1.LocalObjectReference"},
 	}
 }
 
@@ -3824,6 +4085,13 @@ func schema_gardener_landscaper_apis_core_DeployItemTemplate(ref common.Referenc
 							},
 						},
 					},
+					"wave": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Wave defines the rollout wave of the deploy item. A deploy item is only started when all deploy items of lower waves have succeeded, and it is only deleted when all deploy items of higher waves are gone. Defaults to 0.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 					"timeout": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Timeout specifies how long the deployer may take to apply the deploy item. When the time is exceeded, the deploy item fails. Value has to be parsable by time.ParseDuration (or 'none' to deactivate the timeout). Defaults to ten minutes if not specified.",
@@ -3985,12 +4253,18 @@ func schema_gardener_landscaper_apis_core_Error(ref common.ReferenceCallback) co
 							},
 						},
 					},
+					"debugSecretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DebugSecretRef references a secret with diagnostic data about the error, e.g. the source location and the redacted input of a failed template execution.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.ObjectReference"),
+						},
+					},
 				},
 				Required: []string{"operation", "lastTransitionTime", "lastUpdateTime", "reason", "message"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/landscaper/apis/core.ObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -4127,6 +4401,13 @@ func schema_gardener_landscaper_apis_core_ExecutionSpec(ref common.ReferenceCall
 							Format:      "byte",
 						},
 					},
+					"maxParallel": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxParallel limits the number of deploy items of the execution that are processed at the same time. All runnable deploy items are started at once if not set.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
 			},
 		},
@@ -4619,252 +4900,268 @@ func schema_gardener_landscaper_apis_core_InstallationList(ref common.ReferenceC
 	}
 }
 
-func schema_gardener_landscaper_apis_core_InstallationSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_InstallationPlan(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "InstallationSpec defines a component installation.",
+				Description: "InstallationPlan contains the objects that a reconcile of an installation would create, update or delete.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"context": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Context defines the current context of the installation.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"verification": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Verification defines the necessary data to verify the signature of the refered component",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.Verification"),
-						},
-					},
-					"componentDescriptor": {
+					"creationTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ComponentDescriptor is a reference to the installation's component descriptor",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.ComponentDescriptorDefinition"),
+							Description: "CreationTime is the time when the plan was computed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"blueprint": {
+					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Blueprint is the resolved reference to the definition.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core.BlueprintDefinition"),
+							Description: "ObservedGeneration is the generation of the installation for which the plan was computed.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"imports": {
+					"importsHash": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Imports define the imported data objects and targets.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core.InstallationImports"),
+							Description: "ImportsHash is the hash of the import data with which the plan was computed.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"importDataMappings": {
+					"deployItems": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ImportDataMappings contains a template for restructuring imports. It is expected to contain a key for every blueprint-defined data import. Missing keys will be defaulted to their respective data import. Example: namespace: (( installation.imports.namespace ))",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "DeployItems contains the planned deploy items of the execution of the installation.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.PlannedDeployItem"),
 									},
 								},
 							},
 						},
 					},
-					"exports": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Exports define the exported data objects and targets.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core.InstallationExports"),
-						},
-					},
-					"exportDataMappings": {
+					"subInstallations": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExportDataMappings contains a template for restructuring exports. It is expected to contain a key for every blueprint-defined data export. Missing keys will be defaulted to their respective data export. Example: namespace: (( blueprint.exports.namespace ))",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "SubInstallations contains the planned subinstallations of the installation.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.PlannedSubInstallation"),
 									},
 								},
 							},
 						},
 					},
-					"automaticReconcile": {
-						SchemaProps: spec.SchemaProps{
-							Description: "AutomaticReconcile allows to configure automatically repeated reconciliations.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.AutomaticReconcile"),
-						},
-					},
-					"optimization": {
+					"error": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Optimization contains settings to improve execution performance.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.Optimization"),
+							Description: "Error describes the error that prevented the computation of the plan.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.Error"),
 						},
 					},
 				},
-				Required: []string{"blueprint"},
+				Required: []string{"creationTime", "observedGeneration"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.AnyJSON", "github.com/gardener/landscaper/apis/core.AutomaticReconcile", "github.com/gardener/landscaper/apis/core.BlueprintDefinition", "github.com/gardener/landscaper/apis/core.ComponentDescriptorDefinition", "github.com/gardener/landscaper/apis/core.InstallationExports", "github.com/gardener/landscaper/apis/core.InstallationImports", "github.com/gardener/landscaper/apis/core.Optimization", "github.com/gardener/landscaper/apis/core.Verification"},
+			"github.com/gardener/landscaper/apis/core.Error", "github.com/gardener/landscaper/apis/core.PlannedDeployItem", "github.com/gardener/landscaper/apis/core.PlannedSubInstallation", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_InstallationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_InstallationRevision(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "InstallationStatus contains the current status of a Installation.",
+				Description: "The InstallationRevision is a snapshot of a successfully finished job of a root installation. It is created by the landscaper and can be used to roll back the installation to a previous state.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"observedGeneration": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ObservedGeneration is the most recent generation observed for this ControllerInstallations. It corresponds to the ControllerInstallations generation, which is updated on mutation by the landscaper.",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"conditions": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Conditions contains the actual condition of a installation",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core.Condition"),
-									},
-								},
-							},
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"lastError": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastError describes the last error that occurred.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.Error"),
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"subInstCache": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SubInstCache contains the currently existing sub installations belonging to the execution. If nil undefined.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.SubInstCache"),
+							Description: "Spec contains the specification",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.InstallationRevisionSpec"),
 						},
 					},
-					"executionRef": {
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.InstallationRevisionSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_InstallationRevisionList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InstallationRevisionList contains a list of InstallationRevision objects",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExecutionReference is the reference to the execution that schedules the templated execution items.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.ObjectReference"),
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"jobID": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "JobID is the ID of the current working request.",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"jobIDFinished": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "JobIDFinished is the ID of the finished working request.",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"phase": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "InstallationPhase is the current phase of the installation.",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.InstallationRevision"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.InstallationRevision", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_InstallationRevisionSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InstallationRevisionSpec contains the snapshot of an installation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"installationName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InstallationName is the name of the installation.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"phaseTransitionTime": {
+					"revision": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PhaseTransitionTime is the time when the phase last changed.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Revision is the number of the revision. It is increased with every successful job of the installation.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"jobID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JobID is the ID of the job of the installation which has been snapshotted.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 					"importsHash": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ImportsHash is the hash of the import data.",
+							Description: "ImportsHash is the hash of the import data with which the job has been processed.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"automaticReconcileStatus": {
+					"installationSpec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AutomaticReconcileStatus describes the status of automatically triggered reconciles.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.AutomaticReconcileStatus"),
+							Description: "InstallationSpec is the specification of the installation with which the job has been processed. It contains in particular the component version, the blueprint reference and the imports.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.InstallationSpec"),
 						},
 					},
-					"dependentsToTrigger": {
+					"deployItems": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DependentsToTrigger lists dependent installations to be triggered",
+							Description: "DeployItems are the rendered deploy item templates of the execution of the installation.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core.DependentToTrigger"),
+										Ref:     ref("github.com/gardener/landscaper/apis/core.DeployItemTemplate"),
 									},
 								},
 							},
 						},
 					},
-					"transitionTimes": {
-						SchemaProps: spec.SchemaProps{
-							Description: "TransitionTimes contains timestamps of status transitions",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.TransitionTimes"),
-						},
-					},
 				},
-				Required: []string{"observedGeneration"},
+				Required: []string{"installationName", "revision", "jobID", "installationSpec"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.AutomaticReconcileStatus", "github.com/gardener/landscaper/apis/core.Condition", "github.com/gardener/landscaper/apis/core.DependentToTrigger", "github.com/gardener/landscaper/apis/core.Error", "github.com/gardener/landscaper/apis/core.ObjectReference", "github.com/gardener/landscaper/apis/core.SubInstCache", "github.com/gardener/landscaper/apis/core.TransitionTimes", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/landscaper/apis/core.DeployItemTemplate", "github.com/gardener/landscaper/apis/core.InstallationSpec"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_InstallationTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_InstallationSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "InstallationTemplate defines a subinstallation in a blueprint.",
+				Description: "InstallationSpec defines a component installation.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"context": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Context defines the current context of the installation.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"verification": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Verification defines the necessary data to verify the signature of the refered component",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.Verification"),
 						},
 					},
-					"name": {
+					"componentDescriptor": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the unique name of the step",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "ComponentDescriptor is a reference to the installation's component descriptor",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.ComponentDescriptorDefinition"),
 						},
 					},
 					"blueprint": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reference defines a reference to a Blueprint. The blueprint can reside in an OCI or other supported location.",
+							Description: "Blueprint is the resolved reference to the definition.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core.InstallationTemplateBlueprintDefinition"),
+							Ref:         ref("github.com/gardener/landscaper/apis/core.BlueprintDefinition"),
 						},
 					},
 					"imports": {
@@ -4909,184 +5206,180 @@ func schema_gardener_landscaper_apis_core_InstallationTemplate(ref common.Refere
 							},
 						},
 					},
+					"automaticReconcile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AutomaticReconcile allows to configure automatically repeated reconciliations.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.AutomaticReconcile"),
+						},
+					},
 					"optimization": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Optimization contains settings to improve execution performance.",
 							Ref:         ref("github.com/gardener/landscaper/apis/core.Optimization"),
 						},
 					},
-				},
-				Required: []string{"name", "blueprint"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.AnyJSON", "github.com/gardener/landscaper/apis/core.InstallationExports", "github.com/gardener/landscaper/apis/core.InstallationImports", "github.com/gardener/landscaper/apis/core.InstallationTemplateBlueprintDefinition", "github.com/gardener/landscaper/apis/core.Optimization"},
-	}
-}
-
-func schema_gardener_landscaper_apis_core_InstallationTemplateBlueprintDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "InstallationTemplateBlueprintDefinition contains either a reference to a blueprint or an inline definition.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"ref": {
+					"maxParallelDeployItems": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Ref is a reference to a blueprint. Only blueprints that are defined by the component descriptor of the current blueprint can be referenced here. Example: cd://componentReference/dns/resources/blueprint",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "MaxParallelDeployItems limits the number of deploy items of the installation that are processed at the same time. All runnable deploy items are started at once if not set.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"filesystem": {
+					"revisionHistoryLimit": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Filesystem defines a virtual filesystem with all files needed for a blueprint. The filesystem must be a YAML filesystem.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+							Description: "RevisionHistoryLimit is the number of revisions of a root installation that are kept. A revision is created for every successfully finished job of the installation. Defaults to 10. Set it to 0 to disable the creation of revisions.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maintenanceWindow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaintenanceWindow restricts the times at which new jobs of a root installation are started after a reconcile has been requested. Requested jobs are deferred until the next window opens. Deletions are never deferred. If not set, the maintenance window of the referenced context is used, if any.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.MaintenanceWindow"),
 						},
 					},
 				},
+				Required: []string{"blueprint"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.AnyJSON"},
-	}
-}
-
-func schema_gardener_landscaper_apis_core_JSONSchemaDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "JSONSchemaDefinition defines a jsonschema.",
-				Type:        core.JSONSchemaDefinition{}.OpenAPISchemaType(),
-				Format:      core.JSONSchemaDefinition{}.OpenAPISchemaFormat(),
-			},
-		},
+			"github.com/gardener/landscaper/apis/core.AnyJSON", "github.com/gardener/landscaper/apis/core.AutomaticReconcile", "github.com/gardener/landscaper/apis/core.BlueprintDefinition", "github.com/gardener/landscaper/apis/core.ComponentDescriptorDefinition", "github.com/gardener/landscaper/apis/core.InstallationExports", "github.com/gardener/landscaper/apis/core.InstallationImports", "github.com/gardener/landscaper/apis/core.MaintenanceWindow", "github.com/gardener/landscaper/apis/core.Optimization", "github.com/gardener/landscaper/apis/core.Verification"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_LocalConfigMapReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_InstallationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LocalConfigMapReference is a reference to data in a configmap.",
+				Description: "InstallationStatus contains the current status of a Installation.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the configmap",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "ObservedGeneration is the most recent generation observed for this ControllerInstallations. It corresponds to the ControllerInstallations generation, which is updated on mutation by the landscaper.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"key": {
+					"conditions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Key is the name of the key in the configmap that holds the data.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Conditions contains the actual condition of a installation",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.Condition"),
+									},
+								},
+							},
 						},
 					},
-				},
-				Required: []string{"name"},
-			},
-		},
-	}
-}
-
-func schema_gardener_landscaper_apis_core_LocalSecretReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "LocalSecretReference is a reference to data in a secret.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"name": {
+					"lastError": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the secret",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "LastError describes the last error that occurred.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.Error"),
 						},
 					},
-					"key": {
+					"subInstCache": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Key is the name of the key in the secret that holds the data.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "SubInstCache contains the currently existing sub installations belonging to the execution. If nil undefined.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.SubInstCache"),
 						},
 					},
-				},
-				Required: []string{"name"},
-			},
-		},
-	}
-}
-
-func schema_gardener_landscaper_apis_core_LsHealthCheck(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "LsHealthCheck is a resource containing information about problems with the landscaper installation",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"kind": {
+					"executionRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "ExecutionReference is the reference to the execution that schedules the templated execution items.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.ObjectReference"),
 						},
 					},
-					"apiVersion": {
+					"jobID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "JobID is the ID of the current working request.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"jobIDFinished": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "JobIDFinished is the ID of the finished working request.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"status": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status defines the overall status of the landscaper and its standard deployers.",
-							Default:     "",
+							Description: "InstallationPhase is the current phase of the installation.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"lastUpdateTime": {
+					"phaseTransitionTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastUpdateTime contains last time the check was updated.",
+							Description: "PhaseTransitionTime is the time when the phase last changed.",
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"description": {
+					"importsHash": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Description contains description of the problem(s)",
-							Default:     "",
+							Description: "ImportsHash is the hash of the import data.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"automaticReconcileStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AutomaticReconcileStatus describes the status of automatically triggered reconciles.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.AutomaticReconcileStatus"),
+						},
+					},
+					"dependentsToTrigger": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DependentsToTrigger lists dependent installations to be triggered",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.DependentToTrigger"),
+									},
+								},
+							},
+						},
+					},
+					"transitionTimes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TransitionTimes contains timestamps of status transitions",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.TransitionTimes"),
+						},
+					},
+					"plan": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Plan contains the deploy items and subinstallations that a reconcile of the installation would create, update or delete. It is computed if the installation is annotated with the plan operation.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.InstallationPlan"),
+						},
+					},
+					"pendingJob": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PendingJob describes a requested job which is deferred until the next maintenance window opens.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.PendingJob"),
+						},
+					},
 				},
-				Required: []string{"status", "lastUpdateTime", "description"},
+				Required: []string{"observedGeneration"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/landscaper/apis/core.AutomaticReconcileStatus", "github.com/gardener/landscaper/apis/core.Condition", "github.com/gardener/landscaper/apis/core.DependentToTrigger", "github.com/gardener/landscaper/apis/core.Error", "github.com/gardener/landscaper/apis/core.InstallationPlan", "github.com/gardener/landscaper/apis/core.ObjectReference", "github.com/gardener/landscaper/apis/core.PendingJob", "github.com/gardener/landscaper/apis/core.SubInstCache", "github.com/gardener/landscaper/apis/core.TransitionTimes", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_LsHealthCheckList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_InstallationTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LsHealthCheckList contains a list of LsHealthChecks",
+				Description: "InstallationTemplate defines a subinstallation in a blueprint.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -5103,280 +5396,364 @@ func schema_gardener_landscaper_apis_core_LsHealthCheckList(ref common.Reference
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "Name is the unique name of the step",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"items": {
+					"blueprint": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "Reference defines a reference to a Blueprint. The blueprint can reside in an OCI or other supported location.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.InstallationTemplateBlueprintDefinition"),
+						},
+					},
+					"imports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Imports define the imported data objects and targets.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.InstallationImports"),
+						},
+					},
+					"importDataMappings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImportDataMappings contains a template for restructuring imports. It is expected to contain a key for every blueprint-defined data import. Missing keys will be defaulted to their respective data import. Example: namespace: (( installation.imports.namespace ))",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core.LsHealthCheck"),
+										Ref: ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
 									},
 								},
 							},
 						},
 					},
-				},
-				Required: []string{"items"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.LsHealthCheck", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
-	}
-}
-
-func schema_gardener_landscaper_apis_core_NamedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "NamedObjectReference is a named reference to a specific resource.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"name": {
+					"exports": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the unique name of the reference.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Exports define the exported data objects and targets.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.InstallationExports"),
 						},
 					},
-					"ref": {
+					"exportDataMappings": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reference is the reference to an object.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core.ObjectReference"),
+							Description: "ExportDataMappings contains a template for restructuring exports. It is expected to contain a key for every blueprint-defined data export. Missing keys will be defaulted to their respective data export. Example: namespace: (( blueprint.exports.namespace ))",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+									},
+								},
+							},
+						},
+					},
+					"optimization": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Optimization contains settings to improve execution performance.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.Optimization"),
 						},
 					},
 				},
-				Required: []string{"name", "ref"},
+				Required: []string{"name", "blueprint"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.ObjectReference"},
+			"github.com/gardener/landscaper/apis/core.AnyJSON", "github.com/gardener/landscaper/apis/core.InstallationExports", "github.com/gardener/landscaper/apis/core.InstallationImports", "github.com/gardener/landscaper/apis/core.InstallationTemplateBlueprintDefinition", "github.com/gardener/landscaper/apis/core.Optimization"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_ObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_InstallationTemplateBlueprintDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ObjectReference is the reference to a kubernetes object.",
+				Description: "InstallationTemplateBlueprintDefinition contains either a reference to a blueprint or an inline definition.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"ref": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the kubernetes object.",
-							Default:     "",
+							Description: "Ref is a reference to a blueprint. Only blueprints that are defined by the component descriptor of the current blueprint can be referenced here. Example: cd://componentReference/dns/resources/blueprint",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"namespace": {
+					"filesystem": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Namespace is the namespace of kubernetes object.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Filesystem defines a virtual filesystem with all files needed for a blueprint. The filesystem must be a YAML filesystem.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
 						},
 					},
 				},
-				Required: []string{"name"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.AnyJSON"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_OnDeleteConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_JSONSchemaDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OnDeleteConfig specifies particular setting when deleting a deploy item",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"skipUninstallIfClusterRemoved": {
-						SchemaProps: spec.SchemaProps{
-							Description: "SkipUninstallIfClusterRemoved specifies that uninstall is skipped if the target cluster is already deleted. Works only in the context of an existing target sync object which is used to check the Garden project with the shoot cluster resources",
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-				},
+				Description: "JSONSchemaDefinition defines a jsonschema.",
+				Type:        core.JSONSchemaDefinition{}.OpenAPISchemaType(),
+				Format:      core.JSONSchemaDefinition{}.OpenAPISchemaFormat(),
 			},
 		},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_Optimization(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_LandscapeStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Optimization contains settings to improve execution preformance",
+				Description: "The LandscapeStatus aggregates the status of the installation trees of a namespace.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"hasNoSiblingImports": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "set this on true if the installation does not import data from its siblings or has no siblings at all",
-							Type:        []string{"boolean"},
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"hasNoSiblingExports": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "set this on true if the installation does not export data to its siblings or has no siblings at all",
-							Type:        []string{"boolean"},
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec contains the specification",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.LandscapeStatusSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status contains the status",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.LandscapeStatusStatus"),
+						},
+					},
 				},
+				Required: []string{"spec"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.LandscapeStatusSpec", "github.com/gardener/landscaper/apis/core.LandscapeStatusStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_RemoteBlueprintReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_LandscapeStatusItem(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "RemoteBlueprintReference describes a reference to a blueprint defined by a component descriptor.",
+				Description: "LandscapeStatusItem describes an installation, execution or deploy item of an installation tree.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"resourceName": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ResourceName is the name of the blueprint as defined by a component descriptor.",
+							Description: "Kind is the kind of the object, i.e. Installation, Execution or DeployItem.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-				},
-				Required: []string{"resourceName"},
-			},
-		},
-	}
-}
-
-func schema_gardener_landscaper_apis_core_Requirement(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "Requirement contains values, a key, and an operator that relates the key and values. The zero value of Requirement is invalid. Requirement implements both set based match and exact match Requirement should be initialized via NewRequirement constructor for creating a valid Requirement.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"key": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
+							Description: "Name is the name of the object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
 					},
-					"operator": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Namespace is the namespace of the object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"values": {
+					"rootInstallation": {
 						SchemaProps: spec.SchemaProps{
-							Description: "In huge majority of cases we have at most one value here. It is generally faster to operate on a single-element slice than on a single-element map, so we have a slice here.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "RootInstallation is the name of the root installation of the installation tree that contains the object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path contains the names of the installations from the root installation to the object.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the current phase of the object.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"jobID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JobID is the ID of the current job of the object.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"startTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartTime is the time when the current job of the object has been triggered.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastError": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastError describes the last error of the object.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.Error"),
 						},
 					},
 				},
-				Required: []string{"key", "operator"},
+				Required: []string{"kind", "name", "namespace", "rootInstallation"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.Error", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_ResolvedTarget(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_LandscapeStatusList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ResolvedTarget is a helper struct to store a target together with the content of its resolved secret reference.",
+				Description: "LandscapeStatusList contains a list of LandscapeStatus objects",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"target": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Target contains the original target.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.Target"),
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"content": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Content contains the content of the target. If the target has a secret reference, this field should be filled by a TargetResolver. Otherwise, the inline configuration of the target is put here.",
-							Default:     "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.LandscapeStatus"),
+									},
+								},
+							},
+						},
+					},
 				},
-				Required: []string{"target", "content"},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.Target"},
+			"github.com/gardener/landscaper/apis/core.LandscapeStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_ResourceReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_LandscapeStatusSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ResourceReference defines the reference to a resource defined in a component descriptor.",
+				Description: "LandscapeStatusSpec contains the specification for a LandscapeStatus.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"componentName": {
+					"installationSelector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ComponentName defines the unique of the component containing the resource.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "InstallationSelector selects the root installations of the namespace whose installation trees are aggregated. If not set, all root installations of the namespace are aggregated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
 						},
 					},
-					"resourceName": {
+					"maxItems": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ResourceName defines the name of the resource.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "MaxItems is the maximal number of entries of the lists of failed and longest running items. Defaults to 10.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"refreshInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RefreshInterval defines how often the status is aggregated. Defaults to 1m.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.Duration"),
 						},
 					},
 				},
-				Required: []string{"componentName", "resourceName"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.Duration", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_SecretLabelSelectorRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_LandscapeStatusStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SecretLabelSelectorRef selects secrets with the given label and key.",
+				Description: "LandscapeStatusStatus contains the status of a LandscapeStatus.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"selector": {
+					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Selector is a map of labels to select specific secrets.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "ObservedGeneration is the most recent generation observed.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Last time the status was updated",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastError": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastError describes the last error that occurred during the aggregation of the status.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.Error"),
+						},
+					},
+					"rootInstallations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RootInstallations contains the names of the aggregated root installations.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: "",
@@ -5387,39 +5764,81 @@ func schema_gardener_landscaper_apis_core_SecretLabelSelectorRef(ref common.Refe
 							},
 						},
 					},
-					"key": {
+					"installations": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The key of the secret to select from.  Must be a valid secret key.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Installations contains the number of installations per phase.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.PhaseCounts"),
+						},
+					},
+					"executions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Executions contains the number of executions per phase.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.PhaseCounts"),
+						},
+					},
+					"deployItems": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeployItems contains the number of deploy items per phase.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.PhaseCounts"),
+						},
+					},
+					"failedItemCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailedItemCount is the number of failed leaves, i.e. failed objects without failed child objects.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"failedItems": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailedItems contains the failed leaves of the installation trees, i.e. the failed objects without failed child objects. The list is limited to spec.maxItems entries.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.LandscapeStatusItem"),
+									},
+								},
+							},
+						},
+					},
+					"longestRunningItems": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LongestRunningItems contains the objects that have been processing their current job for the longest time. The list is limited to spec.maxItems entries.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.LandscapeStatusItem"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"selector", "key"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.Error", "github.com/gardener/landscaper/apis/core.LandscapeStatusItem", "github.com/gardener/landscaper/apis/core.PhaseCounts", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_SecretReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_LocalConfigMapReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SecretReference is reference to data in a secret. The secret can also be in a different namespace.",
+				Description: "LocalConfigMapReference is a reference to data in a configmap.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the kubernetes object.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"namespace": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Namespace is the namespace of kubernetes object.",
+							Description: "Name is the name of the configmap",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
@@ -5427,7 +5846,7 @@ func schema_gardener_landscaper_apis_core_SecretReference(ref common.ReferenceCa
 					},
 					"key": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Key is the name of the key in the secret that holds the data.",
+							Description: "Key is the name of the key in the configmap that holds the data.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
@@ -5440,141 +5859,101 @@ func schema_gardener_landscaper_apis_core_SecretReference(ref common.ReferenceCa
 	}
 }
 
-func schema_gardener_landscaper_apis_core_StaticDataSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_LocalSecretReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "StaticDataSource defines a static data source",
+				Description: "LocalSecretReference is a reference to data in a secret.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"value": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Value defined inline a raw data",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+							Description: "Name is the name of the secret",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"valueFrom": {
+					"key": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ValueFrom defines data from an external resource",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.StaticDataValueFrom"),
+							Description: "Key is the name of the key in the secret that holds the data.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"name"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.AnyJSON", "github.com/gardener/landscaper/apis/core.StaticDataValueFrom"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_StaticDataValueFrom(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_LsHealthCheck(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "StaticDataValueFrom defines a static data that is read from a external resource.",
+				Description: "LsHealthCheck is a resource containing information about problems with the landscaper installation",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"secretKeyRef": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Selects a key of a secret in the installations's namespace",
-							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"secretLabelSelector": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Selects a key from multiple secrets in the installations's namespace that matches the given labels.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.SecretLabelSelectorRef"),
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.SecretLabelSelectorRef", "k8s.io/api/core/v1.SecretKeySelector"},
-	}
-}
-
-func schema_gardener_landscaper_apis_core_SubInstCache(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "SubInstCache contains the existing sub installations",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"activeSubs": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core.SubNamePair"),
-									},
-								},
-							},
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"orphanedSubs": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "Status defines the overall status of the landscaper and its standard deployers.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.SubNamePair"},
-	}
-}
-
-func schema_gardener_landscaper_apis_core_SubNamePair(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "DiNamePair contains the spec name and the real name of a deploy item",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"specName": {
+					"lastUpdateTime": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "LastUpdateTime contains last time the check was updated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"objectName": {
+					"description": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Description contains description of the problem(s)",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"status", "lastUpdateTime", "description"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_SubinstallationTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_LsHealthCheckList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SubinstallationTemplate defines a subinstallation template.",
+				Description: "LsHealthCheckList contains a list of LsHealthChecks",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"file": {
-						SchemaProps: spec.SchemaProps{
-							Description: "File references a subinstallation template stored in another file.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
 					"kind": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
@@ -5589,99 +5968,64 @@ func schema_gardener_landscaper_apis_core_SubinstallationTemplate(ref common.Ref
 							Format:      "",
 						},
 					},
-					"name": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Name is the unique name of the step",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"blueprint": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Reference defines a reference to a Blueprint. The blueprint can reside in an OCI or other supported location.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core.InstallationTemplateBlueprintDefinition"),
-						},
-					},
-					"imports": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Imports define the imported data objects and targets.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core.InstallationImports"),
-						},
-					},
-					"importDataMappings": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ImportDataMappings contains a template for restructuring imports. It is expected to contain a key for every blueprint-defined data import. Missing keys will be defaulted to their respective data import. Example: namespace: (( installation.imports.namespace ))",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
-									},
-								},
-							},
-						},
-					},
-					"exports": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Exports define the exported data objects and targets.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core.InstallationExports"),
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"exportDataMappings": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExportDataMappings contains a template for restructuring exports. It is expected to contain a key for every blueprint-defined data export. Missing keys will be defaulted to their respective data export. Example: namespace: (( blueprint.exports.namespace ))",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.LsHealthCheck"),
 									},
 								},
 							},
 						},
 					},
-					"optimization": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Optimization contains settings to improve execution performance.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.Optimization"),
-						},
-					},
 				},
-				Required: []string{"name", "blueprint"},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.AnyJSON", "github.com/gardener/landscaper/apis/core.InstallationExports", "github.com/gardener/landscaper/apis/core.InstallationImports", "github.com/gardener/landscaper/apis/core.InstallationTemplateBlueprintDefinition", "github.com/gardener/landscaper/apis/core.Optimization"},
+			"github.com/gardener/landscaper/apis/core.LsHealthCheck", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_SucceededReconcile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_MaintenanceWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SucceededReconcile allows to configure automatically repeated reconciliations for succeeded installations",
+				Description: "MaintenanceWindow defines recurring time windows during which changes are applied.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"interval": {
+					"cronSpec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Interval specifies the interval between two subsequent repeated reconciliations. If not set, a default of 24 hours is used.",
+							Description: "CronSpec describes the start times of the windows according to the cron syntax \"https://pkg.go.dev/github.com/robfig/cron#hdr-CRON_Expression_Format\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration is the length of every window.",
 							Ref:         ref("github.com/gardener/landscaper/apis/core.Duration"),
 						},
 					},
-					"cronSpec": {
+					"timeZone": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CronSpec describes the reconcile intervals according to the cron syntax \"https://pkg.go.dev/github.com/robfig/cron#hdr-CRON_Expression_Format\". If not empty, this specification is used instead of Interval.",
+							Description: "TimeZone is the IANA name of the time zone in which the cron spec is evaluated, for example \"Europe/Berlin\". Defaults to UTC.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
+				Required: []string{"cronSpec", "duration"},
 			},
 		},
 		Dependencies: []string{
@@ -5689,266 +6033,214 @@ func schema_gardener_landscaper_apis_core_SucceededReconcile(ref common.Referenc
 	}
 }
 
-func schema_gardener_landscaper_apis_core_SyncObject(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_NamedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "The SyncObject helps to sync access to deploy items.",
+				Description: "NamedObjectReference is a named reference to a specific resource.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Name is the unique name of the reference.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"spec": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Spec contains the specification",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core.SyncObjectSpec"),
-						},
-					},
-					"status": {
+					"ref": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status contains the status",
+							Description: "Reference is the reference to an object.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core.SyncObjectStatus"),
+							Ref:         ref("github.com/gardener/landscaper/apis/core.ObjectReference"),
 						},
 					},
 				},
-				Required: []string{"spec"},
+				Required: []string{"name", "ref"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.SyncObjectSpec", "github.com/gardener/landscaper/apis/core.SyncObjectStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/landscaper/apis/core.ObjectReference"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_SyncObjectList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_ObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SyncObjectList contains a list of SyncObject objects",
+				Description: "ObjectReference is the reference to a kubernetes object.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Name is the name of the kubernetes object.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Namespace is the namespace of kubernetes object.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
-						},
-					},
-					"items": {
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_OnDeleteConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OnDeleteConfig specifies particular setting when deleting a deploy item",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"skipUninstallIfClusterRemoved": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core.SyncObject"),
-									},
-								},
-							},
+							Description: "SkipUninstallIfClusterRemoved specifies that uninstall is skipped if the target cluster is already deleted. Works only in the context of an existing target sync object which is used to check the Garden project with the shoot cluster resources",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"items"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.SyncObject", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_SyncObjectSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_Optimization(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SyncObjectSpec contains the specification.",
+				Description: "Optimization contains settings to improve execution preformance",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"podName": {
+					"hasNoSiblingImports": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PodName describes the name of the pod of the responsible deployer",
-							Default:     "",
-							Type:        []string{"string"},
+							Description: "set this on true if the installation does not import data from its siblings or has no siblings at all",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"kind": {
+					"hasNoSiblingExports": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind describes the kind of object that is being locked by this SyncObject",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"name": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the object that is being locked by this SyncObject",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"lastUpdateTime": {
-						SchemaProps: spec.SchemaProps{
-							Description: "LastUpdateTime contains last time the object was updated.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"prefix": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Prefix is the prefix of the name of the object.",
-							Default:     "",
-							Type:        []string{"string"},
+							Description: "set this on true if the installation does not export data to its siblings or has no siblings at all",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"podName", "kind", "name", "lastUpdateTime", "prefix"},
-			},
-		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
-	}
-}
-
-func schema_gardener_landscaper_apis_core_SyncObjectStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "SyncObjectStatus contains the status.",
-				Type:        []string{"object"},
 			},
 		},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_Target(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_PendingJob(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Target defines a specific data object that defines target environment. Every deploy item can have a target which is used by the deployer to install the specific application.",
+				Description: "PendingJob describes a requested job of an installation which is deferred by a maintenance window.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
+					"generation": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Generation is the generation of the installation for which the job has been requested.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"metadata": {
+					"requestTime": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "RequestTime is the time when the deferred job was first detected.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"spec": {
+					"nextWindowStart": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/gardener/landscaper/apis/core.TargetSpec"),
+							Description: "NextWindowStart is the time when the next maintenance window opens and the job is started.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
-				Required: []string{"spec"},
+				Required: []string{"generation", "requestTime", "nextWindowStart"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.TargetSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_TargetExport(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_PhaseCounts(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetExport is a single target export.",
+				Description: "PhaseCounts contains the number of objects of one kind.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"total": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name the internal name of the exported target.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Total is the number of objects.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"target": {
+					"phases": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Target is the name of the in-cluster target object.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Phases maps the phases to the number of objects in the phase.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int32",
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"name"},
+				Required: []string{"total"},
 			},
 		},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_TargetImport(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_PlannedDeployItem(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetImport is either a single target or a target list import.",
+				Description: "PlannedDeployItem describes a deploy item of a plan.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name the internal name of the imported target.",
+							Description: "Name is the name of the deploy item as defined in the deploy execution of the blueprint.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"target": {
+					"action": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Target is the name of the in-cluster target object. Exactly one of Target, Targets, and TargetListReference has to be specified.",
+							Description: "Action describes what a reconcile would do with the deploy item.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"targets": {
+					"changedFields": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Targets is a list of in-cluster target objects. Exactly one of Target, Targets, and TargetListReference has to be specified.",
+							Description: "ChangedFields contains the paths of the fields that would be changed by an update.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -5961,18 +6253,49 @@ func schema_gardener_landscaper_apis_core_TargetImport(ref common.ReferenceCallb
 							},
 						},
 					},
-					"targetListRef": {
+					"template": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TargetListReference can (only) be used to import a targetlist that has been imported by the parent installation. Exactly one of Target, Targets, and TargetListReference has to be specified.",
+							Description: "Template is the rendered deploy item template. It is not set for deploy items that would be deleted.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.DeployItemTemplate"),
+						},
+					},
+				},
+				Required: []string{"name", "action"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.DeployItemTemplate"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_PlannedSubInstallation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PlannedSubInstallation describes a subinstallation of a plan.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the subinstallation as defined in the blueprint.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"targetMap": {
+					"action": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "Action describes what a reconcile would do with the subinstallation.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"changedFields": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ChangedFields contains the paths of the fields that would be changed by an update.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: "",
@@ -5983,426 +6306,300 @@ func schema_gardener_landscaper_apis_core_TargetImport(ref common.ReferenceCallb
 							},
 						},
 					},
-					"targetMapRef": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Spec is the rendered specification of the subinstallation. It is not set for subinstallations that would be deleted.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.InstallationSpec"),
 						},
 					},
 				},
-				Required: []string{"name"},
+				Required: []string{"name", "action"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.InstallationSpec"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_TargetList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_RemoteBlueprintReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetList contains a list of Targets",
+				Description: "RemoteBlueprintReference describes a reference to a blueprint defined by a component descriptor.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"resourceName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "ResourceName is the name of the blueprint as defined by a component descriptor.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+				},
+				Required: []string{"resourceName"},
+			},
+		},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_Requirement(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Requirement contains values, a key, and an operator that relates the key and values. The zero value of Requirement is invalid. Requirement implements both set based match and exact match Requirement should be initialized via NewRequirement constructor for creating a valid Requirement.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"key": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"metadata": {
+					"operator": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"items": {
+					"values": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "In huge majority of cases we have at most one value here. It is generally faster to operate on a single-element slice than on a single-element map, so we have a slice here.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core.Target"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"key", "operator"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.Target", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_TargetSelector(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_ResolvedTarget(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetSelector describes a selector that matches specific targets.",
+				Description: "ResolvedTarget is a helper struct to store a target together with the content of its resolved secret reference.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"targets": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Targets defines a list of specific targets (name and namespace) that should be reconciled.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core.ObjectReference"),
-									},
-								},
-							},
-						},
-					},
-					"annotations": {
+					"target": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Annotations matches a target based on annotations.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core.Requirement"),
-									},
-								},
-							},
+							Description: "Target contains the original target.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.Target"),
 						},
 					},
-					"labels": {
+					"content": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Labels matches a target based on its labels.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core.Requirement"),
-									},
-								},
-							},
+							Description: "Content contains the content of the target. If the target has a secret reference, this field should be filled by a TargetResolver. Otherwise, the inline configuration of the target is put here.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"target", "content"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.ObjectReference", "github.com/gardener/landscaper/apis/core.Requirement"},
+			"github.com/gardener/landscaper/apis/core.Target"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_TargetSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_ResourceReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetSpec contains the definition of a target.",
+				Description: "ResourceReference defines the reference to a resource defined in a component descriptor.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
+					"componentName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type is the type of the target that defines its data structure. The actual schema may be defined by a target type crd in the future.",
+							Description: "ComponentName defines the unique of the component containing the resource.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"config": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Configuration contains the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
-						},
-					},
-					"secretRef": {
+					"resourceName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reference to a secret containing the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.LocalSecretReference"),
+							Description: "ResourceName defines the name of the resource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"type"},
+				Required: []string{"componentName", "resourceName"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.AnyJSON", "github.com/gardener/landscaper/apis/core.LocalSecretReference"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_TargetSync(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_SecretLabelSelectorRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "The TargetSync is created targets from secrets.",
+				Description: "SecretLabelSelectorRef selects secrets with the given label and key.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"selector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Selector is a map of labels to select specific secrets.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"apiVersion": {
+					"key": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "The key of the secret to select from.  Must be a valid secret key.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"spec": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Spec contains the specification",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core.TargetSyncSpec"),
-						},
-					},
-					"status": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Status contains the status",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core.TargetSyncStatus"),
-						},
-					},
 				},
-				Required: []string{"spec"},
+				Required: []string{"selector", "key"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.TargetSyncSpec", "github.com/gardener/landscaper/apis/core.TargetSyncStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_TargetSyncList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_SecretReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetSyncList contains a list of TargetSync objects",
+				Description: "SecretReference is reference to data in a secret. The secret can also be in a different namespace.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Name is the name of the kubernetes object.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Namespace is the namespace of kubernetes object.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
-						},
-					},
-					"items": {
+					"key": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core.TargetSync"),
-									},
-								},
-							},
+							Description: "Key is the name of the key in the secret that holds the data.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"name"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.TargetSync", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_TargetSyncSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_StaticDataSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetSyncSpec contains the specification for a TargetSync.",
+				Description: "StaticDataSource defines a static data source",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"sourceNamespace": {
-						SchemaProps: spec.SchemaProps{
-							Description: "SourceNamespace describes the namespace from where the secrets should be synced",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"secretRef": {
-						SchemaProps: spec.SchemaProps{
-							Description: "SecretRef references the secret that contains the kubeconfig to the namespace of the secrets to be synced.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core.LocalSecretReference"),
-						},
-					},
-					"createTargetToSource": {
-						SchemaProps: spec.SchemaProps{
-							Description: "CreateTargetToSource specifies if set on true, that also a target is created, which references the secret in SecretRef",
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-					"targetToSourceName": {
-						SchemaProps: spec.SchemaProps{
-							Description: "TargetToSourceName is the name of the target referencing the secret defined in SecretRef if CreateTargetToSource is set on true. If TargetToSourceName is empty SourceNamespace is used instead.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"secretNameExpression": {
-						SchemaProps: spec.SchemaProps{
-							Description: "SecretNameExpression defines the names of the secrets which should be synced via a regular expression according to https://github.com/google/re2/wiki/Syntax with the extension that * is also a valid expression and matches all names. if not set no secrets are synced",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"shootNameExpression": {
+					"value": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ShootNameExpression defines the names of shoot clusters for which targets with short living access data to the shoots are created via a regular expression according to https://github.com/google/re2/wiki/Syntax with the extension that * is also a valid expression and matches all names. if not set no targets for the shoots are created",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Value defined inline a raw data",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
 						},
 					},
-					"tokenRotation": {
+					"valueFrom": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TokenRotation defines the data to perform an automatic rotation of the token to access the source cluster with the secrets to sync. The token expires after 90 days and will be rotated every 60 days.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.TokenRotation"),
+							Description: "ValueFrom defines data from an external resource",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.StaticDataValueFrom"),
 						},
 					},
 				},
-				Required: []string{"sourceNamespace", "secretRef"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.LocalSecretReference", "github.com/gardener/landscaper/apis/core.TokenRotation"},
+			"github.com/gardener/landscaper/apis/core.AnyJSON", "github.com/gardener/landscaper/apis/core.StaticDataValueFrom"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_TargetSyncStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_StaticDataValueFrom(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetSyncStatus contains the status of a TargetSync.",
+				Description: "StaticDataValueFrom defines a static data that is read from a external resource.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"observedGeneration": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ObservedGeneration is the most recent generation observed.",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int64",
-						},
-					},
-					"lastUpdateTime": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Last time the status was updated",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"lastErrors": {
+					"secretKeyRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastErrors describe the last errors",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "Selects a key of a secret in the installations's namespace",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
 						},
 					},
-					"lastTokenRotationTime": {
+					"secretLabelSelector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Last time the token was rotated",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Selects a key from multiple secrets in the installations's namespace that matches the given labels.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.SecretLabelSelectorRef"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/landscaper/apis/core.SecretLabelSelectorRef", "k8s.io/api/core/v1.SecretKeySelector"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_TargetTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_SubInstCache(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetTemplate exposes specific parts of a target that are used in the exports to export a target",
+				Description: "SubInstCache contains the existing sub installations",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Type is the type of the target that defines its data structure. The actual schema may be defined by a target type crd in the future.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"config": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Configuration contains the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
-						},
-					},
-					"secretRef": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Reference to a secret containing the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.LocalSecretReference"),
-						},
-					},
-					"labels": {
+					"activeSubs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Map of string keys and values that can be used to organize and categorize (scope and select) objects. May match selectors of replication controllers and services. More info: http://kubernetes.io/docs/user-guide/labels",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.SubNamePair"),
 									},
 								},
 							},
 						},
 					},
-					"annotations": {
+					"orphanedSubs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Annotations is an unstructured key value map stored with a resource that may be set by external tools to store and retrieve arbitrary metadata. They are not queryable and should be preserved when modifying objects. More info: http://kubernetes.io/docs/user-guide/annotations",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: "",
@@ -6414,404 +6611,620 @@ func schema_gardener_landscaper_apis_core_TargetTemplate(ref common.ReferenceCal
 						},
 					},
 				},
-				Required: []string{"type"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.AnyJSON", "github.com/gardener/landscaper/apis/core.LocalSecretReference"},
+			"github.com/gardener/landscaper/apis/core.SubNamePair"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_TemplateExecutor(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_SubNamePair(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TemplateExecutor describes a templating mechanism and configuration.",
+				Description: "DiNamePair contains the spec name and the real name of a deploy item",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"specName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the unique name of the template",
-							Default:     "",
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"objectName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_SubinstallationTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SubinstallationTemplate defines a subinstallation template.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"file": {
+						SchemaProps: spec.SchemaProps{
+							Description: "File references a subinstallation template stored in another file.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"type": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type describes the templating mechanism.",
-							Default:     "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"file": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "File is the path to the template in the blueprint's content.",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"template": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Template contains an optional inline template. The template has to be of string for go template and either a string or valid yaml/json for spiff.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+							Description: "Name is the unique name of the step",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"blueprint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reference defines a reference to a Blueprint. The blueprint can reside in an OCI or other supported location.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.InstallationTemplateBlueprintDefinition"),
+						},
+					},
+					"imports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Imports define the imported data objects and targets.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.InstallationImports"),
+						},
+					},
+					"importDataMappings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImportDataMappings contains a template for restructuring imports. It is expected to contain a key for every blueprint-defined data import. Missing keys will be defaulted to their respective data import. Example: namespace: (( installation.imports.namespace ))",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+									},
+								},
+							},
+						},
+					},
+					"exports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Exports define the exported data objects and targets.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.InstallationExports"),
+						},
+					},
+					"exportDataMappings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExportDataMappings contains a template for restructuring exports. It is expected to contain a key for every blueprint-defined data export. Missing keys will be defaulted to their respective data export. Example: namespace: (( blueprint.exports.namespace ))",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+									},
+								},
+							},
+						},
+					},
+					"optimization": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Optimization contains settings to improve execution performance.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.Optimization"),
 						},
 					},
 				},
-				Required: []string{"name", "type"},
+				Required: []string{"name", "blueprint"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.AnyJSON"},
+			"github.com/gardener/landscaper/apis/core.AnyJSON", "github.com/gardener/landscaper/apis/core.InstallationExports", "github.com/gardener/landscaper/apis/core.InstallationImports", "github.com/gardener/landscaper/apis/core.InstallationTemplateBlueprintDefinition", "github.com/gardener/landscaper/apis/core.Optimization"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_TokenRotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_SucceededReconcile(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "SucceededReconcile allows to configure automatically repeated reconciliations for succeeded installations",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"enabled": {
+					"interval": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Enabled defines if automatic token is executed",
-							Type:        []string{"boolean"},
+							Description: "Interval specifies the interval between two subsequent repeated reconciliations. If not set, a default of 24 hours is used.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.Duration"),
+						},
+					},
+					"cronSpec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CronSpec describes the reconcile intervals according to the cron syntax \"https://pkg.go.dev/github.com/robfig/cron#hdr-CRON_Expression_Format\". If not empty, this specification is used instead of Interval.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.Duration"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_TransitionTimes(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_SyncObject(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "The SyncObject helps to sync access to deploy items.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"triggerTime": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TriggerTime is the time when the jobID is set.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"initTime": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "InitTime is the time when the Init phase starts.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"waitTime": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "WaitTime is the time when the work is done.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"finishedTime": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FinishedTime is the time when the finished phase is set.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Spec contains the specification",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.SyncObjectSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status contains the status",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.SyncObjectStatus"),
 						},
 					},
 				},
+				Required: []string{"spec"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/landscaper/apis/core.SyncObjectSpec", "github.com/gardener/landscaper/apis/core.SyncObjectStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_TypedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_SyncObjectList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TypedObjectReference is a reference to a typed kubernetes object.",
+				Description: "SyncObjectList contains a list of SyncObject objects",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"apiVersion": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion is the group and version for the resource being referenced. If APIVersion is not specified, the specified Kind must be in the core API group. For any other third-party types, APIVersion is required.",
-							Default:     "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"kind": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is the type of resource being referenced",
-							Default:     "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"name": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the kubernetes object.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"namespace": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Namespace is the namespace of kubernetes object.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.SyncObject"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"apiVersion", "kind", "name"},
+				Required: []string{"items"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.SyncObject", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_Verification(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_SyncObjectSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Verification defines the necessary data to verify the signature of the refered component",
+				Description: "SyncObjectSpec contains the specification.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"signatureName": {
+					"podName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SignatureName defines the name of the signature that is verified",
+							Description: "PodName describes the name of the pod of the responsible deployer",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind describes the kind of object that is being locked by this SyncObject",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the object that is being locked by this SyncObject",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastUpdateTime contains last time the object was updated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"prefix": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Prefix is the prefix of the name of the object.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"signatureName"},
+				Required: []string{"podName", "kind", "name", "lastUpdateTime", "prefix"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_VerificationSignature(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_SyncObjectStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VerificationSignatures contains the trusted verification information",
+				Description: "SyncObjectStatus contains the status.",
+				Type:        []string{"object"},
+			},
+		},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_Target(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Target defines a specific data object that defines target environment. Every deploy item can have a target which is used by the deployer to install the specific application.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"publicKeySecretReference": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PublicKeySecretReference contains a secret reference to a public key in PEM format that is used to verify the component signature",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.SecretReference"),
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"caCertificateSecretReference": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CaCertificateSecretReference contains a secret reference to one or more certificates in PEM format that are used to verify the compnent signature",
-							Ref:         ref("github.com/gardener/landscaper/apis/core.SecretReference"),
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/gardener/landscaper/apis/core.TargetSpec"),
 						},
 					},
 				},
+				Required: []string{"spec"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.SecretReference"},
+			"github.com/gardener/landscaper/apis/core.TargetSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_VersionedNamedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_TargetExport(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VersionedNamedObjectReference is a named reference to a object with its last observed resource generation. This struct is used by status fields.",
+				Description: "TargetExport is a single target export.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the unique name of the reference.",
+							Description: "Name the internal name of the exported target.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"ref": {
+					"target": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reference is the reference to an object.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core.VersionedObjectReference"),
+							Description: "Target is the name of the in-cluster target object.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"name", "ref"},
+				Required: []string{"name"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.VersionedObjectReference"},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_VersionedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_TargetImport(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VersionedObjectReference is a reference to a object with its last observed resource generation. This struct is used by status fields.",
+				Description: "TargetImport is either a single target or a target list import.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the kubernetes object.",
+							Description: "Name the internal name of the imported target.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"namespace": {
+					"target": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Namespace is the namespace of kubernetes object.",
-							Default:     "",
+							Description: "Target is the name of the in-cluster target object. Exactly one of Target, Targets, and TargetListReference has to be specified.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"observedGeneration": {
+					"targets": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ObservedGeneration defines the last observed generation of the referenced resource.",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Description: "Targets is a list of in-cluster target objects. Exactly one of Target, Targets, and TargetListReference has to be specified.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"targetListRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetListReference can (only) be used to import a targetlist that has been imported by the parent installation. Exactly one of Target, Targets, and TargetListReference has to be specified.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"targetMap": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"targetMapRef": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
 				},
-				Required: []string{"name", "observedGeneration"},
+				Required: []string{"name"},
 			},
 		},
 	}
 }
 
-func schema_gardener_landscaper_apis_core_VersionedResourceReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_TargetList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VersionedResourceReference defines the reference to a resource with its version.",
+				Description: "TargetList contains a list of Targets",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"componentName": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ComponentName defines the unique of the component containing the resource.",
-							Default:     "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"resourceName": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ResourceName defines the name of the resource.",
-							Default:     "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"version": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Version defines the version of the component.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.Target"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"componentName", "resourceName", "version"},
-			},
-		},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_AnyJSON(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "AnyJSON enhances the json.RawMessages with a dedicated openapi definition so that all it is correctly generated.",
-				Type:        v1alpha1.AnyJSON{}.OpenAPISchemaType(),
-				Format:      v1alpha1.AnyJSON{}.OpenAPISchemaFormat(),
+				Required: []string{"items"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.Target", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_AutomaticReconcile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_TargetSelector(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "AutomaticReconcile allows to configure automatically repeated reconciliations.",
+				Description: "TargetSelector describes a selector that matches specific targets.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"succeededReconcile": {
+					"targets": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SucceededReconcile allows to configure automatically repeated reconciliations for succeeded installations. If not set, no such automatically repeated reconciliations are triggered.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.SucceededReconcile"),
+							Description: "Targets defines a list of specific targets (name and namespace) that should be reconciled.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.ObjectReference"),
+									},
+								},
+							},
 						},
 					},
-					"failedReconcile": {
+					"annotations": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FailedReconcile allows to configure automatically repeated reconciliations for failed installations. If not set, no such automatically repeated reconciliations are triggered.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.FailedReconcile"),
+							Description: "Annotations matches a target based on annotations.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.Requirement"),
+									},
+								},
+							},
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels matches a target based on its labels.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.Requirement"),
+									},
+								},
+							},
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.FailedReconcile", "github.com/gardener/landscaper/apis/core/v1alpha1.SucceededReconcile"},
+			"github.com/gardener/landscaper/apis/core.ObjectReference", "github.com/gardener/landscaper/apis/core.Requirement"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_AutomaticReconcileStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_TargetSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "AutomaticReconcileStatus describes the status of automatically triggered reconciles.",
+				Description: "TargetSpec contains the definition of a target.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"generation": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Generation describes the generation of the installation for which the status holds.",
-							Type:        []string{"integer"},
-							Format:      "int64",
-						},
-					},
-					"numberOfReconciles": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NumberOfReconciles is the number of automatic reconciles for the installation with the stored generation.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Type is the type of the target that defines its data structure. The actual schema may be defined by a target type crd in the future.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"lastReconcileTime": {
+					"config": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastReconcileTime is the time of the last automatically triggered reconcile.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Configuration contains the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
 						},
 					},
-					"onFailed": {
+					"secretRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "OnFailed is true if the last automatically triggered reconcile was done for a failed installation.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "Reference to a secret containing the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.LocalSecretReference"),
 						},
 					},
 				},
+				Required: []string{"type"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/landscaper/apis/core.AnyJSON", "github.com/gardener/landscaper/apis/core.LocalSecretReference"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_Blueprint(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_gardener_landscaper_apis_core_TargetSync(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Blueprint contains the configuration of a component",
+				Description: "The TargetSync is created targets from secrets.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -6828,510 +7241,4682 @@ func schema_landscaper_apis_core_v1alpha1_Blueprint(ref common.ReferenceCallback
 							Format:      "",
 						},
 					},
-					"annotations": {
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec contains the specification",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.TargetSyncSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status contains the status",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.TargetSyncStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.TargetSyncSpec", "github.com/gardener/landscaper/apis/core.TargetSyncStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_TargetSyncList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetSyncList contains a list of TargetSync objects",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core.TargetSync"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.TargetSync", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_TargetSyncSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetSyncSpec contains the specification for a TargetSync.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"sourceNamespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SourceNamespace describes the namespace from where the secrets should be synced",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretRef references the secret that contains the kubeconfig to the namespace of the secrets to be synced.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.LocalSecretReference"),
+						},
+					},
+					"createTargetToSource": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CreateTargetToSource specifies if set on true, that also a target is created, which references the secret in SecretRef",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"targetToSourceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetToSourceName is the name of the target referencing the secret defined in SecretRef if CreateTargetToSource is set on true. If TargetToSourceName is empty SourceNamespace is used instead.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"secretNameExpression": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretNameExpression defines the names of the secrets which should be synced via a regular expression according to https://github.com/google/re2/wiki/Syntax with the extension that * is also a valid expression and matches all names. if not set no secrets are synced",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"shootNameExpression": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ShootNameExpression defines the names of shoot clusters for which targets with short living access data to the shoots are created via a regular expression according to https://github.com/google/re2/wiki/Syntax with the extension that * is also a valid expression and matches all names. if not set no targets for the shoots are created",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tokenRotation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TokenRotation defines the data to perform an automatic rotation of the token to access the source cluster with the secrets to sync. The token expires after 90 days and will be rotated every 60 days.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.TokenRotation"),
+						},
+					},
+				},
+				Required: []string{"sourceNamespace", "secretRef"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.LocalSecretReference", "github.com/gardener/landscaper/apis/core.TokenRotation"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_TargetSyncStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetSyncStatus contains the status of a TargetSync.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation observed.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Last time the status was updated",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastErrors": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastErrors describe the last errors",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"lastTokenRotationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Last time the token was rotated",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_TargetTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetTemplate exposes specific parts of a target that are used in the exports to export a target",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the target that defines its data structure. The actual schema may be defined by a target type crd in the future.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"config": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Configuration contains the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+						},
+					},
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reference to a secret containing the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.LocalSecretReference"),
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Map of string keys and values that can be used to organize and categorize (scope and select) objects. May match selectors of replication controllers and services. More info: http://kubernetes.io/docs/user-guide/labels",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"annotations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Annotations is an unstructured key value map stored with a resource that may be set by external tools to store and retrieve arbitrary metadata. They are not queryable and should be preserved when modifying objects. More info: http://kubernetes.io/docs/user-guide/annotations",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"type"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.AnyJSON", "github.com/gardener/landscaper/apis/core.LocalSecretReference"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_TemplateExecutor(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TemplateExecutor describes a templating mechanism and configuration.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the unique name of the template",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type describes the templating mechanism.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"file": {
+						SchemaProps: spec.SchemaProps{
+							Description: "File is the path to the template in the blueprint's content.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"template": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Template contains an optional inline template. The template has to be of string for go template and either a string or valid yaml/json for spiff.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+						},
+					},
+				},
+				Required: []string{"name", "type"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.AnyJSON"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_TemplateLookupResource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TemplateLookupResource defines a kind of objects that can be read by the lookup template functions.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion is the api version of the objects, e.g. \"v1\" or \"apps/v1\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is the kind of the objects, e.g. \"ConfigMap\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"apiVersion", "kind"},
+			},
+		},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_TokenRotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enabled defines if automatic token is executed",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_TransitionTimes(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"triggerTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TriggerTime is the time when the jobID is set.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"initTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InitTime is the time when the Init phase starts.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"waitTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WaitTime is the time when the work is done.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"finishedTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FinishedTime is the time when the finished phase is set.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_TypedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TypedObjectReference is a reference to a typed kubernetes object.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion is the group and version for the resource being referenced. If APIVersion is not specified, the specified Kind must be in the core API group. For any other third-party types, APIVersion is required.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is the type of resource being referenced",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the kubernetes object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace of kubernetes object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"apiVersion", "kind", "name"},
+			},
+		},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_Verification(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Verification defines the necessary data to verify the signature of the refered component",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"signatureName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SignatureName defines the name of the signature that is verified",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"signatureName"},
+			},
+		},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_VerificationSignature(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VerificationSignatures contains the trusted verification information",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"publicKeySecretReference": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PublicKeySecretReference contains a secret reference to a public key in PEM format that is used to verify the component signature",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.SecretReference"),
+						},
+					},
+					"caCertificateSecretReference": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CaCertificateSecretReference contains a secret reference to one or more certificates in PEM format that are used to verify the compnent signature",
+							Ref:         ref("github.com/gardener/landscaper/apis/core.SecretReference"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.SecretReference"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_VersionedNamedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VersionedNamedObjectReference is a named reference to a object with its last observed resource generation. This struct is used by status fields.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the unique name of the reference.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ref": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reference is the reference to an object.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core.VersionedObjectReference"),
+						},
+					},
+				},
+				Required: []string{"name", "ref"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core.VersionedObjectReference"},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_VersionedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VersionedObjectReference is a reference to a object with its last observed resource generation. This struct is used by status fields.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the kubernetes object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace of kubernetes object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration defines the last observed generation of the referenced resource.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"name", "observedGeneration"},
+			},
+		},
+	}
+}
+
+func schema_gardener_landscaper_apis_core_VersionedResourceReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VersionedResourceReference defines the reference to a resource with its version.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"componentName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ComponentName defines the unique of the component containing the resource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resourceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceName defines the name of the resource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version defines the version of the component.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"componentName", "resourceName", "version"},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_AnyJSON(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AnyJSON enhances the json.RawMessages with a dedicated openapi definition so that all it is correctly generated.",
+				Type:        v1alpha1.AnyJSON{}.OpenAPISchemaType(),
+				Format:      v1alpha1.AnyJSON{}.OpenAPISchemaFormat(),
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_AutomaticReconcile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AutomaticReconcile allows to configure automatically repeated reconciliations.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"succeededReconcile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SucceededReconcile allows to configure automatically repeated reconciliations for succeeded installations. If not set, no such automatically repeated reconciliations are triggered.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.SucceededReconcile"),
+						},
+					},
+					"failedReconcile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailedReconcile allows to configure automatically repeated reconciliations for failed installations. If not set, no such automatically repeated reconciliations are triggered.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.FailedReconcile"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.FailedReconcile", "github.com/gardener/landscaper/apis/core/v1alpha1.SucceededReconcile"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_AutomaticReconcileStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AutomaticReconcileStatus describes the status of automatically triggered reconciles.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"generation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Generation describes the generation of the installation for which the status holds.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"numberOfReconciles": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NumberOfReconciles is the number of automatic reconciles for the installation with the stored generation.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"lastReconcileTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastReconcileTime is the time of the last automatically triggered reconcile.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"onFailed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OnFailed is true if the last automatically triggered reconcile was done for a failed installation.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_Blueprint(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Blueprint contains the configuration of a component",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"annotations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Annotations is an unstructured key value map stored with a resource that may be set by external tools to store and retrieve arbitrary metadata.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"jsonSchemaVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JSONSchemaVersion defines the default jsonschema version of the blueprint. e.g. \"https://json-schema.org/draft/2019-09/schema\"",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"localTypes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LocalTypes defines additional blueprint local schemas",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"),
+									},
+								},
+							},
+						},
+					},
+					"imports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Imports define the import values that are needed for the definition and its sub-definitions.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ImportDefinition"),
+									},
+								},
+							},
+						},
+					},
+					"importExecutions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImportExecutions defines the templating executors that are sequentially executed by the landscaper. The templates must return a list of errors",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TemplateExecutor"),
+									},
+								},
+							},
+						},
+					},
+					"exports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Exports define the exported values of the definition and its sub-definitions",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ExportDefinition"),
+									},
+								},
+							},
+						},
+					},
+					"subinstallations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Subinstallations defines an optional list of subinstallations (for aggregating blueprints).",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.SubinstallationTemplate"),
+									},
+								},
+							},
+						},
+					},
+					"subinstallationExecutions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SubinstallationExecutions defines the templating executors that are sequentially executed by the landscaper. The templates must return a list of installation templates. Both subinstallations and SubinstallationExecutions are valid options and will be merged.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TemplateExecutor"),
+									},
+								},
+							},
+						},
+					},
+					"deployExecutions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeployExecutions defines the templating executors that are sequentially executed by the landscaper. The templates must return a list of deploy item templates.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TemplateExecutor"),
+									},
+								},
+							},
+						},
+					},
+					"exportExecutions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExportExecutions defines the templating executors that are used to generate the exports.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TemplateExecutor"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.ExportDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.ImportDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.SubinstallationTemplate", "github.com/gardener/landscaper/apis/core/v1alpha1.TemplateExecutor"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_BlueprintDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BlueprintDefinition defines the blueprint that should be used for the installation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ref": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reference defines a remote reference to a blueprint",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.RemoteBlueprintReference"),
+						},
+					},
+					"inline": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Inline defines a inline yaml filesystem with a blueprint.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InlineBlueprint"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.InlineBlueprint", "github.com/gardener/landscaper/apis/core/v1alpha1.RemoteBlueprintReference"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_BlueprintStaticDataSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BlueprintStaticDataSource defines a static data source for a blueprint",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value defined inline a raw data",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+						},
+					},
+					"valueFrom": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ValueFrom defines data from an external resource",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.StaticDataValueFrom"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.StaticDataValueFrom"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_BlueprintStaticDataValueFrom(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BlueprintStaticDataValueFrom defines static data that is read from a external resource.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"localPath": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selects a key of a secret in the installations's namespace",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_ComponentDescriptorDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ComponentDescriptorDefinition defines the component descriptor that should be used for the installation",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ref": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ComponentDescriptorReference is the reference to a component descriptor",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorReference"),
+						},
+					},
+					"inline": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InlineDescriptorReference defines an inline component descriptor",
+							Ref:         ref("github.com/gardener/landscaper/legacy-component-spec/bindings-go/apis/v2.ComponentDescriptor"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorReference", "github.com/gardener/landscaper/legacy-component-spec/bindings-go/apis/v2.ComponentDescriptor"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_ComponentDescriptorReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ComponentDescriptorReference is the reference to a component descriptor. given an optional context.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"repositoryContext": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RepositoryContext defines the context of the component repository to resolve blueprints.",
+							Ref:         ref("github.com/gardener/landscaper/legacy-component-spec/bindings-go/apis/v2.UnstructuredTypedObject"),
+						},
+					},
+					"componentName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ComponentName defines the unique of the component containing the resource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version defines the version of the component.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"componentName", "version"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/legacy-component-spec/bindings-go/apis/v2.UnstructuredTypedObject"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_ComponentVersionOverwrite(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ComponentVersionOverwrite defines an overwrite for a specific component and/or version of a component.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"source": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Source defines the component that should be replaced.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVersionOverwriteReference"),
+						},
+					},
+					"substitution": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Substitution defines the replacement target for the component or version.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVersionOverwriteReference"),
+						},
+					},
+				},
+				Required: []string{"source", "substitution"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVersionOverwriteReference"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_ComponentVersionOverwriteReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ComponentVersionOverwriteReference defines a component reference by",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"repositoryContext": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RepositoryContext defines the context of the component repository to resolve blueprints.",
+							Ref:         ref("github.com/gardener/landscaper/legacy-component-spec/bindings-go/apis/v2.UnstructuredTypedObject"),
+						},
+					},
+					"componentName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ComponentName defines the unique of the component containing the resource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version defines the version of the component.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/legacy-component-spec/bindings-go/apis/v2.UnstructuredTypedObject"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_ComponentVersionOverwrites(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ComponentVersionOverwrites contain overwrites for specific (versions of) components.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"overwrites": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Overwrites defines a list of component overwrites",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVersionOverwrite"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVersionOverwrite", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_ComponentVersionOverwritesList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ComponentVersionOverwritesList contains a list of ComponentVersionOverwrites",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVersionOverwrites"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVersionOverwrites", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_Condition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Condition holds the information about the state of a resource.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataType of the Shoot condition.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status of the condition, one of True, False, Unknown.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Last time the condition transitioned from one status to another.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Last time the condition was updated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The reason for the condition's last transition.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A human readable message indicating details about the transition.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"codes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Well-defined error codes in case the condition reports a problem.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"type", "status", "lastTransitionTime", "lastUpdateTime", "reason", "message"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_ConfigMapReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ConfigMapReference is reference to data in a configmap. The configmap can also be in a different namespace.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the kubernetes object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace of kubernetes object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Key is the name of the key in the configmap that holds the data.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_Context(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Context is a resource that contains shared information of installations. This includes information about the repository context like the context itself or secrets to access the oci artifacts. But it can also contain deployer specific config.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"repositoryContext": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RepositoryContext defines the context of the component repository to resolve blueprints.",
+							Ref:         ref("github.com/gardener/landscaper/legacy-component-spec/bindings-go/apis/v2.UnstructuredTypedObject"),
+						},
+					},
+					"ocmConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OCMConfig references a k8s config map object that contains the ocm configuration data in the format of an ocm configfile. For more info see: https://github.com/open-component-model/ocm/blob/main/docs/reference/ocm_configfile.md",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+					"registryPullSecrets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RegistryPullSecrets defines a list of registry credentials that are used to pull blueprints, component descriptors and jsonschemas from the respective registry. For more info see: https://kubernetes.io/docs/tasks/configure-pod-container/pull-image-private-registry/ Note that the type information is used to determine the secret key and the type of the secret.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.LocalObjectReference"),
+									},
+								},
+							},
+						},
+					},
+					"configurations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Configurations contains arbitrary configuration information for dedicated purposes given by a string key. The key should use a dns-like syntax to express the purpose and avoid conflicts.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+									},
+								},
+							},
+						},
+					},
+					"componentVersionOverwrites": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ComponentVersionOverwritesReference is a reference to a ComponentVersionOverwrites object The overwrites object has to be in the same namespace as the context. If the string is empty, no overwrites will be used.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"verificationSignatures": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VerificationSignatures maps a signature name to the trusted verification information",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.VerificationSignature"),
+									},
+								},
+							},
+						},
+					},
+					"maintenanceWindow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaintenanceWindow restricts the times at which new jobs of root installations referencing this context are started. It is only used for installations without an own maintenance window.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.MaintenanceWindow"),
+						},
+					},
+					"templateLookups": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TemplateLookups defines the kinds of objects that the templates of installations referencing this context are allowed to read from target clusters with the lookup template functions. The lookup functions are disabled if no kind is defined.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TemplateLookupResource"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.MaintenanceWindow", "github.com/gardener/landscaper/apis/core/v1alpha1.TemplateLookupResource", "github.com/gardener/landscaper/apis/core/v1alpha1.VerificationSignature", "github.com/gardener/landscaper/legacy-component-spec/bindings-go/apis/v2.UnstructuredTypedObject", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_ContextConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"repositoryContext": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RepositoryContext defines the context of the component repository to resolve blueprints.",
+							Ref:         ref("github.com/gardener/landscaper/legacy-component-spec/bindings-go/apis/v2.UnstructuredTypedObject"),
+						},
+					},
+					"ocmConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OCMConfig references a k8s config map object that contains the ocm configuration data in the format of an ocm configfile. For more info see: https://github.com/open-component-model/ocm/blob/main/docs/reference/ocm_configfile.md",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+					"registryPullSecrets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RegistryPullSecrets defines a list of registry credentials that are used to pull blueprints, component descriptors and jsonschemas from the respective registry. For more info see: https://kubernetes.io/docs/tasks/configure-pod-container/pull-image-private-registry/ Note that the type information is used to determine the secret key and the type of the secret.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.LocalObjectReference"),
+									},
+								},
+							},
+						},
+					},
+					"configurations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Configurations contains arbitrary configuration information for dedicated purposes given by a string key. The key should use a dns-like syntax to express the purpose and avoid conflicts.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+									},
+								},
+							},
+						},
+					},
+					"componentVersionOverwrites": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ComponentVersionOverwritesReference is a reference to a ComponentVersionOverwrites object The overwrites object has to be in the same namespace as the context. If the string is empty, no overwrites will be used.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"verificationSignatures": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VerificationSignatures maps a signature name to the trusted verification information",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.VerificationSignature"),
+									},
+								},
+							},
+						},
+					},
+					"maintenanceWindow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaintenanceWindow restricts the times at which new jobs of root installations referencing this context are started. It is only used for installations without an own maintenance window.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.MaintenanceWindow"),
+						},
+					},
+					"templateLookups": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TemplateLookups defines the kinds of objects that the templates of installations referencing this context are allowed to read from target clusters with the lookup template functions. The lookup functions are disabled if no kind is defined.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TemplateLookupResource"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.MaintenanceWindow", "github.com/gardener/landscaper/apis/core/v1alpha1.TemplateLookupResource", "github.com/gardener/landscaper/apis/core/v1alpha1.VerificationSignature", "github.com/gardener/landscaper/legacy-component-spec/bindings-go/apis/v2.UnstructuredTypedObject", "k8s.io/api/core/v1.LocalObjectReference"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_ContextList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ContextList contains a list of Contexts",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.Context"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Context", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_CriticalProblem(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CriticalProblem contains information about one critical problem.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"podName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodName contains the name of the pod where the problem occurred",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"creationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CreationTime contains the timestamp when the problem occurred",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Description contains an error description",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_CriticalProblems(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CriticalProblems contains a list of critical landscaper problems.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec contains the specification",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.CriticalProblemsSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status contains the status",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.CriticalProblemsStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.CriticalProblemsSpec", "github.com/gardener/landscaper/apis/core/v1alpha1.CriticalProblemsStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_CriticalProblemsList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CriticalProblemsList contains a list of critical landscaper problems objects",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.CriticalProblems"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.CriticalProblems", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_CriticalProblemsSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CriticalProblemsSpec contains the specification for a CriticalProblems object.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"criticalProblem": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.CriticalProblem"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.CriticalProblem"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_CriticalProblemsStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CriticalProblemsStatus contains the status of a CriticalProblems object.",
+				Type:        []string{"object"},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_DataExport(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DataExport is a data object export.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name the internal name of the imported/exported data.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"dataRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataRef is the name of the in-cluster data object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "dataRef"},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_DataImport(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DataImport is a data object import.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name the internal name of the imported/exported data.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"dataRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataRef is the name of the in-cluster data object. The reference can also be a namespaces name. E.g. \"default/mydataref\"",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version specifies the imported data version. defaults to \"v1\"",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretRef defines a data reference from a secret. This method is not allowed in installation templates.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"),
+						},
+					},
+					"configMapRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigMapRef defines a data reference from a configmap. This method is not allowed in installation templates.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.LocalConfigMapReference"),
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.LocalConfigMapReference", "github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_DataObject(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DataObject are resources that can hold any kind json or yaml data.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"data": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Data contains the data of the object as string.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+						},
+					},
+				},
+				Required: []string{"data"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_DataObjectList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DataObjectList contains a list of DataObject",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DataObject"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.DataObject", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_Default(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Default defines a default value (future idea: also reference?).",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+						},
+					},
+				},
+				Required: []string{"value"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_DependentToTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the dependent installation",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_DeployItem(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DeployItem defines a resource that should be processed by a external deployer",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemSpec", "github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_DeployItemCache(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DeployItemCache contains the existing deploy items",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"activeDIs": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DiNamePair"),
+									},
+								},
+							},
+						},
+					},
+					"orphanedDIs": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.DiNamePair"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_DeployItemList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DeployItemList contains a list of DeployItems",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DeployItem"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.DeployItem", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_DeployItemSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DeployItemSpec contains the definition of a deploy item.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the deployer that should handle the item.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"target": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Target specifies an optional target of the deploy item. In most cases it contains the secrets to access a evironment. It is also used by the deployers to determine the ownernship.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
+						},
+					},
+					"context": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Context defines the current context of the deployitem.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"config": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Configuration contains the deployer type specific configuration.",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout specifies how long the deployer may take to apply the deploy item. When the time is exceeded, the deploy item fails. Value has to be parsable by time.ParseDuration (or 'none' to deactivate the timeout). Defaults to ten minutes if not specified.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+						},
+					},
+					"updateOnChangeOnly": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UpdateOnChangeOnly specifies if redeployment is executed only if the specification of the deploy item has changed.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"onDelete": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OnDelete specifies particular setting when deleting a deploy item",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.OnDeleteConfig"),
+						},
+					},
+				},
+				Required: []string{"type"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.OnDeleteConfig", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_DeployItemStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DeployItemStatus contains the status of a deploy item.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the current phase of the DeployItem",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation observed for this DeployItem. It corresponds to the DeployItem generation, which is updated on mutation by the landscaper.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions contains the actual condition of a deploy item",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"lastError": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastError describes the last error that occurred.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Error"),
+						},
+					},
+					"lastErrors": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ErrorHistory describes the last n errors that occurred since JobID was changed the last time.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.Error"),
+									},
+								},
+							},
+						},
+					},
+					"firstError": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FirstError describes the first error that occurred since JobID was changed the last time.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Error"),
+						},
+					},
+					"lastReconcileTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastReconcileTime indicates when the reconciliation of the last change to the deploy item has started",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"deployer": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Deployer describes the deployer that has reconciled the deploy item.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.DeployerInformation"),
+						},
+					},
+					"providerStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProviderStatus contains the provider specific status",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+						},
+					},
+					"exportRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExportReference is the reference to the object that contains the exported values.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
+						},
+					},
+					"jobID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JobID is the ID of the current working request.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"jobIDFinished": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JobIDFinished is the ID of the finished working request.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"jobIDGenerationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JobIDGenerationTime is the timestamp when the JobID was set.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"deployItemPhase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeployerPhase is DEPRECATED and will soon be removed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"transitionTimes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TransitionTimes contains timestamps of status transitions",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.TransitionTimes"),
+						},
+					},
+				},
+				Required: []string{"observedGeneration"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Condition", "github.com/gardener/landscaper/apis/core/v1alpha1.DeployerInformation", "github.com/gardener/landscaper/apis/core/v1alpha1.Error", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.TransitionTimes", "k8s.io/apimachinery/pkg/apis/meta/v1.Time", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_DeployItemTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DeployItemTemplate defines a execution element that is translated into a deploy item.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the unique name of the execution.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataType is the DeployItem type of the execution.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"target": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Target is the object reference to the target that the deploy item should deploy to.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels is the map of labels to be added to the deploy item.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"config": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProviderConfiguration contains the type specific configuration for the execution.",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+						},
+					},
+					"dependsOn": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DependsOn lists deploy items that need to be executed before this one",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"wave": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Wave defines the rollout wave of the deploy item. A deploy item is only started when all deploy items of lower waves have succeeded, and it is only deleted when all deploy items of higher waves are gone. Defaults to 0.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout specifies how long the deployer may take to apply the deploy item. When the time is exceeded, the deploy item fails. Value has to be parsable by time.ParseDuration (or 'none' to deactivate the timeout). Defaults to ten minutes if not specified.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+						},
+					},
+					"updateOnChangeOnly": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UpdateOnChangeOnly specifies if redeployment is executed only if the specification of the deploy item has changed.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"onDelete": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OnDelete specifies particular setting when deleting a deploy item",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.OnDeleteConfig"),
+						},
+					},
+				},
+				Required: []string{"name", "type", "config"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.OnDeleteConfig", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_DeployerInformation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DeployerInformation holds additional information about the deployer that has reconciled or is reconciling the deploy item.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"identity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Identity describes the unique identity of the deployer.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the deployer.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version is the version of the deployer.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"identity", "name", "version"},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_DiNamePair(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DiNamePair contains the spec name and the real name of a deploy item",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"specName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"objectName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_Duration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Duration is a wrapper for time.Duration that implements JSON marshalling and openapi scheme.",
+				Type:        v1alpha1.Duration{}.OpenAPISchemaType(),
+				Format:      v1alpha1.Duration{}.OpenAPISchemaFormat(),
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_Error(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Error holds information about an error that occurred.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"operation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Operation describes the operator where the error occurred.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Last time the condition transitioned from one status to another.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Last time the condition was updated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The reason for the condition's last transition.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A human readable message indicating details about the transition.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"codes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Well-defined error codes in case the condition reports a problem.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"debugSecretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DebugSecretRef references a secret with diagnostic data about the error, e.g. the source location and the redacted input of a failed template execution.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
+						},
+					},
+				},
+				Required: []string{"operation", "lastTransitionTime", "lastUpdateTime", "reason", "message"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_Execution(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Execution contains the configuration of a execution and deploy item",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec defines a execution and its items",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ExecutionSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status contains the current status of the execution.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ExecutionStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.ExecutionSpec", "github.com/gardener/landscaper/apis/core/v1alpha1.ExecutionStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_ExecutionList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ExecutionList contains a list of Executions‚",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.Execution"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Execution", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_ExecutionSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ExecutionSpec defines a execution plan.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"context": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Context defines the current context of the execution.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"deployItems": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeployItems defines all execution items that need to be scheduled.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemTemplate"),
+									},
+								},
+							},
+						},
+					},
+					"deployItemsCompressed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeployItemsCompressed as zipped byte array",
+							Type:        []string{"string"},
+							Format:      "byte",
+						},
+					},
+					"maxParallel": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxParallel limits the number of deploy items of the execution that are processed at the same time. All runnable deploy items are started at once if not set.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemTemplate"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_ExecutionStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ExecutionStatus contains the current status of a execution.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation observed for this Execution. It corresponds to the Execution generation, which is updated on mutation by the landscaper.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions contains the actual condition of a execution",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"lastError": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastError describes the last error that occurred.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Error"),
+						},
+					},
+					"exportRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExportReference references the object that contains the exported values. only used for operation purpose.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
+						},
+					},
+					"deployItemCache": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeployItemCache contains the currently existing deploy item belonging to the execution. If nil undefined.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemCache"),
+						},
+					},
+					"jobID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JobID is the ID of the current working request.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"jobIDFinished": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JobIDFinished is the ID of the finished working request.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExecutionPhase is the current phase of the execution.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"phaseTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PhaseTransitionTime is the time when the phase last changed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"transitionTimes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TransitionTimes contains timestamps of status transitions",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.TransitionTimes"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Condition", "github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemCache", "github.com/gardener/landscaper/apis/core/v1alpha1.Error", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.TransitionTimes", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_ExportDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ExportDefinition defines a exported value",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name defines the field name to search for the value and map to exports. Ref: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#selecting-fields",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"schema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schema defines the imported value as jsonschema.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"),
+						},
+					},
+					"targetType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetType defines the type of the imported target.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type specifies which kind of object is being exported. This field should be set and will likely be mandatory in future.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_FailedReconcile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "FailedReconcile allows to configure automatically repeated reconciliations for failed installations",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"numberOfReconciles": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NumberOfReconciles specifies the maximal number of automatically repeated reconciliations. If not set, no upper limit exists.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"interval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Interval specifies the interval between two subsequent repeated reconciliations. If not set, a default of 5 minutes is used.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+						},
+					},
+					"cronSpec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CronSpec describes the reconcile intervals according to the cron syntax \"https://pkg.go.dev/github.com/robfig/cron#hdr-CRON_Expression_Format\". If not empty, this specification is used instead of Interval.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_FieldValueDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "FieldValueDefinition defines a im- or exported field. Either schema or target type have to be defined",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name defines the field name to search for the value and map to exports. Ref: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#selecting-fields",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"schema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schema defines the imported value as jsonschema.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"),
+						},
+					},
+					"targetType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetType defines the type of the imported target.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_ImportDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ImportDefinition defines a imported value",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name defines the field name to search for the value and map to exports. Ref: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#selecting-fields",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"schema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schema defines the imported value as jsonschema.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"),
+						},
+					},
+					"targetType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetType defines the type of the imported target.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type specifies which kind of object is being imported. This field should be set and will likely be mandatory in future.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"required": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Required specifies whether the import is required for the component to run. Defaults to true.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"default": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Default sets a default value for the current import that is used if the key is not set.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Default"),
+						},
+					},
+					"imports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConditionalImports are Imports that are only valid if this imports is satisfied. Does only make sense for optional imports.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ImportDefinition"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Default", "github.com/gardener/landscaper/apis/core/v1alpha1.ImportDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_InlineBlueprint(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InlineBlueprint defines a inline blueprint with component descriptor and filesystem.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"filesystem": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filesystem defines a inline yaml filesystem with a blueprint.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+						},
+					},
+				},
+				Required: []string{"filesystem"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_Installation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Installation contains the configuration of a component",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec contains the specification for a installation.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status contains the status of the installation.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.InstallationSpec", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_InstallationExports(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InstallationExports defines exports of data objects and targets.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"data": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Data defines all data object exports.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DataExport"),
+									},
+								},
+							},
+						},
+					},
+					"targets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Targets defines all target exports.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetExport"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.DataExport", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetExport"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_InstallationImports(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InstallationImports defines import of data objects and targets.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"data": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Data defines all data object imports.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DataImport"),
+									},
+								},
+							},
+						},
+					},
+					"targets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Targets defines all target imports.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetImport"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.DataImport", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetImport"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_InstallationList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InstallationList contains a list of Components",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.Installation"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Installation", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_InstallationPlan(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InstallationPlan contains the objects that a reconcile of an installation would create, update or delete.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"creationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CreationTime is the time when the plan was computed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the generation of the installation for which the plan was computed.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"importsHash": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImportsHash is the hash of the import data with which the plan was computed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"deployItems": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeployItems contains the planned deploy items of the execution of the installation.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.PlannedDeployItem"),
+									},
+								},
+							},
+						},
+					},
+					"subInstallations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SubInstallations contains the planned subinstallations of the installation.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.PlannedSubInstallation"),
+									},
+								},
+							},
+						},
+					},
+					"error": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Error describes the error that prevented the computation of the plan.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Error"),
+						},
+					},
+				},
+				Required: []string{"creationTime", "observedGeneration"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Error", "github.com/gardener/landscaper/apis/core/v1alpha1.PlannedDeployItem", "github.com/gardener/landscaper/apis/core/v1alpha1.PlannedSubInstallation", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_InstallationRevision(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "The InstallationRevision is a snapshot of a successfully finished job of a root installation. It is created by the landscaper and can be used to roll back the installation to a previous state.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec contains the specification",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationRevisionSpec"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.InstallationRevisionSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_InstallationRevisionList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InstallationRevisionList contains a list of InstallationRevision objects",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationRevision"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.InstallationRevision", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_InstallationRevisionSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InstallationRevisionSpec contains the snapshot of an installation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"installationName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InstallationName is the name of the installation.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"revision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Revision is the number of the revision. It is increased with every successful job of the installation.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"jobID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JobID is the ID of the job of the installation which has been snapshotted.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"importsHash": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImportsHash is the hash of the import data with which the job has been processed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"installationSpec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InstallationSpec is the specification of the installation with which the job has been processed. It contains in particular the component version, the blueprint reference and the imports.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationSpec"),
+						},
+					},
+					"deployItems": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeployItems are the rendered deploy item templates of the execution of the installation.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemTemplate"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"installationName", "revision", "jobID", "installationSpec"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemTemplate", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationSpec"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_InstallationSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InstallationSpec defines a component installation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"context": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Context defines the current context of the installation.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"verification": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Verification defines the necessary data to verify the signature of the refered component",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Verification"),
+						},
+					},
+					"componentDescriptor": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ComponentDescriptor is a reference to the installation's component descriptor",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorDefinition"),
+						},
+					},
+					"blueprint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Blueprint is the resolved reference to the definition.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.BlueprintDefinition"),
+						},
+					},
+					"imports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Imports define the imported data objects and targets.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationImports"),
+						},
+					},
+					"importDataMappings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImportDataMappings contains a template for restructuring imports. It is expected to contain a key for every blueprint-defined data import. Missing keys will be defaulted to their respective data import. Example: namespace: (( installation.imports.namespace ))",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+									},
+								},
+							},
+						},
+					},
+					"exports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Exports define the exported data objects and targets.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationExports"),
+						},
+					},
+					"exportDataMappings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExportDataMappings contains a template for restructuring exports. It is expected to contain a key for every blueprint-defined data export. Missing keys will be defaulted to their respective data export. Example: namespace: (( blueprint.exports.namespace ))",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+									},
+								},
+							},
+						},
+					},
+					"automaticReconcile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AutomaticReconcile allows to configure automatically repeated reconciliations.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AutomaticReconcile"),
+						},
+					},
+					"optimization": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Optimization contains settings to improve execution performance.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Optimization"),
+						},
+					},
+					"maxParallelDeployItems": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxParallelDeployItems limits the number of deploy items of the installation that are processed at the same time. All runnable deploy items are started at once if not set.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"revisionHistoryLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RevisionHistoryLimit is the number of revisions of a root installation that are kept. A revision is created for every successfully finished job of the installation. Defaults to 10. Set it to 0 to disable the creation of revisions.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maintenanceWindow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaintenanceWindow restricts the times at which new jobs of a root installation are started after a reconcile has been requested. Requested jobs are deferred until the next window opens. Deletions are never deferred. If not set, the maintenance window of the referenced context is used, if any.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.MaintenanceWindow"),
+						},
+					},
+				},
+				Required: []string{"blueprint"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.AutomaticReconcile", "github.com/gardener/landscaper/apis/core/v1alpha1.BlueprintDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationExports", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationImports", "github.com/gardener/landscaper/apis/core/v1alpha1.MaintenanceWindow", "github.com/gardener/landscaper/apis/core/v1alpha1.Optimization", "github.com/gardener/landscaper/apis/core/v1alpha1.Verification"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_InstallationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InstallationStatus contains the current status of a Installation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation observed for this ControllerInstallations. It corresponds to the ControllerInstallations generation, which is updated on mutation by the landscaper.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions contains the actual condition of a installation",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"lastError": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastError describes the last error that occurred.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Error"),
+						},
+					},
+					"subInstCache": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SubInstCache contains the currently existing sub installations belonging to the execution. If nil undefined.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.SubInstCache"),
+						},
+					},
+					"executionRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExecutionReference is the reference to the execution that schedules the templated execution items.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
+						},
+					},
+					"jobID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JobID is the ID of the current working request.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"jobIDFinished": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JobIDFinished is the ID of the finished working request.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InstallationPhase is the current phase of the installation.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"phaseTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PhaseTransitionTime is the time when the phase last changed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"importsHash": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImportsHash is the hash of the import data.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"automaticReconcileStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AutomaticReconcileStatus describes the status of automatically triggered reconciles.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AutomaticReconcileStatus"),
+						},
+					},
+					"dependentsToTrigger": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DependentsToTrigger lists dependent installations to be triggered",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DependentToTrigger"),
+									},
+								},
+							},
+						},
+					},
+					"transitionTimes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TransitionTimes contains timestamps of status transitions",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.TransitionTimes"),
+						},
+					},
+					"plan": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Plan contains the deploy items and subinstallations that a reconcile of the installation would create, update or delete. It is computed if the installation is annotated with the plan operation.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationPlan"),
+						},
+					},
+					"pendingJob": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PendingJob describes a requested job which is deferred until the next maintenance window opens.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.PendingJob"),
+						},
+					},
+				},
+				Required: []string{"observedGeneration"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.AutomaticReconcileStatus", "github.com/gardener/landscaper/apis/core/v1alpha1.Condition", "github.com/gardener/landscaper/apis/core/v1alpha1.DependentToTrigger", "github.com/gardener/landscaper/apis/core/v1alpha1.Error", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationPlan", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.PendingJob", "github.com/gardener/landscaper/apis/core/v1alpha1.SubInstCache", "github.com/gardener/landscaper/apis/core/v1alpha1.TransitionTimes", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_InstallationTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InstallationTemplate defines a subinstallation in a blueprint.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the unique name of the step",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"blueprint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reference defines a reference to a Blueprint. The blueprint can reside in an OCI or other supported location.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationTemplateBlueprintDefinition"),
+						},
+					},
+					"imports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Imports define the imported data objects and targets.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationImports"),
+						},
+					},
+					"importDataMappings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImportDataMappings contains a template for restructuring imports. It is expected to contain a key for every blueprint-defined data import. Missing keys will be defaulted to their respective data import. Example: namespace: (( installation.imports.namespace ))",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+									},
+								},
+							},
+						},
+					},
+					"exports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Exports define the exported data objects and targets.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationExports"),
+						},
+					},
+					"exportDataMappings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExportDataMappings contains a template for restructuring exports. It is expected to contain a key for every blueprint-defined data export. Missing keys will be defaulted to their respective data export. Example: namespace: (( blueprint.exports.namespace ))",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+									},
+								},
+							},
+						},
+					},
+					"optimization": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Optimization contains settings to improve execution performance.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Optimization"),
+						},
+					},
+				},
+				Required: []string{"name", "blueprint"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationExports", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationImports", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationTemplateBlueprintDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.Optimization"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_InstallationTemplateBlueprintDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InstallationTemplateBlueprintDefinition contains either a reference to a blueprint or an inline definition.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ref": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ref is a reference to a blueprint. Only blueprints that are defined by the component descriptor of the current blueprint can be referenced here. Example: cd://componentReference/dns/resources/blueprint",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"filesystem": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filesystem defines a virtual filesystem with all files needed for a blueprint. The filesystem must be a YAML filesystem.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_JSONSchemaDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "JSONSchemaDefinition defines a jsonschema.",
+				Type:        v1alpha1.JSONSchemaDefinition{}.OpenAPISchemaType(),
+				Format:      v1alpha1.JSONSchemaDefinition{}.OpenAPISchemaFormat(),
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_LandscapeStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "The LandscapeStatus aggregates the status of the installation trees of a namespace.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec contains the specification",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.LandscapeStatusSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status contains the status",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.LandscapeStatusStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.LandscapeStatusSpec", "github.com/gardener/landscaper/apis/core/v1alpha1.LandscapeStatusStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_LandscapeStatusItem(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LandscapeStatusItem describes an installation, execution or deploy item of an installation tree.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is the kind of the object, i.e. Installation, Execution or DeployItem.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace of the object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"rootInstallation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RootInstallation is the name of the root installation of the installation tree that contains the object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path contains the names of the installations from the root installation to the object.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the current phase of the object.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"jobID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JobID is the ID of the current job of the object.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"startTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartTime is the time when the current job of the object has been triggered.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastError": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastError describes the last error of the object.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Error"),
+						},
+					},
+				},
+				Required: []string{"kind", "name", "namespace", "rootInstallation"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Error", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_LandscapeStatusList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LandscapeStatusList contains a list of LandscapeStatus objects",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.LandscapeStatus"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.LandscapeStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_LandscapeStatusSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LandscapeStatusSpec contains the specification for a LandscapeStatus.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"installationSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InstallationSelector selects the root installations of the namespace whose installation trees are aggregated. If not set, all root installations of the namespace are aggregated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"maxItems": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxItems is the maximal number of entries of the lists of failed and longest running items. Defaults to 10.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"refreshInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RefreshInterval defines how often the status is aggregated. Defaults to 1m.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_LandscapeStatusStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LandscapeStatusStatus contains the status of a LandscapeStatus.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation observed.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Last time the status was updated",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastError": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastError describes the last error that occurred during the aggregation of the status.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Error"),
+						},
+					},
+					"rootInstallations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RootInstallations contains the names of the aggregated root installations.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"installations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Installations contains the number of installations per phase.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.PhaseCounts"),
+						},
+					},
+					"executions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Executions contains the number of executions per phase.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.PhaseCounts"),
+						},
+					},
+					"deployItems": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeployItems contains the number of deploy items per phase.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.PhaseCounts"),
+						},
+					},
+					"failedItemCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailedItemCount is the number of failed leaves, i.e. failed objects without failed child objects.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"failedItems": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailedItems contains the failed leaves of the installation trees, i.e. the failed objects without failed child objects. The list is limited to spec.maxItems entries.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.LandscapeStatusItem"),
+									},
+								},
+							},
+						},
+					},
+					"longestRunningItems": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LongestRunningItems contains the objects that have been processing their current job for the longest time. The list is limited to spec.maxItems entries.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.LandscapeStatusItem"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Error", "github.com/gardener/landscaper/apis/core/v1alpha1.LandscapeStatusItem", "github.com/gardener/landscaper/apis/core/v1alpha1.PhaseCounts", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_LocalConfigMapReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LocalConfigMapReference is a reference to data in a configmap.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the configmap",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Key is the name of the key in the configmap that holds the data.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_LocalSecretReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LocalSecretReference is a reference to data in a secret.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the secret",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Key is the name of the key in the secret that holds the data.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_LsHealthCheck(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LsHealthCheck is a resource containing information about problems with the landscaper installation",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Annotations is an unstructured key value map stored with a resource that may be set by external tools to store and retrieve arbitrary metadata.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"jsonSchemaVersion": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "JSONSchemaVersion defines the default jsonschema version of the blueprint. e.g. \"https://json-schema.org/draft/2019-09/schema\"",
-							Default:     "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"localTypes": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LocalTypes defines additional blueprint local schemas",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"),
-									},
-								},
-							},
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"imports": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Imports define the import values that are needed for the definition and its sub-definitions.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ImportDefinition"),
-									},
-								},
-							},
+							Description: "Status defines the overall status of the landscaper and its standard deployers.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"importExecutions": {
+					"lastUpdateTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ImportExecutions defines the templating executors that are sequentially executed by the landscaper. The templates must return a list of errors",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TemplateExecutor"),
-									},
-								},
-							},
+							Description: "LastUpdateTime contains last time the check was updated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"exports": {
+					"description": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Exports define the exported values of the definition and its sub-definitions",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ExportDefinition"),
-									},
-								},
-							},
+							Description: "Description contains description of the problem(s)",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"subinstallations": {
+				},
+				Required: []string{"status", "lastUpdateTime", "description"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_LsHealthCheckList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LsHealthCheckList contains a list of LsHealthChecks",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Subinstallations defines an optional list of subinstallations (for aggregating blueprints).",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.SubinstallationTemplate"),
-									},
-								},
-							},
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"subinstallationExecutions": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SubinstallationExecutions defines the templating executors that are sequentially executed by the landscaper. The templates must return a list of installation templates. Both subinstallations and SubinstallationExecutions are valid options and will be merged.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TemplateExecutor"),
-									},
-								},
-							},
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"deployExecutions": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DeployExecutions defines the templating executors that are sequentially executed by the landscaper. The templates must return a list of deploy item templates.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TemplateExecutor"),
-									},
-								},
-							},
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"exportExecutions": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExportExecutions defines the templating executors that are used to generate the exports.",
-							Type:        []string{"array"},
+							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TemplateExecutor"),
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.LsHealthCheck"),
 									},
 								},
 							},
 						},
 					},
 				},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.ExportDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.ImportDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.SubinstallationTemplate", "github.com/gardener/landscaper/apis/core/v1alpha1.TemplateExecutor"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.LsHealthCheck", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_BlueprintDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_MaintenanceWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "BlueprintDefinition defines the blueprint that should be used for the installation.",
+				Description: "MaintenanceWindow defines recurring time windows during which changes are applied.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"ref": {
+					"cronSpec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reference defines a remote reference to a blueprint",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.RemoteBlueprintReference"),
+							Description: "CronSpec describes the start times of the windows according to the cron syntax \"https://pkg.go.dev/github.com/robfig/cron#hdr-CRON_Expression_Format\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"inline": {
+					"duration": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Inline defines a inline yaml filesystem with a blueprint.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InlineBlueprint"),
+							Description: "Duration is the length of every window.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+						},
+					},
+					"timeZone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimeZone is the IANA name of the time zone in which the cron spec is evaluated, for example \"Europe/Berlin\". Defaults to UTC.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"cronSpec", "duration"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.InlineBlueprint", "github.com/gardener/landscaper/apis/core/v1alpha1.RemoteBlueprintReference"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_BlueprintStaticDataSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_NamedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "BlueprintStaticDataSource defines a static data source for a blueprint",
+				Description: "NamedObjectReference is a named reference to a specific resource.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"value": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Value defined inline a raw data",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+							Description: "Name is the unique name of the reference.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"valueFrom": {
+					"ref": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ValueFrom defines data from an external resource",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.StaticDataValueFrom"),
+							Description: "Reference is the reference to an object.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
 						},
 					},
 				},
+				Required: []string{"name", "ref"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.StaticDataValueFrom"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_BlueprintStaticDataValueFrom(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "BlueprintStaticDataValueFrom defines static data that is read from a external resource.",
+				Description: "ObjectReference is the reference to a kubernetes object.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"localPath": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Selects a key of a secret in the installations's namespace",
+							Description: "Name is the name of the kubernetes object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace of kubernetes object.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
+				Required: []string{"name"},
 			},
 		},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ComponentDescriptorDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_OnDeleteConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ComponentDescriptorDefinition defines the component descriptor that should be used for the installation",
+				Description: "OnDeleteConfig specifies particular setting when deleting a deploy item",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"ref": {
+					"skipUninstallIfClusterRemoved": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ComponentDescriptorReference is the reference to a component descriptor",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorReference"),
+							Description: "SkipUninstallIfClusterRemoved specifies that uninstall is skipped if the target cluster is already deleted. Works only in the context of an existing target sync object which is used to check the Garden project with the shoot cluster resources",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"inline": {
+				},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_Optimization(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Optimization contains settings to improve execution preformance",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"hasNoSiblingImports": {
 						SchemaProps: spec.SchemaProps{
-							Description: "InlineDescriptorReference defines an inline component descriptor",
-							Ref:         ref("github.com/gardener/landscaper/legacy-component-spec/bindings-go/apis/v2.ComponentDescriptor"),
+							Description: "set this on true if the installation does not import data from its siblings or has no siblings at all",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"hasNoSiblingExports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "set this on true if the installation does not export data to its siblings or has no siblings at all",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorReference", "github.com/gardener/landscaper/legacy-component-spec/bindings-go/apis/v2.ComponentDescriptor"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ComponentDescriptorReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_PendingJob(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ComponentDescriptorReference is the reference to a component descriptor. given an optional context.",
+				Description: "PendingJob describes a requested job of an installation which is deferred by a maintenance window.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"repositoryContext": {
+					"generation": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RepositoryContext defines the context of the component repository to resolve blueprints.",
-							Ref:         ref("github.com/gardener/landscaper/legacy-component-spec/bindings-go/apis/v2.UnstructuredTypedObject"),
+							Description: "Generation is the generation of the installation for which the job has been requested.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"componentName": {
+					"requestTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ComponentName defines the unique of the component containing the resource.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "RequestTime is the time when the deferred job was first detected.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"version": {
+					"nextWindowStart": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Version defines the version of the component.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "NextWindowStart is the time when the next maintenance window opens and the job is started.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
-				Required: []string{"componentName", "version"},
+				Required: []string{"generation", "requestTime", "nextWindowStart"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/legacy-component-spec/bindings-go/apis/v2.UnstructuredTypedObject"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ComponentVersionOverwrite(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_PhaseCounts(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ComponentVersionOverwrite defines an overwrite for a specific component and/or version of a component.",
+				Description: "PhaseCounts contains the number of objects of one kind.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"source": {
+					"total": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Source defines the component that should be replaced.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVersionOverwriteReference"),
+							Description: "Total is the number of objects.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"substitution": {
+					"phases": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Substitution defines the replacement target for the component or version.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVersionOverwriteReference"),
+							Description: "Phases maps the phases to the number of objects in the phase.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int32",
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"source", "substitution"},
+				Required: []string{"total"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVersionOverwriteReference"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ComponentVersionOverwriteReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_PlannedDeployItem(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ComponentVersionOverwriteReference defines a component reference by",
+				Description: "PlannedDeployItem describes a deploy item of a plan.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"repositoryContext": {
-						SchemaProps: spec.SchemaProps{
-							Description: "RepositoryContext defines the context of the component repository to resolve blueprints.",
-							Ref:         ref("github.com/gardener/landscaper/legacy-component-spec/bindings-go/apis/v2.UnstructuredTypedObject"),
-						},
-					},
-					"componentName": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ComponentName defines the unique of the component containing the resource.",
+							Description: "Name is the name of the deploy item as defined in the deploy execution of the blueprint.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"version": {
+					"action": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Version defines the version of the component.",
+							Description: "Action describes what a reconcile would do with the deploy item.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"changedFields": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ChangedFields contains the paths of the fields that would be changed by an update.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"template": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Template is the rendered deploy item template. It is not set for deploy items that would be deleted.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemTemplate"),
+						},
+					},
 				},
+				Required: []string{"name", "action"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/legacy-component-spec/bindings-go/apis/v2.UnstructuredTypedObject"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemTemplate"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ComponentVersionOverwrites(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_PlannedSubInstallation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ComponentVersionOverwrites contain overwrites for specific (versions of) components.",
+				Description: "PlannedSubInstallation describes a subinstallation of a plan.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Name is the name of the subinstallation as defined in the blueprint.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"action": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Action describes what a reconcile would do with the subinstallation.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"overwrites": {
+					"changedFields": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Overwrites defines a list of component overwrites",
+							Description: "ChangedFields contains the paths of the fields that would be changed by an update.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVersionOverwrite"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec is the rendered specification of the subinstallation. It is not set for subinstallations that would be deleted.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationSpec"),
+						},
+					},
 				},
+				Required: []string{"name", "action"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVersionOverwrite", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.InstallationSpec"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ComponentVersionOverwritesList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_RemoteBlueprintReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ComponentVersionOverwritesList contains a list of ComponentVersionOverwrites",
+				Description: "RemoteBlueprintReference describes a reference to a blueprint defined by a component descriptor.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"resourceName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "ResourceName is the name of the blueprint as defined by a component descriptor.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+				},
+				Required: []string{"resourceName"},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_Requirement(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Requirement contains values, a key, and an operator that relates the key and values. The zero value of Requirement is invalid. Requirement implements both set based match and exact match Requirement should be initialized via NewRequirement constructor for creating a valid Requirement.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"key": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"metadata": {
+					"operator": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"items": {
+					"values": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "In huge majority of cases we have at most one value here. It is generally faster to operate on a single-element slice than on a single-element map, so we have a slice here.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVersionOverwrites"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"key", "operator"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.ComponentVersionOverwrites", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_Condition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_ResolvedTarget(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Condition holds the information about the state of a resource.",
+				Description: "ResolvedTarget is a helper struct to store a target together with the content of its resolved secret reference.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
+					"target": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DataType of the Shoot condition.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Target contains the original target.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Target"),
 						},
 					},
-					"status": {
+					"content": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status of the condition, one of True, False, Unknown.",
+							Description: "Content contains the content of the target. If the target has a secret reference, this field should be filled by a TargetResolver. Otherwise, the inline configuration of the target is put here.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"lastTransitionTime": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Last time the condition transitioned from one status to another.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"lastUpdateTime": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Last time the condition was updated.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"reason": {
+				},
+				Required: []string{"target", "content"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Target"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_ResourceReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ResourceReference defines the reference to a resource defined in a component descriptor.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"componentName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The reason for the condition's last transition.",
+							Description: "ComponentName defines the unique of the component containing the resource.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"message": {
+					"resourceName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A human readable message indicating details about the transition.",
+							Description: "ResourceName defines the name of the resource.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"codes": {
+				},
+				Required: []string{"componentName", "resourceName"},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_SecretLabelSelectorRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SecretLabelSelectorRef selects secrets with the given label and key.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"selector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Well-defined error codes in case the condition reports a problem.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "Selector is a map of labels to select specific secrets.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: "",
@@ -7342,20 +11927,26 @@ func schema_landscaper_apis_core_v1alpha1_Condition(ref common.ReferenceCallback
 							},
 						},
 					},
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The key of the secret to select from.  Must be a valid secret key.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
-				Required: []string{"type", "status", "lastTransitionTime", "lastUpdateTime", "reason", "message"},
+				Required: []string{"selector", "key"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ConfigMapReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_SecretReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ConfigMapReference is reference to data in a configmap. The configmap can also be in a different namespace.",
+				Description: "SecretReference is reference to data in a secret. The secret can also be in a different namespace.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"name": {
@@ -7376,7 +11967,7 @@ func schema_landscaper_apis_core_v1alpha1_ConfigMapReference(ref common.Referenc
 					},
 					"key": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Key is the name of the key in the configmap that holds the data.",
+							Description: "Key is the name of the key in the secret that holds the data.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
@@ -7389,139 +11980,180 @@ func schema_landscaper_apis_core_v1alpha1_ConfigMapReference(ref common.Referenc
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_Context(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_StaticDataSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Context is a resource that contains shared information of installations. This includes information about the repository context like the context itself or secrets to access the oci artifacts. But it can also contain deployer specific config.",
+				Description: "StaticDataSource defines a static data source",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
+					"value": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Value defined inline a raw data",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
 						},
 					},
-					"metadata": {
+					"valueFrom": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "ValueFrom defines data from an external resource",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.StaticDataValueFrom"),
 						},
 					},
-					"repositoryContext": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.StaticDataValueFrom"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_StaticDataValueFrom(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "StaticDataValueFrom defines a static data that is read from a external resource.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"secretKeyRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RepositoryContext defines the context of the component repository to resolve blueprints.",
-							Ref:         ref("github.com/gardener/landscaper/legacy-component-spec/bindings-go/apis/v2.UnstructuredTypedObject"),
+							Description: "Selects a key of a secret in the installations's namespace",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
 						},
 					},
-					"ocmConfig": {
+					"secretLabelSelector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "OCMConfig references a k8s config map object that contains the ocm configuration data in the format of an ocm configfile. For more info see: https://github.com/open-component-model/ocm/blob/main/docs/reference/ocm_configfile.md",
-							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+							Description: "Selects a key from multiple secrets in the installations's namespace that matches the given labels.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.SecretLabelSelectorRef"),
 						},
 					},
-					"registryPullSecrets": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.SecretLabelSelectorRef", "k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_SubInstCache(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SubInstCache contains the existing sub installations",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"activeSubs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RegistryPullSecrets defines a list of registry credentials that are used to pull blueprints, component descriptors and jsonschemas from the respective registry. For more info see: https://kubernetes.io/docs/tasks/configure-pod-container/pull-image-private-registry/ Note that the type information is used to determine the secret key and the type of the secret.",
-							Type:        []string{"array"},
+							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/api/core/v1.LocalObjectReference"),
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.SubNamePair"),
 									},
 								},
 							},
 						},
 					},
-					"configurations": {
+					"orphanedSubs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Configurations contains arbitrary configuration information for dedicated purposes given by a string key. The key should use a dns-like syntax to express the purpose and avoid conflicts.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-					"componentVersionOverwrites": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.SubNamePair"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_SubNamePair(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DiNamePair contains the spec name and the real name of a deploy item",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"specName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ComponentVersionOverwritesReference is a reference to a ComponentVersionOverwrites object The overwrites object has to be in the same namespace as the context. If the string is empty, no overwrites will be used.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"verificationSignatures": {
+					"objectName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "VerificationSignatures maps a signature name to the trusted verification information",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.VerificationSignature"),
-									},
-								},
-							},
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.VerificationSignature", "github.com/gardener/landscaper/legacy-component-spec/bindings-go/apis/v2.UnstructuredTypedObject", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ContextConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_SubinstallationTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "SubinstallationTemplate defines a subinstallation template.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"repositoryContext": {
+					"file": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RepositoryContext defines the context of the component repository to resolve blueprints.",
-							Ref:         ref("github.com/gardener/landscaper/legacy-component-spec/bindings-go/apis/v2.UnstructuredTypedObject"),
+							Description: "File references a subinstallation template stored in another file.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"ocmConfig": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "OCMConfig references a k8s config map object that contains the ocm configuration data in the format of an ocm configfile. For more info see: https://github.com/open-component-model/ocm/blob/main/docs/reference/ocm_configfile.md",
-							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"registryPullSecrets": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RegistryPullSecrets defines a list of registry credentials that are used to pull blueprints, component descriptors and jsonschemas from the respective registry. For more info see: https://kubernetes.io/docs/tasks/configure-pod-container/pull-image-private-registry/ Note that the type information is used to determine the secret key and the type of the secret.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/api/core/v1.LocalObjectReference"),
-									},
-								},
-							},
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"configurations": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Configurations contains arbitrary configuration information for dedicated purposes given by a string key. The key should use a dns-like syntax to express the purpose and avoid conflicts.",
+							Description: "Name is the unique name of the step",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"blueprint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reference defines a reference to a Blueprint. The blueprint can reside in an OCI or other supported location.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationTemplateBlueprintDefinition"),
+						},
+					},
+					"imports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Imports define the imported data objects and targets.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationImports"),
+						},
+					},
+					"importDataMappings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImportDataMappings contains a template for restructuring imports. It is expected to contain a key for every blueprint-defined data import. Missing keys will be defaulted to their respective data import. Example: namespace: (( installation.imports.namespace ))",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
@@ -7533,109 +12165,58 @@ func schema_landscaper_apis_core_v1alpha1_ContextConfiguration(ref common.Refere
 							},
 						},
 					},
-					"componentVersionOverwrites": {
+					"exports": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ComponentVersionOverwritesReference is a reference to a ComponentVersionOverwrites object The overwrites object has to be in the same namespace as the context. If the string is empty, no overwrites will be used.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Exports define the exported data objects and targets.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationExports"),
 						},
 					},
-					"verificationSignatures": {
+					"exportDataMappings": {
 						SchemaProps: spec.SchemaProps{
-							Description: "VerificationSignatures maps a signature name to the trusted verification information",
+							Description: "ExportDataMappings contains a template for restructuring exports. It is expected to contain a key for every blueprint-defined data export. Missing keys will be defaulted to their respective data export. Example: namespace: (( blueprint.exports.namespace ))",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.VerificationSignature"),
+										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
 									},
 								},
 							},
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.VerificationSignature", "github.com/gardener/landscaper/legacy-component-spec/bindings-go/apis/v2.UnstructuredTypedObject", "k8s.io/api/core/v1.LocalObjectReference"},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_ContextList(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ContextList contains a list of Contexts",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
-						},
-					},
-					"items": {
+					"optimization": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.Context"),
-									},
-								},
-							},
+							Description: "Optimization contains settings to improve execution performance.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Optimization"),
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"name", "blueprint"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Context", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationExports", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationImports", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationTemplateBlueprintDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.Optimization"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_CriticalProblem(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_SucceededReconcile(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "CriticalProblem contains information about one critical problem.",
+				Description: "SucceededReconcile allows to configure automatically repeated reconciliations for succeeded installations",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"podName": {
-						SchemaProps: spec.SchemaProps{
-							Description: "PodName contains the name of the pod where the problem occurred",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"creationTime": {
+					"interval": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CreationTime contains the timestamp when the problem occurred",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Interval specifies the interval between two subsequent repeated reconciliations. If not set, a default of 24 hours is used.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
 						},
 					},
-					"description": {
+					"cronSpec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Description contains an error description",
+							Description: "CronSpec describes the reconcile intervals according to the cron syntax \"https://pkg.go.dev/github.com/robfig/cron#hdr-CRON_Expression_Format\". If not empty, this specification is used instead of Interval.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -7644,15 +12225,15 @@ func schema_landscaper_apis_core_v1alpha1_CriticalProblem(ref common.ReferenceCa
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_CriticalProblems(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_SyncObject(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "CriticalProblems contains a list of critical landscaper problems.",
+				Description: "The SyncObject helps to sync access to deploy items.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -7679,14 +12260,14 @@ func schema_landscaper_apis_core_v1alpha1_CriticalProblems(ref common.ReferenceC
 						SchemaProps: spec.SchemaProps{
 							Description: "Spec contains the specification",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.CriticalProblemsSpec"),
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.SyncObjectSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Status contains the status",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.CriticalProblemsStatus"),
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.SyncObjectStatus"),
 						},
 					},
 				},
@@ -7694,15 +12275,15 @@ func schema_landscaper_apis_core_v1alpha1_CriticalProblems(ref common.ReferenceC
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.CriticalProblemsSpec", "github.com/gardener/landscaper/apis/core/v1alpha1.CriticalProblemsStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.SyncObjectSpec", "github.com/gardener/landscaper/apis/core/v1alpha1.SyncObjectStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_CriticalProblemsList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_SyncObjectList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "CriticalProblemsList contains a list of critical landscaper problems objects",
+				Description: "SyncObjectList contains a list of SyncObject objects",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -7732,7 +12313,7 @@ func schema_landscaper_apis_core_v1alpha1_CriticalProblemsList(ref common.Refere
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.CriticalProblems"),
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.SyncObject"),
 									},
 								},
 							},
@@ -7743,176 +12324,223 @@ func schema_landscaper_apis_core_v1alpha1_CriticalProblemsList(ref common.Refere
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.CriticalProblems", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.SyncObject", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_CriticalProblemsSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_SyncObjectSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "CriticalProblemsSpec contains the specification for a CriticalProblems object.",
+				Description: "SyncObjectSpec contains the specification.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"criticalProblem": {
+					"podName": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.CriticalProblem"),
-									},
-								},
-							},
+							Description: "PodName describes the name of the pod of the responsible deployer",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind describes the kind of object that is being locked by this SyncObject",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the object that is being locked by this SyncObject",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastUpdateTime contains last time the object was updated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"prefix": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Prefix is the prefix of the name of the object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"podName", "kind", "name", "lastUpdateTime", "prefix"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.CriticalProblem"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_CriticalProblemsStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_SyncObjectStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "CriticalProblemsStatus contains the status of a CriticalProblems object.",
+				Description: "SyncObjectStatus contains the status.",
 				Type:        []string{"object"},
 			},
 		},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_DataExport(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_Target(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DataExport is a data object export.",
+				Description: "Target defines a specific data object that defines target environment. Every deploy item can have a target which is used by the deployer to install the specific application.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name the internal name of the imported/exported data.",
-							Default:     "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"dataRef": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DataRef is the name of the in-cluster data object.",
-							Default:     "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetSpec"),
+						},
+					},
 				},
-				Required: []string{"name", "dataRef"},
+				Required: []string{"spec"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_DataImport(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_TargetExport(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DataImport is a data object import.",
+				Description: "TargetExport is a single target export.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name the internal name of the imported/exported data.",
+							Description: "Name the internal name of the exported target.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"dataRef": {
-						SchemaProps: spec.SchemaProps{
-							Description: "DataRef is the name of the in-cluster data object. The reference can also be a namespaces name. E.g. \"default/mydataref\"",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"version": {
+					"target": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Version specifies the imported data version. defaults to \"v1\"",
+							Description: "Target is the name of the in-cluster target object.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"secretRef": {
-						SchemaProps: spec.SchemaProps{
-							Description: "SecretRef defines a data reference from a secret. This method is not allowed in installation templates.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"),
-						},
-					},
-					"configMapRef": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ConfigMapRef defines a data reference from a configmap. This method is not allowed in installation templates.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.LocalConfigMapReference"),
-						},
-					},
 				},
 				Required: []string{"name"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.LocalConfigMapReference", "github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_DataObject(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_TargetImport(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DataObject are resources that can hold any kind json or yaml data.",
+				Description: "TargetImport is either a single target or a target list import.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Name the internal name of the imported target.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"target": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Target is the name of the in-cluster target object. Exactly one of Target, Targets, and TargetListReference has to be specified.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"targets": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "Targets is a list of in-cluster target objects. Exactly one of Target, Targets, and TargetListReference has to be specified.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"data": {
+					"targetListRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Data contains the data of the object as string.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+							Description: "TargetListReference can (only) be used to import a targetlist that has been imported by the parent installation. Exactly one of Target, Targets, and TargetListReference has to be specified.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"targetMap": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"targetMapRef": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
 				},
-				Required: []string{"data"},
+				Required: []string{"name"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_DataObjectList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_TargetList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DataObjectList contains a list of DataObject",
+				Description: "TargetList contains a list of Targets",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -7942,7 +12570,7 @@ func schema_landscaper_apis_core_v1alpha1_DataObjectList(ref common.ReferenceCal
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DataObject"),
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.Target"),
 									},
 								},
 							},
@@ -7953,55 +12581,108 @@ func schema_landscaper_apis_core_v1alpha1_DataObjectList(ref common.ReferenceCal
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.DataObject", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Target", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_Default(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_TargetSelector(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Default defines a default value (future idea: also reference?).",
+				Description: "TargetSelector describes a selector that matches specific targets.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"value": {
+					"targets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Targets defines a list of specific targets (name and namespace) that should be reconciled.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
+									},
+								},
+							},
+						},
+					},
+					"annotations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Annotations matches a target based on annotations.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.Requirement"),
+									},
+								},
+							},
+						},
+					},
+					"labels": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+							Description: "Labels matches a target based on its labels.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.Requirement"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"value"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.Requirement"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_DependentToTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_TargetSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "TargetSpec contains the definition of a target.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the dependent installation",
+							Description: "Type is the type of the target that defines its data structure. The actual schema may be defined by a target type crd in the future.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"config": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Configuration contains the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+						},
+					},
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reference to a secret containing the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"),
+						},
+					},
 				},
+				Required: []string{"type"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_DeployItem(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_TargetSync(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DeployItem defines a resource that should be processed by a external deployer",
+				Description: "The TargetSync is created targets from secrets.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -8026,14 +12707,16 @@ func schema_landscaper_apis_core_v1alpha1_DeployItem(ref common.ReferenceCallbac
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemSpec"),
+							Description: "Spec contains the specification",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetSyncSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemStatus"),
+							Description: "Status contains the status",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetSyncStatus"),
 						},
 					},
 				},
@@ -8041,57 +12724,15 @@ func schema_landscaper_apis_core_v1alpha1_DeployItem(ref common.ReferenceCallbac
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemSpec", "github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_DeployItemCache(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "DeployItemCache contains the existing deploy items",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"activeDIs": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DiNamePair"),
-									},
-								},
-							},
-						},
-					},
-					"orphanedDIs": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.DiNamePair"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSyncSpec", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetSyncStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_DeployItemList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_TargetSyncList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DeployItemList contains a list of DeployItems",
+				Description: "TargetSyncList contains a list of TargetSync objects",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -8121,7 +12762,7 @@ func schema_landscaper_apis_core_v1alpha1_DeployItemList(ref common.ReferenceCal
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DeployItem"),
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetSync"),
 									},
 								},
 							},
@@ -8132,232 +12773,157 @@ func schema_landscaper_apis_core_v1alpha1_DeployItemList(ref common.ReferenceCal
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.DeployItem", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSync", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_DeployItemSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_TargetSyncSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DeployItemSpec contains the definition of a deploy item.",
+				Description: "TargetSyncSpec contains the specification for a TargetSync.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
+					"sourceNamespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type is the type of the deployer that should handle the item.",
+							Description: "SourceNamespace describes the namespace from where the secrets should be synced",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"target": {
+					"secretRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Target specifies an optional target of the deploy item. In most cases it contains the secrets to access a evironment. It is also used by the deployers to determine the ownernship.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
+							Description: "SecretRef references the secret that contains the kubeconfig to the namespace of the secrets to be synced.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"),
 						},
 					},
-					"context": {
+					"createTargetToSource": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Context defines the current context of the deployitem.",
-							Type:        []string{"string"},
+							Description: "CreateTargetToSource specifies if set on true, that also a target is created, which references the secret in SecretRef",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"config": {
+					"targetToSourceName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Configuration contains the deployer type specific configuration.",
-							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+							Description: "TargetToSourceName is the name of the target referencing the secret defined in SecretRef if CreateTargetToSource is set on true. If TargetToSourceName is empty SourceNamespace is used instead.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"timeout": {
+					"secretNameExpression": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Timeout specifies how long the deployer may take to apply the deploy item. When the time is exceeded, the deploy item fails. Value has to be parsable by time.ParseDuration (or 'none' to deactivate the timeout). Defaults to ten minutes if not specified.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+							Description: "SecretNameExpression defines the names of the secrets which should be synced via a regular expression according to https://github.com/google/re2/wiki/Syntax with the extension that * is also a valid expression and matches all names. if not set no secrets are synced",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"updateOnChangeOnly": {
+					"shootNameExpression": {
 						SchemaProps: spec.SchemaProps{
-							Description: "UpdateOnChangeOnly specifies if redeployment is executed only if the specification of the deploy item has changed.",
-							Type:        []string{"boolean"},
+							Description: "ShootNameExpression defines the names of shoot clusters for which targets with short living access data to the shoots are created via a regular expression according to https://github.com/google/re2/wiki/Syntax with the extension that * is also a valid expression and matches all names. if not set no targets for the shoots are created",
+							Default:     "",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"onDelete": {
+					"tokenRotation": {
 						SchemaProps: spec.SchemaProps{
-							Description: "OnDelete specifies particular setting when deleting a deploy item",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.OnDeleteConfig"),
+							Description: "TokenRotation defines the data to perform an automatic rotation of the token to access the source cluster with the secrets to sync. The token expires after 90 days and will be rotated every 60 days.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.TokenRotation"),
 						},
 					},
 				},
-				Required: []string{"type"},
+				Required: []string{"sourceNamespace", "secretRef"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.OnDeleteConfig", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference", "github.com/gardener/landscaper/apis/core/v1alpha1.TokenRotation"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_DeployItemStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_TargetSyncStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DeployItemStatus contains the status of a deploy item.",
+				Description: "TargetSyncStatus contains the status of a TargetSync.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"phase": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Phase is the current phase of the DeployItem",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
 					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ObservedGeneration is the most recent generation observed for this DeployItem. It corresponds to the DeployItem generation, which is updated on mutation by the landscaper.",
+							Description: "ObservedGeneration is the most recent generation observed.",
 							Default:     0,
 							Type:        []string{"integer"},
 							Format:      "int64",
 						},
 					},
-					"conditions": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Conditions contains the actual condition of a deploy item",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.Condition"),
-									},
-								},
-							},
-						},
-					},
-					"lastError": {
-						SchemaProps: spec.SchemaProps{
-							Description: "LastError describes the last error that occurred.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Error"),
-						},
-					},
-					"lastErrors": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ErrorHistory describes the last n errors that occurred since JobID was changed the last time.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.Error"),
-									},
-								},
-							},
-						},
-					},
-					"firstError": {
-						SchemaProps: spec.SchemaProps{
-							Description: "FirstError describes the first error that occurred since JobID was changed the last time.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Error"),
-						},
-					},
-					"lastReconcileTime": {
-						SchemaProps: spec.SchemaProps{
-							Description: "LastReconcileTime indicates when the reconciliation of the last change to the deploy item has started",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"deployer": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Deployer describes the deployer that has reconciled the deploy item.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.DeployerInformation"),
-						},
-					},
-					"providerStatus": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ProviderStatus contains the provider specific status",
-							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
-						},
-					},
-					"exportRef": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ExportReference is the reference to the object that contains the exported values.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
-						},
-					},
-					"jobID": {
-						SchemaProps: spec.SchemaProps{
-							Description: "JobID is the ID of the current working request.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"jobIDFinished": {
-						SchemaProps: spec.SchemaProps{
-							Description: "JobIDFinished is the ID of the finished working request.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"jobIDGenerationTime": {
+					"lastUpdateTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "JobIDGenerationTime is the timestamp when the JobID was set.",
+							Description: "Last time the status was updated",
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"deployItemPhase": {
+					"lastErrors": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DeployerPhase is DEPRECATED and will soon be removed.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "LastErrors describe the last errors",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"transitionTimes": {
+					"lastTokenRotationTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TransitionTimes contains timestamps of status transitions",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.TransitionTimes"),
+							Description: "Last time the token was rotated",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
-				Required: []string{"observedGeneration"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Condition", "github.com/gardener/landscaper/apis/core/v1alpha1.DeployerInformation", "github.com/gardener/landscaper/apis/core/v1alpha1.Error", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.TransitionTimes", "k8s.io/apimachinery/pkg/apis/meta/v1.Time", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_DeployItemTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_TargetTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DeployItemTemplate defines a execution element that is translated into a deploy item.",
+				Description: "TargetTemplate exposes specific parts of a target that are used in the exports to export a target",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the unique name of the execution.",
+							Description: "Type is the type of the target that defines its data structure. The actual schema may be defined by a target type crd in the future.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"type": {
+					"config": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DataType is the DeployItem type of the execution.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Configuration contains the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
 						},
 					},
-					"target": {
+					"secretRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Target is the object reference to the target that the deploy item should deploy to.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
+							Description: "Reference to a secret containing the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"),
 						},
 					},
 					"labels": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Labels is the map of labels to be added to the deploy item.",
+							Description: "Map of string keys and values that can be used to organize and categorize (scope and select) objects. May match selectors of replication controllers and services. More info: http://kubernetes.io/docs/user-guide/labels",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
@@ -8371,17 +12937,12 @@ func schema_landscaper_apis_core_v1alpha1_DeployItemTemplate(ref common.Referenc
 							},
 						},
 					},
-					"config": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ProviderConfiguration contains the type specific configuration for the execution.",
-							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
-						},
-					},
-					"dependsOn": {
+					"annotations": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DependsOn lists deploy items that need to be executed before this one",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "Annotations is an unstructured key value map stored with a resource that may be set by external tools to store and retrieve arbitrary metadata. They are not queryable and should be preserved when modifying objects. More info: http://kubernetes.io/docs/user-guide/annotations",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: "",
@@ -8392,44 +12953,165 @@ func schema_landscaper_apis_core_v1alpha1_DeployItemTemplate(ref common.Referenc
 							},
 						},
 					},
-					"timeout": {
+				},
+				Required: []string{"type"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_TemplateExecutor(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TemplateExecutor describes a templating mechanism and configuration.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Timeout specifies how long the deployer may take to apply the deploy item. When the time is exceeded, the deploy item fails. Value has to be parsable by time.ParseDuration (or 'none' to deactivate the timeout). Defaults to ten minutes if not specified.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+							Description: "Name is the unique name of the template",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"updateOnChangeOnly": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "UpdateOnChangeOnly specifies if redeployment is executed only if the specification of the deploy item has changed.",
+							Description: "Type describes the templating mechanism.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"file": {
+						SchemaProps: spec.SchemaProps{
+							Description: "File is the path to the template in the blueprint's content.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"template": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Template contains an optional inline template. The template has to be of string for go template and either a string or valid yaml/json for spiff.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+						},
+					},
+				},
+				Required: []string{"name", "type"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_TemplateLookupResource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TemplateLookupResource defines a kind of objects that can be read by the lookup template functions.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion is the api version of the objects, e.g. \"v1\" or \"apps/v1\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is the kind of the objects, e.g. \"ConfigMap\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"apiVersion", "kind"},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_TokenRotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enabled defines if automatic token is executed",
 							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"onDelete": {
+				},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_TransitionTimes(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"triggerTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "OnDelete specifies particular setting when deleting a deploy item",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.OnDeleteConfig"),
+							Description: "TriggerTime is the time when the jobID is set.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"initTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InitTime is the time when the Init phase starts.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"waitTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WaitTime is the time when the work is done.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"finishedTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FinishedTime is the time when the finished phase is set.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
-				Required: []string{"name", "type", "config"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.OnDeleteConfig", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_DeployerInformation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_TypedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DeployerInformation holds additional information about the deployer that has reconciled or is reconciling the deploy item.",
+				Description: "TypedObjectReference is a reference to a typed kubernetes object.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"identity": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Identity describes the unique identity of the deployer.",
+							Description: "APIVersion is the group and version for the resource being referenced. If APIVersion is not specified, the specified Kind must be in the core API group. For any other third-party types, APIVersion is required.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is the type of resource being referenced",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
@@ -8437,185 +13119,188 @@ func schema_landscaper_apis_core_v1alpha1_DeployerInformation(ref common.Referen
 					},
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the deployer.",
+							Description: "Name is the name of the kubernetes object.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"version": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Version is the version of the deployer.",
+							Description: "Namespace is the namespace of kubernetes object.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"identity", "name", "version"},
+				Required: []string{"apiVersion", "kind", "name"},
 			},
 		},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_DiNamePair(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_Verification(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DiNamePair contains the spec name and the real name of a deploy item",
+				Description: "Verification defines the necessary data to verify the signature of the refered component",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"specName": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"objectName": {
+					"signatureName": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "SignatureName defines the name of the signature that is verified",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"signatureName"},
 			},
 		},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_Duration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_VerificationSignature(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Duration is a wrapper for time.Duration that implements JSON marshalling and openapi scheme.",
-				Type:        v1alpha1.Duration{}.OpenAPISchemaType(),
-				Format:      v1alpha1.Duration{}.OpenAPISchemaFormat(),
+				Description: "VerificationSignatures contains the trusted verification information",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"publicKeySecretReference": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PublicKeySecretReference contains a secret reference to a public key in PEM format that is used to verify the component signature",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.SecretReference"),
+						},
+					},
+					"caCertificateSecretReference": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CaCertificateSecretReference contains a secret reference to one or more certificates in PEM format that are used to verify the compnent signature",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.SecretReference"),
+						},
+					},
+				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.SecretReference"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_Error(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_VersionedNamedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Error holds information about an error that occurred.",
+				Description: "VersionedNamedObjectReference is a named reference to a object with its last observed resource generation. This struct is used by status fields.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"operation": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Operation describes the operator where the error occurred.",
+							Description: "Name is the unique name of the reference.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"lastTransitionTime": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Last time the condition transitioned from one status to another.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"lastUpdateTime": {
+					"ref": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Last time the condition was updated.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Reference is the reference to an object.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.VersionedObjectReference"),
 						},
 					},
-					"reason": {
+				},
+				Required: []string{"name", "ref"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.VersionedObjectReference"},
+	}
+}
+
+func schema_landscaper_apis_core_v1alpha1_VersionedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VersionedObjectReference is a reference to a object with its last observed resource generation. This struct is used by status fields.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The reason for the condition's last transition.",
+							Description: "Name is the name of the kubernetes object.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"message": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A human readable message indicating details about the transition.",
+							Description: "Namespace is the namespace of kubernetes object.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"codes": {
+					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Well-defined error codes in case the condition reports a problem.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "ObservedGeneration defines the last observed generation of the referenced resource.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
 				},
-				Required: []string{"operation", "lastTransitionTime", "lastUpdateTime", "reason", "message"},
+				Required: []string{"name", "observedGeneration"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_Execution(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_core_v1alpha1_VersionedResourceReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Execution contains the configuration of a execution and deploy item",
+				Description: "VersionedResourceReference defines the reference to a resource with its version.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"componentName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "ComponentName defines the unique of the component containing the resource.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"resourceName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "ResourceName defines the name of the resource.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"spec": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines a execution and its items",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ExecutionSpec"),
-						},
-					},
-					"status": {
+					"version": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status contains the current status of the execution.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ExecutionStatus"),
+							Description: "Version defines the version of the component.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"spec"},
+				Required: []string{"componentName", "resourceName", "version"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.ExecutionSpec", "github.com/gardener/landscaper/apis/core/v1alpha1.ExecutionStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ExecutionList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_container_Configuration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ExecutionList contains a list of Executions‚",
+				Description: "Configuration is the container deployer configuration that configures the controller",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -8632,380 +13317,453 @@ func schema_landscaper_apis_core_v1alpha1_ExecutionList(ref common.ReferenceCall
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"identity": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "Identity identity describes the unique identity of the deployer.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"items": {
+					"oci": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "OCI configures the oci client of the controller",
+							Ref:         ref("github.com/gardener/landscaper/apis/config.OCIConfiguration"),
+						},
+					},
+					"targetSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetSelector describes all selectors the deployer should depend on.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.Execution"),
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector"),
 									},
 								},
 							},
 						},
 					},
-				},
-				Required: []string{"items"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Execution", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_ExecutionSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ExecutionSpec defines a execution plan.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"context": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Context defines the current context of the execution.",
+							Description: "Namespace defines the namespace where the pods should be executed.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"deployItems": {
+					"defaultImage": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DeployItems defines all execution items that need to be scheduled.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemTemplate"),
-									},
-								},
-							},
+							Description: "DefaultImage configures the default images that is used if the DeployItem does not specify one.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.ContainerSpec"),
 						},
 					},
-					"deployItemsCompressed": {
+					"initContainer": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DeployItemsCompressed as zipped byte array",
-							Type:        []string{"string"},
-							Format:      "byte",
+							Description: "InitContainerImage defines the image that is used to init the container. This container bootstraps the necessary directories and files.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.ContainerSpec"),
+						},
+					},
+					"waitContainer": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SidecarContainerImage defines the image that is used as a sidecar to the defined main container. The sidecar container is responsible to collect the exports and the state of the main container.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.ContainerSpec"),
+						},
+					},
+					"garbageCollection": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GarbageCollection configures the container deployer garbage collector.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.GarbageCollection"),
+						},
+					},
+					"execution": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Execution configures how the containers of the deploy items are executed.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.Execution"),
+						},
+					},
+					"debug": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DebugOptions configure additional debug options.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.DebugOptions"),
+						},
+					},
+					"hpa": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HPAConfiguration contains the configuration for horizontal pod autoscaling.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.HPAConfiguration"),
+						},
+					},
+					"controller": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Controller contains configuration concerning the controller framework.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.Controller"),
 						},
 					},
 				},
+				Required: []string{"namespace", "defaultImage", "initContainer", "waitContainer", "garbageCollection"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemTemplate"},
+			"github.com/gardener/landscaper/apis/config.OCIConfiguration", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/container.ContainerSpec", "github.com/gardener/landscaper/apis/deployer/container.Controller", "github.com/gardener/landscaper/apis/deployer/container.DebugOptions", "github.com/gardener/landscaper/apis/deployer/container.Execution", "github.com/gardener/landscaper/apis/deployer/container.GarbageCollection", "github.com/gardener/landscaper/apis/deployer/container.HPAConfiguration"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ExecutionStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_container_ContainerSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ExecutionStatus contains the current status of a execution.",
+				Description: "ContainerSpec defines a container specification",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"observedGeneration": {
+					"image": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ObservedGeneration is the most recent generation observed for this Execution. It corresponds to the Execution generation, which is updated on mutation by the landscaper.",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Description: "Docker image name. More info: https://kubernetes.io/docs/concepts/containers/images The image will be defaulted by the container deployer to the configured default.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"conditions": {
+					"command": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Conditions contains the actual condition of a execution",
+							Description: "Entrypoint array. Not executed within a shell. The docker image's ENTRYPOINT is used if this is not provided. Variable references $(VAR_NAME) are expanded using the container's environment. If a variable cannot be resolved, the reference in the input string will be unchanged. The $(VAR_NAME) syntax can be escaped with a double $$, ie: $$(VAR_NAME). Escaped references will never be expanded, regardless of whether the variable exists or not. Cannot be updated. More info: https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#running-a-command-in-a-shell",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.Condition"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-					"lastError": {
-						SchemaProps: spec.SchemaProps{
-							Description: "LastError describes the last error that occurred.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Error"),
-						},
-					},
-					"exportRef": {
+					"args": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExportReference references the object that contains the exported values. only used for operation purpose.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
+							Description: "Arguments to the entrypoint. The docker image's CMD is used if this is not provided. Variable references $(VAR_NAME) are expanded using the container's environment. If a variable cannot be resolved, the reference in the input string will be unchanged. The $(VAR_NAME) syntax can be escaped with a double $$, ie: $$(VAR_NAME). Escaped references will never be expanded, regardless of whether the variable exists or not. Cannot be updated. More info: https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#running-a-command-in-a-shell",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"deployItemCache": {
+					"imagePullPolicy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DeployItemCache contains the currently existing deploy item belonging to the execution. If nil undefined.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemCache"),
+							Description: "Image pull policy. One of Always, Never, IfNotPresent. Defaults to Always if :latest tag is specified, or IfNotPresent otherwise. Cannot be updated. More info: https://kubernetes.io/docs/concepts/containers/images#updating-images\n\nPossible enum values:\n - `\"Always\"` means that kubelet always attempts to pull the latest image. Container will fail If the pull fails.\n - `\"IfNotPresent\"` means that kubelet pulls if the image isn't present on disk. Container will fail if the image isn't present and the pull fails.\n - `\"Never\"` means that kubelet never pulls an image, but only uses a local image. Container will fail if the image isn't present",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"Always", "IfNotPresent", "Never"},
 						},
 					},
-					"jobID": {
+				},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_deployer_container_ContainerStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ContainerStatus describes the status of a pod with its init, wait and main container.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Description: "JobID is the ID of the current working request.",
+							Description: "A human readable message indicating details about why the pod is in this condition.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"jobIDFinished": {
+					"reason": {
 						SchemaProps: spec.SchemaProps{
-							Description: "JobIDFinished is the ID of the finished working request.",
+							Description: "A brief CamelCase message indicating details about why the pod is in this state. e.g. 'Evicted'",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"phase": {
+					"state": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExecutionPhase is the current phase of the execution.",
+							Description: "Details about the container's current condition.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.ContainerState"),
+						},
+					},
+					"image": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The image the container is running. More info: https://kubernetes.io/docs/concepts/containers/images",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"phaseTransitionTime": {
+					"imageID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PhaseTransitionTime is the time when the phase last changed.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "ImageID of the container's image.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"transitionTimes": {
+					"exitCode": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TransitionTimes contains timestamps of status transitions",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.TransitionTimes"),
+							Description: "ExitCode of the main container.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
+				Required: []string{"image", "imageID"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Condition", "github.com/gardener/landscaper/apis/core/v1alpha1.DeployItemCache", "github.com/gardener/landscaper/apis/core/v1alpha1.Error", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.TransitionTimes", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"k8s.io/api/core/v1.ContainerState"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ExportDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_container_Controller(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ExportDefinition defines a exported value",
+				Description: "Controller contains configuration concerning the controller framework.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"CommonControllerConfig": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name defines the field name to search for the value and map to exports. Ref: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#selecting-fields",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig"),
 						},
 					},
-					"schema": {
+				},
+				Required: []string{"CommonControllerConfig"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig"},
+	}
+}
+
+func schema_landscaper_apis_deployer_container_DebugOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DebugOptions defines optional debug options.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"keepPod": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Schema defines the imported value as jsonschema.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"),
+							Description: "KeepPod will only remove the finalizer on the pod but will not delete the pod.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"targetType": {
+				},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_deployer_container_Execution(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Execution defines how the containers of the deploy items are executed.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"mode": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TargetType defines the type of the imported target.",
+							Description: "Mode defines whether the containers are executed in a bare pod or in a job. Defaults to Pod.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"type": {
+					"job": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type specifies which kind of object is being exported. This field should be set and will likely be mandatory in future.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Job configures the jobs that are created in the Job mode.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.JobConfiguration"),
 						},
 					},
 				},
-				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"},
+			"github.com/gardener/landscaper/apis/deployer/container.JobConfiguration"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_FailedReconcile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_container_GarbageCollection(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "FailedReconcile allows to configure automatically repeated reconciliations for failed installations",
+				Description: "GarbageCollection defines the container deployer garbage collection configuration.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"numberOfReconciles": {
+					"disable": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NumberOfReconciles specifies the maximal number of automatically repeated reconciliations. If not set, no upper limit exists.",
+							Description: "Disable disables the garbage collector and the resources clean-up.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"worker": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Worker defines the number of parallel garbage collection routines. Defaults to 5.",
+							Default:     0,
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
 					},
-					"interval": {
+					"requeueTimeSeconds": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Interval specifies the interval between two subsequent repeated reconciliations. If not set, a default of 5 minutes is used.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+							Description: "RequeueTime specifies the duration after which the object, which is not yet ready to be garbage collected, is requeued. Defaults to 3600.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"cronSpec": {
+				},
+				Required: []string{"disable", "worker", "requeueTimeSeconds"},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_deployer_container_HPAConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HPAConfiguration contains the configuration for horizontal pod autoscaling.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxReplicas": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CronSpec describes the reconcile intervals according to the cron syntax \"https://pkg.go.dev/github.com/robfig/cron#hdr-CRON_Expression_Format\". If not empty, this specification is used instead of Interval.",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"integer"},
+							Format: "int32",
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_FieldValueDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_container_JobConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "FieldValueDefinition defines a im- or exported field. Either schema or target type have to be defined",
+				Description: "JobConfiguration defines the configuration of the jobs that execute the containers.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"backoffLimit": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name defines the field name to search for the value and map to exports. Ref: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#selecting-fields",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "BackoffLimit is the number of retries of a failed pod before the job is considered as failed. The state of a failed pod is restored in the next pod. Defaults to 0.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"schema": {
+					"activeDeadlineSeconds": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Schema defines the imported value as jsonschema.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"),
+							Description: "ActiveDeadlineSeconds is the duration in seconds, after which a running job is terminated and considered as failed.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"targetType": {
+					"ttlSecondsAfterFinished": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TargetType defines the type of the imported target.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "TTLSecondsAfterFinished is the duration in seconds, after which a finished job that is kept with debug.keepPod is deleted by the cluster. It is set when the deployer has collected the result of the job.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
-				Required: []string{"name"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ImportDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_container_PodStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ImportDefinition defines a imported value",
+				Description: "PodStatus describes the status of a pod with its init, wait and main container",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"podName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name defines the field name to search for the value and map to exports. Ref: https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#selecting-fields",
+							Description: "PodName is the name of the created pod.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"schema": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Schema defines the imported value as jsonschema.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"),
-						},
-					},
-					"targetType": {
+					"jobName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TargetType defines the type of the imported target.",
+							Description: "JobName is the name of the job that manages the pod. It is only set if the containers are executed in a job.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"type": {
+					"lastRun": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type specifies which kind of object is being imported. This field should be set and will likely be mandatory in future.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "LastRun is the time when the pod was executed the last time.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"required": {
+					"lastSuccessfulJobID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Required specifies whether the import is required for the component to run. Defaults to true.",
-							Type:        []string{"boolean"},
+							Description: "LastSuccessfulJobID is set to the current JobID when the pod successfully finished. If the pod has not yet finished, this field will be nil.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"default": {
+					"containerStatus": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Default sets a default value for the current import that is used if the key is not set.",
+							Description: "ContainerStatus contains the status of the pod that executes the configured container.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Default"),
-						},
-					},
-					"imports": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ConditionalImports are Imports that are only valid if this imports is satisfied. Does only make sense for optional imports.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ImportDefinition"),
-									},
-								},
-							},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.ContainerStatus"),
 						},
 					},
-				},
-				Required: []string{"name"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Default", "github.com/gardener/landscaper/apis/core/v1alpha1.ImportDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.JSONSchemaDefinition"},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_InlineBlueprint(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "InlineBlueprint defines a inline blueprint with component descriptor and filesystem.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"filesystem": {
+					"initContainerStatus": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Filesystem defines a inline yaml filesystem with a blueprint.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+							Description: "InitContainerStatus contains the status of the init container.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.ContainerStatus"),
+						},
+					},
+					"waitContainerStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WaitContainerStatus contains the status of the wait container.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.ContainerStatus"),
 						},
 					},
 				},
-				Required: []string{"filesystem"},
+				Required: []string{"podName", "containerStatus", "initContainerStatus", "waitContainerStatus"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"},
+			"github.com/gardener/landscaper/apis/deployer/container.ContainerStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_Installation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_container_ProviderConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Installation contains the configuration of a component",
+				Description: "ProviderConfiguration is the container deployer configuration that is expected in a DeployItem",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -9022,387 +13780,201 @@ func schema_landscaper_apis_core_v1alpha1_Installation(ref common.ReferenceCallb
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"spec": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Spec contains the specification for a installation.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationSpec"),
-						},
-					},
-					"status": {
+					"image": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status contains the status of the installation.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationStatus"),
+							Description: "Docker image name. More info: https://kubernetes.io/docs/concepts/containers/images The image will be defaulted by the container deployer to the configured default.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-				},
-				Required: []string{"spec"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.InstallationSpec", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_InstallationExports(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "InstallationExports defines exports of data objects and targets.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"data": {
+					"command": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Data defines all data object exports.",
+							Description: "Entrypoint array. Not executed within a shell. The docker image's ENTRYPOINT is used if this is not provided. Variable references $(VAR_NAME) are expanded using the container's environment. If a variable cannot be resolved, the reference in the input string will be unchanged. The $(VAR_NAME) syntax can be escaped with a double $$, ie: $$(VAR_NAME). Escaped references will never be expanded, regardless of whether the variable exists or not. Cannot be updated. More info: https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#running-a-command-in-a-shell",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DataExport"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-					"targets": {
+					"args": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Targets defines all target exports.",
+							Description: "Arguments to the entrypoint. The docker image's CMD is used if this is not provided. Variable references $(VAR_NAME) are expanded using the container's environment. If a variable cannot be resolved, the reference in the input string will be unchanged. The $(VAR_NAME) syntax can be escaped with a double $$, ie: $$(VAR_NAME). Escaped references will never be expanded, regardless of whether the variable exists or not. Cannot be updated. More info: https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#running-a-command-in-a-shell",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetExport"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.DataExport", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetExport"},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_InstallationImports(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "InstallationImports defines import of data objects and targets.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"data": {
+					"resources": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Data defines all data object imports.",
+							Description: "Resources are the compute resources that are required by the main container. More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.ResourceRequirements"),
+						},
+					},
+					"env": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Env is a list of additional environment variables of the main container. Values can be taken from secrets in the namespace of the pod in the host cluster that are allowed by the container deployer, from fields of the pod and from the resources of the container. The environment variables that are set by the container deployer cannot be overwritten.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DataImport"),
+										Ref:     ref("k8s.io/api/core/v1.EnvVar"),
 									},
 								},
 							},
 						},
 					},
-					"targets": {
+					"envFrom": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Targets defines all target imports.",
+							Description: "EnvFrom is a list of secrets in the namespace of the pod in the host cluster, whose keys are added as environment variables to the main container. Only secrets that are allowed by the container deployer can be referenced.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetImport"),
+										Ref:     ref("k8s.io/api/core/v1.EnvFromSource"),
 									},
 								},
 							},
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.DataImport", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetImport"},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_InstallationList(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "InstallationList contains a list of Components",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
-						},
-					},
-					"items": {
+					"volumes": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Volumes are additional volumes of the pod. Only emptyDir, secret, configMap, downwardAPI, projected, persistentVolumeClaim and ephemeral volumes are allowed. Secrets must be allowed by the container deployer and service account tokens must not be projected.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.Installation"),
+										Ref:     ref("k8s.io/api/core/v1.Volume"),
 									},
 								},
 							},
 						},
 					},
-				},
-				Required: []string{"items"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Installation", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_InstallationSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "InstallationSpec defines a component installation.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"context": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Context defines the current context of the installation.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"verification": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Verification defines the necessary data to verify the signature of the refered component",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Verification"),
-						},
-					},
-					"componentDescriptor": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ComponentDescriptor is a reference to the installation's component descriptor",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorDefinition"),
-						},
-					},
-					"blueprint": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Blueprint is the resolved reference to the definition.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.BlueprintDefinition"),
-						},
-					},
-					"imports": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Imports define the imported data objects and targets.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationImports"),
-						},
-					},
-					"importDataMappings": {
+					"volumeMounts": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ImportDataMappings contains a template for restructuring imports. It is expected to contain a key for every blueprint-defined data import. Missing keys will be defaulted to their respective data import. Example: namespace: (( installation.imports.namespace ))",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "VolumeMounts are additional volume mounts of the main container. The volumes must not be mounted into the directory of the container deployer data.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.VolumeMount"),
 									},
 								},
 							},
 						},
 					},
-					"exports": {
+					"securityContext": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Exports define the exported data objects and targets.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationExports"),
+							Description: "SecurityContext is the security context of the main container. Privileged containers, privilege escalation, added capabilities and running as root are not allowed. More info: https://kubernetes.io/docs/tasks/configure-pod-container/security-context/",
+							Ref:         ref("k8s.io/api/core/v1.SecurityContext"),
 						},
 					},
-					"exportDataMappings": {
+					"nodeSelector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExportDataMappings contains a template for restructuring exports. It is expected to contain a key for every blueprint-defined data export. Missing keys will be defaulted to their respective data export. Example: namespace: (( blueprint.exports.namespace ))",
+							Description: "NodeSelector is a selector which must match the labels of a node for the pod to be scheduled on that node.",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-					"automaticReconcile": {
-						SchemaProps: spec.SchemaProps{
-							Description: "AutomaticReconcile allows to configure automatically repeated reconciliations.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AutomaticReconcile"),
-						},
-					},
-					"optimization": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Optimization contains settings to improve execution performance.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Optimization"),
-						},
-					},
-				},
-				Required: []string{"blueprint"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.AutomaticReconcile", "github.com/gardener/landscaper/apis/core/v1alpha1.BlueprintDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationExports", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationImports", "github.com/gardener/landscaper/apis/core/v1alpha1.Optimization", "github.com/gardener/landscaper/apis/core/v1alpha1.Verification"},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_InstallationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "InstallationStatus contains the current status of a Installation.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"observedGeneration": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ObservedGeneration is the most recent generation observed for this ControllerInstallations. It corresponds to the ControllerInstallations generation, which is updated on mutation by the landscaper.",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int64",
-						},
-					},
-					"conditions": {
+					"tolerations": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Conditions contains the actual condition of a installation",
+							Description: "Tolerations are the tolerations of the pod.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.Condition"),
+										Ref:     ref("k8s.io/api/core/v1.Toleration"),
 									},
 								},
 							},
 						},
 					},
-					"lastError": {
-						SchemaProps: spec.SchemaProps{
-							Description: "LastError describes the last error that occurred.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Error"),
-						},
-					},
-					"subInstCache": {
-						SchemaProps: spec.SchemaProps{
-							Description: "SubInstCache contains the currently existing sub installations belonging to the execution. If nil undefined.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.SubInstCache"),
-						},
-					},
-					"executionRef": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ExecutionReference is the reference to the execution that schedules the templated execution items.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
-						},
-					},
-					"jobID": {
-						SchemaProps: spec.SchemaProps{
-							Description: "JobID is the ID of the current working request.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"jobIDFinished": {
+					"serviceAccountName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "JobIDFinished is the ID of the finished working request.",
+							Description: "ServiceAccountName is the name of a service account in the namespace of the pod in the host cluster that is used to run the pod. The service account must be allowed by the container deployer. Its token is not mounted into the main container.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"phase": {
+					"importValues": {
 						SchemaProps: spec.SchemaProps{
-							Description: "InstallationPhase is the current phase of the installation.",
+							Description: "ImportValues contains the import values for the container.",
 							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"phaseTransitionTime": {
-						SchemaProps: spec.SchemaProps{
-							Description: "PhaseTransitionTime is the time when the phase last changed.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Format:      "byte",
 						},
 					},
-					"importsHash": {
+					"blueprint": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ImportsHash is the hash of the import data.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Blueprint is the resolved reference to the Blueprint definition",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.BlueprintDefinition"),
 						},
 					},
-					"automaticReconcileStatus": {
+					"componentDescriptor": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AutomaticReconcileStatus describes the status of automatically triggered reconciles.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AutomaticReconcileStatus"),
+							Description: "ComponentDescriptor is the resolved reference to the ComponentDescriptor definition",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorDefinition"),
 						},
 					},
-					"dependentsToTrigger": {
+					"registryPullSecrets": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DependentsToTrigger lists dependent installations to be triggered",
+							Description: "RegistryPullSecrets defines a list of registry credentials that are used to pull blueprints and component descriptors from the respective registry. For more info see: https://kubernetes.io/docs/tasks/configure-pod-container/pull-image-private-registry/ Note that the type information is used to determine the secret key and the type of the secret.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.DependentToTrigger"),
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
 									},
 								},
 							},
 						},
 					},
-					"transitionTimes": {
+					"continuousReconcile": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TransitionTimes contains timestamps of status transitions",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.TransitionTimes"),
+							Description: "ContinuousReconcile contains the schedule for continuous reconciliation.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"),
 						},
 					},
 				},
-				Required: []string{"observedGeneration"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.AutomaticReconcileStatus", "github.com/gardener/landscaper/apis/core/v1alpha1.Condition", "github.com/gardener/landscaper/apis/core/v1alpha1.DependentToTrigger", "github.com/gardener/landscaper/apis/core/v1alpha1.Error", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.SubInstCache", "github.com/gardener/landscaper/apis/core/v1alpha1.TransitionTimes", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.BlueprintDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec", "k8s.io/api/core/v1.EnvFromSource", "k8s.io/api/core/v1.EnvVar", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.SecurityContext", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.Volume", "k8s.io/api/core/v1.VolumeMount"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_InstallationTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_container_ProviderStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "InstallationTemplate defines a subinstallation in a blueprint.",
+				Description: "ProviderStatus is the container provider specific status",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -9419,898 +13991,708 @@ func schema_landscaper_apis_core_v1alpha1_InstallationTemplate(ref common.Refere
 							Format:      "",
 						},
 					},
-					"name": {
+					"lastOperation": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the unique name of the step",
+							Description: "LastOperation defines the last run operation of the pod. The operation can be either reconcile or deletion.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"blueprint": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Reference defines a reference to a Blueprint. The blueprint can reside in an OCI or other supported location.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationTemplateBlueprintDefinition"),
-						},
-					},
-					"imports": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Imports define the imported data objects and targets.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationImports"),
-						},
-					},
-					"importDataMappings": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ImportDataMappings contains a template for restructuring imports. It is expected to contain a key for every blueprint-defined data import. Missing keys will be defaulted to their respective data import. Example: namespace: (( installation.imports.namespace ))",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
-									},
-								},
-							},
-						},
-					},
-					"exports": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Exports define the exported data objects and targets.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationExports"),
-						},
-					},
-					"exportDataMappings": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ExportDataMappings contains a template for restructuring exports. It is expected to contain a key for every blueprint-defined data export. Missing keys will be defaulted to their respective data export. Example: namespace: (( blueprint.exports.namespace ))",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
-									},
-								},
-							},
-						},
-					},
-					"optimization": {
+					"podStatus": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Optimization contains settings to improve execution performance.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Optimization"),
+							Description: "PodStatus indicated the status of the executed pod.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.PodStatus"),
 						},
 					},
 				},
-				Required: []string{"name", "blueprint"},
+				Required: []string{"lastOperation"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationExports", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationImports", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationTemplateBlueprintDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.Optimization"},
+			"github.com/gardener/landscaper/apis/deployer/container.PodStatus"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_InstallationTemplateBlueprintDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_container_v1alpha1_Configuration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "InstallationTemplateBlueprintDefinition contains either a reference to a blueprint or an inline definition.",
+				Description: "Configuration is the container deployer configuration that configures the controller",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"ref": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Ref is a reference to a blueprint. Only blueprints that are defined by the component descriptor of the current blueprint can be referenced here. Example: cd://componentReference/dns/resources/blueprint",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"filesystem": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Filesystem defines a virtual filesystem with all files needed for a blueprint. The filesystem must be a YAML filesystem.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_JSONSchemaDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "JSONSchemaDefinition defines a jsonschema.",
-				Type:        v1alpha1.JSONSchemaDefinition{}.OpenAPISchemaType(),
-				Format:      v1alpha1.JSONSchemaDefinition{}.OpenAPISchemaFormat(),
-			},
-		},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_LocalConfigMapReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "LocalConfigMapReference is a reference to data in a configmap.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"name": {
+					"identity": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the configmap",
-							Default:     "",
+							Description: "Identity identity describes the unique identity of the deployer.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"key": {
+					"oci": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Key is the name of the key in the configmap that holds the data.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "OCI configures the oci client of the controller",
+							Ref:         ref("github.com/gardener/landscaper/apis/config.OCIConfiguration"),
 						},
 					},
-				},
-				Required: []string{"name"},
-			},
-		},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_LocalSecretReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "LocalSecretReference is a reference to data in a secret.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"name": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the secret",
+							Description: "Namespace defines the namespace where the pods should be executed. Defaults to default",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"key": {
+					"targetSelector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Key is the name of the key in the secret that holds the data.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "TargetSelector describes all selectors the deployer should depend on.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector"),
+									},
+								},
+							},
 						},
 					},
-				},
-				Required: []string{"name"},
-			},
-		},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_LsHealthCheck(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "LsHealthCheck is a resource containing information about problems with the landscaper installation",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"kind": {
+					"defaultImage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DefaultImage configures the default images that is used if the DeployItem does not specify one.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerSpec"),
+						},
+					},
+					"initContainer": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InitContainerImage defines the image that is used to init the container. This container bootstraps the necessary directories and files.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerSpec"),
+						},
+					},
+					"waitContainer": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "SidecarContainerImage defines the image that is used as a sidecar to the defined main container. The sidecar container is responsible to collect the exports and the state of the main container.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerSpec"),
 						},
 					},
-					"apiVersion": {
+					"garbageCollection": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "GarbageCollection configures the container deployer garbage collector.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.GarbageCollection"),
 						},
 					},
-					"metadata": {
+					"execution": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "Execution configures how the containers of the deploy items are executed.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.Execution"),
 						},
 					},
-					"status": {
+					"debug": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status defines the overall status of the landscaper and its standard deployers.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "DebugOptions configure additional debug options.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.DebugOptions"),
 						},
 					},
-					"lastUpdateTime": {
+					"hpa": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastUpdateTime contains last time the check was updated.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "HPAConfiguration contains the configuration for horizontal pod autoscaling.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.HPAConfiguration"),
 						},
 					},
-					"description": {
+					"controller": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Description contains description of the problem(s)",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Controller contains configuration concerning the controller framework.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.Controller"),
 						},
 					},
 				},
-				Required: []string{"status", "lastUpdateTime", "description"},
+				Required: []string{"defaultImage", "initContainer", "waitContainer", "garbageCollection"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/landscaper/apis/config.OCIConfiguration", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerSpec", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.Controller", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.DebugOptions", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.Execution", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.GarbageCollection", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.HPAConfiguration"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_LsHealthCheckList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_container_v1alpha1_ContainerSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LsHealthCheckList contains a list of LsHealthChecks",
+				Description: "ContainerSpec defines a container specification",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
+					"image": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Docker image name. More info: https://kubernetes.io/docs/concepts/containers/images The image will be defaulted by the container deployer to the configured default.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"command": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "Entrypoint array. Not executed within a shell. The docker image's ENTRYPOINT is used if this is not provided. Variable references $(VAR_NAME) are expanded using the container's environment. If a variable cannot be resolved, the reference in the input string will be unchanged. The $(VAR_NAME) syntax can be escaped with a double $$, ie: $$(VAR_NAME). Escaped references will never be expanded, regardless of whether the variable exists or not. Cannot be updated. More info: https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#running-a-command-in-a-shell",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"items": {
+					"args": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Arguments to the entrypoint. The docker image's CMD is used if this is not provided. Variable references $(VAR_NAME) are expanded using the container's environment. If a variable cannot be resolved, the reference in the input string will be unchanged. The $(VAR_NAME) syntax can be escaped with a double $$, ie: $$(VAR_NAME). Escaped references will never be expanded, regardless of whether the variable exists or not. Cannot be updated. More info: https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#running-a-command-in-a-shell",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.LsHealthCheck"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-				},
-				Required: []string{"items"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.LsHealthCheck", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_NamedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "NamedObjectReference is a named reference to a specific resource.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"name": {
+					"imagePullPolicy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the unique name of the reference.",
-							Default:     "",
+							Description: "Image pull policy. One of Always, Never, IfNotPresent. Defaults to Always if :latest tag is specified, or IfNotPresent otherwise. Cannot be updated. More info: https://kubernetes.io/docs/concepts/containers/images#updating-images\n\nPossible enum values:\n - `\"Always\"` means that kubelet always attempts to pull the latest image. Container will fail If the pull fails.\n - `\"IfNotPresent\"` means that kubelet pulls if the image isn't present on disk. Container will fail if the image isn't present and the pull fails.\n - `\"Never\"` means that kubelet never pulls an image, but only uses a local image. Container will fail if the image isn't present",
 							Type:        []string{"string"},
 							Format:      "",
-						},
-					},
-					"ref": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Reference is the reference to an object.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
+							Enum:        []interface{}{"Always", "IfNotPresent", "Never"},
 						},
 					},
 				},
-				Required: []string{"name", "ref"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_container_v1alpha1_ContainerStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ObjectReference is the reference to a kubernetes object.",
+				Description: "ContainerStatus describes the status of a pod with its init, wait and main container.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the kubernetes object.",
-							Default:     "",
+							Description: "A human readable message indicating details about why the pod is in this condition.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"namespace": {
+					"reason": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Namespace is the namespace of kubernetes object.",
-							Default:     "",
+							Description: "A brief CamelCase message indicating details about why the pod is in this state. e.g. 'Evicted'",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-				},
-				Required: []string{"name"},
-			},
-		},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_OnDeleteConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "OnDeleteConfig specifies particular setting when deleting a deploy item",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"skipUninstallIfClusterRemoved": {
-						SchemaProps: spec.SchemaProps{
-							Description: "SkipUninstallIfClusterRemoved specifies that uninstall is skipped if the target cluster is already deleted. Works only in the context of an existing target sync object which is used to check the Garden project with the shoot cluster resources",
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-				},
-			},
-		},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_Optimization(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "Optimization contains settings to improve execution preformance",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"hasNoSiblingImports": {
+					"state": {
 						SchemaProps: spec.SchemaProps{
-							Description: "set this on true if the installation does not import data from its siblings or has no siblings at all",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "Details about the container's current condition.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.ContainerState"),
 						},
 					},
-					"hasNoSiblingExports": {
+					"image": {
 						SchemaProps: spec.SchemaProps{
-							Description: "set this on true if the installation does not export data to its siblings or has no siblings at all",
-							Type:        []string{"boolean"},
+							Description: "The image the container is running. More info: https://kubernetes.io/docs/concepts/containers/images",
+							Default:     "",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-				},
-			},
-		},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_RemoteBlueprintReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "RemoteBlueprintReference describes a reference to a blueprint defined by a component descriptor.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"resourceName": {
+					"imageID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ResourceName is the name of the blueprint as defined by a component descriptor.",
+							Description: "ImageID of the container's image.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-				},
-				Required: []string{"resourceName"},
-			},
-		},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_Requirement(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "Requirement contains values, a key, and an operator that relates the key and values. The zero value of Requirement is invalid. Requirement implements both set based match and exact match Requirement should be initialized via NewRequirement constructor for creating a valid Requirement.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"key": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"operator": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"values": {
+					"exitCode": {
 						SchemaProps: spec.SchemaProps{
-							Description: "In huge majority of cases we have at most one value here. It is generally faster to operate on a single-element slice than on a single-element map, so we have a slice here.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "ExitCode of the main container.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
-				Required: []string{"key", "operator"},
+				Required: []string{"image", "imageID"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.ContainerState"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ResolvedTarget(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_container_v1alpha1_Controller(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ResolvedTarget is a helper struct to store a target together with the content of its resolved secret reference.",
+				Description: "Controller contains configuration concerning the controller framework.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"target": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Target contains the original target.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Target"),
-						},
-					},
-					"content": {
+					"CommonControllerConfig": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Content contains the content of the target. If the target has a secret reference, this field should be filled by a TargetResolver. Otherwise, the inline configuration of the target is put here.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig"),
 						},
 					},
 				},
-				Required: []string{"target", "content"},
+				Required: []string{"CommonControllerConfig"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Target"},
+			"github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_ResourceReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_container_v1alpha1_DebugOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ResourceReference defines the reference to a resource defined in a component descriptor.",
+				Description: "DebugOptions defines optional debug options.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"componentName": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ComponentName defines the unique of the component containing the resource.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"resourceName": {
+					"keepPod": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ResourceName defines the name of the resource.",
-							Default:     "",
-							Type:        []string{"string"},
+							Description: "KeepPod will only remove the finalizer on the pod but will not delete the pod.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"componentName", "resourceName"},
 			},
 		},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_SecretLabelSelectorRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_container_v1alpha1_Execution(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SecretLabelSelectorRef selects secrets with the given label and key.",
+				Description: "Execution defines how the containers of the deploy items are executed.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"selector": {
+					"mode": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Selector is a map of labels to select specific secrets.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "Mode defines whether the containers are executed in a bare pod or in a job. Defaults to Pod.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"key": {
+					"job": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The key of the secret to select from.  Must be a valid secret key.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Job configures the jobs that are created in the Job mode.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.JobConfiguration"),
 						},
 					},
 				},
-				Required: []string{"selector", "key"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.JobConfiguration"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_SecretReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_container_v1alpha1_GarbageCollection(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SecretReference is reference to data in a secret. The secret can also be in a different namespace.",
+				Description: "GarbageCollection defines the container deployer garbage collection configuration.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"disable": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the kubernetes object.",
-							Default:     "",
-							Type:        []string{"string"},
+							Description: "Disable disables the garbage collector and the resources clean-up.",
+							Default:     false,
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"namespace": {
+					"worker": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Namespace is the namespace of kubernetes object.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Worker defines the number of parallel garbage collection routines. Defaults to 5.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"key": {
+					"requeueTimeSeconds": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Key is the name of the key in the secret that holds the data.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "RequeueTime specifies the duration after which the object, which is not yet ready to be garbage collected, is requeued. Defaults to 3600.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
-				Required: []string{"name"},
+				Required: []string{"disable", "worker", "requeueTimeSeconds"},
 			},
 		},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_StaticDataSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_container_v1alpha1_HPAConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "StaticDataSource defines a static data source",
+				Description: "HPAConfiguration contains the configuration for horizontal pod autoscaling.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"value": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Value defined inline a raw data",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
-						},
-					},
-					"valueFrom": {
+					"maxReplicas": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ValueFrom defines data from an external resource",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.StaticDataValueFrom"),
+							Type:   []string{"integer"},
+							Format: "int32",
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.StaticDataValueFrom"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_StaticDataValueFrom(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_container_v1alpha1_JobConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "StaticDataValueFrom defines a static data that is read from a external resource.",
+				Description: "JobConfiguration defines the configuration of the jobs that execute the containers.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"secretKeyRef": {
+					"backoffLimit": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Selects a key of a secret in the installations's namespace",
-							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+							Description: "BackoffLimit is the number of retries of a failed pod before the job is considered as failed. The state of a failed pod is restored in the next pod. Defaults to 0.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"secretLabelSelector": {
+					"activeDeadlineSeconds": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Selects a key from multiple secrets in the installations's namespace that matches the given labels.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.SecretLabelSelectorRef"),
+							Description: "ActiveDeadlineSeconds is the duration in seconds, after which a running job is terminated and considered as failed.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"ttlSecondsAfterFinished": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TTLSecondsAfterFinished is the duration in seconds, after which a finished job that is kept with debug.keepPod is deleted by the cluster. It is set when the deployer has collected the result of the job.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.SecretLabelSelectorRef", "k8s.io/api/core/v1.SecretKeySelector"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_SubInstCache(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_container_v1alpha1_PodStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SubInstCache contains the existing sub installations",
+				Description: "PodStatus describes the status of a pod with its init, wait and main container",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"activeSubs": {
+					"podName": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.SubNamePair"),
-									},
-								},
-							},
+							Description: "PodName is the name of the created pod.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"orphanedSubs": {
+					"jobName": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "JobName is the name of the job that manages the pod. It is only set if the containers are executed in a job.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.SubNamePair"},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_SubNamePair(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "DiNamePair contains the spec name and the real name of a deploy item",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"specName": {
+					"lastRun": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "LastRun is the time when the pod was executed the last time.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"objectName": {
+					"lastSuccessfulJobID": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "LastSuccessfulJobID is set to the current JobID when the pod successfully finished. If the pod has not yet finished, this field will be nil.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"containerStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ContainerStatus contains the status of the pod that executes the configured container.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerStatus"),
+						},
+					},
+					"initContainerStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InitContainerStatus contains the status of the init container.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerStatus"),
+						},
+					},
+					"waitContainerStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WaitContainerStatus contains the status of the wait container.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerStatus"),
 						},
 					},
 				},
+				Required: []string{"podName", "containerStatus", "initContainerStatus", "waitContainerStatus"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_SubinstallationTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_container_v1alpha1_ProviderConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SubinstallationTemplate defines a subinstallation template.",
+				Description: "ProviderConfiguration is the container deployer configuration that is expected in a DeployItem",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"file": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "File references a subinstallation template stored in another file.",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"kind": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"image": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Docker image name. More info: https://kubernetes.io/docs/concepts/containers/images The image will be defaulted by the container deployer to the configured default.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"command": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Entrypoint array. Not executed within a shell. The docker image's ENTRYPOINT is used if this is not provided. Variable references $(VAR_NAME) are expanded using the container's environment. If a variable cannot be resolved, the reference in the input string will be unchanged. The $(VAR_NAME) syntax can be escaped with a double $$, ie: $$(VAR_NAME). Escaped references will never be expanded, regardless of whether the variable exists or not. Cannot be updated. More info: https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#running-a-command-in-a-shell",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"args": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Arguments to the entrypoint. The docker image's CMD is used if this is not provided. Variable references $(VAR_NAME) are expanded using the container's environment. If a variable cannot be resolved, the reference in the input string will be unchanged. The $(VAR_NAME) syntax can be escaped with a double $$, ie: $$(VAR_NAME). Escaped references will never be expanded, regardless of whether the variable exists or not. Cannot be updated. More info: https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#running-a-command-in-a-shell",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"resources": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Resources are the compute resources that are required by the main container. More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.ResourceRequirements"),
 						},
 					},
-					"name": {
+					"env": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the unique name of the step",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Env is a list of additional environment variables of the main container. Values can be taken from secrets in the namespace of the pod in the host cluster that are allowed by the container deployer, from fields of the pod and from the resources of the container. The environment variables that are set by the container deployer cannot be overwritten.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.EnvVar"),
+									},
+								},
+							},
 						},
 					},
-					"blueprint": {
+					"envFrom": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reference defines a reference to a Blueprint. The blueprint can reside in an OCI or other supported location.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationTemplateBlueprintDefinition"),
+							Description: "EnvFrom is a list of secrets in the namespace of the pod in the host cluster, whose keys are added as environment variables to the main container. Only secrets that are allowed by the container deployer can be referenced.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.EnvFromSource"),
+									},
+								},
+							},
 						},
 					},
-					"imports": {
+					"volumes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Imports define the imported data objects and targets.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationImports"),
+							Description: "Volumes are additional volumes of the pod. Only emptyDir, secret, configMap, downwardAPI, projected, persistentVolumeClaim and ephemeral volumes are allowed. Secrets must be allowed by the container deployer and service account tokens must not be projected.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.Volume"),
+									},
+								},
+							},
 						},
 					},
-					"importDataMappings": {
+					"volumeMounts": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ImportDataMappings contains a template for restructuring imports. It is expected to contain a key for every blueprint-defined data import. Missing keys will be defaulted to their respective data import. Example: namespace: (( installation.imports.namespace ))",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "VolumeMounts are additional volume mounts of the main container. The volumes must not be mounted into the directory of the container deployer data.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.VolumeMount"),
 									},
 								},
 							},
 						},
 					},
-					"exports": {
+					"securityContext": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Exports define the exported data objects and targets.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.InstallationExports"),
+							Description: "SecurityContext is the security context of the main container. Privileged containers, privilege escalation, added capabilities and running as root are not allowed. More info: https://kubernetes.io/docs/tasks/configure-pod-container/security-context/",
+							Ref:         ref("k8s.io/api/core/v1.SecurityContext"),
 						},
 					},
-					"exportDataMappings": {
+					"nodeSelector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExportDataMappings contains a template for restructuring exports. It is expected to contain a key for every blueprint-defined data export. Missing keys will be defaulted to their respective data export. Example: namespace: (( blueprint.exports.namespace ))",
+							Description: "NodeSelector is a selector which must match the labels of a node for the pod to be scheduled on that node.",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-					"optimization": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Optimization contains settings to improve execution performance.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Optimization"),
-						},
-					},
-				},
-				Required: []string{"name", "blueprint"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationExports", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationImports", "github.com/gardener/landscaper/apis/core/v1alpha1.InstallationTemplateBlueprintDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.Optimization"},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_SucceededReconcile(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "SucceededReconcile allows to configure automatically repeated reconciliations for succeeded installations",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"interval": {
+					"tolerations": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Interval specifies the interval between two subsequent repeated reconciliations. If not set, a default of 24 hours is used.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+							Description: "Tolerations are the tolerations of the pod.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.Toleration"),
+									},
+								},
+							},
 						},
 					},
-					"cronSpec": {
+					"serviceAccountName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CronSpec describes the reconcile intervals according to the cron syntax \"https://pkg.go.dev/github.com/robfig/cron#hdr-CRON_Expression_Format\". If not empty, this specification is used instead of Interval.",
+							Description: "ServiceAccountName is the name of a service account in the namespace of the pod in the host cluster that is used to run the pod. The service account must be allowed by the container deployer. Its token is not mounted into the main container.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_SyncObject(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "The SyncObject helps to sync access to deploy items.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"kind": {
+					"importValues": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "ImportValues contains the import values for the container.",
 							Type:        []string{"string"},
-							Format:      "",
+							Format:      "byte",
 						},
 					},
-					"apiVersion": {
+					"blueprint": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Blueprint is the resolved reference to the Blueprint definition",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.BlueprintDefinition"),
 						},
 					},
-					"metadata": {
+					"componentDescriptor": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "ComponentDescriptor is the resolved reference to the ComponentDescriptor defnition",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorDefinition"),
 						},
 					},
-					"spec": {
+					"registryPullSecrets": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec contains the specification",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.SyncObjectSpec"),
+							Description: "RegistryPullSecrets defines a list of registry credentials that are used to pull blueprints and component descriptors from the respective registry. For more info see: https://kubernetes.io/docs/tasks/configure-pod-container/pull-image-private-registry/ Note that the type information is used to determine the secret key and the type of the secret.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
+									},
+								},
+							},
 						},
 					},
-					"status": {
+					"continuousReconcile": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status contains the status",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.SyncObjectStatus"),
+							Description: "ContinuousReconcile contains the schedule for continuous reconciliation.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"),
 						},
 					},
 				},
-				Required: []string{"spec"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.SyncObjectSpec", "github.com/gardener/landscaper/apis/core/v1alpha1.SyncObjectStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.BlueprintDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec", "k8s.io/api/core/v1.EnvFromSource", "k8s.io/api/core/v1.EnvVar", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.SecurityContext", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.Volume", "k8s.io/api/core/v1.VolumeMount"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_SyncObjectList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_container_v1alpha1_ProviderStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SyncObjectList contains a list of SyncObject objects",
+				Description: "ProviderStatus is the container provider specific status",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -10327,977 +14709,857 @@ func schema_landscaper_apis_core_v1alpha1_SyncObjectList(ref common.ReferenceCal
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"lastOperation": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "LastOperation defines the last run operation of the pod. The operation can be either reconcile or deletion.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"items": {
+					"podStatus": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.SyncObject"),
-									},
-								},
-							},
+							Description: "PodStatus indicated the status of the executed pod.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.PodStatus"),
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"lastOperation"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.SyncObject", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.PodStatus"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_SyncObjectSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_helm_ArchiveAccess(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SyncObjectSpec contains the specification.",
+				Description: "ArchiveAccess defines the access for a helm chart as compressed archive.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"podName": {
-						SchemaProps: spec.SchemaProps{
-							Description: "PodName describes the name of the pod of the responsible deployer",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind describes the kind of object that is being locked by this SyncObject",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"name": {
+					"raw": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the object that is being locked by this SyncObject",
-							Default:     "",
+							Description: "Raw defines a compressed tarred helm chart as base64 encoded string.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"lastUpdateTime": {
-						SchemaProps: spec.SchemaProps{
-							Description: "LastUpdateTime contains last time the object was updated.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"prefix": {
+					"remote": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Prefix is the prefix of the name of the object.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Remote defines the remote access for a helm chart as compressed archive.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm.RemoteArchiveAccess"),
 						},
 					},
 				},
-				Required: []string{"podName", "kind", "name", "lastUpdateTime", "prefix"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_SyncObjectStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "SyncObjectStatus contains the status.",
-				Type:        []string{"object"},
-			},
-		},
+			"github.com/gardener/landscaper/apis/deployer/helm.RemoteArchiveAccess"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_Target(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_helm_Auth(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Target defines a specific data object that defines target environment. Every deploy item can have a target which is used by the deployer to install the specific application.",
+				Description: "Auth contains authentication info for one helm chart repo",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"url": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "URL contains URL of helm chart repo",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"customCAData": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "CustomCAData allows you to add a custom CA, which is useful if your server speaks HTTPS with a self- signed certificate. The added certificate must be in PEM format and base64 encoded.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"authHeader": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "AuthHeader contains the value that will be set in the \"Authorization\" header when fetching the Chart, e.g. \"Basic dX...3dvcmQ=\". Exactly one of the fields AuthHeader and SecretRef must be set.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"spec": {
+					"secretRef": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetSpec"),
+							Description: "Reference to a secret containing the AuthHeader Exactly one of the fields AuthHeader and SecretRef must be set.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"),
 						},
 					},
 				},
-				Required: []string{"spec"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_TargetExport(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_helm_Chart(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetExport is a single target export.",
+				Description: "Chart defines the helm chart to render and apply.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"ref": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name the internal name of the exported target.",
-							Default:     "",
+							Description: "Ref defines the reference to a helm chart in a oci repository.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"target": {
+					"fromResource": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Target is the name of the in-cluster target object.",
+							Description: "FromResource fetches the chart based on the resource's access method. The resource is defined as part of a component descriptor which is necessary to also handle local artifacts.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm.RemoteChartReference"),
+						},
+					},
+					"archive": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Archive defines a compressed tarred helm chart as base64 encoded string.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm.ArchiveAccess"),
+						},
+					},
+					"helmChartRepo": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HelmChartRepo defines a reference to a chart in a helm chart repo.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm.HelmChartRepo"),
+						},
+					},
+					"resourceRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceKey defines a key that can be given to a corresponding API in order to fetch the content of the resource defined in the blueprint",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"name"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/deployer/helm.ArchiveAccess", "github.com/gardener/landscaper/apis/deployer/helm.HelmChartRepo", "github.com/gardener/landscaper/apis/deployer/helm.RemoteChartReference"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_TargetImport(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_helm_Configuration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetImport is either a single target or a target list import.",
+				Description: "Configuration is the helm deployer configuration that configures the controller",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name the internal name of the imported target.",
-							Default:     "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"target": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Target is the name of the in-cluster target object. Exactly one of Target, Targets, and TargetListReference has to be specified.",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"targets": {
+					"identity": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Targets is a list of in-cluster target objects. Exactly one of Target, Targets, and TargetListReference has to be specified.",
+							Description: "Identity identity describes the unique identity of the deployer.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"oci": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OCI configures the oci client of the controller",
+							Ref:         ref("github.com/gardener/landscaper/apis/config.OCIConfiguration"),
+						},
+					},
+					"targetSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetSelector describes all selectors the deployer should depend on.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector"),
 									},
 								},
 							},
 						},
 					},
-					"targetListRef": {
+					"export": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TargetListReference can (only) be used to import a targetlist that has been imported by the parent installation. Exactly one of Target, Targets, and TargetListReference has to be specified.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Export defines the export configuration.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm.ExportConfiguration"),
 						},
 					},
-					"targetMap": {
+					"hpa": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "HPAConfiguration contains the configuration for horizontal pod autoscaling.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm.HPAConfiguration"),
 						},
 					},
-					"targetMapRef": {
+					"controller": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Controller contains configuration concerning the controller framework.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm.Controller"),
 						},
 					},
 				},
-				Required: []string{"name"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/config.OCIConfiguration", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/helm.Controller", "github.com/gardener/landscaper/apis/deployer/helm.ExportConfiguration", "github.com/gardener/landscaper/apis/deployer/helm.HPAConfiguration"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_TargetList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_helm_Controller(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetList contains a list of Targets",
+				Description: "Controller contains configuration concerning the controller framework.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"metadata": {
+					"CommonControllerConfig": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
-						},
-					},
-					"items": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.Target"),
-									},
-								},
-							},
+							Ref:     ref("github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig"),
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"CommonControllerConfig"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Target", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_TargetSelector(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_helm_ExportConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetSelector describes a selector that matches specific targets.",
+				Description: "ExportConfiguration defines the export configuration for the deployer.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"targets": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Targets defines a list of specific targets (name and namespace) that should be reconciled.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
-									},
-								},
-							},
-						},
-					},
-					"annotations": {
+					"defaultTimeout": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Annotations matches a target based on annotations.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.Requirement"),
-									},
-								},
-							},
+							Description: "DefaultTimeout configures the default timeout for all exports without a explicit export timeout defined.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
 						},
 					},
-					"labels": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
+	}
+}
+
+func schema_landscaper_apis_deployer_helm_HPAConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HPAConfiguration contains the configuration for horizontal pod autoscaling.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxReplicas": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Labels matches a target based on its labels.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.Requirement"),
-									},
-								},
-							},
+							Type:   []string{"integer"},
+							Format: "int32",
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/core/v1alpha1.Requirement"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_TargetSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_helm_HelmChartRepo(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetSpec contains the definition of a target.",
+				Description: "HelmChartRepo defines a reference to a chart in a helm chart repo",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
+					"helmChartRepoUrl": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type is the type of the target that defines its data structure. The actual schema may be defined by a target type crd in the future.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"config": {
+					"helmChartName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Configuration contains the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"secretRef": {
+					"helmChartVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reference to a secret containing the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
 				},
-				Required: []string{"type"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_TargetSync(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_helm_HelmChartRepoCredentials(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "The TargetSync is created targets from secrets.",
+				Description: "HelmChartRepoCredentials contains the credentials to access hepl chart repos",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"spec": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Spec contains the specification",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetSyncSpec"),
-						},
-					},
-					"status": {
+					"auths": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status contains the status",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetSyncStatus"),
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/helm.Auth"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"spec"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSyncSpec", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetSyncStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/landscaper/apis/deployer/helm.Auth"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_TargetSyncList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_helm_HelmDeploymentConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetSyncList contains a list of TargetSync objects",
+				Description: "HelmDeploymentConfiguration defines settings for a helm deployment.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
+					"install": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+									},
+								},
+							},
 						},
 					},
-					"metadata": {
+					"upgrade": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+									},
+								},
+							},
 						},
 					},
-					"items": {
+					"uninstall": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetSync"),
+										Ref: ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSync", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/gardener/landscaper/apis/core.AnyJSON"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_TargetSyncSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_helm_HelmInstallConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetSyncSpec contains the specification for a TargetSync.",
+				Description: "HelmInstallConfiguration defines settings for a helm install operation.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"sourceNamespace": {
-						SchemaProps: spec.SchemaProps{
-							Description: "SourceNamespace describes the namespace from where the secrets should be synced",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"secretRef": {
+					"atomic": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SecretRef references the secret that contains the kubeconfig to the namespace of the secrets to be synced.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"),
+							Type:   []string{"boolean"},
+							Format: "",
 						},
 					},
-					"createTargetToSource": {
+					"force": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CreateTargetToSource specifies if set on true, that also a target is created, which references the secret in SecretRef",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Type:   []string{"boolean"},
+							Format: "",
 						},
 					},
-					"targetToSourceName": {
+					"skipSchemaValidation": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TargetToSourceName is the name of the target referencing the secret defined in SecretRef if CreateTargetToSource is set on true. If TargetToSourceName is empty SourceNamespace is used instead.",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"boolean"},
+							Format: "",
 						},
 					},
-					"secretNameExpression": {
+					"takeOwnership": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SecretNameExpression defines the names of the secrets which should be synced via a regular expression according to https://github.com/google/re2/wiki/Syntax with the extension that * is also a valid expression and matches all names. if not set no secrets are synced",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"boolean"},
+							Format: "",
 						},
 					},
-					"shootNameExpression": {
+					"wait": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ShootNameExpression defines the names of shoot clusters for which targets with short living access data to the shoots are created via a regular expression according to https://github.com/google/re2/wiki/Syntax with the extension that * is also a valid expression and matches all names. if not set no targets for the shoots are created",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"boolean"},
+							Format: "",
 						},
 					},
-					"tokenRotation": {
+					"timeout": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TokenRotation defines the data to perform an automatic rotation of the token to access the source cluster with the secrets to sync. The token expires after 90 days and will be rotated every 60 days.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.TokenRotation"),
+							Description: "Timeout is the timeout for the operation in minutes.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
 						},
 					},
 				},
-				Required: []string{"sourceNamespace", "secretRef"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference", "github.com/gardener/landscaper/apis/core/v1alpha1.TokenRotation"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_TargetSyncStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_helm_HelmUninstallConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetSyncStatus contains the status of a TargetSync.",
+				Description: "HelmUninstallConfiguration defines settings for a helm uninstall operation.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"observedGeneration": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ObservedGeneration is the most recent generation observed.",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int64",
-						},
-					},
-					"lastUpdateTime": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Last time the status was updated",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"lastErrors": {
+					"wait": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastErrors describe the last errors",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Type:   []string{"boolean"},
+							Format: "",
 						},
 					},
-					"lastTokenRotationTime": {
+					"timeout": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Last time the token was rotated",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Timeout is the timeout for the operation in minutes.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_TargetTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_helm_ProviderConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TargetTemplate exposes specific parts of a target that are used in the exports to export a target",
+				Description: "ProviderConfiguration is the helm deployer configuration that is expected in a DeployItem",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type is the type of the target that defines its data structure. The actual schema may be defined by a target type crd in the future.",
-							Default:     "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"config": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Configuration contains the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"secretRef": {
+					"readinessChecks": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reference to a secret containing the target type specific configuration. Exactly one of the fields Configuration and SecretRef must be set",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"),
+							Description: "ReadinessChecks configures the readiness checks.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration"),
 						},
 					},
-					"labels": {
+					"updateStrategy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Map of string keys and values that can be used to organize and categorize (scope and select) objects. May match selectors of replication controllers and services. More info: http://kubernetes.io/docs/user-guide/labels",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "UpdateStrategy defines the strategy how the manifests are updated in the cluster. Defaults to \"update\".",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"annotations": {
+					"serverSideApply": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Annotations is an unstructured key value map stored with a resource that may be set by external tools to store and retrieve arbitrary metadata. They are not queryable and should be preserved when modifying objects. More info: http://kubernetes.io/docs/user-guide/annotations",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "ServerSideApply configures the server-side apply of the manifests. Only relevant if the update strategy is \"serverSideApply\" and HelmDeployment is false.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.ServerSideApplyConfiguration"),
+						},
+					},
+					"chart": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Chart defines helm chart to be templated and applied.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm.Chart"),
 						},
 					},
-				},
-				Required: []string{"type"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON", "github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_TemplateExecutor(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "TemplateExecutor describes a templating mechanism and configuration.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the unique name of the template",
+							Description: "Name is the release name of the chart",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"type": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type describes the templating mechanism.",
+							Description: "Namespace is the release namespace of the chart",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"file": {
+					"createNamespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "File is the path to the template in the blueprint's content.",
-							Type:        []string{"string"},
+							Description: "CreateNamespace configures the deployer to create the release namespace if not present. The behavior is similar to the \"helm install --create-namespace\"",
+							Default:     false,
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"template": {
+					"values": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Template contains an optional inline template. The template has to be of string for go template and either a string or valid yaml/json for spiff.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+							Description: "Values are the values that are used for templating.",
+							Type:        []string{"string"},
+							Format:      "byte",
 						},
 					},
-				},
-				Required: []string{"name", "type"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_TokenRotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"enabled": {
+					"exportsFromManifests": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Enabled defines if automatic token is executed",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "ExportsFromManifests describe the exports from the templated manifests that should be exported by the helm deployer. DEPRECATED",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.Export"),
+									},
+								},
+							},
 						},
 					},
-				},
-			},
-		},
-	}
-}
-
-func schema_landscaper_apis_core_v1alpha1_TransitionTimes(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"triggerTime": {
+					"exports": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TriggerTime is the time when the jobID is set.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Exports describe the exports from the templated manifests that should be exported by the helm deployer.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports"),
 						},
 					},
-					"initTime": {
+					"continuousReconcile": {
 						SchemaProps: spec.SchemaProps{
-							Description: "InitTime is the time when the Init phase starts.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "ContinuousReconcile contains the schedule for continuous reconciliation.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"),
 						},
 					},
-					"waitTime": {
+					"driftDetection": {
 						SchemaProps: spec.SchemaProps{
-							Description: "WaitTime is the time when the work is done.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "DriftDetection configures how the deployer reacts if managed resources are modified or deleted out-of-band.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.DriftDetectionSpec"),
 						},
 					},
-					"finishedTime": {
+					"helmDeployment": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FinishedTime is the time when the finished phase is set.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "HelmDeployment indicates that helm is used as complete deployment mechanism and not only helm templating. Default is true.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"helmDeploymentConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HelmDeploymentConfig contains settings for helm operations. Only relevant if HelmDeployment is true.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm.HelmDeploymentConfiguration"),
+						},
+					},
+					"deletionGroups": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeletionGroups defines the order in which objects are deleted. Only relevant if HelmDeployment is false.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.DeletionGroupDefinition"),
+									},
+								},
+							},
+						},
+					},
+					"deletionGroupsDuringUpdate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeletionGroupsDuringUpdate defines the order in which objects are deleted during an update.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.DeletionGroupDefinition"),
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"chart", "name", "namespace", "createNamespace"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/landscaper/apis/deployer/helm.Chart", "github.com/gardener/landscaper/apis/deployer/helm.HelmDeploymentConfiguration", "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.DeletionGroupDefinition", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.DriftDetectionSpec", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Export", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.ServerSideApplyConfiguration", "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_TypedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_helm_ProviderStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TypedObjectReference is a reference to a typed kubernetes object.",
+				Description: "ProviderStatus is the helm provider specific status",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"apiVersion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion is the group and version for the resource being referenced. If APIVersion is not specified, the specified Kind must be in the core API group. For any other third-party types, APIVersion is required.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
 					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is the type of resource being referenced",
-							Default:     "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"name": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the kubernetes object.",
-							Default:     "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"namespace": {
+					"managedResources": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Namespace is the namespace of kubernetes object.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "ManagedResources contains all kubernetes resources that are deployed by the helm deployer.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.ManagedResourceStatus"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"apiVersion", "kind", "name"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/deployer/utils/managedresource.ManagedResourceStatus"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_Verification(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_helm_RemoteArchiveAccess(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Verification defines the necessary data to verify the signature of the refered component",
+				Description: "RemoteArchiveAccess defines the remote access for a helm chart as compressed archive.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"signatureName": {
+					"url": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SignatureName defines the name of the signature that is verified",
-							Default:     "",
+							Description: "URL defines a compressed tarred helm chart that is fetched from a url.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"signatureName"},
 			},
 		},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_VerificationSignature(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_helm_RemoteChartReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VerificationSignatures contains the trusted verification information",
+				Description: "RemoteChartReference defines a reference to a remote Helm chart through a Component-Descriptor",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"publicKeySecretReference": {
+					"ref": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PublicKeySecretReference contains a secret reference to a public key in PEM format that is used to verify the component signature",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.SecretReference"),
+							Description: "ComponentDescriptorReference is the reference to a component descriptor",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorReference"),
 						},
 					},
-					"caCertificateSecretReference": {
+					"inline": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CaCertificateSecretReference contains a secret reference to one or more certificates in PEM format that are used to verify the compnent signature",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.SecretReference"),
+							Description: "InlineDescriptorReference defines an inline component descriptor",
+							Ref:         ref("github.com/gardener/landscaper/legacy-component-spec/bindings-go/apis/v2.ComponentDescriptor"),
+						},
+					},
+					"resourceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceName is the name of the Helm chart as defined by a component descriptor.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"resourceName"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.SecretReference"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorReference", "github.com/gardener/landscaper/legacy-component-spec/bindings-go/apis/v2.ComponentDescriptor"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_VersionedNamedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_ArchiveAccess(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VersionedNamedObjectReference is a named reference to a object with its last observed resource generation. This struct is used by status fields.",
+				Description: "ArchiveAccess defines the access for a helm chart as compressed archive.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"raw": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the unique name of the reference.",
-							Default:     "",
+							Description: "Raw defines a compressed tarred helm chart as base64 encoded string.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"ref": {
+					"remote": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reference is the reference to an object.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.VersionedObjectReference"),
+							Description: "Remote defines the remote access for a helm chart as compressed archive.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.RemoteArchiveAccess"),
 						},
 					},
 				},
-				Required: []string{"name", "ref"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.VersionedObjectReference"},
+			"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.RemoteArchiveAccess"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_VersionedObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_Auth(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VersionedObjectReference is a reference to a object with its last observed resource generation. This struct is used by status fields.",
+				Description: "Auth contains authentication info for one helm chart repo",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"url": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the kubernetes object.",
-							Default:     "",
+							Description: "URL contains URL of helm chart repo",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"namespace": {
+					"customCAData": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Namespace is the namespace of kubernetes object.",
-							Default:     "",
+							Description: "CustomCAData allows you to add a custom CA, which is useful if your server speaks HTTPS with a self- signed certificate. The added certificate must be in PEM format and base64 encoded.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"authHeader": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AuthHeader contains the value that will be set in the \"Authorization\" header when fetching the Chart, e.g. \"Basic dX...3dvcmQ=\". Exactly one of the fields AuthHeader and SecretRef must be set.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"observedGeneration": {
+					"secretRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ObservedGeneration defines the last observed generation of the referenced resource.",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Description: "Reference to a secret containing the AuthHeader Exactly one of the fields AuthHeader and SecretRef must be set.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"),
 						},
 					},
 				},
-				Required: []string{"name", "observedGeneration"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"},
 	}
 }
 
-func schema_landscaper_apis_core_v1alpha1_VersionedResourceReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_Chart(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VersionedResourceReference defines the reference to a resource with its version.",
+				Description: "Chart defines the helm chart to render and apply.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"componentName": {
+					"ref": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ComponentName defines the unique of the component containing the resource.",
-							Default:     "",
+							Description: "Ref defines the reference to a helm chart in a oci repository.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"resourceName": {
+					"fromResource": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ResourceName defines the name of the resource.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "FromResource fetches the chart based on the resource's access method. The resource is defined as part of a component descriptor which is necessary to also handle local artifacts.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.RemoteChartReference"),
 						},
 					},
-					"version": {
+					"archive": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Version defines the version of the component.",
-							Default:     "",
+							Description: "Archive defines a compressed tarred helm chart as base64 encoded string.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ArchiveAccess"),
+						},
+					},
+					"helmChartRepo": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HelmChartRepo defines a reference to a chart in a helm chart repo.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HelmChartRepo"),
+						},
+					},
+					"resourceRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceKey defines a key that can be given to a corresponding API in order to fetch the content of the resource defined in the blueprint",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"componentName", "resourceName", "version"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ArchiveAccess", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HelmChartRepo", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.RemoteChartReference"},
 	}
 }
 
-func schema_landscaper_apis_deployer_container_Configuration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_Configuration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Configuration is the container deployer configuration that configures the controller",
+				Description: "Configuration is the helm deployer configuration that configures the controller",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -11341,222 +15603,88 @@ func schema_landscaper_apis_deployer_container_Configuration(ref common.Referenc
 							},
 						},
 					},
-					"namespace": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Namespace defines the namespace where the pods should be executed.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"defaultImage": {
-						SchemaProps: spec.SchemaProps{
-							Description: "DefaultImage configures the default images that is used if the DeployItem does not specify one.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.ContainerSpec"),
-						},
-					},
-					"initContainer": {
-						SchemaProps: spec.SchemaProps{
-							Description: "InitContainerImage defines the image that is used to init the container. This container bootstraps the necessary directories and files.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.ContainerSpec"),
-						},
-					},
-					"waitContainer": {
-						SchemaProps: spec.SchemaProps{
-							Description: "SidecarContainerImage defines the image that is used as a sidecar to the defined main container. The sidecar container is responsible to collect the exports and the state of the main container.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.ContainerSpec"),
-						},
-					},
-					"garbageCollection": {
+					"export": {
 						SchemaProps: spec.SchemaProps{
-							Description: "GarbageCollection configures the container deployer garbage collector.",
+							Description: "Export defines the export configuration.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.GarbageCollection"),
-						},
-					},
-					"debug": {
-						SchemaProps: spec.SchemaProps{
-							Description: "DebugOptions configure additional debug options.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.DebugOptions"),
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ExportConfiguration"),
 						},
 					},
 					"hpa": {
 						SchemaProps: spec.SchemaProps{
 							Description: "HPAConfiguration contains the configuration for horizontal pod autoscaling.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.HPAConfiguration"),
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HPAConfiguration"),
 						},
 					},
 					"controller": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Controller contains configuration concerning the controller framework.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.Controller"),
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.Controller"),
 						},
 					},
 				},
-				Required: []string{"namespace", "defaultImage", "initContainer", "waitContainer", "garbageCollection"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/config.OCIConfiguration", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/container.ContainerSpec", "github.com/gardener/landscaper/apis/deployer/container.Controller", "github.com/gardener/landscaper/apis/deployer/container.DebugOptions", "github.com/gardener/landscaper/apis/deployer/container.GarbageCollection", "github.com/gardener/landscaper/apis/deployer/container.HPAConfiguration"},
-	}
-}
-
-func schema_landscaper_apis_deployer_container_ContainerSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ContainerSpec defines a container specification",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"image": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Docker image name. More info: https://kubernetes.io/docs/concepts/containers/images The image will be defaulted by the container deployer to the configured default.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"command": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Entrypoint array. Not executed within a shell. The docker image's ENTRYPOINT is used if this is not provided. Variable references $(VAR_NAME) are expanded using the container's environment. If a variable cannot be resolved, the reference in the input string will be unchanged. The $(VAR_NAME) syntax can be escaped with a double $$, ie: $$(VAR_NAME). Escaped references will never be expanded, regardless of whether the variable exists or not. Cannot be updated. More info: https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#running-a-command-in-a-shell",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
-						},
-					},
-					"args": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Arguments to the entrypoint. The docker image's CMD is used if this is not provided. Variable references $(VAR_NAME) are expanded using the container's environment. If a variable cannot be resolved, the reference in the input string will be unchanged. The $(VAR_NAME) syntax can be escaped with a double $$, ie: $$(VAR_NAME). Escaped references will never be expanded, regardless of whether the variable exists or not. Cannot be updated. More info: https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#running-a-command-in-a-shell",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
-						},
-					},
-					"imagePullPolicy": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Image pull policy. One of Always, Never, IfNotPresent. Defaults to Always if :latest tag is specified, or IfNotPresent otherwise. Cannot be updated. More info: https://kubernetes.io/docs/concepts/containers/images#updating-images\n\nPossible enum values:\n - `\"Always\"` means that kubelet always attempts to pull the latest image. Container will fail If the pull fails.\n - `\"IfNotPresent\"` means that kubelet pulls if the image isn't present on disk. Container will fail if the image isn't present and the pull fails.\n - `\"Never\"` means that kubelet never pulls an image, but only uses a local image. Container will fail if the image isn't present",
-							Type:        []string{"string"},
-							Format:      "",
-							Enum:        []interface{}{"Always", "IfNotPresent", "Never"},
-						},
-					},
-				},
-			},
-		},
+			"github.com/gardener/landscaper/apis/config.OCIConfiguration", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.Controller", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ExportConfiguration", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HPAConfiguration"},
 	}
 }
 
-func schema_landscaper_apis_deployer_container_ContainerStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_Controller(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ContainerStatus describes the status of a pod with its init, wait and main container.",
+				Description: "Controller contains configuration concerning the controller framework.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"message": {
-						SchemaProps: spec.SchemaProps{
-							Description: "A human readable message indicating details about why the pod is in this condition.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"reason": {
-						SchemaProps: spec.SchemaProps{
-							Description: "A brief CamelCase message indicating details about why the pod is in this state. e.g. 'Evicted'",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"state": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Details about the container's current condition.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/api/core/v1.ContainerState"),
-						},
-					},
-					"image": {
-						SchemaProps: spec.SchemaProps{
-							Description: "The image the container is running. More info: https://kubernetes.io/docs/concepts/containers/images",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"imageID": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ImageID of the container's image.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"exitCode": {
+					"CommonControllerConfig": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExitCode of the main container.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig"),
 						},
 					},
 				},
-				Required: []string{"image", "imageID"},
+				Required: []string{"CommonControllerConfig"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.ContainerState"},
+			"github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig"},
 	}
 }
 
-func schema_landscaper_apis_deployer_container_Controller(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_ExportConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Controller contains configuration concerning the controller framework.",
+				Description: "ExportConfiguration defines the export configuration for the deployer.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"CommonControllerConfig": {
+					"defaultTimeout": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig"),
+							Description: "DefaultTimeout configures the default timeout for all exports without a explicit export timeout defined.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
 						},
 					},
 				},
-				Required: []string{"CommonControllerConfig"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
 	}
 }
 
-func schema_landscaper_apis_deployer_container_DebugOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_HPAConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DebugOptions defines optional debug options.",
+				Description: "HPAConfiguration contains the configuration for horizontal pod autoscaling.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"keepPod": {
+					"maxReplicas": {
 						SchemaProps: spec.SchemaProps{
-							Description: "KeepPod will only remove the finalizer on the pod but will not delete the pod.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Type:   []string{"integer"},
+							Format: "int32",
 						},
 					},
 				},
@@ -11565,231 +15693,202 @@ func schema_landscaper_apis_deployer_container_DebugOptions(ref common.Reference
 	}
 }
 
-func schema_landscaper_apis_deployer_container_GarbageCollection(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_HelmChartRepo(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "GarbageCollection defines the container deployer garbage collection configuration.",
+				Description: "HelmChartRepo defines a reference to a chart in a helm chart repo",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"disable": {
+					"helmChartRepoUrl": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Disable disables the garbage collector and the resources clean-up.",
-							Default:     false,
-							Type:        []string{"boolean"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"worker": {
+					"helmChartName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Worker defines the number of parallel garbage collection routines. Defaults to 5.",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"requeueTimeSeconds": {
+					"helmChartVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RequeueTime specifies the duration after which the object, which is not yet ready to be garbage collected, is requeued. Defaults to 3600.",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
 				},
-				Required: []string{"disable", "worker", "requeueTimeSeconds"},
 			},
 		},
 	}
 }
 
-func schema_landscaper_apis_deployer_container_HPAConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_HelmChartRepoCredentials(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "HPAConfiguration contains the configuration for horizontal pod autoscaling.",
+				Description: "HelmChartRepoCredentials contains the credentials to access hepl chart repos",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"maxReplicas": {
+					"auths": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.Auth"),
+									},
+								},
+							},
 						},
 					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.Auth"},
 	}
 }
 
-func schema_landscaper_apis_deployer_container_PodStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_HelmDeploymentConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PodStatus describes the status of a pod with its init, wait and main container",
+				Description: "HelmDeploymentConfiguration defines settings for a helm deployment.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"podName": {
-						SchemaProps: spec.SchemaProps{
-							Description: "PodName is the name of the created pod.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"lastRun": {
-						SchemaProps: spec.SchemaProps{
-							Description: "LastRun is the time when the pod was executed the last time.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"lastSuccessfulJobID": {
-						SchemaProps: spec.SchemaProps{
-							Description: "LastSuccessfulJobID is set to the current JobID when the pod successfully finished. If the pod has not yet finished, this field will be nil.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"containerStatus": {
+					"install": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ContainerStatus contains the status of the pod that executes the configured container.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.ContainerStatus"),
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+									},
+								},
+							},
 						},
 					},
-					"initContainerStatus": {
+					"upgrade": {
 						SchemaProps: spec.SchemaProps{
-							Description: "InitContainerStatus contains the status of the init container.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.ContainerStatus"),
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+									},
+								},
+							},
 						},
 					},
-					"waitContainerStatus": {
+					"uninstall": {
 						SchemaProps: spec.SchemaProps{
-							Description: "WaitContainerStatus contains the status of the wait container.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.ContainerStatus"),
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"podName", "containerStatus", "initContainerStatus", "waitContainerStatus"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/container.ContainerStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"},
 	}
 }
 
-func schema_landscaper_apis_deployer_container_ProviderConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_HelmInstallConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ProviderConfiguration is the container deployer configuration that is expected in a DeployItem",
+				Description: "HelmInstallConfiguration defines settings for a helm install operation.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"image": {
+					"atomic": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Docker image name. More info: https://kubernetes.io/docs/concepts/containers/images The image will be defaulted by the container deployer to the configured default.",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"boolean"},
+							Format: "",
 						},
 					},
-					"command": {
+					"force": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Entrypoint array. Not executed within a shell. The docker image's ENTRYPOINT is used if this is not provided. Variable references $(VAR_NAME) are expanded using the container's environment. If a variable cannot be resolved, the reference in the input string will be unchanged. The $(VAR_NAME) syntax can be escaped with a double $$, ie: $$(VAR_NAME). Escaped references will never be expanded, regardless of whether the variable exists or not. Cannot be updated. More info: https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#running-a-command-in-a-shell",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Type:   []string{"boolean"},
+							Format: "",
 						},
 					},
-					"args": {
+					"skipSchemaValidation": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Arguments to the entrypoint. The docker image's CMD is used if this is not provided. Variable references $(VAR_NAME) are expanded using the container's environment. If a variable cannot be resolved, the reference in the input string will be unchanged. The $(VAR_NAME) syntax can be escaped with a double $$, ie: $$(VAR_NAME). Escaped references will never be expanded, regardless of whether the variable exists or not. Cannot be updated. More info: https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#running-a-command-in-a-shell",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Type:   []string{"boolean"},
+							Format: "",
 						},
 					},
-					"importValues": {
+					"takeOwnership": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ImportValues contains the import values for the container.",
-							Type:        []string{"string"},
-							Format:      "byte",
+							Type:   []string{"boolean"},
+							Format: "",
 						},
 					},
-					"blueprint": {
+					"wait": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Blueprint is the resolved reference to the Blueprint definition",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.BlueprintDefinition"),
+							Type:   []string{"boolean"},
+							Format: "",
 						},
 					},
-					"componentDescriptor": {
+					"timeout": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ComponentDescriptor is the resolved reference to the ComponentDescriptor definition",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorDefinition"),
+							Description: "Timeout is the timeout for the operation in minutes.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
 						},
 					},
-					"registryPullSecrets": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
+	}
+}
+
+func schema_apis_deployer_helm_v1alpha1_HelmUninstallConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HelmUninstallConfiguration defines settings for a helm uninstall operation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"wait": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RegistryPullSecrets defines a list of registry credentials that are used to pull blueprints and component descriptors from the respective registry. For more info see: https://kubernetes.io/docs/tasks/configure-pod-container/pull-image-private-registry/ Note that the type information is used to determine the secret key and the type of the secret.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
-									},
-								},
-							},
+							Type:   []string{"boolean"},
+							Format: "",
 						},
 					},
-					"continuousReconcile": {
+					"timeout": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ContinuousReconcile contains the schedule for continuous reconciliation.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"),
+							Description: "Timeout is the timeout for the operation in minutes.",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.BlueprintDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
 	}
 }
 
-func schema_landscaper_apis_deployer_container_ProviderStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_ProviderConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ProviderStatus is the container provider specific status",
+				Description: "ProviderConfiguration is the helm deployer configuration that is expected in a DeployItem",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -11806,421 +15905,402 @@ func schema_landscaper_apis_deployer_container_ProviderStatus(ref common.Referen
 							Format:      "",
 						},
 					},
-					"lastOperation": {
+					"updateStrategy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastOperation defines the last run operation of the pod. The operation can be either reconcile or deletion.",
-							Default:     "",
+							Description: "UpdateStrategy defines the strategy how the manifests are updated in the cluster. Defaults to \"update\".",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"podStatus": {
+					"serverSideApply": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PodStatus indicated the status of the executed pod.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container.PodStatus"),
+							Description: "ServerSideApply configures the server-side apply of the manifests. Only relevant if the update strategy is \"serverSideApply\" and HelmDeployment is false.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.ServerSideApplyConfiguration"),
 						},
 					},
-				},
-				Required: []string{"lastOperation"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/container.PodStatus"},
-	}
-}
-
-func schema_apis_deployer_container_v1alpha1_Configuration(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "Configuration is the container deployer configuration that configures the controller",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"kind": {
+					"readinessChecks": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "ReadinessChecks configures the readiness checks.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration"),
 						},
 					},
-					"apiVersion": {
+					"chart": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Chart defines helm chart to be templated and applied.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.Chart"),
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the release name of the chart",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"identity": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Identity identity describes the unique identity of the deployer.",
+							Description: "Namespace is the release namespace of the chart",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"oci": {
+					"createNamespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "OCI configures the oci client of the controller",
-							Ref:         ref("github.com/gardener/landscaper/apis/config.OCIConfiguration"),
+							Description: "CreateNamespace configures the deployer to create the release namespace if not present. The behavior is similar to the \"helm install --create-namespace\"",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"namespace": {
+					"values": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Namespace defines the namespace where the pods should be executed. Defaults to default",
-							Default:     "",
+							Description: "Values are the values that are used for templating.",
 							Type:        []string{"string"},
-							Format:      "",
+							Format:      "byte",
 						},
 					},
-					"targetSelector": {
+					"exportsFromManifests": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TargetSelector describes all selectors the deployer should depend on.",
+							Description: "ExportsFromManifests describe the exports from the templated manifests that should be exported by the helm deployer. DEPRECATED",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector"),
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.Export"),
 									},
 								},
 							},
 						},
 					},
-					"defaultImage": {
+					"exports": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DefaultImage configures the default images that is used if the DeployItem does not specify one.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerSpec"),
+							Description: "Exports describe the exports from the templated manifests that should be exported by the helm deployer.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports"),
 						},
 					},
-					"initContainer": {
+					"continuousReconcile": {
 						SchemaProps: spec.SchemaProps{
-							Description: "InitContainerImage defines the image that is used to init the container. This container bootstraps the necessary directories and files.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerSpec"),
+							Description: "ContinuousReconcile contains the schedule for continuous reconciliation.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"),
 						},
 					},
-					"waitContainer": {
+					"driftDetection": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SidecarContainerImage defines the image that is used as a sidecar to the defined main container. The sidecar container is responsible to collect the exports and the state of the main container.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerSpec"),
+							Description: "DriftDetection configures how the deployer reacts if managed resources are modified or deleted out-of-band.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.DriftDetectionSpec"),
 						},
 					},
-					"garbageCollection": {
+					"helmDeployment": {
 						SchemaProps: spec.SchemaProps{
-							Description: "GarbageCollection configures the container deployer garbage collector.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.GarbageCollection"),
+							Description: "HelmDeployment indicates that helm is used as complete deployment mechanism and not only helm templating. Default is true.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"debug": {
+					"helmDeploymentConfig": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DebugOptions configure additional debug options.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.DebugOptions"),
+							Description: "HelmDeploymentConfig contains settings for helm operations. Only relevant if HelmDeployment is true.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HelmDeploymentConfiguration"),
 						},
 					},
-					"hpa": {
+					"deletionGroups": {
 						SchemaProps: spec.SchemaProps{
-							Description: "HPAConfiguration contains the configuration for horizontal pod autoscaling.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.HPAConfiguration"),
+							Description: "DeletionGroups defines the order in which objects are deleted. Only relevant if HelmDeployment is false.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.DeletionGroupDefinition"),
+									},
+								},
+							},
 						},
 					},
-					"controller": {
+					"deletionGroupsDuringUpdate": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Controller contains configuration concerning the controller framework.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.Controller"),
+							Description: "DeletionGroupsDuringUpdate defines the order in which objects are deleted during an update.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.DeletionGroupDefinition"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"defaultImage", "initContainer", "waitContainer", "garbageCollection"},
+				Required: []string{"chart", "name", "namespace", "createNamespace"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/config.OCIConfiguration", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerSpec", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.Controller", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.DebugOptions", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.GarbageCollection", "github.com/gardener/landscaper/apis/deployer/container/v1alpha1.HPAConfiguration"},
+			"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.Chart", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HelmDeploymentConfiguration", "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.DeletionGroupDefinition", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.DriftDetectionSpec", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Export", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.ServerSideApplyConfiguration", "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration"},
 	}
 }
 
-func schema_apis_deployer_container_v1alpha1_ContainerSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_ProviderStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ContainerSpec defines a container specification",
+				Description: "ProviderStatus is the helm provider specific status",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"image": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Docker image name. More info: https://kubernetes.io/docs/concepts/containers/images The image will be defaulted by the container deployer to the configured default.",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"command": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Entrypoint array. Not executed within a shell. The docker image's ENTRYPOINT is used if this is not provided. Variable references $(VAR_NAME) are expanded using the container's environment. If a variable cannot be resolved, the reference in the input string will be unchanged. The $(VAR_NAME) syntax can be escaped with a double $$, ie: $$(VAR_NAME). Escaped references will never be expanded, regardless of whether the variable exists or not. Cannot be updated. More info: https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#running-a-command-in-a-shell",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"args": {
+					"managedResources": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Arguments to the entrypoint. The docker image's CMD is used if this is not provided. Variable references $(VAR_NAME) are expanded using the container's environment. If a variable cannot be resolved, the reference in the input string will be unchanged. The $(VAR_NAME) syntax can be escaped with a double $$, ie: $$(VAR_NAME). Escaped references will never be expanded, regardless of whether the variable exists or not. Cannot be updated. More info: https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#running-a-command-in-a-shell",
+							Description: "ManagedResources contains all kubernetes resources that are deployed by the helm deployer.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.ManagedResourceStatus"),
 									},
 								},
 							},
 						},
 					},
-					"imagePullPolicy": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Image pull policy. One of Always, Never, IfNotPresent. Defaults to Always if :latest tag is specified, or IfNotPresent otherwise. Cannot be updated. More info: https://kubernetes.io/docs/concepts/containers/images#updating-images\n\nPossible enum values:\n - `\"Always\"` means that kubelet always attempts to pull the latest image. Container will fail If the pull fails.\n - `\"IfNotPresent\"` means that kubelet pulls if the image isn't present on disk. Container will fail if the image isn't present and the pull fails.\n - `\"Never\"` means that kubelet never pulls an image, but only uses a local image. Container will fail if the image isn't present",
-							Type:        []string{"string"},
-							Format:      "",
-							Enum:        []interface{}{"Always", "IfNotPresent", "Never"},
-						},
-					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/deployer/utils/managedresource.ManagedResourceStatus"},
 	}
 }
 
-func schema_apis_deployer_container_v1alpha1_ContainerStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_RemoteArchiveAccess(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ContainerStatus describes the status of a pod with its init, wait and main container.",
+				Description: "RemoteArchiveAccess defines the remote access for a helm chart as compressed archive.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"message": {
-						SchemaProps: spec.SchemaProps{
-							Description: "A human readable message indicating details about why the pod is in this condition.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"reason": {
+					"url": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A brief CamelCase message indicating details about why the pod is in this state. e.g. 'Evicted'",
+							Description: "URL defines a compressed tarred helm chart that is fetched from a url.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"state": {
+				},
+			},
+		},
+	}
+}
+
+func schema_apis_deployer_helm_v1alpha1_RemoteChartReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RemoteChartReference defines a reference to a remote Helm chart through a Component-Descriptor",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ref": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Details about the container's current condition.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/api/core/v1.ContainerState"),
+							Description: "ComponentDescriptorReference is the reference to a component descriptor",
+							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorReference"),
 						},
 					},
-					"image": {
+					"inline": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The image the container is running. More info: https://kubernetes.io/docs/concepts/containers/images",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "InlineDescriptorReference defines an inline component descriptor",
+							Ref:         ref("github.com/gardener/landscaper/legacy-component-spec/bindings-go/apis/v2.ComponentDescriptor"),
 						},
 					},
-					"imageID": {
+					"resourceName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ImageID of the container's image.",
+							Description: "ResourceName is the name of the Helm chart as defined by a component descriptor.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"exitCode": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ExitCode of the main container.",
-							Type:        []string{"integer"},
-							Format:      "int32",
-						},
-					},
 				},
-				Required: []string{"image", "imageID"},
+				Required: []string{"resourceName"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.ContainerState"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorReference", "github.com/gardener/landscaper/legacy-component-spec/bindings-go/apis/v2.ComponentDescriptor"},
 	}
 }
 
-func schema_apis_deployer_container_v1alpha1_Controller(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_helm_v1alpha1_ResourceRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Controller contains configuration concerning the controller framework.",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"CommonControllerConfig": {
+					"key": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
 				},
-				Required: []string{"CommonControllerConfig"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig"},
 	}
 }
 
-func schema_apis_deployer_container_v1alpha1_DebugOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_http_Configuration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DebugOptions defines optional debug options.",
+				Description: "Configuration is the http deployer configuration that configures the controller.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"keepPod": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "KeepPod will only remove the finalizer on the pod but will not delete the pod.",
-							Type:        []string{"boolean"},
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-				},
-			},
-		},
-	}
-}
-
-func schema_apis_deployer_container_v1alpha1_GarbageCollection(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "GarbageCollection defines the container deployer garbage collection configuration.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"disable": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Disable disables the garbage collector and the resources clean-up.",
-							Default:     false,
-							Type:        []string{"boolean"},
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"worker": {
+					"identity": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Worker defines the number of parallel garbage collection routines. Defaults to 5.",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Identity identity describes the unique identity of the deployer.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"targetSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetSelector describes all selectors the deployer should depend on.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector"),
+									},
+								},
+							},
+						},
+					},
+					"hpa": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HPAConfiguration contains the configuration for horizontal pod autoscaling.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/http.HPAConfiguration"),
 						},
 					},
-					"requeueTimeSeconds": {
+					"controller": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RequeueTime specifies the duration after which the object, which is not yet ready to be garbage collected, is requeued. Defaults to 3600.",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Controller contains configuration concerning the controller framework.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/http.Controller"),
 						},
 					},
 				},
-				Required: []string{"disable", "worker", "requeueTimeSeconds"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/http.Controller", "github.com/gardener/landscaper/apis/deployer/http.HPAConfiguration"},
 	}
 }
 
-func schema_apis_deployer_container_v1alpha1_HPAConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_http_Controller(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "HPAConfiguration contains the configuration for horizontal pod autoscaling.",
+				Description: "Controller contains configuration concerning the controller framework.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"maxReplicas": {
+					"CommonControllerConfig": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig"),
 						},
 					},
 				},
+				Required: []string{"CommonControllerConfig"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig"},
 	}
 }
 
-func schema_apis_deployer_container_v1alpha1_PodStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_http_Export(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PodStatus describes the status of a pod with its init, wait and main container",
+				Description: "Export describes one export that is read from the state of the object.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"podName": {
+					"key": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PodName is the name of the created pod.",
+							Description: "Key is the key that the value from JSONPath is exported to.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"lastRun": {
-						SchemaProps: spec.SchemaProps{
-							Description: "LastRun is the time when the pod was executed the last time.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"lastSuccessfulJobID": {
+					"jsonPath": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastSuccessfulJobID is set to the current JobID when the pod successfully finished. If the pod has not yet finished, this field will be nil.",
+							Description: "JSONPath is the jsonpath to look for a value. The JSONPath root is the state of the object.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"containerStatus": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ContainerStatus contains the status of the pod that executes the configured container.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerStatus"),
-						},
-					},
-					"initContainerStatus": {
-						SchemaProps: spec.SchemaProps{
-							Description: "InitContainerStatus contains the status of the init container.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerStatus"),
-						},
-					},
-					"waitContainerStatus": {
+				},
+				Required: []string{"key", "jsonPath"},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_deployer_http_HPAConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HPAConfiguration contains the configuration for horizontal pod autoscaling.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxReplicas": {
 						SchemaProps: spec.SchemaProps{
-							Description: "WaitContainerStatus contains the status of the wait container.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerStatus"),
+							Type:   []string{"integer"},
+							Format: "int32",
 						},
 					},
 				},
-				Required: []string{"podName", "containerStatus", "initContainerStatus", "waitContainerStatus"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.ContainerStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_apis_deployer_container_v1alpha1_ProviderConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_http_ProviderConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ProviderConfiguration is the container deployer configuration that is expected in a DeployItem",
+				Description: "ProviderConfiguration is the http deployer configuration that is expected in a DeployItem. The requests describe the lifecycle of one object of an http api. The url, the headers and the body of the requests are go templates, which are rendered with the state of the object as \".state\".",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -12237,71 +16317,40 @@ func schema_apis_deployer_container_v1alpha1_ProviderConfiguration(ref common.Re
 							Format:      "",
 						},
 					},
-					"image": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Docker image name. More info: https://kubernetes.io/docs/concepts/containers/images The image will be defaulted by the container deployer to the configured default.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"command": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Entrypoint array. Not executed within a shell. The docker image's ENTRYPOINT is used if this is not provided. Variable references $(VAR_NAME) are expanded using the container's environment. If a variable cannot be resolved, the reference in the input string will be unchanged. The $(VAR_NAME) syntax can be escaped with a double $$, ie: $$(VAR_NAME). Escaped references will never be expanded, regardless of whether the variable exists or not. Cannot be updated. More info: https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#running-a-command-in-a-shell",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
-						},
-					},
-					"args": {
+					"create": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Arguments to the entrypoint. The docker image's CMD is used if this is not provided. Variable references $(VAR_NAME) are expanded using the container's environment. If a variable cannot be resolved, the reference in the input string will be unchanged. The $(VAR_NAME) syntax can be escaped with a double $$, ie: $$(VAR_NAME). Escaped references will never be expanded, regardless of whether the variable exists or not. Cannot be updated. More info: https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#running-a-command-in-a-shell",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "Create is the request that creates the object. It is only sent if the object does not exist.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/http.Request"),
 						},
 					},
-					"importValues": {
+					"read": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ImportValues contains the import values for the container.",
-							Type:        []string{"string"},
-							Format:      "byte",
+							Description: "Read is the request that reads the object. It is used to check whether the object that has been created by the deployer still exists, and to refresh its state. If it is not set, the object is considered to exist once it has been created.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/http.Request"),
 						},
 					},
-					"blueprint": {
+					"update": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Blueprint is the resolved reference to the Blueprint definition",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.BlueprintDefinition"),
+							Description: "Update is the request that updates the existing object. It is only sent if the provider configuration has changed since the object was created or updated.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/http.Request"),
 						},
 					},
-					"componentDescriptor": {
+					"delete": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ComponentDescriptor is the resolved reference to the ComponentDescriptor defnition",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorDefinition"),
+							Description: "Delete is the request that deletes the object when the deploy item is deleted. If it is not set, the object is kept.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/http.Request"),
 						},
 					},
-					"registryPullSecrets": {
+					"exports": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RegistryPullSecrets defines a list of registry credentials that are used to pull blueprints and component descriptors from the respective registry. For more info see: https://kubernetes.io/docs/tasks/configure-pod-container/pull-image-private-registry/ Note that the type information is used to determine the secret key and the type of the secret.",
+							Description: "Exports describe the values that are exported from the state of the object.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference"),
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/http.Export"),
 									},
 								},
 							},
@@ -12314,18 +16363,19 @@ func schema_apis_deployer_container_v1alpha1_ProviderConfiguration(ref common.Re
 						},
 					},
 				},
+				Required: []string{"create"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.BlueprintDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorDefinition", "github.com/gardener/landscaper/apis/core/v1alpha1.ObjectReference", "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"},
+			"github.com/gardener/landscaper/apis/deployer/http.Export", "github.com/gardener/landscaper/apis/deployer/http.Request", "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"},
 	}
 }
 
-func schema_apis_deployer_container_v1alpha1_ProviderStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_http_ProviderStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ProviderStatus is the container provider specific status",
+				Description: "ProviderStatus is the http provider specific status.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -12342,151 +16392,105 @@ func schema_apis_deployer_container_v1alpha1_ProviderStatus(ref common.Reference
 							Format:      "",
 						},
 					},
-					"lastOperation": {
+					"created": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastOperation defines the last run operation of the pod. The operation can be either reconcile or deletion.",
-							Default:     "",
-							Type:        []string{"string"},
+							Description: "Created is true if the object has been created by the deployer.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"podStatus": {
-						SchemaProps: spec.SchemaProps{
-							Description: "PodStatus indicated the status of the executed pod.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/container/v1alpha1.PodStatus"),
-						},
-					},
-				},
-				Required: []string{"lastOperation"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/container/v1alpha1.PodStatus"},
-	}
-}
-
-func schema_landscaper_apis_deployer_helm_ArchiveAccess(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ArchiveAccess defines the access for a helm chart as compressed archive.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"raw": {
+					"stateSecretName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Raw defines a compressed tarred helm chart as base64 encoded string.",
+							Description: "StateSecretName is the name of the secret in the namespace of the deploy item that contains the state of the object. The state is the json body of the last response with a json object or array. It is kept in a secret, as the response might contain credentials.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"remote": {
+					"configurationHash": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Remote defines the remote access for a helm chart as compressed archive.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm.RemoteArchiveAccess"),
+							Description: "ConfigurationHash is the hash of the provider configuration with which the object was created or updated.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/helm.RemoteArchiveAccess"},
 	}
 }
 
-func schema_landscaper_apis_deployer_helm_Auth(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_http_Request(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Auth contains authentication info for one helm chart repo",
+				Description: "Request defines an http request.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"url": {
-						SchemaProps: spec.SchemaProps{
-							Description: "URL contains URL of helm chart repo",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"customCAData": {
+					"method": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CustomCAData allows you to add a custom CA, which is useful if your server speaks HTTPS with a self- signed certificate. The added certificate must be in PEM format and base64 encoded.",
+							Description: "Method is the http method of the request. Defaults to POST for create, GET for read, PUT for update and DELETE for delete requests.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"authHeader": {
+					"url": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AuthHeader contains the value that will be set in the \"Authorization\" header when fetching the Chart, e.g. \"Basic dX...3dvcmQ=\". Exactly one of the fields AuthHeader and SecretRef must be set.",
+							Description: "URL is the url of the request. A relative url is resolved against the url of the target.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"secretRef": {
+					"headers": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reference to a secret containing the AuthHeader Exactly one of the fields AuthHeader and SecretRef must be set.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"),
+							Description: "Headers are the headers of the request. They overwrite the headers of the target.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"},
-	}
-}
-
-func schema_landscaper_apis_deployer_helm_Chart(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "Chart defines the helm chart to render and apply.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"ref": {
+					"body": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Ref defines the reference to a helm chart in a oci repository.",
+							Description: "Body is the json body of the request.",
 							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"fromResource": {
-						SchemaProps: spec.SchemaProps{
-							Description: "FromResource fetches the chart based on the resource's access method. The resource is defined as part of a component descriptor which is necessary to also handle local artifacts.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm.RemoteChartReference"),
-						},
-					},
-					"archive": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Archive defines a compressed tarred helm chart as base64 encoded string.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm.ArchiveAccess"),
-						},
-					},
-					"helmChartRepo": {
-						SchemaProps: spec.SchemaProps{
-							Description: "HelmChartRepo defines a reference to a chart in a helm chart repo.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm.HelmChartRepo"),
+							Format:      "byte",
 						},
 					},
-					"resourceRef": {
+					"successCodes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ResourceKey defines a key that can be given to a corresponding API in order to fetch the content of the resource defined in the blueprint",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "SuccessCodes are the status codes of a successful response. Defaults to all 2xx status codes.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int32",
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"url"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/helm.ArchiveAccess", "github.com/gardener/landscaper/apis/deployer/helm.HelmChartRepo", "github.com/gardener/landscaper/apis/deployer/helm.RemoteChartReference"},
 	}
 }
 
-func schema_landscaper_apis_deployer_helm_Configuration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_http_v1alpha1_Configuration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Configuration is the helm deployer configuration that configures the controller",
+				Description: "Configuration is the http deployer configuration that configures the controller.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -12510,12 +16514,6 @@ func schema_landscaper_apis_deployer_helm_Configuration(ref common.ReferenceCall
 							Format:      "",
 						},
 					},
-					"oci": {
-						SchemaProps: spec.SchemaProps{
-							Description: "OCI configures the oci client of the controller",
-							Ref:         ref("github.com/gardener/landscaper/apis/config.OCIConfiguration"),
-						},
-					},
 					"targetSelector": {
 						SchemaProps: spec.SchemaProps{
 							Description: "TargetSelector describes all selectors the deployer should depend on.",
@@ -12530,35 +16528,28 @@ func schema_landscaper_apis_deployer_helm_Configuration(ref common.ReferenceCall
 							},
 						},
 					},
-					"export": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Export defines the export configuration.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm.ExportConfiguration"),
-						},
-					},
 					"hpa": {
 						SchemaProps: spec.SchemaProps{
 							Description: "HPAConfiguration contains the configuration for horizontal pod autoscaling.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm.HPAConfiguration"),
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/http/v1alpha1.HPAConfiguration"),
 						},
 					},
 					"controller": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Controller contains configuration concerning the controller framework.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm.Controller"),
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/http/v1alpha1.Controller"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/config.OCIConfiguration", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/helm.Controller", "github.com/gardener/landscaper/apis/deployer/helm.ExportConfiguration", "github.com/gardener/landscaper/apis/deployer/helm.HPAConfiguration"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/http/v1alpha1.Controller", "github.com/gardener/landscaper/apis/deployer/http/v1alpha1.HPAConfiguration"},
 	}
 }
 
-func schema_landscaper_apis_deployer_helm_Controller(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_http_v1alpha1_Controller(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -12580,28 +16571,37 @@ func schema_landscaper_apis_deployer_helm_Controller(ref common.ReferenceCallbac
 	}
 }
 
-func schema_landscaper_apis_deployer_helm_ExportConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_http_v1alpha1_Export(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ExportConfiguration defines the export configuration for the deployer.",
+				Description: "Export describes one export that is read from the state of the object.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"defaultTimeout": {
+					"key": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DefaultTimeout configures the default timeout for all exports without a explicit export timeout defined.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+							Description: "Key is the key that the value from JSONPath is exported to.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"jsonPath": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JSONPath is the jsonpath to look for a value. The JSONPath root is the state of the object.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"key", "jsonPath"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
 	}
 }
 
-func schema_landscaper_apis_deployer_helm_HPAConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_http_v1alpha1_HPAConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -12620,159 +16620,326 @@ func schema_landscaper_apis_deployer_helm_HPAConfiguration(ref common.ReferenceC
 	}
 }
 
-func schema_landscaper_apis_deployer_helm_HelmChartRepo(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_http_v1alpha1_ProviderConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "HelmChartRepo defines a reference to a chart in a helm chart repo",
+				Description: "ProviderConfiguration is the http deployer configuration that is expected in a DeployItem. The requests describe the lifecycle of one object of an http api. The url, the headers and the body of the requests are go templates, which are rendered with the state of the object as \".state\".",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"helmChartRepoUrl": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"helmChartName": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"helmChartVersion": {
+					"create": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Create is the request that creates the object. It is only sent if the object does not exist.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/http/v1alpha1.Request"),
+						},
+					},
+					"read": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Read is the request that reads the object. It is used to check whether the object that has been created by the deployer still exists, and to refresh its state. If it is not set, the object is considered to exist once it has been created.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/http/v1alpha1.Request"),
+						},
+					},
+					"update": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Update is the request that updates the existing object. It is only sent if the provider configuration has changed since the object was created or updated.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/http/v1alpha1.Request"),
+						},
+					},
+					"delete": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Delete is the request that deletes the object when the deploy item is deleted. If it is not set, the object is kept.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/http/v1alpha1.Request"),
+						},
+					},
+					"exports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Exports describe the values that are exported from the state of the object.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/http/v1alpha1.Export"),
+									},
+								},
+							},
+						},
+					},
+					"continuousReconcile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ContinuousReconcile contains the schedule for continuous reconciliation.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"),
 						},
 					},
 				},
+				Required: []string{"create"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/deployer/http/v1alpha1.Export", "github.com/gardener/landscaper/apis/deployer/http/v1alpha1.Request", "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"},
 	}
 }
 
-func schema_landscaper_apis_deployer_helm_HelmChartRepoCredentials(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_http_v1alpha1_ProviderStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "HelmChartRepoCredentials contains the credentials to access hepl chart repos",
+				Description: "ProviderStatus is the http provider specific status.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"auths": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/deployer/helm.Auth"),
-									},
-								},
-							},
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"created": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Created is true if the object has been created by the deployer.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"stateSecretName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StateSecretName is the name of the secret in the namespace of the deploy item that contains the state of the object. The state is the json body of the last response with a json object or array. It is kept in a secret, as the response might contain credentials.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"configurationHash": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigurationHash is the hash of the provider configuration with which the object was created or updated.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/helm.Auth"},
 	}
 }
 
-func schema_landscaper_apis_deployer_helm_HelmDeploymentConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_http_v1alpha1_Request(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "HelmDeploymentConfiguration defines settings for a helm deployment.",
+				Description: "Request defines an http request.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"install": {
+					"method": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
+							Description: "Method is the http method of the request. Defaults to POST for create, GET for read, PUT for update and DELETE for delete requests.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL is the url of the request. A relative url is resolved against the url of the target.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"headers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Headers are the headers of the request. They overwrite the headers of the target.",
+							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-					"upgrade": {
+					"body": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "Body is the json body of the request.",
+							Type:        []string{"string"},
+							Format:      "byte",
+						},
+					},
+					"successCodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SuccessCodes are the status codes of a successful response. Defaults to all 2xx status codes.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int32",
 									},
 								},
 							},
 						},
 					},
-					"uninstall": {
+				},
+				Required: []string{"url"},
+			},
+		},
+	}
+}
+
+func schema_landscaper_apis_deployer_kustomize_ArchiveAccess(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ArchiveAccess defines the access for a kustomization as tar archive.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"raw": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "Raw defines a tar archive as base64 encoded string.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"remote": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Remote defines the remote access for a tar archive.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/kustomize.RemoteArchiveAccess"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/deployer/kustomize.RemoteArchiveAccess"},
+	}
+}
+
+func schema_landscaper_apis_deployer_kustomize_Configuration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Configuration is the kustomize deployer configuration that configures the controller.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"identity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Identity identity describes the unique identity of the deployer.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"targetSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetSelector describes all selectors the deployer should depend on.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core.AnyJSON"),
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector"),
 									},
 								},
 							},
 						},
 					},
+					"export": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Export defines the export configuration.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/kustomize.ExportConfiguration"),
+						},
+					},
+					"hpa": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HPAConfiguration contains the configuration for horizontal pod autoscaling.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/kustomize.HPAConfiguration"),
+						},
+					},
+					"controller": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Controller contains configuration concerning the controller framework.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/kustomize.Controller"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/kustomize.Controller", "github.com/gardener/landscaper/apis/deployer/kustomize.ExportConfiguration", "github.com/gardener/landscaper/apis/deployer/kustomize.HPAConfiguration"},
+	}
+}
+
+func schema_landscaper_apis_deployer_kustomize_Controller(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Controller contains configuration concerning the controller framework.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"CommonControllerConfig": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig"),
+						},
+					},
 				},
+				Required: []string{"CommonControllerConfig"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core.AnyJSON"},
+			"github.com/gardener/landscaper/apis/config/v1alpha1.CommonControllerConfig"},
 	}
 }
 
-func schema_landscaper_apis_deployer_helm_HelmInstallConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_kustomize_ExportConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "HelmInstallConfiguration defines settings for a helm install operation.",
+				Description: "ExportConfiguration defines the export configuration for the deployer.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"atomic": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
-						},
-					},
-					"force": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
-						},
-					},
-					"skipSchemaValidation": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
-						},
-					},
-					"takeOwnership": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
-						},
-					},
-					"wait": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
-						},
-					},
-					"timeout": {
+					"defaultTimeout": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Timeout is the timeout for the operation in minutes.",
+							Description: "DefaultTimeout configures the default timeout for all exports without a explicit export timeout defined.",
 							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
 						},
 					},
@@ -12784,123 +16951,134 @@ func schema_landscaper_apis_deployer_helm_HelmInstallConfiguration(ref common.Re
 	}
 }
 
-func schema_landscaper_apis_deployer_helm_HelmUninstallConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_kustomize_HPAConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "HelmUninstallConfiguration defines settings for a helm uninstall operation.",
+				Description: "HPAConfiguration contains the configuration for horizontal pod autoscaling.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"wait": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
-						},
-					},
-					"timeout": {
+					"maxReplicas": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Timeout is the timeout for the operation in minutes.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+							Type:   []string{"integer"},
+							Format: "int32",
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
 	}
 }
 
-func schema_landscaper_apis_deployer_helm_ProviderConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_kustomize_Kustomization(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ProviderConfiguration is the helm deployer configuration that is expected in a DeployItem",
+				Description: "Kustomization defines the files of a kustomization. The files of the resource or the archive are combined with the inline files, so that an overlay can be defined inline for a base of a resource.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"resourceRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "ResourceRef is the key of a resource of a component version that contains the kustomization as tar archive. The key is created with the template function \"getResourceKey\".",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"archive": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Archive defines the access to the kustomization as (optionally gzip compressed) tar archive.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/kustomize.ArchiveAccess"),
 						},
 					},
-					"readinessChecks": {
+					"files": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ReadinessChecks configures the readiness checks.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration"),
+							Description: "Files contains inline files of the kustomization, the key is the path of the file. Inline files overwrite files of the resource or the archive with the same path.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"updateStrategy": {
+					"path": {
 						SchemaProps: spec.SchemaProps{
-							Description: "UpdateStrategy defines the strategy how the manifests are updated in the cluster. Defaults to \"update\".",
+							Description: "Path is the path of the directory of the kustomization file that is built. Defaults to the root directory.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"chart": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/landscaper/apis/deployer/kustomize.ArchiveAccess"},
+	}
+}
+
+func schema_landscaper_apis_deployer_kustomize_ProviderConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProviderConfiguration is the kustomize deployer configuration that is expected in a DeployItem.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Chart defines helm chart to be templated and applied.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm.Chart"),
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"name": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the release name of the chart",
-							Default:     "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"namespace": {
+					"updateStrategy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Namespace is the release namespace of the chart",
-							Default:     "",
+							Description: "UpdateStrategy defines the strategy how the manifests are updated in the cluster. Defaults to \"update\".",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"createNamespace": {
+					"serverSideApply": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CreateNamespace configures the deployer to create the release namespace if not present. The behavior is similar to the \"helm install --create-namespace\"",
-							Default:     false,
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "ServerSideApply configures the server-side apply of the manifests. Only relevant if the update strategy is \"serverSideApply\".",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.ServerSideApplyConfiguration"),
 						},
 					},
-					"values": {
+					"readinessChecks": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Values are the values that are used for templating.",
-							Type:        []string{"string"},
-							Format:      "byte",
+							Description: "ReadinessChecks configures the readiness checks.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration"),
 						},
 					},
-					"exportsFromManifests": {
+					"kustomization": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExportsFromManifests describe the exports from the templated manifests that should be exported by the helm deployer. DEPRECATED",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.Export"),
-									},
-								},
-							},
+							Description: "Kustomization defines the kustomization that is built and applied.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/kustomize.Kustomization"),
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace of namespaced resources of the built manifests that do not define a namespace.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 					"exports": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Exports describe the exports from the templated manifests that should be exported by the helm deployer.",
+							Description: "Exports describe the exports from the built manifests that should be exported by the kustomize deployer.",
 							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports"),
 						},
 					},
@@ -12910,22 +17088,15 @@ func schema_landscaper_apis_deployer_helm_ProviderConfiguration(ref common.Refer
 							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"),
 						},
 					},
-					"helmDeployment": {
-						SchemaProps: spec.SchemaProps{
-							Description: "HelmDeployment indicates that helm is used as complete deployment mechanism and not only helm templating. Default is true.",
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-					"helmDeploymentConfig": {
+					"driftDetection": {
 						SchemaProps: spec.SchemaProps{
-							Description: "HelmDeploymentConfig contains settings for helm operations. Only relevant if HelmDeployment is true.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm.HelmDeploymentConfiguration"),
+							Description: "DriftDetection configures how the deployer reacts if managed resources are modified or deleted out-of-band.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.DriftDetectionSpec"),
 						},
 					},
 					"deletionGroups": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DeletionGroups defines the order in which objects are deleted. Only relevant if HelmDeployment is false.",
+							Description: "DeletionGroups defines the order in which objects are deleted.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -12952,19 +17123,19 @@ func schema_landscaper_apis_deployer_helm_ProviderConfiguration(ref common.Refer
 						},
 					},
 				},
-				Required: []string{"chart", "name", "namespace", "createNamespace"},
+				Required: []string{"kustomization"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/helm.Chart", "github.com/gardener/landscaper/apis/deployer/helm.HelmDeploymentConfiguration", "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.DeletionGroupDefinition", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Export", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports", "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration"},
+			"github.com/gardener/landscaper/apis/deployer/kustomize.Kustomization", "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.DeletionGroupDefinition", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.DriftDetectionSpec", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.ServerSideApplyConfiguration", "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration"},
 	}
 }
 
-func schema_landscaper_apis_deployer_helm_ProviderStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_kustomize_ProviderStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ProviderStatus is the helm provider specific status",
+				Description: "ProviderStatus is the kustomize provider specific status.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -12974,213 +17145,88 @@ func schema_landscaper_apis_deployer_helm_ProviderStatus(ref common.ReferenceCal
 							Format:      "",
 						},
 					},
-					"apiVersion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"managedResources": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ManagedResources contains all kubernetes resources that are deployed by the helm deployer.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.ManagedResourceStatus"),
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/utils/managedresource.ManagedResourceStatus"},
-	}
-}
-
-func schema_landscaper_apis_deployer_helm_RemoteArchiveAccess(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "RemoteArchiveAccess defines the remote access for a helm chart as compressed archive.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"url": {
-						SchemaProps: spec.SchemaProps{
-							Description: "URL defines a compressed tarred helm chart that is fetched from a url.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-				},
-			},
-		},
-	}
-}
-
-func schema_landscaper_apis_deployer_helm_RemoteChartReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "RemoteChartReference defines a reference to a remote Helm chart through a Component-Descriptor",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"ref": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ComponentDescriptorReference is the reference to a component descriptor",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorReference"),
-						},
-					},
-					"inline": {
-						SchemaProps: spec.SchemaProps{
-							Description: "InlineDescriptorReference defines an inline component descriptor",
-							Ref:         ref("github.com/gardener/landscaper/legacy-component-spec/bindings-go/apis/v2.ComponentDescriptor"),
-						},
-					},
-					"resourceName": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ResourceName is the name of the Helm chart as defined by a component descriptor.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-				},
-				Required: []string{"resourceName"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorReference", "github.com/gardener/landscaper/legacy-component-spec/bindings-go/apis/v2.ComponentDescriptor"},
-	}
-}
-
-func schema_apis_deployer_helm_v1alpha1_ArchiveAccess(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ArchiveAccess defines the access for a helm chart as compressed archive.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"raw": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Raw defines a compressed tarred helm chart as base64 encoded string.",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"remote": {
+					"managedResources": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Remote defines the remote access for a helm chart as compressed archive.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.RemoteArchiveAccess"),
+							Description: "ManagedResources contains all kubernetes resources that are deployed by the deployer.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.ManagedResourceStatus"),
+									},
+								},
+							},
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.RemoteArchiveAccess"},
+			"github.com/gardener/landscaper/apis/deployer/utils/managedresource.ManagedResourceStatus"},
 	}
 }
 
-func schema_apis_deployer_helm_v1alpha1_Auth(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_landscaper_apis_deployer_kustomize_RemoteArchiveAccess(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Auth contains authentication info for one helm chart repo",
+				Description: "RemoteArchiveAccess defines the remote access for a tar archive.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"url": {
 						SchemaProps: spec.SchemaProps{
-							Description: "URL contains URL of helm chart repo",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"customCAData": {
-						SchemaProps: spec.SchemaProps{
-							Description: "CustomCAData allows you to add a custom CA, which is useful if your server speaks HTTPS with a self- signed certificate. The added certificate must be in PEM format and base64 encoded.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"authHeader": {
-						SchemaProps: spec.SchemaProps{
-							Description: "AuthHeader contains the value that will be set in the \"Authorization\" header when fetching the Chart, e.g. \"Basic dX...3dvcmQ=\". Exactly one of the fields AuthHeader and SecretRef must be set.",
+							Description: "URL defines a tar archive that is fetched from a url.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"secretRef": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Reference to a secret containing the AuthHeader Exactly one of the fields AuthHeader and SecretRef must be set.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"),
-						},
-					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.LocalSecretReference"},
 	}
 }
 
-func schema_apis_deployer_helm_v1alpha1_Chart(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_kustomize_v1alpha1_ArchiveAccess(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Chart defines the helm chart to render and apply.",
+				Description: "ArchiveAccess defines the access for a kustomization as tar archive.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"ref": {
+					"raw": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Ref defines the reference to a helm chart in a oci repository.",
+							Description: "Raw defines a tar archive as base64 encoded string.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"fromResource": {
-						SchemaProps: spec.SchemaProps{
-							Description: "FromResource fetches the chart based on the resource's access method. The resource is defined as part of a component descriptor which is necessary to also handle local artifacts.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.RemoteChartReference"),
-						},
-					},
-					"archive": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Archive defines a compressed tarred helm chart as base64 encoded string.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ArchiveAccess"),
-						},
-					},
-					"helmChartRepo": {
-						SchemaProps: spec.SchemaProps{
-							Description: "HelmChartRepo defines a reference to a chart in a helm chart repo.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HelmChartRepo"),
-						},
-					},
-					"resourceRef": {
+					"remote": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ResourceKey defines a key that can be given to a corresponding API in order to fetch the content of the resource defined in the blueprint",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Remote defines the remote access for a tar archive.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/kustomize/v1alpha1.RemoteArchiveAccess"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ArchiveAccess", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HelmChartRepo", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.RemoteChartReference"},
+			"github.com/gardener/landscaper/apis/deployer/kustomize/v1alpha1.RemoteArchiveAccess"},
 	}
 }
 
-func schema_apis_deployer_helm_v1alpha1_Configuration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_kustomize_v1alpha1_Configuration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Configuration is the helm deployer configuration that configures the controller",
+				Description: "Configuration is the kustomize deployer configuration that configures the controller.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -13204,12 +17250,6 @@ func schema_apis_deployer_helm_v1alpha1_Configuration(ref common.ReferenceCallba
 							Format:      "",
 						},
 					},
-					"oci": {
-						SchemaProps: spec.SchemaProps{
-							Description: "OCI configures the oci client of the controller",
-							Ref:         ref("github.com/gardener/landscaper/apis/config.OCIConfiguration"),
-						},
-					},
 					"targetSelector": {
 						SchemaProps: spec.SchemaProps{
 							Description: "TargetSelector describes all selectors the deployer should depend on.",
@@ -13228,31 +17268,31 @@ func schema_apis_deployer_helm_v1alpha1_Configuration(ref common.ReferenceCallba
 						SchemaProps: spec.SchemaProps{
 							Description: "Export defines the export configuration.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ExportConfiguration"),
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/kustomize/v1alpha1.ExportConfiguration"),
 						},
 					},
 					"hpa": {
 						SchemaProps: spec.SchemaProps{
 							Description: "HPAConfiguration contains the configuration for horizontal pod autoscaling.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HPAConfiguration"),
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/kustomize/v1alpha1.HPAConfiguration"),
 						},
 					},
 					"controller": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Controller contains configuration concerning the controller framework.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.Controller"),
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/kustomize/v1alpha1.Controller"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/config.OCIConfiguration", "github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.Controller", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.ExportConfiguration", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HPAConfiguration"},
+			"github.com/gardener/landscaper/apis/core/v1alpha1.TargetSelector", "github.com/gardener/landscaper/apis/deployer/kustomize/v1alpha1.Controller", "github.com/gardener/landscaper/apis/deployer/kustomize/v1alpha1.ExportConfiguration", "github.com/gardener/landscaper/apis/deployer/kustomize/v1alpha1.HPAConfiguration"},
 	}
 }
 
-func schema_apis_deployer_helm_v1alpha1_Controller(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_kustomize_v1alpha1_Controller(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -13274,7 +17314,7 @@ func schema_apis_deployer_helm_v1alpha1_Controller(ref common.ReferenceCallback)
 	}
 }
 
-func schema_apis_deployer_helm_v1alpha1_ExportConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_kustomize_v1alpha1_ExportConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -13295,7 +17335,7 @@ func schema_apis_deployer_helm_v1alpha1_ExportConfiguration(ref common.Reference
 	}
 }
 
-func schema_apis_deployer_helm_v1alpha1_HPAConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_kustomize_v1alpha1_HPAConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -13314,202 +17354,62 @@ func schema_apis_deployer_helm_v1alpha1_HPAConfiguration(ref common.ReferenceCal
 	}
 }
 
-func schema_apis_deployer_helm_v1alpha1_HelmChartRepo(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "HelmChartRepo defines a reference to a chart in a helm chart repo",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"helmChartRepoUrl": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"helmChartName": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"helmChartVersion": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-				},
-			},
-		},
-	}
-}
-
-func schema_apis_deployer_helm_v1alpha1_HelmChartRepoCredentials(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "HelmChartRepoCredentials contains the credentials to access hepl chart repos",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"auths": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.Auth"),
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.Auth"},
-	}
-}
-
-func schema_apis_deployer_helm_v1alpha1_HelmDeploymentConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "HelmDeploymentConfiguration defines settings for a helm deployment.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"install": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
-									},
-								},
-							},
-						},
-					},
-					"upgrade": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
-									},
-								},
-							},
-						},
-					},
-					"uninstall": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"),
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.AnyJSON"},
-	}
-}
-
-func schema_apis_deployer_helm_v1alpha1_HelmInstallConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_kustomize_v1alpha1_Kustomization(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "HelmInstallConfiguration defines settings for a helm install operation.",
+				Description: "Kustomization defines the files of a kustomization. The files of the resource or the archive are combined with the inline files, so that an overlay can be defined inline for a base of a resource.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"atomic": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
-						},
-					},
-					"force": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
-						},
-					},
-					"skipSchemaValidation": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
-						},
-					},
-					"takeOwnership": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
-						},
-					},
-					"wait": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
-						},
-					},
-					"timeout": {
+					"resourceRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Timeout is the timeout for the operation in minutes.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+							Description: "ResourceRef is the key of a resource of a component version that contains the kustomization as tar archive. The key is created with the template function \"getResourceKey\".",
+							Type:        []string{"string"},
+							Format:      "",
 						},
-					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
-	}
-}
-
-func schema_apis_deployer_helm_v1alpha1_HelmUninstallConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "HelmUninstallConfiguration defines settings for a helm uninstall operation.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"wait": {
+					},
+					"archive": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Description: "Archive defines the access to the kustomization as (optionally gzip compressed) tar archive.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/kustomize/v1alpha1.ArchiveAccess"),
 						},
 					},
-					"timeout": {
+					"files": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Timeout is the timeout for the operation in minutes.",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.Duration"),
+							Description: "Files contains inline files of the kustomization, the key is the path of the file. Inline files overwrite files of the resource or the archive with the same path.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is the path of the directory of the kustomization file that is built. Defaults to the root directory.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.Duration"},
+			"github.com/gardener/landscaper/apis/deployer/kustomize/v1alpha1.ArchiveAccess"},
 	}
 }
 
-func schema_apis_deployer_helm_v1alpha1_ProviderConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_kustomize_v1alpha1_ProviderConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ProviderConfiguration is the helm deployer configuration that is expected in a DeployItem",
+				Description: "ProviderConfiguration is the kustomize deployer configuration that is expected in a DeployItem.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -13533,6 +17433,12 @@ func schema_apis_deployer_helm_v1alpha1_ProviderConfiguration(ref common.Referen
 							Format:      "",
 						},
 					},
+					"serverSideApply": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServerSideApply configures the server-side apply of the manifests. Only relevant if the update strategy is \"serverSideApply\".",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.ServerSideApplyConfiguration"),
+						},
+					},
 					"readinessChecks": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ReadinessChecks configures the readiness checks.",
@@ -13540,61 +17446,23 @@ func schema_apis_deployer_helm_v1alpha1_ProviderConfiguration(ref common.Referen
 							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration"),
 						},
 					},
-					"chart": {
+					"kustomization": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Chart defines helm chart to be templated and applied.",
+							Description: "Kustomization defines the kustomization that is built and applied.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.Chart"),
-						},
-					},
-					"name": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Name is the release name of the chart",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/kustomize/v1alpha1.Kustomization"),
 						},
 					},
 					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Namespace is the release namespace of the chart",
-							Default:     "",
+							Description: "Namespace is the namespace of namespaced resources of the built manifests that do not define a namespace.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"createNamespace": {
-						SchemaProps: spec.SchemaProps{
-							Description: "CreateNamespace configures the deployer to create the release namespace if not present. The behavior is similar to the \"helm install --create-namespace\"",
-							Default:     false,
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-					"values": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Values are the values that are used for templating.",
-							Type:        []string{"string"},
-							Format:      "byte",
-						},
-					},
-					"exportsFromManifests": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ExportsFromManifests describe the exports from the templated manifests that should be exported by the helm deployer. DEPRECATED",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.Export"),
-									},
-								},
-							},
-						},
-					},
 					"exports": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Exports describe the exports from the templated manifests that should be exported by the helm deployer.",
+							Description: "Exports describe the exports from the built manifests that should be exported by the kustomize deployer.",
 							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports"),
 						},
 					},
@@ -13604,22 +17472,15 @@ func schema_apis_deployer_helm_v1alpha1_ProviderConfiguration(ref common.Referen
 							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"),
 						},
 					},
-					"helmDeployment": {
-						SchemaProps: spec.SchemaProps{
-							Description: "HelmDeployment indicates that helm is used as complete deployment mechanism and not only helm templating. Default is true.",
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-					"helmDeploymentConfig": {
+					"driftDetection": {
 						SchemaProps: spec.SchemaProps{
-							Description: "HelmDeploymentConfig contains settings for helm operations. Only relevant if HelmDeployment is true.",
-							Ref:         ref("github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HelmDeploymentConfiguration"),
+							Description: "DriftDetection configures how the deployer reacts if managed resources are modified or deleted out-of-band.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.DriftDetectionSpec"),
 						},
 					},
 					"deletionGroups": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DeletionGroups defines the order in which objects are deleted. Only relevant if HelmDeployment is false.",
+							Description: "DeletionGroups defines the order in which objects are deleted.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -13646,19 +17507,19 @@ func schema_apis_deployer_helm_v1alpha1_ProviderConfiguration(ref common.Referen
 						},
 					},
 				},
-				Required: []string{"chart", "name", "namespace", "createNamespace"},
+				Required: []string{"kustomization"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.Chart", "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1.HelmDeploymentConfiguration", "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.DeletionGroupDefinition", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Export", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports", "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration"},
+			"github.com/gardener/landscaper/apis/deployer/kustomize/v1alpha1.Kustomization", "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.DeletionGroupDefinition", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.DriftDetectionSpec", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.ServerSideApplyConfiguration", "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration"},
 	}
 }
 
-func schema_apis_deployer_helm_v1alpha1_ProviderStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_kustomize_v1alpha1_ProviderStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ProviderStatus is the helm provider specific status",
+				Description: "ProviderStatus is the kustomize provider specific status.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -13677,7 +17538,7 @@ func schema_apis_deployer_helm_v1alpha1_ProviderStatus(ref common.ReferenceCallb
 					},
 					"managedResources": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ManagedResources contains all kubernetes resources that are deployed by the helm deployer.",
+							Description: "ManagedResources contains all kubernetes resources that are deployed by the deployer.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -13697,75 +17558,21 @@ func schema_apis_deployer_helm_v1alpha1_ProviderStatus(ref common.ReferenceCallb
 	}
 }
 
-func schema_apis_deployer_helm_v1alpha1_RemoteArchiveAccess(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_apis_deployer_kustomize_v1alpha1_RemoteArchiveAccess(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "RemoteArchiveAccess defines the remote access for a helm chart as compressed archive.",
+				Description: "RemoteArchiveAccess defines the remote access for a tar archive.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"url": {
 						SchemaProps: spec.SchemaProps{
-							Description: "URL defines a compressed tarred helm chart that is fetched from a url.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-				},
-			},
-		},
-	}
-}
-
-func schema_apis_deployer_helm_v1alpha1_RemoteChartReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "RemoteChartReference defines a reference to a remote Helm chart through a Component-Descriptor",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"ref": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ComponentDescriptorReference is the reference to a component descriptor",
-							Ref:         ref("github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorReference"),
-						},
-					},
-					"inline": {
-						SchemaProps: spec.SchemaProps{
-							Description: "InlineDescriptorReference defines an inline component descriptor",
-							Ref:         ref("github.com/gardener/landscaper/legacy-component-spec/bindings-go/apis/v2.ComponentDescriptor"),
-						},
-					},
-					"resourceName": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ResourceName is the name of the Helm chart as defined by a component descriptor.",
-							Default:     "",
+							Description: "URL defines a tar archive that is fetched from a url.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"resourceName"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/core/v1alpha1.ComponentDescriptorReference", "github.com/gardener/landscaper/legacy-component-spec/bindings-go/apis/v2.ComponentDescriptor"},
-	}
-}
-
-func schema_apis_deployer_helm_v1alpha1_ResourceRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"key": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-				},
 			},
 		},
 	}
@@ -13932,6 +17739,19 @@ func schema_landscaper_apis_deployer_manifest_ProviderConfiguration(ref common.R
 							Format:      "",
 						},
 					},
+					"serverSideApply": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServerSideApply configures the server-side apply of the manifests. Only relevant if the update strategy is \"serverSideApply\".",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.ServerSideApplyConfiguration"),
+						},
+					},
+					"diffOnly": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DiffOnly defines that the manifests are not applied. Instead, the changes that would be applied are computed using server-side dry runs and recorded in the managed resources of the provider status.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 					"readiness": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ReadinessChecks configures the readiness checks.",
@@ -13965,6 +17785,12 @@ func schema_landscaper_apis_deployer_manifest_ProviderConfiguration(ref common.R
 							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"),
 						},
 					},
+					"driftDetection": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DriftDetection configures how the deployer reacts if managed resources are modified or deleted out-of-band.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.DriftDetectionSpec"),
+						},
+					},
 					"deletionGroups": {
 						SchemaProps: spec.SchemaProps{
 							Description: "DeletionGroups defines the order in which objects are deleted.",
@@ -13997,7 +17823,7 @@ func schema_landscaper_apis_deployer_manifest_ProviderConfiguration(ref common.R
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.DeletionGroupDefinition", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Manifest", "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration"},
+			"github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.DeletionGroupDefinition", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.DriftDetectionSpec", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Manifest", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.ServerSideApplyConfiguration", "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration"},
 	}
 }
 
@@ -14501,6 +18327,19 @@ func schema_apis_deployer_manifest_v1alpha2_ProviderConfiguration(ref common.Ref
 							Format:      "",
 						},
 					},
+					"serverSideApply": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServerSideApply configures the server-side apply of the manifests. Only relevant if the update strategy is \"serverSideApply\".",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.ServerSideApplyConfiguration"),
+						},
+					},
+					"diffOnly": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DiffOnly defines that the manifests are not applied. Instead, the changes that would be applied are computed using server-side dry runs and recorded in the managed resources of the provider status.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 					"readinessChecks": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ReadinessChecks configures the readiness checks.",
@@ -14534,6 +18373,12 @@ func schema_apis_deployer_manifest_v1alpha2_ProviderConfiguration(ref common.Ref
 							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec"),
 						},
 					},
+					"driftDetection": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DriftDetection configures how the deployer reacts if managed resources are modified or deleted out-of-band.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.DriftDetectionSpec"),
+						},
+					},
 					"deletionGroups": {
 						SchemaProps: spec.SchemaProps{
 							Description: "DeletionGroups defines the order in which objects are deleted.",
@@ -14566,7 +18411,7 @@ func schema_apis_deployer_manifest_v1alpha2_ProviderConfiguration(ref common.Ref
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.DeletionGroupDefinition", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Manifest", "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration"},
+			"github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile.ContinuousReconcileSpec", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.DeletionGroupDefinition", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.DriftDetectionSpec", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Exports", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.Manifest", "github.com/gardener/landscaper/apis/deployer/utils/managedresource.ServerSideApplyConfiguration", "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks.ReadinessCheckConfiguration"},
 	}
 }
 
@@ -14934,6 +18779,26 @@ func schema_apis_deployer_utils_managedresource_DeletionGroupDefinition(ref comm
 	}
 }
 
+func schema_apis_deployer_utils_managedresource_DriftDetectionSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DriftDetectionSpec configures how the deployer reacts if managed resources are modified or deleted on the target cluster by someone else than the deployer.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"policy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Policy defines the action that is performed when a drift of a managed resource is detected. Defaults to \"ignore\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_apis_deployer_utils_managedresource_Export(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -15094,12 +18959,33 @@ func schema_apis_deployer_utils_managedresource_ManagedResourceStatus(ref common
 							Ref:         ref("k8s.io/api/core/v1.ObjectReference"),
 						},
 					},
+					"diff": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Diff describes the changes of the resource during the last reconcile of the deployer.",
+							Ref:         ref("github.com/gardener/landscaper/apis/deployer/utils/managedresource.ResourceDiff"),
+						},
+					},
+					"conflicts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conflicts contains the fields of the resource that are owned by other field managers and prevented the resource from being applied with server-side apply.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"resource"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.ObjectReference", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+			"github.com/gardener/landscaper/apis/deployer/utils/managedresource.ResourceDiff", "k8s.io/api/core/v1.ObjectReference", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
@@ -15199,6 +19085,43 @@ func schema_apis_deployer_utils_managedresource_PredefinedResourceGroup(ref comm
 	}
 }
 
+func schema_apis_deployer_utils_managedresource_ResourceDiff(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ResourceDiff describes the changes of a managed resource. The diff is computed by the api server using the same request that applies the resource, or a server-side dry run of it if the deployer only computes the diff.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"action": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Action describes how the resource was changed.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"changedFields": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ChangedFields contains the paths of all changed fields of the resource, e.g. \"spec.replicas\".",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"action"},
+			},
+		},
+	}
+}
+
 func schema_apis_deployer_utils_managedresource_ResourceType(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -15251,6 +19174,33 @@ func schema_apis_deployer_utils_managedresource_ResourceType(ref common.Referenc
 	}
 }
 
+func schema_apis_deployer_utils_managedresource_ServerSideApplyConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ServerSideApplyConfiguration configures how manifests are applied with server-side apply.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"fieldManager": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FieldManager is the name of the field manager that owns the applied fields. Defaults to \"landscaper\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"conflictPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConflictPolicy defines how conflicts with fields that are owned by other field managers are handled. Defaults to \"force\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_apis_deployer_utils_readinesschecks_CustomReadinessCheckConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
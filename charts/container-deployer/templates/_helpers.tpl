@@ -95,6 +95,14 @@ oci:
 targetSelector:
 {{ toYaml . }}
 {{- end }}
+{{- with .Values.deployer.execution }}
+execution:
+{{ toYaml . | indent 2 }}
+{{- end }}
+{{- with .Values.deployer.hostAccess }}
+hostAccess:
+{{ toYaml . | indent 2 }}
+{{- end }}
 {{- if .Values.hpa }}
 hpa:
 {{ .Values.hpa | toYaml | indent 2 }}
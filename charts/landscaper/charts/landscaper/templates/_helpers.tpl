@@ -144,6 +144,9 @@ registry:
 {{- if .Values.landscaper.metrics }}
 metrics:
   port: {{ .Values.landscaper.metrics.port | default 8080 }}
+  {{- if .Values.landscaper.metrics.objectPhaseInterval }}
+  objectPhaseInterval: {{ .Values.landscaper.metrics.objectPhaseInterval }}
+  {{- end }}
 {{- end }}
 {{- if .Values.landscaper.crdManagement }}
 crdManagement:
@@ -189,6 +192,11 @@ hpaMain:
 {{ .Values.hpaMain | toYaml | indent 2 }}
 {{- end }}
 
+{{- if .Values.landscaper.templatePlugins }}
+templatePlugins:
+{{ .Values.landscaper.templatePlugins | toYaml | indent 2 }}
+{{- end }}
+
 {{- end }}
 
 {{- define "landscaper-image" -}}
@@ -0,0 +1,147 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/mandelsoft/vfs/pkg/osfs"
+	"github.com/mandelsoft/vfs/pkg/projectionfs"
+	"github.com/spf13/cobra"
+
+	"github.com/gardener/landscaper/pkg/utils/blueprintdoc"
+	"github.com/gardener/landscaper/pkg/utils/blueprints"
+)
+
+// NewBlueprintDocCommand creates a new command that generates reference documentation of blueprints
+func NewBlueprintDocCommand(ctx context.Context) *cobra.Command {
+	options := NewOptions()
+
+	cmd := &cobra.Command{
+		Use:   "blueprint-doc [blueprint directories]...",
+		Short: "Generates Markdown or HTML reference documentation of the imports, exports and subinstallations of blueprints",
+		Example: `  blueprint-doc ./blueprint > README.md
+  blueprint-doc ./blueprint-a ./blueprint-b -o html --output-dir ./docs
+  blueprint-doc --registry-path ./registry --component-name example.com/my-component --component-version v1.0.0`,
+		SilenceUsage: true,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.Complete(args)
+			if err := options.Validate(); err != nil {
+				return err
+			}
+			return options.run(ctx, cmd.OutOrStdout())
+		},
+	}
+
+	options.AddFlags(cmd.Flags())
+
+	return cmd
+}
+
+// documentedBlueprint is a blueprint together with the name under which it is documented.
+type documentedBlueprint struct {
+	name      string
+	blueprint *blueprints.Blueprint
+}
+
+func (o *options) run(ctx context.Context, out io.Writer) error {
+	var (
+		resolver blueprintdoc.BlueprintResolver
+		toDoc    []documentedBlueprint
+	)
+	if len(o.componentName) != 0 {
+		var (
+			componentResolver *componentResolver
+			err               error
+		)
+		ctx, componentResolver, err = newComponentResolver(ctx, o.registryPath, o.componentName, o.componentVersion)
+		if err != nil {
+			return err
+		}
+		resolver = componentResolver
+
+		// the blueprint of the component version is documented if no directory is given,
+		// otherwise the component version is only used to resolve the blueprint references of the directories.
+		if len(o.blueprintDirs) == 0 {
+			blueprint, err := componentResolver.blueprint(ctx, o.blueprintResource)
+			if err != nil {
+				return err
+			}
+			toDoc = append(toDoc, documentedBlueprint{name: o.blueprintResource, blueprint: blueprint})
+		}
+	}
+
+	for _, dir := range o.blueprintDirs {
+		fs, err := projectionfs.New(osfs.New(), dir)
+		if err != nil {
+			return fmt.Errorf("unable to read blueprint from %s: %w", dir, err)
+		}
+		blueprint, err := blueprints.NewFromFs(fs)
+		if err != nil {
+			return fmt.Errorf("unable to read blueprint from %s: %w", dir, err)
+		}
+		name, err := filepath.Abs(dir)
+		if err != nil {
+			return err
+		}
+		toDoc = append(toDoc, documentedBlueprint{name: filepath.Base(name), blueprint: blueprint})
+	}
+
+	for i, bp := range toDoc {
+		title := bp.name
+		if len(o.title) != 0 {
+			title = o.title
+		}
+		doc, err := blueprintdoc.Generate(ctx, bp.blueprint, blueprintdoc.Options{
+			Title:    title,
+			Resolver: resolver,
+			MaxDepth: o.maxDepth,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to generate documentation of blueprint %s: %w", bp.name, err)
+		}
+
+		if len(o.outputDir) == 0 {
+			if i > 0 {
+				if _, err := fmt.Fprintln(out); err != nil {
+					return err
+				}
+			}
+			if err := doc.Write(out, blueprintdoc.Format(o.outputFormat)); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := o.writeFile(bp.name, doc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeFile writes the documentation of a blueprint into a file of the output directory.
+func (o *options) writeFile(name string, doc *blueprintdoc.Document) error {
+	if err := os.MkdirAll(o.outputDir, os.ModePerm); err != nil {
+		return fmt.Errorf("unable to create output directory %s: %w", o.outputDir, err)
+	}
+	extension := ".md"
+	if o.outputFormat == string(blueprintdoc.FormatHTML) {
+		extension = ".html"
+	}
+	file, err := os.Create(filepath.Join(o.outputDir, name+extension))
+	if err != nil {
+		return err
+	}
+	if err := doc.Write(file, blueprintdoc.Format(o.outputFormat)); err != nil {
+		_ = file.Close()
+		return fmt.Errorf("unable to write documentation of blueprint %s: %w", name, err)
+	}
+	return file.Close()
+}
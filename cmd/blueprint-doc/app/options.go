@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"fmt"
+
+	flag "github.com/spf13/pflag"
+
+	"github.com/gardener/landscaper/pkg/utils/blueprintdoc"
+)
+
+// options holds the options of the blueprint doc command
+type options struct {
+	blueprintDirs []string
+	outputFormat  string
+	outputDir     string
+	title         string
+	maxDepth      int
+
+	registryPath      string
+	componentName     string
+	componentVersion  string
+	blueprintResource string
+}
+
+// NewOptions returns a new options instance
+func NewOptions() *options {
+	return &options{}
+}
+
+// AddFlags adds flags passed via command line
+func (o *options) AddFlags(fs *flag.FlagSet) {
+	fs.StringVarP(&o.outputFormat, "output", "o", string(blueprintdoc.FormatMarkdown),
+		fmt.Sprintf("Output format, either %q or %q", blueprintdoc.FormatMarkdown, blueprintdoc.FormatHTML))
+	fs.StringVar(&o.outputDir, "output-dir", "", "Directory to which one file per blueprint is written. The documentation is written to stdout if not set")
+	fs.StringVar(&o.title, "title", "", "Title of the documentation. Defaults to the name of the blueprint directory or resource")
+	fs.IntVar(&o.maxDepth, "max-depth", blueprintdoc.DefaultMaxDepth, "Maximal depth of the documented subinstallation tree")
+	fs.StringVar(&o.registryPath, "registry-path", "", "Path to a local component registry that contains the component version of the blueprint")
+	fs.StringVar(&o.componentName, "component-name", "", "Name of the component version that contains the blueprint and its referenced blueprints")
+	fs.StringVar(&o.componentVersion, "component-version", "", "Version of the component version that contains the blueprint and its referenced blueprints")
+	fs.StringVar(&o.blueprintResource, "blueprint-resource", "blueprint",
+		"Name of the blueprint resource of the component version that is documented if no blueprint directory is given")
+}
+
+// Complete sets the blueprint directories from the arguments
+func (o *options) Complete(args []string) {
+	o.blueprintDirs = args
+}
+
+// Validate validates the options
+func (o *options) Validate() error {
+	if len(o.blueprintDirs) == 0 && len(o.componentName) == 0 {
+		return fmt.Errorf("at least one blueprint directory or a component version must be specified")
+	}
+	if o.outputFormat != string(blueprintdoc.FormatMarkdown) && o.outputFormat != string(blueprintdoc.FormatHTML) {
+		return fmt.Errorf("unknown output format %q, must be %q or %q", o.outputFormat, blueprintdoc.FormatMarkdown, blueprintdoc.FormatHTML)
+	}
+	if len(o.componentName) != 0 {
+		if len(o.registryPath) == 0 || len(o.componentVersion) == 0 {
+			return fmt.Errorf("the registry path and the component version must be specified together with the component name")
+		}
+	} else if len(o.registryPath) != 0 || len(o.componentVersion) != 0 {
+		return fmt.Errorf("the component name must be specified together with the registry path and the component version")
+	}
+	if len(o.title) != 0 && len(o.blueprintDirs) > 1 {
+		return fmt.Errorf("a title can only be specified for a single blueprint")
+	}
+	if o.maxDepth <= 0 {
+		return fmt.Errorf("the maximal depth must be greater than 0")
+	}
+	return nil
+}
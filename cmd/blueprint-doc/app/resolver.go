@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"ocm.software/ocm/api/datacontext"
+	"ocm.software/ocm/api/ocm"
+
+	"github.com/gardener/landscaper/apis/config"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/components/model"
+	"github.com/gardener/landscaper/pkg/components/model/types"
+	"github.com/gardener/landscaper/pkg/components/registries"
+	"github.com/gardener/landscaper/pkg/landscaper/registry/components/cdutils"
+	"github.com/gardener/landscaper/pkg/utils/blueprintdoc"
+	"github.com/gardener/landscaper/pkg/utils/blueprints"
+)
+
+// componentResolver resolves the blueprint references of subinstallations in the context of a component version.
+type componentResolver struct {
+	componentVersion  model.ComponentVersion
+	repositoryContext *types.UnstructuredTypedObject
+}
+
+var _ blueprintdoc.BlueprintResolver = &componentResolver{}
+
+// newComponentResolver returns a resolver for the component version of a local component registry.
+func newComponentResolver(ctx context.Context, registryPath, name, version string) (context.Context, *componentResolver, error) {
+	// the local registry is configured in the ocm context
+	ctx = ocm.New(datacontext.MODE_EXTENDED).BindTo(ctx)
+	registryAccess, err := registries.GetFactory().NewRegistryAccess(ctx, &model.RegistryAccessOptions{
+		LocalRegistryConfig: &config.LocalRegistryConfiguration{RootPath: registryPath},
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to create registry access for %s: %w", registryPath, err)
+	}
+
+	repositoryContext := &types.UnstructuredTypedObject{}
+	if err := repositoryContext.UnmarshalJSON([]byte(`{"type":"local"}`)); err != nil {
+		return nil, nil, err
+	}
+
+	componentVersion, err := registryAccess.GetComponentVersion(ctx, &lsv1alpha1.ComponentDescriptorReference{
+		RepositoryContext: repositoryContext,
+		ComponentName:     name,
+		Version:           version,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to get component version %s:%s: %w", name, version, err)
+	}
+	return ctx, &componentResolver{
+		componentVersion:  componentVersion,
+		repositoryContext: repositoryContext,
+	}, nil
+}
+
+// blueprint returns the blueprint of a resource of the component version.
+func (r *componentResolver) blueprint(ctx context.Context, resourceName string) (*blueprints.Blueprint, error) {
+	resource, err := r.componentVersion.GetResource(resourceName, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get resource %s: %w", resourceName, err)
+	}
+	return blueprintOfResource(ctx, resource)
+}
+
+// ResolveBlueprint resolves a blueprint reference like "cd://componentReferences/dns/resources/blueprint".
+// The returned resolver resolves references relative to the component version of the blueprint.
+func (r *componentResolver) ResolveBlueprint(ctx context.Context, ref string) (*blueprints.Blueprint, blueprintdoc.BlueprintResolver, error) {
+	uri, err := cdutils.ParseURI(ref)
+	if err != nil {
+		return nil, nil, err
+	}
+	componentVersion, resource, err := uri.GetResource(r.componentVersion, r.repositoryContext)
+	if err != nil {
+		return nil, nil, err
+	}
+	blueprint, err := blueprintOfResource(ctx, resource)
+	if err != nil {
+		return nil, nil, err
+	}
+	return blueprint, &componentResolver{
+		componentVersion:  componentVersion,
+		repositoryContext: r.repositoryContext,
+	}, nil
+}
+
+func blueprintOfResource(ctx context.Context, resource model.Resource) (*blueprints.Blueprint, error) {
+	content, err := resource.GetTypedContent(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get content of resource %s: %w", resource.GetName(), err)
+	}
+	blueprint, ok := content.Resource.(*blueprints.Blueprint)
+	if !ok {
+		return nil, fmt.Errorf("resource %s is of type %T but a blueprint is expected", resource.GetName(), content.Resource)
+	}
+	return blueprint, nil
+}
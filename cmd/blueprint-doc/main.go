@@ -0,0 +1,24 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/gardener/landscaper/cmd/blueprint-doc/app"
+)
+
+func main() {
+	ctx := context.Background()
+	defer ctx.Done()
+	cmd := app.NewBlueprintDocCommand(ctx)
+
+	if err := cmd.Execute(); err != nil {
+		fmt.Print(err)
+		os.Exit(1)
+	}
+}
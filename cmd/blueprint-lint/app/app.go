@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/mandelsoft/vfs/pkg/osfs"
+	"github.com/mandelsoft/vfs/pkg/projectionfs"
+	"github.com/spf13/cobra"
+
+	"github.com/gardener/landscaper/pkg/utils/blueprintlint"
+	"github.com/gardener/landscaper/pkg/utils/blueprints"
+)
+
+// NewBlueprintLintCommand creates a new command that checks blueprints for semantic issues
+func NewBlueprintLintCommand(ctx context.Context) *cobra.Command {
+	options := NewOptions()
+
+	cmd := &cobra.Command{
+		Use:   "blueprint-lint [blueprint directories]...",
+		Short: "Checks blueprints and their templates for semantic issues, like unused imports or exports that are never produced",
+		Example: `  blueprint-lint ./blueprint
+  blueprint-lint ./blueprint --fail-on warning
+  blueprint-lint ./blueprint -o json`,
+		SilenceUsage: true,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.Complete(args)
+			if err := options.Validate(); err != nil {
+				return err
+			}
+			return options.run(ctx, cmd.OutOrStdout())
+		},
+	}
+
+	options.AddFlags(cmd.Flags())
+
+	return cmd
+}
+
+// blueprintFindings contains the findings of a blueprint.
+type blueprintFindings struct {
+	Blueprint string                  `json:"blueprint"`
+	Findings  []blueprintlint.Finding `json:"findings"`
+}
+
+func (o *options) run(_ context.Context, out io.Writer) error {
+	results := make([]blueprintFindings, 0, len(o.blueprintDirs))
+	for _, dir := range o.blueprintDirs {
+		fs, err := projectionfs.New(osfs.New(), dir)
+		if err != nil {
+			return fmt.Errorf("unable to read blueprint from %s: %w", dir, err)
+		}
+		blueprint, err := blueprints.NewFromFs(fs)
+		if err != nil {
+			return fmt.Errorf("unable to read blueprint from %s: %w", dir, err)
+		}
+		findings, err := blueprintlint.Lint(blueprint)
+		if err != nil {
+			return fmt.Errorf("unable to lint blueprint %s: %w", dir, err)
+		}
+		results = append(results, blueprintFindings{Blueprint: dir, Findings: findings})
+	}
+
+	if err := o.write(out, results); err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, result := range results {
+		if blueprintlint.HasFindings(result.Findings, blueprintlint.Severity(o.failOn)) {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d blueprint(s) have findings with severity %q or higher", failed, len(results), o.failOn)
+	}
+	return nil
+}
+
+func (o *options) write(out io.Writer, results []blueprintFindings) error {
+	if o.outputFormat == OutputFormatJSON {
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(results)
+	}
+
+	for _, result := range results {
+		for _, finding := range result.Findings {
+			if _, err := fmt.Fprintf(out, "%s: %s\n", result.Blueprint, finding.String()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
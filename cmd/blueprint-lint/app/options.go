@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"fmt"
+
+	flag "github.com/spf13/pflag"
+
+	"github.com/gardener/landscaper/pkg/utils/blueprintlint"
+)
+
+const (
+	// OutputFormatText writes the findings in a human-readable format.
+	OutputFormatText = "text"
+	// OutputFormatJSON writes the findings as JSON.
+	OutputFormatJSON = "json"
+)
+
+// options holds the options of the blueprint lint command
+type options struct {
+	blueprintDirs []string
+	outputFormat  string
+	failOn        string
+}
+
+// NewOptions returns a new options instance
+func NewOptions() *options {
+	return &options{}
+}
+
+// AddFlags adds flags passed via command line
+func (o *options) AddFlags(fs *flag.FlagSet) {
+	fs.StringVarP(&o.outputFormat, "output", "o", OutputFormatText, fmt.Sprintf("Output format, either %q or %q", OutputFormatText, OutputFormatJSON))
+	fs.StringVar(&o.failOn, "fail-on", string(blueprintlint.SeverityError),
+		fmt.Sprintf("Minimal severity of findings that lets the command fail, either %q or %q", blueprintlint.SeverityError, blueprintlint.SeverityWarning))
+}
+
+// Complete sets the blueprint directories from the arguments
+func (o *options) Complete(args []string) {
+	o.blueprintDirs = args
+}
+
+// Validate validates the options
+func (o *options) Validate() error {
+	if len(o.blueprintDirs) == 0 {
+		return fmt.Errorf("at least one blueprint directory must be specified")
+	}
+	if o.outputFormat != OutputFormatText && o.outputFormat != OutputFormatJSON {
+		return fmt.Errorf("unknown output format %q, must be %q or %q", o.outputFormat, OutputFormatText, OutputFormatJSON)
+	}
+	if o.failOn != string(blueprintlint.SeverityError) && o.failOn != string(blueprintlint.SeverityWarning) {
+		return fmt.Errorf("unknown severity %q, must be %q or %q", o.failOn, blueprintlint.SeverityError, blueprintlint.SeverityWarning)
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/utils/blueprinttest"
+)
+
+// NewBlueprintTestCommand creates a new command that executes blueprint test cases
+func NewBlueprintTestCommand(ctx context.Context) *cobra.Command {
+	options := NewOptions()
+
+	cmd := &cobra.Command{
+		Use:   "blueprint-test [test case files or directories]...",
+		Short: "Renders blueprints with the imports of test cases and compares the deploy items, subinstallations and exports with golden files",
+		Example: `  blueprint-test ./tests
+  blueprint-test ./tests/default.yaml --update
+  blueprint-test ./tests -o json`,
+		SilenceUsage: true,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.Complete(args)
+			if err := options.Validate(); err != nil {
+				return err
+			}
+			return options.run(ctx, cmd.OutOrStdout())
+		},
+	}
+
+	options.AddFlags(cmd.Flags())
+
+	return cmd
+}
+
+func (o *options) run(ctx context.Context, out io.Writer) error {
+	testCases, err := blueprinttest.LoadTestCases(o.paths...)
+	if err != nil {
+		return err
+	}
+
+	// the logs of the rendering are not relevant for the results
+	ctx = logging.NewContext(ctx, logging.Discard())
+	results := blueprinttest.NewRunner().WithUpdate(o.update).RunAll(ctx, testCases)
+
+	if o.outputFormat == OutputFormatJSON {
+		err = blueprinttest.WriteResultsJSON(out, results)
+	} else {
+		err = blueprinttest.WriteResults(out, results)
+	}
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, result := range results {
+		if !result.Passed {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d test case(s) failed", failed, len(results))
+	}
+	return nil
+}
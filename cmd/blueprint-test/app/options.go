@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"fmt"
+
+	flag "github.com/spf13/pflag"
+)
+
+const (
+	// OutputFormatText writes the results in a human-readable format.
+	OutputFormatText = "text"
+	// OutputFormatJSON writes the results as JSON.
+	OutputFormatJSON = "json"
+)
+
+// options holds the options of the blueprint test command
+type options struct {
+	paths        []string
+	update       bool
+	outputFormat string
+}
+
+// NewOptions returns a new options instance
+func NewOptions() *options {
+	return &options{}
+}
+
+// AddFlags adds flags passed via command line
+func (o *options) AddFlags(fs *flag.FlagSet) {
+	fs.BoolVar(&o.update, "update", false, "Write the rendered results into the golden files instead of comparing them")
+	fs.StringVarP(&o.outputFormat, "output", "o", OutputFormatText, fmt.Sprintf("Output format, either %q or %q", OutputFormatText, OutputFormatJSON))
+}
+
+// Complete sets the test case files and directories from the arguments
+func (o *options) Complete(args []string) {
+	o.paths = args
+}
+
+// Validate validates the options
+func (o *options) Validate() error {
+	if len(o.paths) == 0 {
+		return fmt.Errorf("at least one test case file or directory must be specified")
+	}
+	if o.outputFormat != OutputFormatText && o.outputFormat != OutputFormatJSON {
+		return fmt.Errorf("unknown output format %q, must be %q or %q", o.outputFormat, OutputFormatText, OutputFormatJSON)
+	}
+	return nil
+}
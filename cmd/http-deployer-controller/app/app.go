@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	_ "net/http/pprof"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	httpctlr "github.com/gardener/landscaper/pkg/deployer/http"
+	"github.com/gardener/landscaper/pkg/utils"
+	"github.com/gardener/landscaper/pkg/version"
+)
+
+func NewHTTPDeployerControllerCommand(ctx context.Context) *cobra.Command {
+	options := NewOptions()
+
+	cmd := &cobra.Command{
+		Use:          "http-deployer",
+		Short:        fmt.Sprintf("HTTP Deployer is a controller that manages objects of http apis based on DeployItems of type %s", httpctlr.Type),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := options.Complete(); err != nil {
+				return err
+			}
+			return options.run(ctx)
+		},
+	}
+
+	options.AddFlags(cmd.Flags())
+
+	return cmd
+}
+
+func (o *options) run(ctx context.Context) error {
+	o.DeployerOptions.Log.Info("Starting HTTP Deployer", lc.KeyVersion, version.Get().GitVersion)
+
+	callerName := "http"
+	controllerName := "deployitem"
+
+	if err := httpctlr.AddDeployerToManager(
+		o.DeployerOptions.LsUncachedClient, o.DeployerOptions.LsCachedClient, o.DeployerOptions.HostUncachedClient, o.DeployerOptions.HostCachedClient,
+		o.DeployerOptions.FinishedObjectCache,
+		o.DeployerOptions.Log, o.DeployerOptions.LsMgr,
+		o.DeployerOptions.HostMgr, o.Config, callerName, controllerName); err != nil {
+		return fmt.Errorf("unable to setup http controller")
+	}
+
+	if os.Getenv("ENABLE_PROFILER") == "true" {
+		go func() {
+			o.DeployerOptions.Log.Info("Starting profiler for http deployer")
+			err := http.ListenAndServe("localhost:8081", nil)
+			o.DeployerOptions.Log.Error(err, "http deployer profiler stopped")
+		}()
+
+		go utils.LogMemStatsPeriodically(logging.NewContext(ctx, o.DeployerOptions.Log), 60*time.Second,
+			o.DeployerOptions.HostUncachedClient, "http-deployer")
+	}
+
+	o.DeployerOptions.Log.Info("Starting http deployer manager")
+	return o.DeployerOptions.StartManagers(ctx)
+}
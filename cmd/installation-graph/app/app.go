@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsinstall "github.com/gardener/landscaper/apis/core/install"
+	"github.com/gardener/landscaper/pkg/utils/dependencies"
+)
+
+// NewInstallationGraphCommand creates a new command that exports the installation graph of a namespace
+func NewInstallationGraphCommand(ctx context.Context) *cobra.Command {
+	options := NewOptions()
+
+	cmd := &cobra.Command{
+		Use:   "installation-graph",
+		Short: "Exports the installations of a namespace, their nesting and the data objects and targets flowing between them as Graphviz DOT or JSON",
+		Example: `  installation-graph -n my-namespace -i my-root-installation | dot -Tsvg > graph.svg
+  installation-graph -n my-namespace -o json`,
+		SilenceUsage: true,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := options.Validate(); err != nil {
+				return err
+			}
+			return options.run(ctx, cmd.OutOrStdout())
+		},
+	}
+
+	options.AddFlags(cmd.Flags())
+
+	return cmd
+}
+
+func (o *options) run(ctx context.Context, out io.Writer) error {
+	restConfig, err := o.restConfig()
+	if err != nil {
+		return err
+	}
+
+	scheme := runtime.NewScheme()
+	lsinstall.Install(scheme)
+	kubeClient, err := client.New(restConfig, client.Options{Scheme: scheme})
+	if err != nil {
+		return fmt.Errorf("unable to create kubernetes client: %w", err)
+	}
+
+	graph, err := dependencies.LoadInstallationGraph(ctx, kubeClient, o.namespace, o.installations...)
+	if err != nil {
+		return err
+	}
+
+	if o.outputFormat == OutputFormatJSON {
+		return graph.WriteJSON(out)
+	}
+	return graph.WriteDOT(out)
+}
+
+func (o *options) restConfig() (*rest.Config, error) {
+	if len(o.kubeconfigPath) == 0 {
+		loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+		return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, &clientcmd.ConfigOverrides{}).ClientConfig()
+	}
+
+	data, err := os.ReadFile(o.kubeconfigPath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read kubeconfig from %s: %w", o.kubeconfigPath, err)
+	}
+	restConfig, err := clientcmd.RESTConfigFromKubeConfig(data)
+	if err != nil {
+		return nil, fmt.Errorf("unable to build rest config from %s: %w", o.kubeconfigPath, err)
+	}
+	return restConfig, nil
+}
@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"fmt"
+
+	flag "github.com/spf13/pflag"
+)
+
+const (
+	// OutputFormatDOT renders the graph in the Graphviz DOT format.
+	OutputFormatDOT = "dot"
+	// OutputFormatJSON renders the graph as JSON.
+	OutputFormatJSON = "json"
+)
+
+// options holds the options of the installation graph command
+type options struct {
+	kubeconfigPath string
+	namespace      string
+	installations  []string
+	outputFormat   string
+}
+
+// NewOptions returns a new options instance
+func NewOptions() *options {
+	return &options{}
+}
+
+// AddFlags adds flags passed via command line
+func (o *options) AddFlags(fs *flag.FlagSet) {
+	fs.StringVar(&o.kubeconfigPath, "kubeconfig", "", "Path to the kubeconfig of the landscaper resource cluster. If not set, the default kubeconfig is used")
+	fs.StringVarP(&o.namespace, "namespace", "n", "default", "Namespace of the installations")
+	fs.StringSliceVarP(&o.installations, "installation", "i", nil, "Names of the root installations whose installation trees are exported. If not set, all root installations of the namespace are exported")
+	fs.StringVarP(&o.outputFormat, "output", "o", OutputFormatDOT, fmt.Sprintf("Output format, either %q or %q", OutputFormatDOT, OutputFormatJSON))
+}
+
+// Validate validates the options
+func (o *options) Validate() error {
+	if len(o.namespace) == 0 {
+		return fmt.Errorf("a namespace must be specified")
+	}
+	if o.outputFormat != OutputFormatDOT && o.outputFormat != OutputFormatJSON {
+		return fmt.Errorf("unknown output format %q, must be %q or %q", o.outputFormat, OutputFormatDOT, OutputFormatJSON)
+	}
+	return nil
+}
@@ -31,13 +31,17 @@ import (
 	deployitemctrl "github.com/gardener/landscaper/pkg/landscaper/controllers/deployitem"
 	executionactrl "github.com/gardener/landscaper/pkg/landscaper/controllers/execution"
 	"github.com/gardener/landscaper/pkg/landscaper/controllers/healthcheck"
+	"github.com/gardener/landscaper/pkg/landscaper/controllers/installationrevision"
 	installationsctrl "github.com/gardener/landscaper/pkg/landscaper/controllers/installations"
+	"github.com/gardener/landscaper/pkg/landscaper/controllers/landscapestatus"
 	"github.com/gardener/landscaper/pkg/landscaper/controllers/targetsync"
 	"github.com/gardener/landscaper/pkg/landscaper/crdmanager"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/plugin"
 	"github.com/gardener/landscaper/pkg/metrics"
 	lsutils "github.com/gardener/landscaper/pkg/utils"
 	"github.com/gardener/landscaper/pkg/utils/lock"
 	"github.com/gardener/landscaper/pkg/utils/monitoring"
+	"github.com/gardener/landscaper/pkg/utils/tracing"
 	"github.com/gardener/landscaper/pkg/version"
 )
 
@@ -76,6 +80,16 @@ func (o *Options) run(ctx context.Context) error {
 	}
 	_, _ = fmt.Fprintln(os.Stderr, string(configBytes))
 
+	shutdownTracing, err := tracing.Setup(logging.NewContext(ctx, setupLogger), "landscaper-controller")
+	if err != nil {
+		return fmt.Errorf("unable to setup tracing: %w", err)
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			setupLogger.Error(err, "unable to shutdown tracing")
+		}
+	}()
+
 	hostAndResourceClusterDifferent := len(o.landscaperKubeconfigPath) > 0
 
 	burst, qps := lsutils.GetHostClientRequestRestrictions(setupLogger, hostAndResourceClusterDifferent)
@@ -154,6 +168,10 @@ func (o *Options) startMainController(ctx context.Context,
 	lsUncachedClient, lsCachedClient, hostUncachedClient, hostCachedClient client.Client,
 	lsMgr, hostMgr manager.Manager, ctrlLogger, setupLogger logging.Logger) error {
 
+	if err := plugin.Validate(o.Config.TemplatePlugins); err != nil {
+		return fmt.Errorf("invalid template plugins: %w", err)
+	}
+
 	controllerName := "installation"
 	if err := installationsctrl.AddControllerToManager(controllerName,
 		lsUncachedClient, lsCachedClient, hostUncachedClient, hostCachedClient,
@@ -168,6 +186,17 @@ func (o *Options) startMainController(ctx context.Context,
 
 	eg, ctx := errgroup.WithContext(ctx)
 
+	if o.Config.Metrics != nil {
+		interval := time.Minute
+		if o.Config.Metrics.ObjectPhaseInterval != nil {
+			interval = o.Config.Metrics.ObjectPhaseInterval.Duration
+		}
+		eg.Go(func() error {
+			metrics.CollectObjectPhasesPeriodically(logging.NewContext(ctx, setupLogger), lsUncachedClient, interval)
+			return nil
+		})
+	}
+
 	if os.Getenv("ENABLE_PROFILER") == "true" {
 		eg.Go(func() error {
 			setupLogger.Info("Starting profiler for main landscaper")
@@ -225,6 +254,14 @@ func (o *Options) startCentralLandscaper(ctx context.Context,
 		return fmt.Errorf("unable to register target sync controller: %w", err)
 	}
 
+	if err := landscapestatus.AddControllerToManager(lsUncachedClient, ctrlLogger, lsMgr); err != nil {
+		return fmt.Errorf("unable to register landscape status controller: %w", err)
+	}
+
+	if err := installationrevision.AddControllerToManager(lsUncachedClient, ctrlLogger, lsMgr); err != nil {
+		return fmt.Errorf("unable to register installation revision controller: %w", err)
+	}
+
 	eg, ctx := errgroup.WithContext(ctx)
 
 	if os.Getenv("ENABLE_PROFILER") == "true" {
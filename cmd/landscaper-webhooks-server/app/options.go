@@ -68,6 +68,16 @@ var defaultWebhooks = webhooklib.NewWebhookRegistry().
 		Operations:    webhooklib.Operations(webhooklib.CREATE, webhooklib.UPDATE),
 		LabelSelector: landscaperSkipValidationSelector,
 		Process:       webhook.TargetWebhookLogic,
+	}).
+	Register(&webhooklib.Webhook{
+		Name:          "contexts",
+		Type:          webhooklib.ValidatingWebhook,
+		APIGroup:      core.GroupName,
+		APIVersions:   []string{"v1alpha1"},
+		ResourceName:  "contexts",
+		Operations:    webhooklib.Operations(webhooklib.CREATE, webhooklib.UPDATE),
+		LabelSelector: landscaperSkipValidationSelector,
+		Process:       webhook.ContextWebhookLogic,
 	})
 
 type options struct {
@@ -26,6 +26,8 @@ for pf in ${PLATFORMS//,/ }; do
 	docker buildx build --builder ${DOCKER_BUILDER_NAME} --load --build-arg EFFECTIVE_VERSION=${EFFECTIVE_VERSION} --platform ${pf} -t container-deployer-init:${EFFECTIVE_VERSION}-${os}-${arch} -f Dockerfile --target container-deployer-init "${PROJECT_ROOT}"
 	docker buildx build --builder ${DOCKER_BUILDER_NAME} --load --build-arg EFFECTIVE_VERSION=${EFFECTIVE_VERSION} --platform ${pf} -t container-deployer-wait:${EFFECTIVE_VERSION}-${os}-${arch} -f Dockerfile --target container-deployer-wait "${PROJECT_ROOT}"
 	docker buildx build --builder ${DOCKER_BUILDER_NAME} --load --build-arg EFFECTIVE_VERSION=${EFFECTIVE_VERSION} --platform ${pf} -t helm-deployer-controller:${EFFECTIVE_VERSION}-${os}-${arch} -f Dockerfile --target helm-deployer-controller "${PROJECT_ROOT}"
+	docker buildx build --builder ${DOCKER_BUILDER_NAME} --load --build-arg EFFECTIVE_VERSION=${EFFECTIVE_VERSION} --platform ${pf} -t http-deployer-controller:${EFFECTIVE_VERSION}-${os}-${arch} -f Dockerfile --target http-deployer-controller "${PROJECT_ROOT}"
+	docker buildx build --builder ${DOCKER_BUILDER_NAME} --load --build-arg EFFECTIVE_VERSION=${EFFECTIVE_VERSION} --platform ${pf} -t kustomize-deployer-controller:${EFFECTIVE_VERSION}-${os}-${arch} -f Dockerfile --target kustomize-deployer-controller "${PROJECT_ROOT}"
 	docker buildx build --builder ${DOCKER_BUILDER_NAME} --load --build-arg EFFECTIVE_VERSION=${EFFECTIVE_VERSION} --platform ${pf} -t manifest-deployer-controller:${EFFECTIVE_VERSION}-${os}-${arch} -f Dockerfile --target manifest-deployer-controller "${PROJECT_ROOT}"
 	docker buildx build --builder ${DOCKER_BUILDER_NAME} --load --build-arg EFFECTIVE_VERSION=${EFFECTIVE_VERSION} --platform ${pf} -t mock-deployer-controller:${EFFECTIVE_VERSION}-${os}-${arch} -f Dockerfile --target mock-deployer-controller "${PROJECT_ROOT}"
 done
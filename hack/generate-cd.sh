@@ -36,6 +36,8 @@ LANDSCAPER_CONTROLLER_DEPLOYMENT_CHART_PATH="${PROJECT_ROOT}/charts/landscaper/c
 LANDSCAPER_AGENT_CHART_PATH="${PROJECT_ROOT}/charts/landscaper-agent"
 HELM_DEPLOYER_CHART_PATH="${PROJECT_ROOT}/charts/helm-deployer"
 MANIFEST_DEPLOYER_CHART_PATH="${PROJECT_ROOT}/charts/manifest-deployer"
+KUSTOMIZE_DEPLOYER_CHART_PATH="${PROJECT_ROOT}/charts/kustomize-deployer"
+HTTP_DEPLOYER_CHART_PATH="${PROJECT_ROOT}/charts/http-deployer"
 CONTAINER_DEPLOYER_CHART_PATH="${PROJECT_ROOT}/charts/container-deployer"
 MOCK_DEPLOYER_CHART_PATH="${PROJECT_ROOT}/charts/mock-deployer"
 
@@ -49,6 +51,8 @@ MOCK_DEPLOYER_CHART_PATH="${PROJECT_ROOT}/charts/mock-deployer"
      LANDSCAPER_AGENT_CHART_PATH=${LANDSCAPER_AGENT_CHART_PATH} \
      HELM_DEPLOYER_CHART_PATH=${HELM_DEPLOYER_CHART_PATH} \
      MANIFEST_DEPLOYER_CHART_PATH=${MANIFEST_DEPLOYER_CHART_PATH} \
+     KUSTOMIZE_DEPLOYER_CHART_PATH=${KUSTOMIZE_DEPLOYER_CHART_PATH} \
+     HTTP_DEPLOYER_CHART_PATH=${HTTP_DEPLOYER_CHART_PATH} \
      CONTAINER_DEPLOYER_CHART_PATH=${CONTAINER_DEPLOYER_CHART_PATH} \
      MOCK_DEPLOYER_CHART_PATH=${MOCK_DEPLOYER_CHART_PATH}
 
@@ -64,6 +68,12 @@ echo "> Remote Component Version Helm Deployer"
 echo "> Remote Component Version Manifest Deployer"
 "$OCM" get componentversion --repo OCIRegistry::${PROVIDER} "github.com/gardener/landscaper/manifest-deployer:${EFFECTIVE_VERSION}" -o yaml
 
+echo "> Remote Component Version Kustomize Deployer"
+"$OCM" get componentversion --repo OCIRegistry::${PROVIDER} "github.com/gardener/landscaper/kustomize-deployer:${EFFECTIVE_VERSION}" -o yaml
+
+echo "> Remote Component Version HTTP Deployer"
+"$OCM" get componentversion --repo OCIRegistry::${PROVIDER} "github.com/gardener/landscaper/http-deployer:${EFFECTIVE_VERSION}" -o yaml
+
 echo "> Remote Component Version Container Deployer"
 "$OCM" get componentversion --repo OCIRegistry::${PROVIDER} "github.com/gardener/landscaper/container-deployer:${EFFECTIVE_VERSION}" -o yaml
 
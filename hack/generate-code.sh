@@ -62,6 +62,8 @@ kube::codegen::gen_helpers "$PROJECT_ROOT" \
    --extra-pkgs "$API_MODULE_PATH/deployer/utils/managedresource" \
    --extra-pkgs "$API_MODULE_PATH/deployer/utils/continuousreconcile" \
    --extra-pkgs "$API_MODULE_PATH/deployer/helm/v1alpha1" \
+   --extra-pkgs "$API_MODULE_PATH/deployer/http/v1alpha1" \
+   --extra-pkgs "$API_MODULE_PATH/deployer/kustomize/v1alpha1" \
    --extra-pkgs "$API_MODULE_PATH/deployer/manifest/v1alpha1" \
    --extra-pkgs "$API_MODULE_PATH/deployer/manifest/v1alpha2" \
    --extra-pkgs "$API_MODULE_PATH/deployer/container/v1alpha1" \
@@ -13,6 +13,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	containerv1alpha1 "github.com/gardener/landscaper/apis/deployer/container/v1alpha1"
+	container1alpha1validation "github.com/gardener/landscaper/apis/deployer/container/v1alpha1/validation"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	deployerlib "github.com/gardener/landscaper/pkg/deployer/lib"
 	"github.com/gardener/landscaper/pkg/utils"
@@ -26,6 +27,10 @@ func AddControllerToManager(lsUncachedClient, lsCachedClient, hostUncachedClient
 	callerName, controllerName string) (*GarbageCollector, error) {
 	log := logger.WithName("container")
 
+	if err := container1alpha1validation.ValidateConfiguration(&config); err != nil {
+		return nil, fmt.Errorf("invalid container deployer configuration: %w", err)
+	}
+
 	lockingEnabled := config.HPAConfiguration != nil && config.HPAConfiguration.MaxReplicas > 1
 
 	log.Info(fmt.Sprintf("Running on pod %s in namespace %s", utils.GetCurrentPodName(), utils.GetCurrentPodNamespace()),
@@ -11,9 +11,11 @@ import (
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
@@ -45,6 +47,33 @@ func CleanupPod(ctx context.Context, hostClient client.Client, pod *corev1.Pod,
 	return nil
 }
 
+// CleanupJob cleans up a job that was started with the container deployer.
+// The pods of the job are deleted together with the job.
+// If the job is kept, it is deleted by the cluster after the given ttl.
+// The ttl is only set now and not when the job is created, as the job and its pods could otherwise be deleted
+// before the deployer has collected the result.
+func CleanupJob(ctx context.Context, hostClient client.Client, job *batchv1.Job, keepJob bool, ttlSecondsAfterFinished *int32) error {
+	// only remove the finalizer if we get the status of the job
+	controllerutil.RemoveFinalizer(job, container.ContainerDeployerFinalizer)
+	if keepJob {
+		job.Spec.TTLSecondsAfterFinished = ttlSecondsAfterFinished
+	}
+	if err := hostClient.Update(ctx, job); err != nil {
+		err = fmt.Errorf("unable to remove finalizer from job: %w", err)
+		return lserrors.NewWrappedError(err,
+			"CleanupJob", "RemoveFinalizer", err.Error())
+	}
+	if keepJob {
+		return nil
+	}
+	if err := hostClient.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil && !apierrors.IsNotFound(err) {
+		err = fmt.Errorf("unable to delete job: %w", err)
+		return lserrors.NewWrappedError(err,
+			"CleanupJob", "DeleteJob", err.Error())
+	}
+	return nil
+}
+
 // CleanupRBAC removes all service accounts, roles and rolebindings that belong to the deploy item
 func CleanupRBAC(ctx context.Context, deployItem *lsv1alpha1.DeployItem, hostClient client.Client, hostNamespace string) error {
 	log := logging.FromContextOrDiscard(ctx)
@@ -79,6 +79,10 @@ func New(lsUncachedClient, lsCachedClient, hostUncachedClient, hostCachedClient
 		return nil, lserrors.NewWrappedError(err,
 			currOp, "ValidateProviderConfiguration", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
 	}
+	if err := container1alpha1validation.ValidateHostAccess(providerConfig, config.HostAccess); err != nil {
+		return nil, lserrors.NewWrappedError(err,
+			currOp, "ValidateHostAccess", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+	}
 
 	status, err := DecodeProviderStatus(item.Status.ProviderStatus)
 	if err != nil {
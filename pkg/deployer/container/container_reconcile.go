@@ -22,6 +22,7 @@ import (
 	"github.com/docker/cli/cli/config/types"
 	"github.com/mandelsoft/vfs/pkg/osfs"
 	"github.com/mandelsoft/vfs/pkg/vfs"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/utils/ptr"
@@ -56,7 +57,7 @@ func (c *Container) Reconcile(ctx context.Context, operation container.Operation
 		return err
 	}
 
-	pod, err := c.getPod(ctx)
+	exec, err := c.getExecution(ctx)
 	logger := logging.FromContextOrDiscard(ctx)
 	if err != nil && !apierrors.IsNotFound(err) {
 		return lserrors.NewWrappedError(err,
@@ -66,31 +67,34 @@ func (c *Container) Reconcile(ctx context.Context, operation container.Operation
 	lsWriter := read_write_layer.NewWriter(c.lsUncachedClient)
 
 	// do nothing if the pod is still running
-	if pod != nil {
-		if pod.Status.Phase == corev1.PodPending || pod.Status.Phase == corev1.PodRunning || pod.Status.Phase == corev1.PodUnknown {
-			if err := c.collectAndSetPodStatus(pod, false); err != nil {
+	if exec != nil {
+		if exec.isRunning() {
+			if err := c.collectAndSetPodStatus(exec, false); err != nil {
 				return lserrors.NewWrappedError(err,
 					"Reconcile", "UpdatePodStatus", err.Error())
 			}
-			// check if pod is in error state
-			if err := podIsInErrorState(pod); err != nil {
-				lsv1alpha1helper.SetDeployItemToFailed(c.DeployItem)
-				if err := lsWriter.UpdateDeployItemStatus(ctx, read_write_layer.W000055, c.DeployItem); err != nil {
-					return err // returns the error and retry
-				}
-
-				// only cleanup the pod if the error messages could be collected
-				if err := c.CleanupPod(ctx, pod); err != nil {
+			// check if pod is in error state.
+			// The pods of a job are checked as well, as image errors are not resolved by the retries of the job controller.
+			if exec.pod != nil {
+				if err := podIsInErrorState(exec.pod); err != nil {
+					lsv1alpha1helper.SetDeployItemToFailed(c.DeployItem)
+					if err := lsWriter.UpdateDeployItemStatus(ctx, read_write_layer.W000055, c.DeployItem); err != nil {
+						return err // returns the error and retry
+					}
+
+					// only cleanup the pod if the error messages could be collected
+					if err := c.cleanupExecution(ctx, exec); err != nil {
+						return err
+					}
 					return err
 				}
-				return err
 			}
 			c.DeployItem.Status.Phase = lsv1alpha1.DeployItemPhases.Progressing
 			return nil
 		}
 	}
 
-	if c.shouldRunNewPod(ctx, pod) {
+	if c.shouldRunNewPod(ctx, exec) {
 		operationName := "DeployPod"
 
 		// before we start syncing lets read the current deploy item from the server
@@ -157,20 +161,20 @@ func (c *Container) Reconcile(ctx context.Context, operation container.Operation
 			Operation: operation,
 			Debug:     true,
 		}
-		pod, err := generatePod(podOpts)
+		exec, err := c.generateExecution(podOpts)
 		if err != nil {
 			return lserrors.NewWrappedError(err,
 				operationName, "PodGeneration", err.Error())
 		}
 
-		if err := c.hostUncachedClient.Create(ctx, pod); err != nil {
+		if err := c.hostUncachedClient.Create(ctx, exec.object); err != nil {
 			return lserrors.NewWrappedError(err,
 				operationName, "CreatePod", err.Error())
 		}
 
 		// update status
 		c.ProviderStatus.LastOperation = string(operation)
-		if err := c.collectAndSetPodStatus(pod, false); err != nil {
+		if err := c.collectAndSetPodStatus(exec, false); err != nil {
 			return lserrors.NewWrappedError(err,
 				operationName, "UpdatePodStatus", err.Error())
 		}
@@ -194,19 +198,19 @@ func (c *Container) Reconcile(ctx context.Context, operation container.Operation
 	}
 
 	operationName := "Complete"
-	if pod != nil {
-		podSucceeded := pod.Status.Phase == corev1.PodSucceeded
+	if exec != nil {
+		podSucceeded := exec.phase == corev1.PodSucceeded
 		if podSucceeded {
 			if err := c.SyncExport(ctx); err != nil {
 				return lserrors.NewWrappedError(err,
 					operationName, "SyncExport", err.Error())
 			}
-		} else if pod.Status.Phase == corev1.PodFailed {
+		} else if exec.phase == corev1.PodFailed {
 			lsv1alpha1helper.SetDeployItemToFailed(c.DeployItem)
 		}
 
 		c.ProviderStatus.LastOperation = string(operation)
-		if err := c.collectAndSetPodStatus(pod, podSucceeded); err != nil {
+		if err := c.collectAndSetPodStatus(exec, podSucceeded); err != nil {
 			return lserrors.NewWrappedError(err,
 				"Reconcile", "UpdatePodStatus", err.Error())
 		}
@@ -217,8 +221,8 @@ func (c *Container) Reconcile(ctx context.Context, operation container.Operation
 		}
 
 		// only remove the finalizer if we get the status of the pod
-		logger.Debug("Deleting pod, as it has finished", "podStatus", pod.Status.Phase)
-		if err := c.CleanupPod(ctx, pod); err != nil {
+		logger.Debug("Deleting pod, as it has finished", "podStatus", exec.phase)
+		if err := c.cleanupExecution(ctx, exec); err != nil {
 			return err
 		}
 	}
@@ -230,7 +234,13 @@ func (c *Container) Reconcile(ctx context.Context, operation container.Operation
 }
 
 // collectAndSetPodStatus the pod status and updates the container provider status
-func (c *Container) collectAndSetPodStatus(pod *corev1.Pod, updateLastSuccessfulJobID bool) error {
+func (c *Container) collectAndSetPodStatus(exec *execution, updateLastSuccessfulJobID bool) error {
+	pod := exec.pod
+	if pod == nil {
+		// the job controller has not yet created the pod of the job
+		pod = &corev1.Pod{}
+		pod.CreationTimestamp = exec.object.GetCreationTimestamp()
+	}
 	c.DeployItem.Status.Conditions = setConditionsFromPod(pod, c.DeployItem.Status.Conditions)
 	var jobID *string
 	if updateLastSuccessfulJobID {
@@ -239,6 +249,9 @@ func (c *Container) collectAndSetPodStatus(pod *corev1.Pod, updateLastSuccessful
 	if err := setStatusFromPod(pod, c.ProviderStatus, jobID); err != nil {
 		return err
 	}
+	if _, ok := exec.object.(*batchv1.Job); ok {
+		c.ProviderStatus.PodStatus.JobName = exec.object.GetName()
+	}
 
 	encStatus, err := kutil.ConvertToRawExtension(c.ProviderStatus, Scheme)
 	if err != nil {
@@ -248,12 +261,12 @@ func (c *Container) collectAndSetPodStatus(pod *corev1.Pod, updateLastSuccessful
 	return nil
 }
 
-func (c *Container) shouldRunNewPod(ctx context.Context, pod *corev1.Pod) bool {
+func (c *Container) shouldRunNewPod(ctx context.Context, exec *execution) bool {
 	// if there is already a pod we need to be sure that the current observed generation is not already run.
 	genString := ""
-	if pod != nil {
+	if exec != nil {
 		ok := false
-		if genString, ok = pod.Labels[container.ContainerDeployerDeployItemGenerationLabel]; ok {
+		if genString, ok = exec.object.GetLabels()[container.ContainerDeployerDeployItemGenerationLabel]; ok {
 			gen, err := strconv.Atoi(genString)
 			if err == nil {
 				if int64(gen) == c.DeployItem.Generation {
@@ -272,7 +285,7 @@ func (c *Container) shouldRunNewPod(ctx context.Context, pod *corev1.Pod) bool {
 		if c.ProviderStatus != nil && c.ProviderStatus.PodStatus != nil {
 			lsji = c.ProviderStatus.PodStatus.LastSuccessfulJobID
 		}
-		logger.Debug("newRootLogger pod required", "podExists", pod != nil, "podGenerationLabel", genString, lc.KeyDeployItemPhase, c.DeployItem.Status.Phase, "podStatusLastSuccessfulJobID", lsji)
+		logger.Debug("newRootLogger pod required", "podExists", exec != nil, "podGenerationLabel", genString, lc.KeyDeployItemPhase, c.DeployItem.Status.Phase, "podStatusLastSuccessfulJobID", lsji)
 		return true
 	}
 	return false
@@ -285,6 +298,10 @@ func setStatusFromPod(pod *corev1.Pod, providerStatus *containerv1alpha1.Provide
 	}
 	if providerStatus.PodStatus != nil {
 		podStatus = providerStatus.PodStatus
+		// the pod of a job changes if a failed pod is retried
+		if len(pod.Name) != 0 {
+			podStatus.PodName = pod.Name
+		}
 	}
 
 	if currentJobID != nil {
@@ -753,3 +770,12 @@ func (c *Container) SyncExport(ctx context.Context) error {
 func (c *Container) CleanupPod(ctx context.Context, pod *corev1.Pod) error {
 	return CleanupPod(ctx, c.hostUncachedClient, pod, c.Configuration.DebugOptions != nil && c.Configuration.DebugOptions.KeepPod)
 }
+
+// CleanupJob cleans up a job that was started with the container deployer.
+func (c *Container) CleanupJob(ctx context.Context, job *batchv1.Job) error {
+	var ttl *int32
+	if c.Configuration.Execution.Job != nil {
+		ttl = c.Configuration.Execution.Job.TTLSecondsAfterFinished
+	}
+	return CleanupJob(ctx, c.hostUncachedClient, job, c.Configuration.DebugOptions != nil && c.Configuration.DebugOptions.KeepPod, ttl)
+}
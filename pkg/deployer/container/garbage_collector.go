@@ -13,9 +13,11 @@ import (
 
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -149,6 +151,19 @@ func (gc *GarbageCollector) Cleanup(ctx context.Context) {
 				logger.Error(err, "cleanup pod", lc.KeyResource, kutil.ObjectKeyFromObject(next).String())
 			}
 		}
+
+		// cleanup jobs
+		jobList := &batchv1.JobList{}
+		if err := read_write_layer.ListJobs(ctx, gc.hostUncachedClient, jobList, read_write_layer.R000128, listOptions...); err != nil {
+			logger.Error(err, err.Error())
+		}
+
+		for i := range jobList.Items {
+			next := &jobList.Items[i]
+			if err := gc.cleanupJob(ctx, next); err != nil {
+				logger.Error(err, "cleanup job", lc.KeyResource, kutil.ObjectKeyFromObject(next).String())
+			}
+		}
 	}
 }
 
@@ -193,6 +208,10 @@ func (gc *GarbageCollector) cleanupPod(ctx context.Context, obj *corev1.Pod) err
 		logger.Debug("Not garbage collected", lc.KeyReason, "pod is still running", lc.KeyPhase, obj.Status.Phase)
 		return nil
 	}
+	if isJobPod(obj) {
+		logger.Debug("Not garbage collected", lc.KeyReason, "pod is deleted together with its job")
+		return nil
+	}
 
 	shouldGC, err := gc.shouldGarbageCollect(ctx, obj)
 	if err != nil {
@@ -277,6 +296,67 @@ func (gc *GarbageCollector) isLatestPod(ctx context.Context, pod *corev1.Pod) (b
 	return latest.Name == pod.Name, nil // namespace is irrelevant
 }
 
+// cleanupJob deletes jobs that do not have a parent deploy item anymore.
+func (gc *GarbageCollector) cleanupJob(ctx context.Context, obj *batchv1.Job) error {
+	logger, _ := logging.FromContextOrNew(ctx, nil)
+	if _, finished := jobFinished(obj); !finished {
+		logger.Debug("Not garbage collected", lc.KeyReason, "job is still running")
+		return nil
+	}
+
+	shouldGC, err := gc.shouldGarbageCollect(ctx, obj)
+	if err != nil {
+		return err
+	}
+	if shouldGC {
+		// always garbage collect jobs that do not have a corresponding deployitem anymore
+		logger.Debug("Garbage collected", lc.KeyReason, "deploy item does not exist anymore")
+		if err := CleanupJob(ctx, gc.hostUncachedClient, obj, false, nil); err != nil {
+			return fmt.Errorf("unable to garbage collect job %s: %w", kutil.ObjectKeyFromObject(obj).String(), err)
+		}
+		return nil
+	}
+
+	if !controllerutil.ContainsFinalizer(obj, container.ContainerDeployerFinalizer) {
+		logger.Debug("Garbage collected", lc.KeyReason, "job has no finalizer")
+		return client.IgnoreNotFound(gc.hostUncachedClient.Delete(ctx, obj, client.PropagationPolicy(metav1.DeletePropagationBackground)))
+	}
+
+	isLatest, err := gc.isLatestJob(ctx, obj)
+	if err != nil {
+		return err
+	}
+	if isLatest {
+		logger.Debug("Not garbage collected", lc.KeyReason, "latest job")
+		return nil
+	}
+
+	if err := CleanupJob(ctx, gc.hostUncachedClient, obj, false, nil); err != nil {
+		return fmt.Errorf("unable to garbage collect job %s: %w", kutil.ObjectKeyFromObject(obj).String(), err)
+	}
+	logger.Debug("Garbage collected")
+	return nil
+}
+
+// isLatestJob returns if the current job is the latest executed job.
+func (gc *GarbageCollector) isLatestJob(ctx context.Context, job *batchv1.Job) (bool, error) {
+	jobList := &batchv1.JobList{}
+	if err := read_write_layer.ListJobs(ctx, gc.hostUncachedClient, jobList, read_write_layer.R000129,
+		client.InNamespace(gc.hostNamespace),
+		client.MatchingLabels{
+			container.ContainerDeployerDeployItemNameLabel:      job.Labels[container.ContainerDeployerDeployItemNameLabel],
+			container.ContainerDeployerDeployItemNamespaceLabel: job.Labels[container.ContainerDeployerDeployItemNamespaceLabel],
+		}); err != nil {
+		return false, err
+	}
+
+	latest := latestJob(jobList.Items)
+	if latest == nil {
+		return false, nil
+	}
+	return latest.Name == job.Name, nil // namespace is irrelevant
+}
+
 // shouldGarbageCollect checks whether the object should be garbage collected.
 // By default, an object should be garbage collected if the corresponding deploy item has been deleted.
 func (gc *GarbageCollector) shouldGarbageCollect(ctx context.Context, obj client.Object) (bool, error) {
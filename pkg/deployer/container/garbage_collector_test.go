@@ -14,12 +14,15 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	errors2 "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
@@ -418,4 +421,116 @@ var _ = Describe("GarbageCollector", func() {
 			Expect(hostTestEnv.Client.Get(ctx, kutil.ObjectKeyFromObject(pod), &corev1.Pod{})).ToNot(Succeed())
 		})
 	})
+
+	Context("Jobs", func() {
+
+		var defaultJob = func(namespace, name string) *batchv1.Job {
+			job := &batchv1.Job{}
+			job.Name = name
+			job.Namespace = namespace
+			job.Spec.Template.Spec.RestartPolicy = corev1.RestartPolicyNever
+			job.Spec.Template.Spec.Containers = []corev1.Container{
+				{
+					Name:  "test",
+					Image: "ubuntu",
+				},
+			}
+			return job
+		}
+
+		var completeJob = func(job *batchv1.Job) {
+			now := metav1.Now()
+			job.Status.StartTime = &now
+			job.Status.CompletionTime = &now
+			job.Status.Succeeded = 1
+			job.Status.Conditions = []batchv1.JobCondition{
+				{
+					Type:   batchv1.JobComplete,
+					Status: corev1.ConditionTrue,
+				},
+			}
+			Expect(hostTestEnv.Client.Status().Update(ctx, job)).To(Succeed())
+		}
+
+		It("should garbage collect finished jobs that have no corresponding deployitem", func() {
+			job := defaultJob(hostState.Namespace, "test")
+			job.Finalizers = []string{container.ContainerDeployerFinalizer}
+			containerctlr.InjectDefaultLabels(job, containerctlr.DefaultLabels("test", "a", "not", lsState.Namespace))
+			Expect(hostState.Create(ctx, job)).To(Succeed())
+			completeJob(job)
+
+			gc.Cleanup(ctx)
+			Eventually(func() error {
+				err := hostTestEnv.Client.Get(ctx, kutil.ObjectKeyFromObject(job), &batchv1.Job{})
+				if err != nil {
+					if apierrors.IsNotFound(err) {
+						return nil
+					}
+					return err
+				}
+				return errors.New("still exists")
+			}, 10*time.Second, 1*time.Second).Should(Succeed(), "job should be deleted")
+		})
+
+		It("should not garbage collect a job that is still running", func() {
+			job := defaultJob(hostState.Namespace, "test")
+			containerctlr.InjectDefaultLabels(job, containerctlr.DefaultLabels("test", "a", "not", lsState.Namespace))
+			Expect(hostState.Create(ctx, job)).To(Succeed())
+
+			gc.Cleanup(ctx)
+			Expect(hostTestEnv.Client.Get(ctx, kutil.ObjectKeyFromObject(job), &batchv1.Job{})).To(Succeed())
+		})
+
+		It("should only garbage collect finished jobs of a deployitem that are not the latest", func() {
+			di := &lsv1alpha1.DeployItem{}
+			di.Name = "not"
+			di.Namespace = lsState.Namespace
+			Expect(lsState.Create(ctx, di)).To(Succeed())
+
+			job := defaultJob(hostState.Namespace, "test")
+			job.Finalizers = []string{container.ContainerDeployerFinalizer}
+			containerctlr.InjectDefaultLabels(job, containerctlr.DefaultLabels("test", "a", di.Name, di.Namespace))
+			Expect(hostState.Create(ctx, job)).To(Succeed())
+			completeJob(job)
+			time.Sleep(1 * time.Second) // we need to get a different creation time for job 2
+
+			job2 := defaultJob(hostState.Namespace, "test2")
+			job2.Finalizers = []string{container.ContainerDeployerFinalizer}
+			containerctlr.InjectDefaultLabels(job2, containerctlr.DefaultLabels("test", "a", di.Name, di.Namespace))
+			Expect(hostState.Create(ctx, job2)).To(Succeed())
+			completeJob(job2)
+
+			gc.Cleanup(ctx)
+			Eventually(func() error {
+				err := hostTestEnv.Client.Get(ctx, kutil.ObjectKeyFromObject(job), &batchv1.Job{})
+				if err != nil {
+					if apierrors.IsNotFound(err) {
+						return nil
+					}
+					return err
+				}
+				return errors.New("still exists")
+			}, 10*time.Second, 1*time.Second).Should(Succeed(), "job should be deleted")
+			Expect(hostTestEnv.Client.Get(ctx, kutil.ObjectKeyFromObject(job2), &batchv1.Job{})).To(Succeed())
+		})
+
+		It("should not garbage collect pods of a job", func() {
+			job := defaultJob(hostState.Namespace, "test")
+			containerctlr.InjectDefaultLabels(job, containerctlr.DefaultLabels("test", "a", "not", lsState.Namespace))
+			Expect(hostState.Create(ctx, job)).To(Succeed())
+
+			pod := &corev1.Pod{}
+			pod.Name = "test"
+			pod.Namespace = hostState.Namespace
+			pod.Spec = job.Spec.Template.Spec
+			containerctlr.InjectDefaultLabels(pod, containerctlr.DefaultLabels("test", "a", "not", lsState.Namespace))
+			Expect(controllerutil.SetControllerReference(job, pod, scheme.Scheme)).To(Succeed())
+			Expect(hostState.Create(ctx, pod)).To(Succeed())
+			pod.Status.Phase = corev1.PodSucceeded
+			Expect(hostTestEnv.Client.Status().Update(ctx, pod)).To(Succeed())
+
+			gc.Cleanup(ctx)
+			Expect(hostTestEnv.Client.Get(ctx, kutil.ObjectKeyFromObject(pod), &corev1.Pod{})).To(Succeed())
+		})
+	})
 })
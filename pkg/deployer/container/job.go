@@ -0,0 +1,234 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"context"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/gardener/landscaper/apis/deployer/container"
+	containerv1alpha1 "github.com/gardener/landscaper/apis/deployer/container/v1alpha1"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// execution is a run of the containers of a deploy item.
+// Depending on the execution mode, the containers are executed in a bare pod or in a pod that is managed by a job.
+type execution struct {
+	// object is the pod or the job that executes the containers.
+	object client.Object
+	// pod is the pod that executes the containers.
+	// For jobs, it is the latest pod of the job, which is nil as long as the job controller has not created it.
+	pod *corev1.Pod
+	// phase is the phase of the execution.
+	// The phase of a job is derived from its conditions.
+	phase corev1.PodPhase
+}
+
+func newPodExecution(pod *corev1.Pod) *execution {
+	return &execution{
+		object: pod,
+		pod:    pod,
+		phase:  pod.Status.Phase,
+	}
+}
+
+func newJobExecution(job *batchv1.Job, pod *corev1.Pod) *execution {
+	exec := &execution{
+		object: job,
+		pod:    pod,
+		phase:  corev1.PodRunning,
+	}
+	if pod == nil {
+		exec.phase = corev1.PodPending
+	}
+	if succeeded, finished := jobFinished(job); finished {
+		exec.phase = corev1.PodFailed
+		if succeeded {
+			exec.phase = corev1.PodSucceeded
+		}
+	}
+	return exec
+}
+
+// isRunning returns whether the execution has not yet finished.
+func (e *execution) isRunning() bool {
+	return e.phase == corev1.PodPending || e.phase == corev1.PodRunning || e.phase == corev1.PodUnknown
+}
+
+// isJobMode returns whether the containers are executed in jobs.
+func (c *Container) isJobMode() bool {
+	return c.Configuration.Execution.Mode == containerv1alpha1.ExecutionModeJob
+}
+
+// getExecution returns the latest execution of the deploy item.
+func (c *Container) getExecution(ctx context.Context) (*execution, error) {
+	if !c.isJobMode() {
+		pod, err := c.getPod(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return newPodExecution(pod), nil
+	}
+
+	job, err := c.getJob(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pod, err := c.getJobPod(ctx, job)
+	if err != nil {
+		return nil, err
+	}
+	return newJobExecution(job, pod), nil
+}
+
+// generateExecution generates the pod or the job that executes the containers.
+func (c *Container) generateExecution(opts PodOptions) (*execution, error) {
+	if !c.isJobMode() {
+		pod, err := generatePod(opts)
+		if err != nil {
+			return nil, err
+		}
+		return newPodExecution(pod), nil
+	}
+
+	job, err := generateJob(opts, c.Configuration.Execution.Job)
+	if err != nil {
+		return nil, err
+	}
+	return newJobExecution(job, nil), nil
+}
+
+// cleanupExecution removes the finalizer of the pod or job and deletes it.
+func (c *Container) cleanupExecution(ctx context.Context, exec *execution) error {
+	if job, ok := exec.object.(*batchv1.Job); ok {
+		return c.CleanupJob(ctx, job)
+	}
+	return c.CleanupPod(ctx, exec.pod)
+}
+
+// generateJob generates a job that runs the pod of the given options.
+// The finalizer of the pod is set on the job, so that the pods can be removed by the job controller.
+func generateJob(opts PodOptions, config *containerv1alpha1.JobConfiguration) (*batchv1.Job, error) {
+	pod, err := generatePod(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	job := &batchv1.Job{}
+	job.GenerateName = pod.GenerateName
+	job.Namespace = pod.Namespace
+	job.Labels = pod.Labels
+	job.Finalizers = pod.Finalizers
+
+	job.Spec.Template.Labels = make(map[string]string, len(pod.Labels))
+	for k, v := range pod.Labels {
+		job.Spec.Template.Labels[k] = v
+	}
+	job.Spec.Template.Spec = pod.Spec
+	if config != nil {
+		job.Spec.BackoffLimit = config.BackoffLimit
+		job.Spec.ActiveDeadlineSeconds = config.ActiveDeadlineSeconds
+		// the ttl is set when the job is released after its result has been collected, see CleanupJob
+	}
+	return job, nil
+}
+
+// jobFinished returns whether the job has finished and whether it has succeeded.
+func jobFinished(job *batchv1.Job) (succeeded, finished bool) {
+	for _, cond := range job.Status.Conditions {
+		if cond.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch cond.Type {
+		case batchv1.JobComplete:
+			return true, true
+		case batchv1.JobFailed:
+			return false, true
+		}
+	}
+	return false, false
+}
+
+// getJob returns the latest executed job.
+// Jobs that have no finalizer are ignored.
+func (c *Container) getJob(ctx context.Context) (*batchv1.Job, error) {
+	jobList := &batchv1.JobList{}
+	if err := read_write_layer.ListJobs(ctx, c.hostUncachedClient, jobList, read_write_layer.R000126,
+		client.InNamespace(c.Configuration.Namespace), client.MatchingLabels{
+			container.ContainerDeployerDeployItemNameLabel:      c.DeployItem.Name,
+			container.ContainerDeployerDeployItemNamespaceLabel: c.DeployItem.Namespace,
+		}); err != nil {
+		return nil, err
+	}
+
+	latest := latestJob(jobList.Items)
+	if latest == nil {
+		return nil, apierrors.NewNotFound(schema.GroupResource{
+			Group:    batchv1.SchemeGroupVersion.Group,
+			Resource: "Job",
+		}, c.DeployItem.Name)
+	}
+	return latest, nil
+}
+
+// latestJob returns the latest of the jobs that have a finalizer.
+func latestJob(jobs []batchv1.Job) *batchv1.Job {
+	var latest *batchv1.Job
+	for i := range jobs {
+		job := &jobs[i]
+		// ignore jobs with no finalizer as they are already reconciled and their state was persisted.
+		if !controllerutil.ContainsFinalizer(job, container.ContainerDeployerFinalizer) {
+			continue
+		}
+		if latest == nil || job.CreationTimestamp.After(latest.CreationTimestamp.Time) {
+			latest = job
+		}
+	}
+	if latest == nil {
+		return nil
+	}
+	return latest.DeepCopy()
+}
+
+// getJobPod returns the latest pod of a job.
+// Nil is returned if the job controller has not yet created a pod.
+func (c *Container) getJobPod(ctx context.Context, job *batchv1.Job) (*corev1.Pod, error) {
+	podList := &corev1.PodList{}
+	if err := read_write_layer.ListPods(ctx, c.hostUncachedClient, podList, read_write_layer.R000127,
+		client.InNamespace(job.Namespace), client.MatchingLabels{
+			container.ContainerDeployerDeployItemNameLabel:      c.DeployItem.Name,
+			container.ContainerDeployerDeployItemNamespaceLabel: c.DeployItem.Namespace,
+		}); err != nil {
+		return nil, err
+	}
+
+	var latest *corev1.Pod
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		if !metav1.IsControlledBy(pod, job) {
+			continue
+		}
+		if latest == nil || pod.CreationTimestamp.After(latest.CreationTimestamp.Time) {
+			latest = pod
+		}
+	}
+	if latest == nil {
+		return nil, nil
+	}
+	return latest.DeepCopy(), nil
+}
+
+// isJobPod returns whether the pod is managed by a job.
+func isJobPod(pod *corev1.Pod) bool {
+	owner := metav1.GetControllerOf(pod)
+	return owner != nil && owner.APIVersion == batchv1.SchemeGroupVersion.String() && owner.Kind == "Job"
+}
@@ -0,0 +1,247 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/deployer/container"
+	containerv1alpha1 "github.com/gardener/landscaper/apis/deployer/container/v1alpha1"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/deployer/lib/timeout"
+)
+
+var _ = Describe("Job", func() {
+
+	var newPodOptions = func() PodOptions {
+		return PodOptions{
+			DeployerID:            "test",
+			ProviderConfiguration: &containerv1alpha1.ProviderConfiguration{Image: "example.com/terraform:1.0.0"},
+			Name:                  "my-item",
+			Namespace:             "host",
+			DeployItemName:        "my-item",
+			DeployItemNamespace:   "default",
+			Operation:             container.OperationReconcile,
+		}
+	}
+
+	var newJob = func(conditions ...batchv1.JobCondition) *batchv1.Job {
+		job := &batchv1.Job{}
+		job.Name = "my-item-abc"
+		job.Namespace = "host"
+		job.UID = types.UID("job-uid")
+		job.Status.Conditions = conditions
+		return job
+	}
+
+	Context("generateJob", func() {
+
+		It("should run the pod of the deploy item", func() {
+			pod, err := generatePod(newPodOptions())
+			Expect(err).ToNot(HaveOccurred())
+
+			job, err := generateJob(newPodOptions(), nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(job.GenerateName).To(Equal(pod.GenerateName))
+			Expect(job.Namespace).To(Equal("host"))
+			Expect(job.Labels).To(Equal(pod.Labels))
+			Expect(job.Spec.Template.Labels).To(Equal(pod.Labels))
+			Expect(job.Spec.Template.Spec).To(Equal(pod.Spec))
+			Expect(job.Spec.BackoffLimit).To(BeNil())
+			Expect(job.Spec.ActiveDeadlineSeconds).To(BeNil())
+			Expect(job.Spec.TTLSecondsAfterFinished).To(BeNil())
+		})
+
+		It("should set the finalizer on the job and not on its pods", func() {
+			job, err := generateJob(newPodOptions(), nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(job.Finalizers).To(ConsistOf(container.ContainerDeployerFinalizer))
+			Expect(job.Spec.Template.Finalizers).To(BeEmpty())
+		})
+
+		It("should apply the job configuration", func() {
+			job, err := generateJob(newPodOptions(), &containerv1alpha1.JobConfiguration{
+				BackoffLimit:            ptr.To[int32](3),
+				ActiveDeadlineSeconds:   ptr.To[int64](600),
+				TTLSecondsAfterFinished: ptr.To[int32](60),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(job.Spec.BackoffLimit).To(Equal(ptr.To[int32](3)))
+			Expect(job.Spec.ActiveDeadlineSeconds).To(Equal(ptr.To[int64](600)))
+			// the ttl is set when the result of the job has been collected
+			Expect(job.Spec.TTLSecondsAfterFinished).To(BeNil())
+		})
+	})
+
+	Context("newJobExecution", func() {
+
+		It("should be pending as long as the job has no pod", func() {
+			exec := newJobExecution(newJob(), nil)
+			Expect(exec.phase).To(Equal(corev1.PodPending))
+			Expect(exec.isRunning()).To(BeTrue())
+		})
+
+		It("should be running if the job has a pod and has not finished", func() {
+			exec := newJobExecution(newJob(), &corev1.Pod{})
+			Expect(exec.phase).To(Equal(corev1.PodRunning))
+			Expect(exec.isRunning()).To(BeTrue())
+		})
+
+		It("should be running if the pod of the job failed but the job has not", func() {
+			pod := &corev1.Pod{}
+			pod.Status.Phase = corev1.PodFailed
+			exec := newJobExecution(newJob(), pod)
+			Expect(exec.phase).To(Equal(corev1.PodRunning))
+			Expect(exec.isRunning()).To(BeTrue())
+		})
+
+		It("should be succeeded if the job is complete", func() {
+			exec := newJobExecution(newJob(batchv1.JobCondition{
+				Type:   batchv1.JobComplete,
+				Status: corev1.ConditionTrue,
+			}), &corev1.Pod{})
+			Expect(exec.phase).To(Equal(corev1.PodSucceeded))
+			Expect(exec.isRunning()).To(BeFalse())
+		})
+
+		It("should be failed if the job failed", func() {
+			exec := newJobExecution(newJob(batchv1.JobCondition{
+				Type:   batchv1.JobFailed,
+				Status: corev1.ConditionTrue,
+				Reason: "BackoffLimitExceeded",
+			}), &corev1.Pod{})
+			Expect(exec.phase).To(Equal(corev1.PodFailed))
+			Expect(exec.isRunning()).To(BeFalse())
+		})
+
+		It("should ignore conditions that are not true", func() {
+			exec := newJobExecution(newJob(batchv1.JobCondition{
+				Type:   batchv1.JobFailed,
+				Status: corev1.ConditionFalse,
+			}), &corev1.Pod{})
+			Expect(exec.phase).To(Equal(corev1.PodRunning))
+		})
+	})
+
+	Context("Reconcile", func() {
+
+		var (
+			ctx        context.Context
+			hostClient client.Client
+			deployer   *Container
+		)
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			timeout.ActivateIgnoreTimeoutChecker()
+
+			job, err := generateJob(newPodOptions(), &containerv1alpha1.JobConfiguration{BackoffLimit: ptr.To[int32](2)})
+			Expect(err).ToNot(HaveOccurred())
+			job.Name = "my-item-abc"
+			job.UID = types.UID("job-uid")
+
+			// the init container of the pod of the job cannot pull its image
+			pod := &corev1.Pod{}
+			pod.Name = "my-item-abc-1"
+			pod.Namespace = job.Namespace
+			pod.Labels = job.Spec.Template.Labels
+			pod.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(job, batchv1.SchemeGroupVersion.WithKind("Job"))}
+			pod.Status.Phase = corev1.PodPending
+			pod.Status.InitContainerStatuses = []corev1.ContainerStatus{
+				{
+					Name: container.InitContainerName,
+					State: corev1.ContainerState{
+						Waiting: &corev1.ContainerStateWaiting{Reason: kutil.ErrImagePullBackOff},
+					},
+				},
+			}
+
+			hostClient = fake.NewClientBuilder().WithObjects(job, pod).Build()
+
+			di := &lsv1alpha1.DeployItem{}
+			di.Name = "my-item"
+			di.Namespace = "default"
+			di.Status.JobID = "1"
+			lsClient := fake.NewClientBuilder().WithScheme(api.LandscaperScheme).WithStatusSubresource(&lsv1alpha1.DeployItem{}).Build()
+			Expect(lsClient.Create(ctx, di)).To(Succeed())
+
+			deployer = &Container{
+				lsUncachedClient:   lsClient,
+				hostUncachedClient: hostClient,
+				hostCachedClient:   hostClient,
+				Configuration: containerv1alpha1.Configuration{
+					Namespace: "host",
+					Execution: containerv1alpha1.Execution{Mode: containerv1alpha1.ExecutionModeJob},
+				},
+				DeployItem:            di,
+				ProviderStatus:        &containerv1alpha1.ProviderStatus{},
+				ProviderConfiguration: newPodOptions().ProviderConfiguration,
+			}
+		})
+
+		AfterEach(func() {
+			timeout.ActivateStandardTimeoutChecker()
+		})
+
+		It("should fail a running job whose pod cannot pull its image", func() {
+			err := deployer.Reconcile(ctx, container.OperationReconcile)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(kutil.ErrImagePullBackOff))
+			Expect(deployer.DeployItem.Status.Phase).To(Equal(lsv1alpha1.DeployItemPhases.Failed))
+			Expect(deployer.ProviderStatus.PodStatus.JobName).To(Equal("my-item-abc"))
+			Expect(deployer.ProviderStatus.PodStatus.PodName).To(Equal("my-item-abc-1"))
+
+			// the job is deleted, as the retries of the job controller would fail in the same way
+			job := &batchv1.Job{}
+			Expect(apierrors.IsNotFound(hostClient.Get(ctx, client.ObjectKey{Namespace: "host", Name: "my-item-abc"}, job))).To(BeTrue())
+		})
+
+		It("should not fail a running job whose pod is waiting", func() {
+			pod := &corev1.Pod{}
+			Expect(hostClient.Get(ctx, client.ObjectKey{Namespace: "host", Name: "my-item-abc-1"}, pod)).To(Succeed())
+			pod.Status.InitContainerStatuses[0].State.Waiting.Reason = "ContainerCreating"
+			Expect(hostClient.Update(ctx, pod)).To(Succeed())
+
+			Expect(deployer.Reconcile(ctx, container.OperationReconcile)).To(Succeed())
+			Expect(deployer.DeployItem.Status.Phase).To(Equal(lsv1alpha1.DeployItemPhases.Progressing))
+
+			job := &batchv1.Job{}
+			Expect(hostClient.Get(ctx, client.ObjectKey{Namespace: "host", Name: "my-item-abc"}, job)).To(Succeed())
+			Expect(job.Finalizers).To(ConsistOf(container.ContainerDeployerFinalizer))
+		})
+	})
+
+	Context("CleanupJob", func() {
+
+		It("should set the ttl of a kept job only after its result has been collected", func() {
+			job, err := generateJob(newPodOptions(), &containerv1alpha1.JobConfiguration{TTLSecondsAfterFinished: ptr.To[int32](60)})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(job.Spec.TTLSecondsAfterFinished).To(BeNil())
+			job.Name = "my-item-abc"
+
+			ctx := context.Background()
+			hostClient := fake.NewClientBuilder().WithObjects(job).Build()
+			Expect(CleanupJob(ctx, hostClient, job, true, ptr.To[int32](60))).To(Succeed())
+
+			res := &batchv1.Job{}
+			Expect(hostClient.Get(ctx, client.ObjectKeyFromObject(job), res)).To(Succeed())
+			Expect(res.Finalizers).To(BeEmpty())
+			Expect(res.Spec.TTLSecondsAfterFinished).To(Equal(ptr.To[int32](60)))
+		})
+	})
+})
@@ -346,11 +346,13 @@ func generatePod(opts PodOptions) (*corev1.Pod, error) {
 		Image:                    opts.ProviderConfiguration.Image,
 		Command:                  opts.ProviderConfiguration.Command,
 		Args:                     opts.ProviderConfiguration.Args,
-		Env:                      append(container.DefaultEnvVars, additionalEnvVars...),
-		Resources:                corev1.ResourceRequirements{},
+		Env:                      append(container.DefaultEnvVars, append(additionalEnvVars, opts.ProviderConfiguration.Env...)...),
+		EnvFrom:                  opts.ProviderConfiguration.EnvFrom,
+		Resources:                opts.ProviderConfiguration.Resources,
+		SecurityContext:          opts.ProviderConfiguration.SecurityContext,
 		TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
 		ImagePullPolicy:          corev1.PullIfNotPresent,
-		VolumeMounts:             []corev1.VolumeMount{sharedVolumeMount},
+		VolumeMounts:             append([]corev1.VolumeMount{sharedVolumeMount}, opts.ProviderConfiguration.VolumeMounts...),
 	}
 
 	if opts.Debug {
@@ -368,7 +370,10 @@ func generatePod(opts PodOptions) (*corev1.Pod, error) {
 	pod.Spec.AutomountServiceAccountToken = ptr.To[bool](false)
 	pod.Spec.RestartPolicy = corev1.RestartPolicyNever
 	pod.Spec.TerminationGracePeriodSeconds = ptr.To[int64](300)
-	pod.Spec.Volumes = volumes
+	pod.Spec.Volumes = append(volumes, opts.ProviderConfiguration.Volumes...)
+	pod.Spec.NodeSelector = opts.ProviderConfiguration.NodeSelector
+	pod.Spec.Tolerations = opts.ProviderConfiguration.Tolerations
+	pod.Spec.ServiceAccountName = opts.ProviderConfiguration.ServiceAccountName
 	pod.Spec.SecurityContext = &corev1.PodSecurityContext{
 		RunAsUser:  ptr.To[int64](1000),
 		RunAsGroup: ptr.To[int64](3000),
@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/landscaper/apis/deployer/container"
+	containerv1alpha1 "github.com/gardener/landscaper/apis/deployer/container/v1alpha1"
+)
+
+var _ = Describe("Pod", func() {
+
+	var newPodOptions = func(providerConfig *containerv1alpha1.ProviderConfiguration) PodOptions {
+		return PodOptions{
+			DeployerID:            "test",
+			ProviderConfiguration: providerConfig,
+			Name:                  "my-item",
+			Namespace:             "host",
+			DeployItemName:        "my-item",
+			DeployItemNamespace:   "default",
+			Operation:             container.OperationReconcile,
+		}
+	}
+
+	var mainContainer = func(pod *corev1.Pod) corev1.Container {
+		for _, c := range pod.Spec.Containers {
+			if c.Name == container.MainContainerName {
+				return c
+			}
+		}
+		Fail("main container not found")
+		return corev1.Container{}
+	}
+
+	It("should apply the additional fields of the provider configuration to the main container and the pod", func() {
+		secretVolume := corev1.Volume{
+			Name: "creds",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{SecretName: "terraform-creds"},
+			},
+		}
+		providerConfig := &containerv1alpha1.ProviderConfiguration{
+			Image: "example.com/terraform:1.0.0",
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+				Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("2Gi")},
+			},
+			Env: []corev1.EnvVar{{Name: "TF_LOG", Value: "DEBUG"}},
+			EnvFrom: []corev1.EnvFromSource{{SecretRef: &corev1.SecretEnvSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "terraform-env"},
+			}}},
+			Volumes:         []corev1.Volume{secretVolume},
+			VolumeMounts:    []corev1.VolumeMount{{Name: "creds", MountPath: "/creds", ReadOnly: true}},
+			SecurityContext: &corev1.SecurityContext{ReadOnlyRootFilesystem: ptr.To(true)},
+			NodeSelector:    map[string]string{"pool": "terraform"},
+			Tolerations: []corev1.Toleration{
+				{Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "terraform", Effect: corev1.TaintEffectNoSchedule},
+			},
+			ServiceAccountName: "terraform",
+		}
+
+		pod, err := generatePod(newPodOptions(providerConfig))
+		Expect(err).ToNot(HaveOccurred())
+
+		main := mainContainer(pod)
+		Expect(main.Image).To(Equal("example.com/terraform:1.0.0"))
+		Expect(main.Resources).To(Equal(providerConfig.Resources))
+		Expect(main.Env).To(ContainElements(
+			corev1.EnvVar{Name: container.OperationName, Value: string(container.OperationReconcile)},
+			corev1.EnvVar{Name: "TF_LOG", Value: "DEBUG"},
+		))
+		Expect(main.EnvFrom).To(Equal(providerConfig.EnvFrom))
+		Expect(main.VolumeMounts).To(ContainElement(corev1.VolumeMount{Name: "creds", MountPath: "/creds", ReadOnly: true}))
+		Expect(main.VolumeMounts).To(ContainElement(HaveField("MountPath", container.SharedBasePath)))
+		Expect(main.SecurityContext).To(Equal(providerConfig.SecurityContext))
+
+		Expect(pod.Spec.Volumes).To(ContainElement(secretVolume))
+		Expect(pod.Spec.Volumes).To(ContainElement(HaveField("Name", "shared-volume")))
+		Expect(pod.Spec.NodeSelector).To(Equal(providerConfig.NodeSelector))
+		Expect(pod.Spec.Tolerations).To(Equal(providerConfig.Tolerations))
+		Expect(pod.Spec.ServiceAccountName).To(Equal("terraform"))
+		Expect(pod.Spec.AutomountServiceAccountToken).To(PointTo(BeFalse()))
+	})
+
+	It("should not apply the additional fields to the init and wait container", func() {
+		providerConfig := &containerv1alpha1.ProviderConfiguration{
+			Env:          []corev1.EnvVar{{Name: "TF_LOG", Value: "DEBUG"}},
+			Volumes:      []corev1.Volume{{Name: "cache"}},
+			VolumeMounts: []corev1.VolumeMount{{Name: "cache", MountPath: "/cache"}},
+		}
+
+		pod, err := generatePod(newPodOptions(providerConfig))
+		Expect(err).ToNot(HaveOccurred())
+
+		containers := append(pod.Spec.InitContainers, pod.Spec.Containers...)
+		for _, c := range containers {
+			if c.Name == container.MainContainerName {
+				continue
+			}
+			Expect(c.Env).ToNot(ContainElement(HaveField("Name", "TF_LOG")), "container %s", c.Name)
+			Expect(c.VolumeMounts).ToNot(ContainElement(HaveField("Name", "cache")), "container %s", c.Name)
+			Expect(c.SecurityContext).To(BeNil(), "container %s", c.Name)
+		}
+	})
+
+})
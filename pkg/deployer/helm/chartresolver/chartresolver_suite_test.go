@@ -336,7 +336,7 @@ var _ = Describe("GetChart", func() {
 			Expect(err).To(BeNil())
 			Expect(cv).ToNot(BeNil())
 
-			templateFuncs, err := gotemplate.LandscaperTplFuncMap(&blueprints.Blueprint{}, cv, nil, nil)
+			templateFuncs, err := gotemplate.LandscaperTplFuncMap(gotemplate.FuncMapOptions{Blueprint: &blueprints.Blueprint{}, ComponentVersion: cv})
 			Expect(err).To(BeNil())
 
 			getResourceKey := templateFuncs["getResourceKey"].(func(args ...interface{}) (string, error))
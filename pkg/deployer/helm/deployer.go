@@ -18,6 +18,7 @@ import (
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	deployerlib "github.com/gardener/landscaper/pkg/deployer/lib"
 	cr "github.com/gardener/landscaper/pkg/deployer/lib/continuousreconcile"
+	"github.com/gardener/landscaper/pkg/deployer/lib/driftdetection"
 	"github.com/gardener/landscaper/pkg/deployer/lib/extension"
 	"github.com/gardener/landscaper/pkg/deployer/lib/timeout"
 )
@@ -50,6 +51,7 @@ func NewDeployer(
 		log:                log,
 		config:             config,
 		hooks:              extension.ReconcileExtensionHooks{},
+		driftDetector:      driftdetection.NewDetector(lsUncachedClient),
 	}
 	dep.hooks.RegisterHookSetup(cr.ContinuousReconcileExtensionSetup(dep.NextReconcile))
 	return dep, nil
@@ -65,6 +67,8 @@ type deployer struct {
 	log    logging.Logger
 	config helmv1alpha1.Configuration
 	hooks  extension.ReconcileExtensionHooks
+
+	driftDetector *driftdetection.Detector
 }
 
 func (d *deployer) Reconcile(ctx context.Context, lsCtx *lsv1alpha1.Context, di *lsv1alpha1.DeployItem, rt *lsv1alpha1.ResolvedTarget) error {
@@ -101,7 +105,17 @@ func (d *deployer) Reconcile(ctx context.Context, lsCtx *lsv1alpha1.Context, di
 		return err
 	}
 
-	return helm.ApplyFiles(ctx, filesForManifestDeployer, crdsForManifestDeployer, exports, ch)
+	// the deployer modifies the managed resources itself, so they must not be watched during the reconcile.
+	d.driftDetector.Stop(client.ObjectKeyFromObject(di))
+	if err := helm.ApplyFiles(ctx, filesForManifestDeployer, crdsForManifestDeployer, exports, ch); err != nil {
+		return err
+	}
+	if err := d.driftDetector.Watch(ctx, di, helm.ProviderConfiguration.DriftDetection,
+		helm.targetAccess.TargetRestConfig(), helm.ProviderStatus.ManagedResources); err != nil {
+		logger, _ := logging.FromContextOrNew(ctx, nil)
+		logger.Error(err, "unable to start drift detection")
+	}
+	return nil
 }
 
 func (d *deployer) Delete(ctx context.Context, lsCtx *lsv1alpha1.Context, di *lsv1alpha1.DeployItem, rt *lsv1alpha1.ResolvedTarget) error {
@@ -118,6 +132,8 @@ func (d *deployer) Delete(ctx context.Context, lsCtx *lsv1alpha1.Context, di *ls
 		return err
 	}
 
+	d.driftDetector.Stop(client.ObjectKeyFromObject(di))
+
 	return helm.DeleteFiles(ctx)
 }
 
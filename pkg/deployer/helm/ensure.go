@@ -145,6 +145,7 @@ func (h *Helm) applyManifests(ctx context.Context, manifests []managedresource.M
 		},
 		DeletionGroupsDuringUpdate: h.ProviderConfiguration.DeletionGroupsDuringUpdate,
 		InterruptionChecker:        interruption.NewStandardInterruptionChecker(h.DeployItem, h.lsUncachedClient),
+		ServerSideApply:            h.ProviderConfiguration.ServerSideApply,
 		LsUncachedClient:           h.lsUncachedClient,
 		LsRestConfig:               h.lsRestConfig,
 	})
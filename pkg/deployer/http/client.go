@@ -0,0 +1,292 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/Masterminds/sprig/v3"
+	"sigs.k8s.io/yaml"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/core/v1alpha1/targettypes"
+	httpv1alpha1 "github.com/gardener/landscaper/apis/deployer/http/v1alpha1"
+)
+
+const (
+	// maxErrorBodyLength is the maximal length of a response body that is added to an error message.
+	maxErrorBodyLength = 512
+	// maxResponseBodySize is the maximal size in bytes of a response body that is read.
+	maxResponseBodySize = 4 << 20
+	// maxStateSize is the maximal size in bytes of a response body that is stored as state in the provider status.
+	maxStateSize = 256 << 10
+
+	// requestTimeout is the timeout of a request, including reading the response body.
+	requestTimeout = time.Minute
+	// maxRedirects is the maximal number of redirects that are followed for a request.
+	maxRedirects = 10
+)
+
+// targetClient sends the requests of a deploy item to the api of its target.
+type targetClient struct {
+	config *targettypes.HTTPTargetConfig
+	// targetURL is the parsed url of the target.
+	// The credentials and headers of the target are only sent to its scheme and host.
+	targetURL *url.URL
+	client    *http.Client
+}
+
+// response is the response of a request.
+type response struct {
+	// Method and URL are the method and the rendered url of the request.
+	Method     string
+	URL        string
+	StatusCode int
+	Body       []byte
+}
+
+// newTargetClient creates a client for the given target.
+// The target is optional, without a target only absolute urls can be used.
+func newTargetClient(rt *lsv1alpha1.ResolvedTarget) (*targetClient, error) {
+	config := &targettypes.HTTPTargetConfig{}
+	if rt != nil {
+		if rt.Target != nil && rt.Target.Spec.Type != targettypes.HTTPTargetType {
+			return nil, fmt.Errorf("unsupported target type %q, expected %q", rt.Target.Spec.Type, targettypes.HTTPTargetType)
+		}
+		if err := yaml.Unmarshal([]byte(rt.Content), config); err != nil {
+			return nil, fmt.Errorf("unable to parse target configuration: %w", err)
+		}
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if len(config.CAData) != 0 || config.InsecureSkipVerify {
+		tlsConfig := &tls.Config{
+			InsecureSkipVerify: config.InsecureSkipVerify, // #nosec G402 -- explicitly configured in the target
+		}
+		if len(config.CAData) != 0 {
+			pool, err := x509.SystemCertPool()
+			if err != nil {
+				pool = x509.NewCertPool()
+			}
+			if !pool.AppendCertsFromPEM(config.CAData) {
+				return nil, errors.New("unable to parse the ca data of the target")
+			}
+			tlsConfig.RootCAs = pool
+		}
+		transport.TLSClientConfig = tlsConfig
+	}
+
+	c := &targetClient{
+		config: config,
+	}
+	if len(config.URL) != 0 {
+		targetURL, err := url.Parse(config.URL)
+		if err != nil || !targetURL.IsAbs() {
+			return nil, fmt.Errorf("invalid url %q of the target", config.URL)
+		}
+		c.targetURL = targetURL
+	}
+	c.client = &http.Client{
+		Transport:     transport,
+		Timeout:       requestTimeout,
+		CheckRedirect: c.checkRedirect,
+	}
+	return c, nil
+}
+
+// Do renders the request with the given state and sends it.
+// An error is returned if the request cannot be sent; the status code of the response is not checked.
+func (c *targetClient) Do(ctx context.Context, req *httpv1alpha1.Request, state json.RawMessage) (*response, error) {
+	values, err := templateValues(state)
+	if err != nil {
+		return nil, err
+	}
+
+	rawURL, err := render("url", req.URL, values)
+	if err != nil {
+		return nil, err
+	}
+	reqURL, err := c.resolveURL(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var body io.Reader
+	if len(req.Body) != 0 {
+		renderedBody, err := render("body", string(req.Body), values)
+		if err != nil {
+			return nil, err
+		}
+		body = strings.NewReader(renderedBody)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, req.Method, reqURL, body)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %w", err)
+	}
+	if body != nil {
+		httpReq.Header.Set("Content-Type", "application/json")
+	}
+	httpReq.Header.Set("Accept", "application/json")
+	if c.isTargetURL(httpReq.URL) {
+		c.setCredentials(httpReq)
+	}
+	for key, value := range req.Headers {
+		renderedValue, err := render("header "+key, value, values)
+		if err != nil {
+			return nil, err
+		}
+		httpReq.Header.Set(key, renderedValue)
+	}
+
+	redactedURL := httpReq.URL.Redacted()
+	res, err := c.client.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("unable to send %s request to %s: %w", req.Method, redactedURL, err)
+	}
+	defer res.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(res.Body, maxResponseBodySize+1))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read response of %s request to %s: %w", req.Method, redactedURL, err)
+	}
+	if len(data) > maxResponseBodySize {
+		return nil, fmt.Errorf("response of %s request to %s exceeds the maximal size of %d bytes", req.Method, redactedURL, maxResponseBodySize)
+	}
+	return &response{
+		Method:     req.Method,
+		URL:        redactedURL,
+		StatusCode: res.StatusCode,
+		Body:       data,
+	}, nil
+}
+
+// isTargetURL checks whether the url has the scheme and the host of the target url.
+func (c *targetClient) isTargetURL(u *url.URL) bool {
+	return c.targetURL != nil &&
+		strings.EqualFold(u.Scheme, c.targetURL.Scheme) &&
+		strings.EqualFold(u.Host, c.targetURL.Host)
+}
+
+// setCredentials adds the credentials and the headers of the target to the request.
+func (c *targetClient) setCredentials(req *http.Request) {
+	if c.config.BasicAuth != nil {
+		req.SetBasicAuth(c.config.BasicAuth.Username, c.config.BasicAuth.Password)
+	}
+	if len(c.config.BearerToken) != 0 {
+		req.Header.Set("Authorization", "Bearer "+c.config.BearerToken)
+	}
+	for key, value := range c.config.Headers {
+		req.Header.Set(key, value)
+	}
+}
+
+// checkRedirect removes the credentials and the headers of the target from requests
+// that are redirected to another scheme or host.
+func (c *targetClient) checkRedirect(req *http.Request, via []*http.Request) error {
+	if len(via) >= maxRedirects {
+		return fmt.Errorf("stopped after %d redirects", maxRedirects)
+	}
+	if !c.isTargetURL(req.URL) {
+		req.Header.Del("Authorization")
+		for key := range c.config.Headers {
+			req.Header.Del(key)
+		}
+	}
+	return nil
+}
+
+// resolveURL resolves a relative url against the url of the target.
+func (c *targetClient) resolveURL(rawURL string) (string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid url %q: %w", rawURL, err)
+	}
+	if u.IsAbs() {
+		return rawURL, nil
+	}
+	if len(c.config.URL) == 0 {
+		return "", fmt.Errorf("relative url %q requires a target with a url", rawURL)
+	}
+	return strings.TrimSuffix(c.config.URL, "/") + "/" + strings.TrimPrefix(rawURL, "/"), nil
+}
+
+// templateValues returns the values with which the requests are rendered.
+func templateValues(state json.RawMessage) (map[string]interface{}, error) {
+	var stateValue interface{}
+	if len(state) != 0 {
+		if err := json.Unmarshal(state, &stateValue); err != nil {
+			return nil, fmt.Errorf("unable to decode state: %w", err)
+		}
+	}
+	return map[string]interface{}{
+		"state": stateValue,
+	}, nil
+}
+
+// render renders a go template with the given values.
+func render(name, text string, values map[string]interface{}) (string, error) {
+	tmpl, err := template.New(name).Funcs(sprig.TxtFuncMap()).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("unable to parse template of %s: %w", name, err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, values); err != nil {
+		return "", fmt.Errorf("unable to render template of %s: %w", name, err)
+	}
+	return buf.String(), nil
+}
+
+// isSuccess checks whether the status code of the response is one of the expected codes of the request.
+func isSuccess(req *httpv1alpha1.Request, res *response) bool {
+	if len(req.SuccessCodes) == 0 {
+		return res.StatusCode >= 200 && res.StatusCode < 300
+	}
+	for _, code := range req.SuccessCodes {
+		if res.StatusCode == code {
+			return true
+		}
+	}
+	return false
+}
+
+// isNotFound checks whether the response indicates that the object does not exist.
+func isNotFound(res *response) bool {
+	return res.StatusCode == http.StatusNotFound || res.StatusCode == http.StatusGone
+}
+
+// unexpectedResponseError returns the error for a response with an unexpected status code.
+func unexpectedResponseError(res *response) error {
+	body := string(res.Body)
+	if len(body) > maxErrorBodyLength {
+		body = body[:maxErrorBodyLength] + "..."
+	}
+	return fmt.Errorf("%s request to %s failed with status %d: %s", res.Method, res.URL, res.StatusCode, body)
+}
+
+// jsonBody returns the body of the response if it is a json object or array.
+// An error is returned if the body is too large to be stored as state.
+func jsonBody(res *response) (json.RawMessage, bool, error) {
+	body := bytes.TrimSpace(res.Body)
+	if len(body) == 0 || (body[0] != '{' && body[0] != '[') || !json.Valid(body) {
+		return nil, false, nil
+	}
+	if len(body) > maxStateSize {
+		return nil, false, fmt.Errorf("response of %s request to %s exceeds the maximal state size of %d bytes", res.Method, res.URL, maxStateSize)
+	}
+	return json.RawMessage(body), true, nil
+}
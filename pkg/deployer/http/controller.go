@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	httpv1alpha1 "github.com/gardener/landscaper/apis/deployer/http/v1alpha1"
+	crval "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile/validation"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	deployerlib "github.com/gardener/landscaper/pkg/deployer/lib"
+	cr "github.com/gardener/landscaper/pkg/deployer/lib/continuousreconcile"
+	"github.com/gardener/landscaper/pkg/deployer/lib/extension"
+)
+
+const (
+	TimeoutCheckpointHTTPStartReconcile            = "http deployer: start reconcile"
+	TimeoutCheckpointHTTPBeforeReadingExportValues = "http deployer: before reading export values"
+	TimeoutCheckpointHTTPStartDelete               = "http deployer: start delete"
+)
+
+// NewDeployer creates a new deployer that reconciles deploy items of type http.
+func NewDeployer(lsUncachedClient, lsCachedClient, hostUncachedClient, hostCachedClient client.Client,
+	log logging.Logger,
+	config httpv1alpha1.Configuration) (deployerlib.Deployer, error) {
+
+	dep := &deployer{
+		lsUncachedClient:   lsUncachedClient,
+		lsCachedClient:     lsCachedClient,
+		hostUncachedClient: hostUncachedClient,
+		hostCachedClient:   hostCachedClient,
+		log:                log,
+		config:             config,
+		hooks:              extension.ReconcileExtensionHooks{},
+	}
+	dep.hooks.RegisterHookSetup(cr.ContinuousReconcileExtensionSetup(dep.NextReconcile))
+	return dep, nil
+}
+
+type deployer struct {
+	lsUncachedClient   client.Client
+	lsCachedClient     client.Client
+	hostUncachedClient client.Client
+	hostCachedClient   client.Client
+	log                logging.Logger
+	config             httpv1alpha1.Configuration
+	hooks              extension.ReconcileExtensionHooks
+}
+
+func (d *deployer) Reconcile(ctx context.Context, _ *lsv1alpha1.Context, di *lsv1alpha1.DeployItem, rt *lsv1alpha1.ResolvedTarget) error {
+	httpItem, err := New(d.lsUncachedClient, &d.config, di, rt)
+	if err != nil {
+		return err
+	}
+	return httpItem.Reconcile(ctx)
+}
+
+func (d *deployer) Delete(ctx context.Context, _ *lsv1alpha1.Context, di *lsv1alpha1.DeployItem, rt *lsv1alpha1.ResolvedTarget) error {
+	httpItem, err := New(d.lsUncachedClient, &d.config, di, rt)
+	if err != nil {
+		return err
+	}
+	return httpItem.Delete(ctx)
+}
+
+func (d *deployer) Abort(ctx context.Context, lsCtx *lsv1alpha1.Context, di *lsv1alpha1.DeployItem, rt *lsv1alpha1.ResolvedTarget) error {
+	d.log.Info("abort is not yet implemented")
+	return nil
+}
+
+func (d *deployer) ExtensionHooks() extension.ReconcileExtensionHooks {
+	return d.hooks
+}
+
+func (d *deployer) NextReconcile(ctx context.Context, last time.Time, di *lsv1alpha1.DeployItem) (*time.Time, error) {
+	httpItem, err := New(d.lsUncachedClient, &d.config, di, nil)
+	if err != nil {
+		return nil, err
+	}
+	if crval.ContinuousReconcileSpecIsEmpty(httpItem.ProviderConfiguration.ContinuousReconcile) {
+		// no continuous reconciliation configured
+		return nil, nil
+	}
+	schedule, err := cr.Schedule(httpItem.ProviderConfiguration.ContinuousReconcile)
+	if err != nil {
+		return nil, err
+	}
+	next := schedule.Next(last)
+	return &next, nil
+}
@@ -0,0 +1,267 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	httpv1alpha1 "github.com/gardener/landscaper/apis/deployer/http/v1alpha1"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	deployerlib "github.com/gardener/landscaper/pkg/deployer/lib"
+	"github.com/gardener/landscaper/pkg/deployer/lib/timeout"
+	"github.com/gardener/landscaper/pkg/landscaper/dataobjects/jsonpath"
+	"github.com/gardener/landscaper/pkg/utils"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// Reconcile creates the object if it does not exist, or updates it if the configuration has changed.
+// Afterwards, the exports are read from the state of the object.
+func (h *HTTP) Reconcile(ctx context.Context) error {
+	currOp := "ReconcileHTTP"
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, currOp})
+
+	if _, err := timeout.TimeoutExceeded(ctx, h.DeployItem, TimeoutCheckpointHTTPStartReconcile); err != nil {
+		return err
+	}
+
+	h.DeployItem.Status.Phase = lsv1alpha1.DeployItemPhases.Progressing
+
+	if err := h.ensureClient(); err != nil {
+		return lserrors.NewWrappedError(err, currOp, "ensureClient", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+	}
+
+	if err := h.loadState(ctx); err != nil {
+		return lserrors.NewWrappedError(err, currOp, "LoadState", err.Error())
+	}
+
+	exists, err := h.exists(ctx)
+	if err != nil {
+		return lserrors.NewWrappedError(err, currOp, "ReadObject", err.Error())
+	}
+
+	configHash, err := h.configurationHash()
+	if err != nil {
+		return lserrors.NewWrappedError(err, currOp, "ConfigurationHash", err.Error())
+	}
+	var changed bool
+	switch {
+	case !exists:
+		logger.Info("Creating object")
+		if err := h.send(ctx, &h.ProviderConfiguration.Create); err != nil {
+			return lserrors.NewWrappedError(err, currOp, "CreateObject", err.Error())
+		}
+		h.ProviderStatus.Created = true
+		changed = true
+	case h.ProviderStatus.ConfigurationHash != configHash && h.ProviderConfiguration.Update != nil:
+		logger.Info("Updating object")
+		if err := h.send(ctx, h.ProviderConfiguration.Update); err != nil {
+			return lserrors.NewWrappedError(err, currOp, "UpdateObject", err.Error())
+		}
+		changed = true
+	default:
+		logger.Debug("Object is up to date")
+	}
+	h.ProviderStatus.ConfigurationHash = configHash
+
+	if changed {
+		// persist the status immediately, so that the object is not created again if a later step fails
+		if err := h.updateStatus(ctx); err != nil {
+			return lserrors.NewWrappedError(err, currOp, "UpdateStatus", err.Error())
+		}
+
+		if h.ProviderConfiguration.Read != nil {
+			// refresh the state, as the responses of create or update requests do not necessarily contain the object
+			if _, err := h.exists(ctx); err != nil {
+				return lserrors.NewWrappedError(err, currOp, "ReadObject", err.Error())
+			}
+		}
+	}
+
+	if err := h.setProviderStatus(); err != nil {
+		return lserrors.NewWrappedError(err, currOp, "ProviderStatus", err.Error())
+	}
+
+	if len(h.ProviderConfiguration.Exports) != 0 {
+		if _, err := timeout.TimeoutExceeded(ctx, h.DeployItem, TimeoutCheckpointHTTPBeforeReadingExportValues); err != nil {
+			return err
+		}
+
+		exports, err := h.constructExports()
+		if err != nil {
+			return lserrors.NewWrappedError(err, currOp, "ReadExportValues", err.Error())
+		}
+
+		if err := deployerlib.CreateOrUpdateExport(ctx, h.Writer(), h.lsUncachedClient, h.DeployItem, exports); err != nil {
+			return err
+		}
+	}
+
+	h.DeployItem.Status.Phase = lsv1alpha1.DeployItemPhases.Succeeded
+
+	return nil
+}
+
+// Delete sends the delete request if the object exists.
+func (h *HTTP) Delete(ctx context.Context) error {
+	currOp := "DeleteHTTP"
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, currOp})
+
+	h.DeployItem.Status.Phase = lsv1alpha1.DeployItemPhases.Deleting
+
+	if h.ProviderConfiguration.Delete != nil {
+		if _, err := timeout.TimeoutExceeded(ctx, h.DeployItem, TimeoutCheckpointHTTPStartDelete); err != nil {
+			return err
+		}
+
+		if err := h.ensureClient(); err != nil {
+			return lserrors.NewWrappedError(err, currOp, "ensureClient", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+		}
+
+		if err := h.loadState(ctx); err != nil {
+			return lserrors.NewWrappedError(err, currOp, "LoadState", err.Error())
+		}
+
+		exists, err := h.exists(ctx)
+		if err != nil {
+			return lserrors.NewWrappedError(err, currOp, "ReadObject", err.Error())
+		}
+
+		if exists {
+			logger.Info("Deleting object")
+			req := h.ProviderConfiguration.Delete
+			res, err := h.client.Do(ctx, req, h.state)
+			if err != nil {
+				return lserrors.NewWrappedError(err, currOp, "DeleteObject", err.Error())
+			}
+			if !isSuccess(req, res) && !isNotFound(res) {
+				err := unexpectedResponseError(res)
+				return lserrors.NewWrappedError(err, currOp, "DeleteObject", err.Error())
+			}
+		}
+	}
+
+	controllerutil.RemoveFinalizer(h.DeployItem, lsv1alpha1.LandscaperFinalizer)
+	return h.Writer().UpdateDeployItem(ctx, read_write_layer.W000163, h.DeployItem)
+}
+
+// exists checks whether the object that has been created by the deployer still exists.
+// If a read request is configured, it is sent and the state is refreshed with its response.
+func (h *HTTP) exists(ctx context.Context) (bool, error) {
+	if !h.ProviderStatus.Created {
+		return false, nil
+	}
+	req := h.ProviderConfiguration.Read
+	if req == nil {
+		return true, nil
+	}
+
+	res, err := h.client.Do(ctx, req, h.state)
+	if err != nil {
+		return false, err
+	}
+	if isNotFound(res) {
+		return false, nil
+	}
+	if !isSuccess(req, res) {
+		return false, unexpectedResponseError(res)
+	}
+	if err := h.setState(ctx, res); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// send sends a create or update request and stores its response as state.
+func (h *HTTP) send(ctx context.Context, req *httpv1alpha1.Request) error {
+	res, err := h.client.Do(ctx, req, h.state)
+	if err != nil {
+		return err
+	}
+	if !isSuccess(req, res) {
+		return unexpectedResponseError(res)
+	}
+	return h.setState(ctx, res)
+}
+
+// setState stores the body of the response as state, if it is a json object or array.
+// Otherwise, the previous state is kept.
+// The state secret is written in both cases, as it marks that a request of the deployer was successful.
+func (h *HTTP) setState(ctx context.Context, res *response) error {
+	state, ok, err := jsonBody(res)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		state = h.state
+	}
+	return h.storeState(ctx, state)
+}
+
+// configurationHash returns the hash of the provider configuration of the deploy item.
+func (h *HTTP) configurationHash() (string, error) {
+	// the configuration is encoded again to get a canonical representation,
+	// as the api server might change the order of the keys.
+	var config interface{}
+	if err := json.Unmarshal(h.DeployItem.Spec.Configuration.Raw, &config); err != nil {
+		return "", fmt.Errorf("unable to decode provider configuration: %w", err)
+	}
+	data, err := json.Marshal(config)
+	if err != nil {
+		return "", fmt.Errorf("unable to encode provider configuration: %w", err)
+	}
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// constructExports reads the exports from the state of the object.
+func (h *HTTP) constructExports() (map[string]interface{}, error) {
+	var state interface{}
+	if len(h.state) != 0 {
+		if err := json.Unmarshal(h.state, &state); err != nil {
+			return nil, fmt.Errorf("unable to decode state: %w", err)
+		}
+	}
+
+	exports := make(map[string]interface{})
+	for _, export := range h.ProviderConfiguration.Exports {
+		var val interface{}
+		if err := jsonpath.GetValue(export.JSONPath, state, &val); err != nil {
+			return nil, fmt.Errorf("unable to read export %q: %w", export.Key, err)
+		}
+
+		newValue, err := jsonpath.Construct(export.Key, val)
+		if err != nil {
+			return nil, err
+		}
+
+		exports = utils.MergeMaps(exports, newValue)
+	}
+	return exports, nil
+}
+
+// setProviderStatus sets the provider status of the deploy item.
+// It is written together with the status of the deploy item at the end of the reconciliation.
+func (h *HTTP) setProviderStatus() error {
+	var err error
+	h.DeployItem.Status.ProviderStatus, err = kutil.ConvertToRawExtension(h.ProviderStatus, Scheme)
+	return err
+}
+
+func (h *HTTP) updateStatus(ctx context.Context) error {
+	if err := h.setProviderStatus(); err != nil {
+		return err
+	}
+	return h.Writer().UpdateDeployItemStatus(ctx, read_write_layer.W000162, h.DeployItem)
+}
@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	httpinstall "github.com/gardener/landscaper/apis/deployer/http/install"
+	httpv1alpha1 "github.com/gardener/landscaper/apis/deployer/http/v1alpha1"
+	httpvalidation "github.com/gardener/landscaper/apis/deployer/http/v1alpha1/validation"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/utils"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+const (
+	Type lsv1alpha1.DeployItemType = "landscaper.gardener.cloud/http"
+	Name string                    = "http.deployer.landscaper.gardener.cloud"
+)
+
+var Scheme = runtime.NewScheme()
+
+func init() {
+	httpinstall.Install(Scheme)
+}
+
+// HTTP is the internal representation of a DeployItem of Type HTTP
+type HTTP struct {
+	lsUncachedClient client.Client
+
+	Configuration *httpv1alpha1.Configuration
+
+	DeployItem            *lsv1alpha1.DeployItem
+	Target                *lsv1alpha1.ResolvedTarget
+	ProviderConfiguration *httpv1alpha1.ProviderConfiguration
+	ProviderStatus        *httpv1alpha1.ProviderStatus
+
+	client *targetClient
+	// state is the state of the object, that is kept in the state secret.
+	state json.RawMessage
+}
+
+// NewDeployItemBuilder creates a new deployitem builder for http deployitems
+func NewDeployItemBuilder() *utils.DeployItemBuilder {
+	return utils.NewDeployItemBuilder(string(Type)).Scheme(Scheme)
+}
+
+// New creates a new internal http item
+func New(lsUncachedClient client.Client,
+	configuration *httpv1alpha1.Configuration,
+	item *lsv1alpha1.DeployItem,
+	rt *lsv1alpha1.ResolvedTarget) (*HTTP, error) {
+
+	currOp := "InitHTTPOperation"
+
+	config := &httpv1alpha1.ProviderConfiguration{}
+
+	decoder := api.NewDecoder(Scheme)
+	if _, _, err := decoder.Decode(item.Spec.Configuration.Raw, nil, config); err != nil {
+		return nil, lserrors.NewWrappedError(err,
+			currOp, "ParseProviderConfiguration", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+	}
+
+	if err := httpvalidation.ValidateProviderConfiguration(config); err != nil {
+		return nil, lserrors.NewWrappedError(err,
+			currOp, "ValidateProviderConfiguration", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+	}
+
+	status := &httpv1alpha1.ProviderStatus{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: httpv1alpha1.SchemeGroupVersion.String(),
+			Kind:       "ProviderStatus",
+		},
+	}
+	if item.Status.ProviderStatus != nil {
+		if _, _, err := decoder.Decode(item.Status.ProviderStatus.Raw, nil, status); err != nil {
+			return nil, lserrors.NewWrappedError(err,
+				currOp, "ParseProviderStatus", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+		}
+	}
+
+	return &HTTP{
+		lsUncachedClient:      lsUncachedClient,
+		Configuration:         configuration,
+		DeployItem:            item,
+		Target:                rt,
+		ProviderConfiguration: config,
+		ProviderStatus:        status,
+	}, nil
+}
+
+func (h *HTTP) ensureClient() (err error) {
+	if h.client == nil {
+		h.client, err = newTargetClient(h.Target)
+	}
+	return err
+}
+
+func (h *HTTP) Writer() *read_write_layer.Writer {
+	return read_write_layer.NewWriter(h.lsUncachedClient)
+}
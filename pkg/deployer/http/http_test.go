@@ -0,0 +1,467 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/core/v1alpha1/targettypes"
+	httpv1alpha1 "github.com/gardener/landscaper/apis/deployer/http/v1alpha1"
+	"github.com/gardener/landscaper/pkg/api"
+	httpdeployer "github.com/gardener/landscaper/pkg/deployer/http"
+	"github.com/gardener/landscaper/pkg/deployer/lib/timeout"
+)
+
+const testToken = "my-token"
+
+// recordServer is a simple rest api for records, that requires a bearer token.
+type recordServer struct {
+	mutex    sync.Mutex
+	records  map[string]map[string]interface{}
+	nextID   int
+	requests map[string]int
+}
+
+func newRecordServer() *recordServer {
+	return &recordServer{
+		records:  map[string]map[string]interface{}{},
+		requests: map[string]int{},
+	}
+}
+
+// Records returns a copy of the records.
+func (s *recordServer) Records() map[string]map[string]interface{} {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	records := make(map[string]map[string]interface{}, len(s.records))
+	for id, record := range s.records {
+		records[id] = record
+	}
+	return records
+}
+
+// Requests returns the number of requests with the given method.
+func (s *recordServer) Requests(method string) int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.requests[method]
+}
+
+// Remove deletes a record out-of-band.
+func (s *recordServer) Remove(id string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	delete(s.records, id)
+}
+
+func (s *recordServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.requests[r.Method]++
+	if r.Header.Get("Authorization") != "Bearer "+testToken {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	id := strings.TrimPrefix(r.URL.Path, "/records/")
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/records":
+		record := map[string]interface{}{}
+		if err := json.NewDecoder(r.Body).Decode(&record); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		s.nextID++
+		record["id"] = fmt.Sprintf("%d", s.nextID)
+		s.records[record["id"].(string)] = record
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(record)
+	case r.Method == http.MethodGet:
+		record, ok := s.records[id]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(record)
+	case r.Method == http.MethodPut:
+		if _, ok := s.records[id]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		record := map[string]interface{}{}
+		if err := json.NewDecoder(r.Body).Decode(&record); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		record["id"] = id
+		s.records[id] = record
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodDelete:
+		if _, ok := s.records[id]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		delete(s.records, id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+var _ = Describe("HTTP Deployer", func() {
+
+	var (
+		ctx      context.Context
+		server   *recordServer
+		testsrv  *httptest.Server
+		lsClient client.Client
+		target   *lsv1alpha1.ResolvedTarget
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		timeout.ActivateIgnoreTimeoutChecker()
+
+		server = newRecordServer()
+		testsrv = httptest.NewServer(server)
+
+		lsClient = fake.NewClientBuilder().WithScheme(api.LandscaperScheme).WithStatusSubresource(&lsv1alpha1.DeployItem{}).Build()
+
+		targetConfig, err := json.Marshal(targettypes.HTTPTargetConfig{
+			URL:         testsrv.URL,
+			BearerToken: testToken,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		target = &lsv1alpha1.ResolvedTarget{
+			Target: &lsv1alpha1.Target{
+				Spec: lsv1alpha1.TargetSpec{
+					Type: targettypes.HTTPTargetType,
+				},
+			},
+			Content: string(targetConfig),
+		}
+	})
+
+	AfterEach(func() {
+		testsrv.Close()
+		timeout.ActivateStandardTimeoutChecker()
+	})
+
+	providerConfig := func(name string) *httpv1alpha1.ProviderConfiguration {
+		return &httpv1alpha1.ProviderConfiguration{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: httpv1alpha1.SchemeGroupVersion.String(),
+				Kind:       "ProviderConfiguration",
+			},
+			Create: httpv1alpha1.Request{
+				URL:  "records",
+				Body: json.RawMessage(fmt.Sprintf(`{"name": %q}`, name)),
+			},
+			Read: &httpv1alpha1.Request{
+				URL: "records/{{ .state.id }}",
+			},
+			Update: &httpv1alpha1.Request{
+				URL:  "records/{{ .state.id }}",
+				Body: json.RawMessage(fmt.Sprintf(`{"name": %q}`, name)),
+			},
+			Delete: &httpv1alpha1.Request{
+				URL: "records/{{ .state.id }}",
+			},
+			Exports: []httpv1alpha1.Export{
+				{Key: "record.id", JSONPath: ".id"},
+				{Key: "record.name", JSONPath: ".name"},
+			},
+		}
+	}
+
+	createDeployItem := func(config *httpv1alpha1.ProviderConfiguration) *lsv1alpha1.DeployItem {
+		di, err := httpdeployer.NewDeployItemBuilder().
+			Key("default", "my-record").
+			ProviderConfig(config).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		di.Finalizers = []string{lsv1alpha1.LandscaperFinalizer}
+		Expect(lsClient.Create(ctx, di)).To(Succeed())
+		return di
+	}
+
+	reconcile := func(di *lsv1alpha1.DeployItem) {
+		item, err := httpdeployer.New(lsClient, &httpv1alpha1.Configuration{}, di, target)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(item.Reconcile(ctx)).To(Succeed())
+		Expect(di.Status.Phase).To(Equal(lsv1alpha1.DeployItemPhases.Succeeded))
+	}
+
+	setConfig := func(di *lsv1alpha1.DeployItem, config *httpv1alpha1.ProviderConfiguration) {
+		raw, err := json.Marshal(config)
+		Expect(err).ToNot(HaveOccurred())
+		di.Spec.Configuration.Raw = raw
+	}
+
+	readExports := func(di *lsv1alpha1.DeployItem) map[string]interface{} {
+		Expect(di.Status.ExportReference).ToNot(BeNil())
+		secret := &corev1.Secret{}
+		Expect(lsClient.Get(ctx, client.ObjectKey{Namespace: di.Status.ExportReference.Namespace, Name: di.Status.ExportReference.Name}, secret)).To(Succeed())
+		exports := map[string]interface{}{}
+		Expect(json.Unmarshal(secret.Data[lsv1alpha1.DataObjectSecretDataKey], &exports)).To(Succeed())
+		return exports
+	}
+
+	It("should create an object and export values of its state", func() {
+		di := createDeployItem(providerConfig("a"))
+		reconcile(di)
+
+		Expect(server.Records()).To(HaveLen(1))
+		Expect(server.Records()).To(HaveKeyWithValue("1", HaveKeyWithValue("name", "a")))
+		Expect(readExports(di)).To(HaveKeyWithValue("record", And(
+			HaveKeyWithValue("id", "1"),
+			HaveKeyWithValue("name", "a"),
+		)))
+	})
+
+	It("should not create an existing object again", func() {
+		di := createDeployItem(providerConfig("a"))
+		reconcile(di)
+		reconcile(di)
+
+		Expect(server.Records()).To(HaveLen(1))
+		Expect(server.Requests(http.MethodPost)).To(Equal(1))
+		Expect(server.Requests(http.MethodPut)).To(Equal(0))
+	})
+
+	It("should update an object if the configuration has changed", func() {
+		di := createDeployItem(providerConfig("a"))
+		reconcile(di)
+
+		setConfig(di, providerConfig("b"))
+		reconcile(di)
+
+		Expect(server.Records()).To(HaveLen(1))
+		Expect(server.Records()).To(HaveKeyWithValue("1", HaveKeyWithValue("name", "b")))
+		Expect(server.Requests(http.MethodPut)).To(Equal(1))
+		Expect(readExports(di)).To(HaveKeyWithValue("record", HaveKeyWithValue("name", "b")))
+	})
+
+	It("should create an object again that has been deleted out-of-band", func() {
+		di := createDeployItem(providerConfig("a"))
+		reconcile(di)
+
+		server.Remove("1")
+		reconcile(di)
+
+		Expect(server.Records()).To(HaveLen(1))
+		Expect(server.Records()).To(HaveKey("2"))
+		Expect(readExports(di)).To(HaveKeyWithValue("record", HaveKeyWithValue("id", "2")))
+	})
+
+	It("should delete the object", func() {
+		di := createDeployItem(providerConfig("a"))
+		reconcile(di)
+
+		item, err := httpdeployer.New(lsClient, &httpv1alpha1.Configuration{}, di, target)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(item.Delete(ctx)).To(Succeed())
+
+		Expect(server.Records()).To(BeEmpty())
+		Expect(di.Finalizers).To(BeEmpty())
+	})
+
+	It("should succeed the deletion if the object does not exist anymore", func() {
+		di := createDeployItem(providerConfig("a"))
+		reconcile(di)
+		server.Remove("1")
+
+		item, err := httpdeployer.New(lsClient, &httpv1alpha1.Configuration{}, di, target)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(item.Delete(ctx)).To(Succeed())
+
+		Expect(server.Requests(http.MethodDelete)).To(Equal(0))
+		Expect(di.Finalizers).To(BeEmpty())
+	})
+
+	It("should fail if a request is not successful", func() {
+		target.Content = fmt.Sprintf(`{"url": %q, "bearerToken": "wrong"}`, testsrv.URL)
+		di := createDeployItem(providerConfig("a"))
+
+		item, err := httpdeployer.New(lsClient, &httpv1alpha1.Configuration{}, di, target)
+		Expect(err).ToNot(HaveOccurred())
+		err = item.Reconcile(ctx)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("401"))
+	})
+
+	It("should report the rendered url of a failed request", func() {
+		di := createDeployItem(providerConfig("a"))
+		reconcile(di)
+
+		target.Content = fmt.Sprintf(`{"url": %q, "bearerToken": "wrong"}`, testsrv.URL)
+		item, err := httpdeployer.New(lsClient, &httpv1alpha1.Configuration{}, di, target)
+		Expect(err).ToNot(HaveOccurred())
+		err = item.Reconcile(ctx)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("GET request to " + testsrv.URL + "/records/1 failed with status 401"))
+	})
+
+	It("should reject responses that are too large to be stored as state", func() {
+		largesrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(map[string]string{"id": "1", "data": strings.Repeat("a", 1<<20)})
+		}))
+		defer largesrv.Close()
+
+		target.Content = fmt.Sprintf(`{"url": %q}`, largesrv.URL)
+		config := providerConfig("a")
+		config.Read = nil
+		di := createDeployItem(config)
+
+		item, err := httpdeployer.New(lsClient, &httpv1alpha1.Configuration{}, di, target)
+		Expect(err).ToNot(HaveOccurred())
+		err = item.Reconcile(ctx)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("exceeds the maximal state size"))
+	})
+
+	It("should reject targets of other types", func() {
+		target.Target.Spec.Type = targettypes.KubernetesClusterTargetType
+		di := createDeployItem(providerConfig("a"))
+
+		item, err := httpdeployer.New(lsClient, &httpv1alpha1.Configuration{}, di, target)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(item.Reconcile(ctx)).ToNot(Succeed())
+	})
+
+	It("should keep the state in a secret and not in the status of the deploy item", func() {
+		di := createDeployItem(providerConfig("a"))
+		reconcile(di)
+
+		stored := &lsv1alpha1.DeployItem{}
+		Expect(lsClient.Get(ctx, client.ObjectKeyFromObject(di), stored)).To(Succeed())
+		status := &httpv1alpha1.ProviderStatus{}
+		Expect(json.Unmarshal(stored.Status.ProviderStatus.Raw, status)).To(Succeed())
+		Expect(status.Created).To(BeTrue())
+		Expect(status.StateSecretName).To(Equal(httpdeployer.StateSecretName(di)))
+		Expect(string(stored.Status.ProviderStatus.Raw)).ToNot(ContainSubstring(`"name":"a"`))
+
+		secret := &corev1.Secret{}
+		Expect(lsClient.Get(ctx, client.ObjectKey{Namespace: di.Namespace, Name: status.StateSecretName}, secret)).To(Succeed())
+		Expect(string(secret.Data["state"])).To(ContainSubstring(`"id":"1"`))
+		Expect(secret.OwnerReferences).To(ConsistOf(HaveField("Name", di.Name)))
+	})
+
+	It("should not create the object again if the status of the deploy item was not written after its creation", func() {
+		di := createDeployItem(providerConfig("a"))
+		reconcile(di)
+
+		// the deployer failed to write the status after the object was created
+		di.Status.ProviderStatus = nil
+		reconcile(di)
+
+		Expect(server.Records()).To(HaveLen(1))
+		Expect(server.Requests(http.MethodPost)).To(Equal(1))
+		Expect(readExports(di)).To(HaveKeyWithValue("record", HaveKeyWithValue("id", "1")))
+	})
+
+	It("should not create the object again without a read request if the status of the deploy item was not written", func() {
+		config := providerConfig("a")
+		config.Read = nil
+		di := createDeployItem(config)
+		reconcile(di)
+
+		di.Status.ProviderStatus = nil
+		reconcile(di)
+
+		Expect(server.Records()).To(HaveLen(1))
+		Expect(server.Requests(http.MethodPost)).To(Equal(1))
+	})
+
+	Context("Credentials", func() {
+
+		var (
+			otherHeaders chan http.Header
+			othersrv     *httptest.Server
+		)
+
+		BeforeEach(func() {
+			otherHeaders = make(chan http.Header, 1)
+			othersrv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				otherHeaders <- r.Header.Clone()
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write([]byte(`{"id": "1"}`))
+			}))
+		})
+
+		AfterEach(func() {
+			othersrv.Close()
+		})
+
+		createOnly := func(url string) *httpv1alpha1.ProviderConfiguration {
+			config := providerConfig("a")
+			config.Create.URL = url
+			config.Read = nil
+			config.Update = nil
+			config.Delete = nil
+			config.Exports = nil
+			return config
+		}
+
+		It("should not send the credentials of the target to other hosts", func() {
+			target.Content = fmt.Sprintf(`{"url": %q, "bearerToken": %q, "headers": {"X-Api-Key": "key"}}`, testsrv.URL, testToken)
+			di := createDeployItem(createOnly(othersrv.URL + "/records"))
+			reconcile(di)
+
+			var header http.Header
+			Eventually(otherHeaders).Should(Receive(&header))
+			Expect(header.Get("Authorization")).To(BeEmpty())
+			Expect(header.Get("X-Api-Key")).To(BeEmpty())
+		})
+
+		It("should remove the credentials of the target from requests that are redirected to other hosts", func() {
+			targetHeaders := make(chan http.Header, 1)
+			redirectsrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				targetHeaders <- r.Header.Clone()
+				http.Redirect(w, r, othersrv.URL+"/records", http.StatusTemporaryRedirect)
+			}))
+			defer redirectsrv.Close()
+
+			target.Content = fmt.Sprintf(`{"url": %q, "bearerToken": %q, "headers": {"X-Api-Key": "key"}}`, redirectsrv.URL, testToken)
+			di := createDeployItem(createOnly("records"))
+			reconcile(di)
+
+			var header http.Header
+			Eventually(targetHeaders).Should(Receive(&header))
+			Expect(header.Get("Authorization")).To(Equal("Bearer " + testToken))
+			Expect(header.Get("X-Api-Key")).To(Equal("key"))
+
+			Eventually(otherHeaders).Should(Receive(&header))
+			Expect(header.Get("Authorization")).To(BeEmpty())
+			Expect(header.Get("X-Api-Key")).To(BeEmpty())
+		})
+	})
+
+	It("should default the methods of the requests", func() {
+		di := createDeployItem(providerConfig("a"))
+		item, err := httpdeployer.New(lsClient, &httpv1alpha1.Configuration{}, di, target)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(item.ProviderConfiguration.Create.Method).To(Equal(http.MethodPost))
+		Expect(item.ProviderConfiguration.Read.Method).To(Equal(http.MethodGet))
+		Expect(item.ProviderConfiguration.Update.Method).To(Equal(http.MethodPut))
+		Expect(item.ProviderConfiguration.Delete.Method).To(Equal(http.MethodDelete))
+	})
+})
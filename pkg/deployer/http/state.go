@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/pkg/api"
+)
+
+// stateSecretKey is the key of the state in the state secret.
+const stateSecretKey = "state"
+
+// StateSecretName returns the name of the secret that contains the state of the object of a deploy item.
+func StateSecretName(deployItem *lsv1alpha1.DeployItem) string {
+	return fmt.Sprintf("%s-http-state", deployItem.Name)
+}
+
+// loadState reads the state of the object from the state secret of the deploy item.
+// The secret is read even if the provider status does not reference it,
+// as the status might not have been written after the object was created.
+func (h *HTTP) loadState(ctx context.Context) error {
+	secret := &corev1.Secret{}
+	if err := h.lsUncachedClient.Get(ctx, kutil.ObjectKey(StateSecretName(h.DeployItem), h.DeployItem.Namespace), secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			h.state = nil
+			h.ProviderStatus.StateSecretName = ""
+			return nil
+		}
+		return fmt.Errorf("unable to read state secret: %w", err)
+	}
+	// the secret is only written after a successful request,
+	// so the object has been created even if this is not recorded in the provider status.
+	h.state = secret.Data[stateSecretKey]
+	h.ProviderStatus.Created = true
+	h.ProviderStatus.StateSecretName = secret.Name
+	return nil
+}
+
+// storeState writes the state of the object to the state secret of the deploy item.
+// The secret is owned by the deploy item, so that it is deleted together with the deploy item.
+func (h *HTTP) storeState(ctx context.Context, state json.RawMessage) error {
+	if bytes.Equal(state, h.state) && len(h.ProviderStatus.StateSecretName) != 0 {
+		return nil
+	}
+
+	secret := &corev1.Secret{}
+	secret.Name = StateSecretName(h.DeployItem)
+	secret.Namespace = h.DeployItem.Namespace
+	if _, err := controllerutil.CreateOrUpdate(ctx, h.lsUncachedClient, secret, func() error {
+		secret.Data = map[string][]byte{
+			stateSecretKey: state,
+		}
+		return controllerutil.SetOwnerReference(h.DeployItem, secret, api.LandscaperScheme)
+	}); err != nil {
+		return fmt.Errorf("unable to write state secret: %w", err)
+	}
+
+	h.state = state
+	h.ProviderStatus.StateSecretName = secret.Name
+	return nil
+}
@@ -0,0 +1,258 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package kustomize
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/kustomize/api/krusty"
+	"sigs.k8s.io/kustomize/api/types"
+	"sigs.k8s.io/kustomize/kyaml/filesys"
+
+	kustomizev1alpha1 "github.com/gardener/landscaper/apis/deployer/kustomize/v1alpha1"
+	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
+)
+
+// rootDir is the directory of the in-memory filesystem to which the files of the kustomization are written.
+const rootDir = "/"
+
+const (
+	// MaxArchiveSize is the maximal size of an archive in bytes, before it is decompressed.
+	MaxArchiveSize int64 = 32 << 20
+	// MaxFileSize is the maximal size in bytes of a file extracted from an archive.
+	MaxFileSize int64 = 8 << 20
+	// MaxExtractedSize is the maximal size in bytes of all files extracted from an archive.
+	MaxExtractedSize int64 = 64 << 20
+
+	// remoteArchiveTimeout is the timeout for fetching a remote archive, including reading the response body.
+	remoteArchiveTimeout = 2 * time.Minute
+)
+
+var remoteArchiveClient = &http.Client{Timeout: remoteArchiveTimeout}
+
+// ArchiveFetcher returns the tar archive of the resource with the given key.
+type ArchiveFetcher func(ctx context.Context, resourceRef string) (io.ReadCloser, error)
+
+// Build builds the kustomization and returns the manifests of the resulting resources.
+// The archive of a resource reference is fetched with the given fetcher.
+func Build(ctx context.Context, kustomization *kustomizev1alpha1.Kustomization, fetchResource ArchiveFetcher) ([]managedresource.Manifest, error) {
+	fs, err := NewFileSystem(ctx, kustomization, fetchResource)
+	if err != nil {
+		return nil, err
+	}
+
+	kustomizationDir := path.Join(rootDir, kustomization.Path)
+	if err := validateReferences(fs, kustomizationDir, map[string]bool{}); err != nil {
+		return nil, err
+	}
+
+	opts := krusty.MakeDefaultOptions()
+	// files must not be loaded from outside of the directory of the kustomization that references them
+	opts.LoadRestrictions = types.LoadRestrictionsRootOnly
+	resMap, err := krusty.MakeKustomizer(opts).Run(fs, kustomizationDir)
+	if err != nil {
+		return nil, fmt.Errorf("unable to build kustomization: %w", err)
+	}
+
+	resources := resMap.Resources()
+	manifests := make([]managedresource.Manifest, len(resources))
+	for i, res := range resources {
+		data, err := res.MarshalJSON()
+		if err != nil {
+			return nil, fmt.Errorf("unable to encode resource %s: %w", res.CurId().String(), err)
+		}
+		manifests[i] = managedresource.Manifest{
+			Policy:   managedresource.ManagePolicy,
+			Manifest: &runtime.RawExtension{Raw: data},
+		}
+	}
+	return manifests, nil
+}
+
+// NewFileSystem returns an in-memory filesystem with the files of the kustomization.
+// The files of the resource or the archive are written first, so that they are overwritten by the inline files.
+func NewFileSystem(ctx context.Context, kustomization *kustomizev1alpha1.Kustomization, fetchResource ArchiveFetcher) (filesys.FileSystem, error) {
+	fs := filesys.MakeFsInMemory()
+
+	var (
+		archive io.ReadCloser
+		err     error
+	)
+	switch {
+	case len(kustomization.ResourceRef) != 0:
+		if fetchResource == nil {
+			return nil, errors.New("resource references are not supported")
+		}
+		archive, err = fetchResource(ctx, kustomization.ResourceRef)
+	case kustomization.Archive != nil:
+		archive, err = fetchArchive(ctx, kustomization.Archive)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if archive != nil {
+		err := extractArchive(archive, fs)
+		if closeErr := archive.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to extract archive of kustomization: %w", err)
+		}
+	}
+
+	// sort the files to get deterministic errors
+	names := make([]string, 0, len(kustomization.Files))
+	for name := range kustomization.Files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := writeFile(fs, name, []byte(kustomization.Files[name])); err != nil {
+			return nil, fmt.Errorf("unable to write file %s: %w", name, err)
+		}
+	}
+	return fs, nil
+}
+
+// fetchArchive returns the content of an inline or remote archive.
+func fetchArchive(ctx context.Context, archive *kustomizev1alpha1.ArchiveAccess) (io.ReadCloser, error) {
+	if len(archive.Raw) != 0 {
+		data, err := base64.StdEncoding.DecodeString(archive.Raw)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode archive: %w", err)
+		}
+		return io.NopCloser(bytes.NewReader(data)), nil
+	}
+	if archive.Remote != nil {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, archive.Remote.URL, nil)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create request for %q: %w", archive.Remote.URL, err)
+		}
+		res, err := remoteArchiveClient.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch archive from %q: %w", archive.Remote.URL, err)
+		}
+		if res.StatusCode < 200 || res.StatusCode > 299 {
+			_ = res.Body.Close()
+			return nil, fmt.Errorf("unable to fetch archive from %q: %s", archive.Remote.URL, res.Status)
+		}
+		return res.Body, nil
+	}
+	return nil, errors.New("no archive defined")
+}
+
+// extractArchive extracts a tar archive, which might be gzip compressed, to the filesystem.
+// Archives, files and extracted content exceeding the size limits are rejected.
+func extractArchive(archive io.Reader, fs filesys.FileSystem) error {
+	reader := bufio.NewReader(&limitedReader{
+		reader: archive,
+		limit:  MaxArchiveSize,
+		err:    fmt.Errorf("archive exceeds the maximal size of %d bytes", MaxArchiveSize),
+	})
+	magic, err := reader.Peek(2)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+	var tarStream io.Reader = reader
+	if bytes.Equal(magic, []byte{0x1f, 0x8b}) {
+		gzipReader, err := gzip.NewReader(reader)
+		if err != nil {
+			return err
+		}
+		defer gzipReader.Close()
+		tarStream = gzipReader
+	}
+
+	tarReader := tar.NewReader(tarStream)
+	var extractedSize int64
+	for {
+		header, err := tarReader.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			dir, err := filePath(header.Name)
+			if err != nil {
+				return err
+			}
+			if err := fs.MkdirAll(dir); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if header.Size > MaxFileSize {
+				return fmt.Errorf("file %s exceeds the maximal size of %d bytes", header.Name, MaxFileSize)
+			}
+			extractedSize += header.Size
+			if extractedSize > MaxExtractedSize {
+				return fmt.Errorf("extracted files exceed the maximal size of %d bytes", MaxExtractedSize)
+			}
+			data, err := io.ReadAll(io.LimitReader(tarReader, header.Size))
+			if err != nil {
+				return err
+			}
+			if err := writeFile(fs, header.Name, data); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// limitedReader is a reader returning an error instead of EOF if more than limit bytes are read.
+type limitedReader struct {
+	reader io.Reader
+	limit  int64
+	read   int64
+	err    error
+}
+
+func (r *limitedReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.read += int64(n)
+	if r.read > r.limit {
+		return n, r.err
+	}
+	return n, err
+}
+
+// writeFile writes a file with a path relative to the root of the kustomization.
+func writeFile(fs filesys.FileSystem, relPath string, data []byte) error {
+	p, err := filePath(relPath)
+	if err != nil {
+		return err
+	}
+	if err := fs.MkdirAll(path.Dir(p)); err != nil {
+		return err
+	}
+	return fs.WriteFile(p, data)
+}
+
+// filePath returns the absolute path of a path relative to the root of the kustomization.
+// Paths that leave the root are rejected.
+func filePath(relPath string) (string, error) {
+	cleaned := path.Clean(strings.TrimPrefix(relPath, "/"))
+	if cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+		return "", fmt.Errorf("path %q leaves the kustomization", relPath)
+	}
+	return path.Join(rootDir, cleaned), nil
+}
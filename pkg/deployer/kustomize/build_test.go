@@ -0,0 +1,279 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package kustomize_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	kustomizev1alpha1 "github.com/gardener/landscaper/apis/deployer/kustomize/v1alpha1"
+	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
+	"github.com/gardener/landscaper/pkg/deployer/kustomize"
+)
+
+const baseKustomization = `
+resources:
+- configmap.yaml
+`
+
+const baseConfigMap = `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: cm
+data:
+  key: base
+`
+
+const overlayKustomization = `
+resources:
+- ../base
+namePrefix: dev-
+namespace: dev
+`
+
+func tarArchive(files map[string]string, compress bool) []byte {
+	var buf bytes.Buffer
+	var w io.Writer = &buf
+	var gw *gzip.Writer
+	if compress {
+		gw = gzip.NewWriter(&buf)
+		w = gw
+	}
+	tw := tar.NewWriter(w)
+	for name, content := range files {
+		Expect(tw.WriteHeader(&tar.Header{
+			Name:     name,
+			Mode:     0644,
+			Size:     int64(len(content)),
+			Typeflag: tar.TypeReg,
+		})).To(Succeed())
+		_, err := tw.Write([]byte(content))
+		Expect(err).ToNot(HaveOccurred())
+	}
+	Expect(tw.Close()).To(Succeed())
+	if gw != nil {
+		Expect(gw.Close()).To(Succeed())
+	}
+	return buf.Bytes()
+}
+
+func decodeManifests(manifests []managedresource.Manifest) []map[string]interface{} {
+	objects := make([]map[string]interface{}, len(manifests))
+	for i, m := range manifests {
+		Expect(m.Policy).To(Equal(managedresource.ManagePolicy))
+		Expect(json.Unmarshal(m.Manifest.Raw, &objects[i])).To(Succeed())
+	}
+	return objects
+}
+
+var _ = Describe("Build", func() {
+
+	var ctx context.Context
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	It("should build a kustomization of inline files", func() {
+		manifests, err := kustomize.Build(ctx, &kustomizev1alpha1.Kustomization{
+			Files: map[string]string{
+				"kustomization.yaml": baseKustomization,
+				"configmap.yaml":     baseConfigMap,
+			},
+		}, nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		objects := decodeManifests(manifests)
+		Expect(objects).To(HaveLen(1))
+		Expect(objects[0]).To(HaveKeyWithValue("kind", "ConfigMap"))
+		Expect(objects[0]).To(HaveKeyWithValue("data", HaveKeyWithValue("key", "base")))
+	})
+
+	It("should build an overlay of inline files on top of a compressed archive", func() {
+		archive := tarArchive(map[string]string{
+			"base/kustomization.yaml": baseKustomization,
+			"base/configmap.yaml":     baseConfigMap,
+		}, true)
+
+		manifests, err := kustomize.Build(ctx, &kustomizev1alpha1.Kustomization{
+			Archive: &kustomizev1alpha1.ArchiveAccess{
+				Raw: base64.StdEncoding.EncodeToString(archive),
+			},
+			Files: map[string]string{
+				"overlay/kustomization.yaml": overlayKustomization,
+			},
+			Path: "overlay",
+		}, nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		objects := decodeManifests(manifests)
+		Expect(objects).To(HaveLen(1))
+		Expect(objects[0]).To(HaveKeyWithValue("metadata", And(
+			HaveKeyWithValue("name", "dev-cm"),
+			HaveKeyWithValue("namespace", "dev"),
+		)))
+	})
+
+	It("should overwrite files of the archive with inline files", func() {
+		archive := tarArchive(map[string]string{
+			"kustomization.yaml": baseKustomization,
+			"configmap.yaml":     baseConfigMap,
+		}, false)
+
+		manifests, err := kustomize.Build(ctx, &kustomizev1alpha1.Kustomization{
+			Archive: &kustomizev1alpha1.ArchiveAccess{
+				Raw: base64.StdEncoding.EncodeToString(archive),
+			},
+			Files: map[string]string{
+				"configmap.yaml": `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: cm
+data:
+  key: inline
+`,
+			},
+		}, nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		objects := decodeManifests(manifests)
+		Expect(objects).To(HaveLen(1))
+		Expect(objects[0]).To(HaveKeyWithValue("data", HaveKeyWithValue("key", "inline")))
+	})
+
+	It("should fetch a remote archive", func() {
+		archive := tarArchive(map[string]string{
+			"kustomization.yaml": baseKustomization,
+			"configmap.yaml":     baseConfigMap,
+		}, true)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write(archive)
+		}))
+		defer server.Close()
+
+		manifests, err := kustomize.Build(ctx, &kustomizev1alpha1.Kustomization{
+			Archive: &kustomizev1alpha1.ArchiveAccess{
+				Remote: &kustomizev1alpha1.RemoteArchiveAccess{URL: server.URL},
+			},
+		}, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(manifests).To(HaveLen(1))
+	})
+
+	It("should read the archive of a resource reference with the given fetcher", func() {
+		archive := tarArchive(map[string]string{
+			"kustomization.yaml": baseKustomization,
+			"configmap.yaml":     baseConfigMap,
+		}, true)
+
+		var fetched string
+		fetcher := func(_ context.Context, resourceRef string) (io.ReadCloser, error) {
+			fetched = resourceRef
+			return io.NopCloser(bytes.NewReader(archive)), nil
+		}
+
+		manifests, err := kustomize.Build(ctx, &kustomizev1alpha1.Kustomization{
+			ResourceRef: "my-ref",
+		}, fetcher)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fetched).To(Equal("my-ref"))
+		Expect(manifests).To(HaveLen(1))
+	})
+
+	It("should reject files outside of the kustomization", func() {
+		_, err := kustomize.Build(ctx, &kustomizev1alpha1.Kustomization{
+			Files: map[string]string{
+				"../kustomization.yaml": baseKustomization,
+			},
+		}, nil)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("leaves the kustomization"))
+	})
+
+	DescribeTable("should reject remote references",
+		func(kustomizationFile string) {
+			_, err := kustomize.Build(ctx, &kustomizev1alpha1.Kustomization{
+				Files: map[string]string{
+					"kustomization.yaml": kustomizationFile,
+					"configmap.yaml":     baseConfigMap,
+				},
+			}, nil)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("not supported"))
+		},
+		Entry("http resource", "resources:\n- https://example.com/configmap.yaml\n"),
+		Entry("git base", "bases:\n- github.com/org/repo//base?ref=v1.0.0\n"),
+		Entry("ssh component", "components:\n- git@github.com:org/repo.git\n"),
+		Entry("http patch", "resources:\n- configmap.yaml\npatches:\n- path: https://example.com/patch.yaml\n"),
+		Entry("http configmap generator file", "configMapGenerator:\n- name: cm\n  files:\n  - key=https://example.com/file\n"),
+	)
+
+	It("should reject remote references of referenced kustomizations", func() {
+		_, err := kustomize.Build(ctx, &kustomizev1alpha1.Kustomization{
+			Files: map[string]string{
+				"base/kustomization.yaml":    "resources:\n- https://example.com/configmap.yaml\n",
+				"overlay/kustomization.yaml": overlayKustomization,
+			},
+			Path: "overlay",
+		}, nil)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("remote reference \"https://example.com/configmap.yaml\" is not supported"))
+	})
+
+	It("should reject archives with files exceeding the maximal file size", func() {
+		archive := tarArchive(map[string]string{
+			"kustomization.yaml": baseKustomization,
+			"configmap.yaml":     strings.Repeat("a", int(kustomize.MaxFileSize)+1),
+		}, true)
+
+		_, err := kustomize.Build(ctx, &kustomizev1alpha1.Kustomization{
+			Archive: &kustomizev1alpha1.ArchiveAccess{
+				Raw: base64.StdEncoding.EncodeToString(archive),
+			},
+		}, nil)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("exceeds the maximal size"))
+	})
+
+	It("should reject archives exceeding the maximal extracted size", func() {
+		files := map[string]string{}
+		content := strings.Repeat("a", int(kustomize.MaxFileSize))
+		for i := int64(0); i <= kustomize.MaxExtractedSize/kustomize.MaxFileSize; i++ {
+			files[fmt.Sprintf("file-%d", i)] = content
+		}
+		archive := tarArchive(files, true)
+
+		_, err := kustomize.Build(ctx, &kustomizev1alpha1.Kustomization{
+			Archive: &kustomizev1alpha1.ArchiveAccess{
+				Raw: base64.StdEncoding.EncodeToString(archive),
+			},
+		}, nil)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("extracted files exceed the maximal size"))
+	})
+
+	It("should fail if the kustomization is invalid", func() {
+		_, err := kustomize.Build(ctx, &kustomizev1alpha1.Kustomization{
+			Files: map[string]string{
+				"kustomization.yaml": baseKustomization,
+			},
+		}, nil)
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package kustomize
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	kustomizev1alpha1 "github.com/gardener/landscaper/apis/deployer/kustomize/v1alpha1"
+	crval "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile/validation"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	deployerlib "github.com/gardener/landscaper/pkg/deployer/lib"
+	cr "github.com/gardener/landscaper/pkg/deployer/lib/continuousreconcile"
+	"github.com/gardener/landscaper/pkg/deployer/lib/driftdetection"
+	"github.com/gardener/landscaper/pkg/deployer/lib/extension"
+)
+
+const (
+	TimeoutCheckpointKustomizeStartReconcile            = "kustomize deployer: start reconcile"
+	TimeoutCheckpointKustomizeStartBuild                = "kustomize deployer: start build"
+	TimeoutCheckpointKustomizeBeforeReadinessCheck      = "kustomize deployer: before readiness check"
+	TimeoutCheckpointKustomizeBeforeReadingExportValues = "kustomize deployer: before reading export values"
+	TimeoutCheckpointKustomizeDefaultReadinessChecks    = "kustomize deployer: default readiness checks"
+	TimeoutCheckpointKustomizeCustomReadinessChecks     = "kustomize deployer: custom readiness checks"
+	TimeoutCheckpointKustomizeStartDelete               = "kustomize deployer: start delete"
+)
+
+// NewDeployer creates a new deployer that reconciles deploy items of type kustomize.
+func NewDeployer(lsRestConfig *rest.Config,
+	lsUncachedClient, lsCachedClient, hostUncachedClient, hostCachedClient client.Client,
+	log logging.Logger,
+	config kustomizev1alpha1.Configuration) (deployerlib.Deployer, error) {
+
+	dep := &deployer{
+		lsRestConfig:       lsRestConfig,
+		lsUncachedClient:   lsUncachedClient,
+		lsCachedClient:     lsCachedClient,
+		hostUncachedClient: hostUncachedClient,
+		hostCachedClient:   hostCachedClient,
+		log:                log,
+		config:             config,
+		hooks:              extension.ReconcileExtensionHooks{},
+		driftDetector:      driftdetection.NewDetector(lsUncachedClient),
+	}
+	dep.hooks.RegisterHookSetup(cr.ContinuousReconcileExtensionSetup(dep.NextReconcile))
+	return dep, nil
+}
+
+type deployer struct {
+	lsRestConfig       *rest.Config
+	lsUncachedClient   client.Client
+	lsCachedClient     client.Client
+	hostUncachedClient client.Client
+	hostCachedClient   client.Client
+	log                logging.Logger
+	config             kustomizev1alpha1.Configuration
+	hooks              extension.ReconcileExtensionHooks
+	driftDetector      *driftdetection.Detector
+}
+
+func (d *deployer) Reconcile(ctx context.Context, lsCtx *lsv1alpha1.Context, di *lsv1alpha1.DeployItem, rt *lsv1alpha1.ResolvedTarget) error {
+	kustomize, err := New(d.lsUncachedClient, d.hostUncachedClient, d.lsRestConfig, &d.config, di, rt, lsCtx)
+	if err != nil {
+		return err
+	}
+
+	// the deployer modifies the managed resources itself, so they must not be watched during the reconcile.
+	d.driftDetector.Stop(client.ObjectKeyFromObject(di))
+	if err := kustomize.Reconcile(ctx); err != nil {
+		return err
+	}
+	if err := d.driftDetector.Watch(ctx, di, kustomize.ProviderConfiguration.DriftDetection,
+		kustomize.targetAccess.TargetRestConfig(), kustomize.ProviderStatus.ManagedResources); err != nil {
+		logger, _ := logging.FromContextOrNew(ctx, nil)
+		logger.Error(err, "unable to start drift detection")
+	}
+	return nil
+}
+
+func (d *deployer) Delete(ctx context.Context, lsCtx *lsv1alpha1.Context, di *lsv1alpha1.DeployItem, rt *lsv1alpha1.ResolvedTarget) error {
+	kustomize, err := New(d.lsUncachedClient, d.hostUncachedClient, d.lsRestConfig, &d.config, di, rt, lsCtx)
+	if err != nil {
+		return err
+	}
+	d.driftDetector.Stop(client.ObjectKeyFromObject(di))
+	return kustomize.Delete(ctx)
+}
+
+func (d *deployer) Abort(ctx context.Context, lsCtx *lsv1alpha1.Context, di *lsv1alpha1.DeployItem, rt *lsv1alpha1.ResolvedTarget) error {
+	d.log.Info("abort is not yet implemented")
+	return nil
+}
+
+func (d *deployer) ExtensionHooks() extension.ReconcileExtensionHooks {
+	return d.hooks
+}
+
+func (d *deployer) NextReconcile(ctx context.Context, last time.Time, di *lsv1alpha1.DeployItem) (*time.Time, error) {
+	kustomize, err := New(d.lsUncachedClient, d.hostUncachedClient, d.lsRestConfig, &d.config, di, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if crval.ContinuousReconcileSpecIsEmpty(kustomize.ProviderConfiguration.ContinuousReconcile) {
+		// no continuous reconciliation configured
+		return nil, nil
+	}
+	schedule, err := cr.Schedule(kustomize.ProviderConfiguration.ContinuousReconcile)
+	if err != nil {
+		return nil, err
+	}
+	next := schedule.Next(last)
+	return &next, nil
+}
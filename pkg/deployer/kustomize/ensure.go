@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package kustomize
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	kustomizev1alpha1 "github.com/gardener/landscaper/apis/deployer/kustomize/v1alpha1"
+	manifestv1alpha2 "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2"
+	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	"github.com/gardener/landscaper/pkg/deployer/lib/manifestdeployment"
+	"github.com/gardener/landscaper/pkg/deployer/lib/resourcemanager"
+	"github.com/gardener/landscaper/pkg/deployer/lib/timeout"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+func (k *Kustomize) Reconcile(ctx context.Context) error {
+	currOp := "ReconcileKustomization"
+
+	if _, err := timeout.TimeoutExceeded(ctx, k.DeployItem, TimeoutCheckpointKustomizeStartReconcile); err != nil {
+		return err
+	}
+
+	k.DeployItem.Status.Phase = lsv1alpha1.DeployItemPhases.Progressing
+
+	if err := k.ensureTargetAccess(ctx); err != nil {
+		return lserrors.NewWrappedError(err, currOp, "ensureTargetAccess", err.Error())
+	}
+
+	if _, err := timeout.TimeoutExceeded(ctx, k.DeployItem, TimeoutCheckpointKustomizeStartBuild); err != nil {
+		return err
+	}
+
+	manifests, err := Build(ctx, &k.ProviderConfiguration.Kustomization, resourceFetcher(k.lsUncachedClient, k.Context))
+	if err != nil {
+		return lserrors.NewWrappedError(err, currOp, "BuildKustomization", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+	}
+
+	if k.ProviderStatus == nil {
+		k.ProviderStatus = &kustomizev1alpha1.ProviderStatus{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: kustomizev1alpha1.SchemeGroupVersion.String(),
+				Kind:       "ProviderStatus",
+			},
+			ManagedResources: make([]managedresource.ManagedResourceStatus, 0),
+		}
+	}
+
+	return k.deployment().Apply(ctx, resourcemanager.ManifestApplierOptions{
+		Decoder:          serializer.NewCodecFactory(scheme.Scheme).UniversalDecoder(),
+		DefaultNamespace: k.ProviderConfiguration.Namespace,
+		UpdateStrategy:   manifestv1alpha2.UpdateStrategy(k.ProviderConfiguration.UpdateStrategy),
+		Manifests:        manifests,
+		Labels: map[string]string{
+			kustomizev1alpha1.ManagedDeployItemLabel: k.DeployItem.Name,
+		},
+		DeletionGroupsDuringUpdate: k.ProviderConfiguration.DeletionGroupsDuringUpdate,
+		ServerSideApply:            k.ProviderConfiguration.ServerSideApply,
+	})
+}
+
+// CheckResourcesReady checks if the managed resources are Ready/Healthy.
+func (k *Kustomize) CheckResourcesReady(ctx context.Context, client client.Client) error {
+	return k.deployment().CheckResourcesReady(ctx, client)
+}
+
+// Delete deletes the managed resources in the order of the deletion groups.
+func (k *Kustomize) Delete(ctx context.Context) error {
+	op := "DeleteKustomization"
+
+	k.DeployItem.Status.Phase = lsv1alpha1.DeployItemPhases.Deleting
+
+	if k.ProviderStatus == nil || len(k.ProviderStatus.ManagedResources) == 0 {
+		controllerutil.RemoveFinalizer(k.DeployItem, lsv1alpha1.LandscaperFinalizer)
+		return k.Writer().UpdateDeployItem(ctx, read_write_layer.W000160, k.DeployItem)
+	}
+
+	if _, err := timeout.TimeoutExceeded(ctx, k.DeployItem, TimeoutCheckpointKustomizeStartDelete); err != nil {
+		return err
+	}
+
+	if err := k.ensureTargetAccess(ctx); err != nil {
+		return lserrors.NewWrappedError(err, op, "ensureTargetAccess", err.Error())
+	}
+
+	if err := k.deployment().Delete(ctx); err != nil {
+		return err
+	}
+
+	controllerutil.RemoveFinalizer(k.DeployItem, lsv1alpha1.LandscaperFinalizer)
+	return k.Writer().UpdateDeployItem(ctx, read_write_layer.W000161, k.DeployItem)
+}
+
+// deployment returns the deployment of the manifests built from the kustomization.
+func (k *Kustomize) deployment() *manifestdeployment.Deployment {
+	return &manifestdeployment.Deployment{
+		Name:             "Kustomize",
+		LsUncachedClient: k.lsUncachedClient,
+		LsRestConfig:     k.lsRestConfig,
+		DeployItem:       k.DeployItem,
+		TargetAccess:     k.targetAccess,
+		ProviderStatus:   k.ProviderStatus,
+		Scheme:           Scheme,
+		ManagedResources: &k.ProviderStatus.ManagedResources,
+		StatusWriteID:    read_write_layer.W000159,
+		ReadinessChecks:  k.ProviderConfiguration.ReadinessChecks,
+		Exports:          k.ProviderConfiguration.Exports,
+		DeletionGroups:   k.ProviderConfiguration.DeletionGroups,
+		Checkpoints: manifestdeployment.TimeoutCheckpoints{
+			BeforeReadinessCheck:      TimeoutCheckpointKustomizeBeforeReadinessCheck,
+			BeforeReadingExportValues: TimeoutCheckpointKustomizeBeforeReadingExportValues,
+			DefaultReadinessChecks:    TimeoutCheckpointKustomizeDefaultReadinessChecks,
+			CustomReadinessChecks:     TimeoutCheckpointKustomizeCustomReadinessChecks,
+		},
+	}
+}
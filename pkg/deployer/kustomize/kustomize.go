@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package kustomize
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	kustomizeinstall "github.com/gardener/landscaper/apis/deployer/kustomize/install"
+	kustomizev1alpha1 "github.com/gardener/landscaper/apis/deployer/kustomize/v1alpha1"
+	kustomizevalidation "github.com/gardener/landscaper/apis/deployer/kustomize/v1alpha1/validation"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/deployer/lib"
+	"github.com/gardener/landscaper/pkg/utils"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+const (
+	Type lsv1alpha1.DeployItemType = "landscaper.gardener.cloud/kustomize"
+	Name string                    = "kustomize.deployer.landscaper.gardener.cloud"
+)
+
+var Scheme = runtime.NewScheme()
+
+func init() {
+	kustomizeinstall.Install(Scheme)
+}
+
+// Kustomize is the internal representation of a DeployItem of Type Kustomize
+type Kustomize struct {
+	lsRestConfig       *rest.Config
+	lsUncachedClient   client.Client
+	hostUncachedClient client.Client
+
+	Configuration *kustomizev1alpha1.Configuration
+
+	DeployItem            *lsv1alpha1.DeployItem
+	Target                *lsv1alpha1.ResolvedTarget
+	Context               *lsv1alpha1.Context
+	ProviderConfiguration *kustomizev1alpha1.ProviderConfiguration
+	ProviderStatus        *kustomizev1alpha1.ProviderStatus
+
+	targetAccess *lib.TargetAccess
+}
+
+// NewDeployItemBuilder creates a new deployitem builder for kustomize deployitems
+func NewDeployItemBuilder() *utils.DeployItemBuilder {
+	return utils.NewDeployItemBuilder(string(Type)).Scheme(Scheme)
+}
+
+// New creates a new internal kustomize item
+func New(lsUncachedClient client.Client, hostUncachedClient client.Client, lsRestConfig *rest.Config,
+	configuration *kustomizev1alpha1.Configuration,
+	item *lsv1alpha1.DeployItem,
+	rt *lsv1alpha1.ResolvedTarget,
+	lsCtx *lsv1alpha1.Context) (*Kustomize, error) {
+
+	currOp := "InitKustomizeOperation"
+
+	config := &kustomizev1alpha1.ProviderConfiguration{}
+
+	decoder := api.NewDecoder(Scheme)
+	if _, _, err := decoder.Decode(item.Spec.Configuration.Raw, nil, config); err != nil {
+		return nil, lserrors.NewWrappedError(err,
+			currOp, "ParseProviderConfiguration", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+	}
+
+	if err := kustomizevalidation.ValidateProviderConfiguration(config); err != nil {
+		return nil, lserrors.NewWrappedError(err,
+			currOp, "ValidateProviderConfiguration", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+	}
+
+	var status *kustomizev1alpha1.ProviderStatus
+	if item.Status.ProviderStatus != nil {
+		status = &kustomizev1alpha1.ProviderStatus{}
+		if _, _, err := decoder.Decode(item.Status.ProviderStatus.Raw, nil, status); err != nil {
+			return nil, lserrors.NewWrappedError(err,
+				currOp, "ParseProviderStatus", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+		}
+	}
+
+	return &Kustomize{
+		lsRestConfig:          lsRestConfig,
+		lsUncachedClient:      lsUncachedClient,
+		hostUncachedClient:    hostUncachedClient,
+		Configuration:         configuration,
+		DeployItem:            item,
+		Target:                rt,
+		Context:               lsCtx,
+		ProviderConfiguration: config,
+		ProviderStatus:        status,
+	}, nil
+}
+
+func (k *Kustomize) ensureTargetAccess(ctx context.Context) (err error) {
+	if k.targetAccess == nil {
+		k.targetAccess, err = lib.NewTargetAccess(ctx, k.Target, k.lsUncachedClient, k.lsRestConfig)
+	}
+	return err
+}
+
+func (k *Kustomize) Writer() *read_write_layer.Writer {
+	return read_write_layer.NewWriter(k.lsUncachedClient)
+}
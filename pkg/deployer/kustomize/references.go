@@ -0,0 +1,133 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package kustomize
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"sigs.k8s.io/kustomize/api/konfig"
+	"sigs.k8s.io/kustomize/api/types"
+	"sigs.k8s.io/kustomize/kyaml/filesys"
+)
+
+// validateReferences checks that the kustomization in the given directory and all kustomizations it references
+// only reference files and directories of the in-memory filesystem.
+// Kustomize itself clones git urls and downloads http urls of resources, bases, components and files,
+// which would bypass the size limits and the timeout of the deployer, so remote references are rejected.
+func validateReferences(fs filesys.FileSystem, dir string, visited map[string]bool) error {
+	if visited[dir] {
+		return nil
+	}
+	visited[dir] = true
+
+	for _, name := range konfig.RecognizedKustomizationFileNames() {
+		kustomizationPath := path.Join(dir, name)
+		if !fs.Exists(kustomizationPath) {
+			continue
+		}
+		data, err := fs.ReadFile(kustomizationPath)
+		if err != nil {
+			return err
+		}
+		kustomization := &types.Kustomization{}
+		if err := kustomization.Unmarshal(data); err != nil {
+			return fmt.Errorf("unable to read %s: %w", kustomizationPath, err)
+		}
+
+		for _, ref := range baseReferences(kustomization) {
+			refPath, err := localPath(fs, dir, ref)
+			if err != nil {
+				return fmt.Errorf("invalid reference in %s: %w", kustomizationPath, err)
+			}
+			if fs.IsDir(refPath) {
+				if err := validateReferences(fs, refPath, visited); err != nil {
+					return err
+				}
+			}
+		}
+		for _, ref := range fileReferences(kustomization) {
+			if isRemote(ref) {
+				return fmt.Errorf("invalid reference in %s: remote file %q is not supported", kustomizationPath, ref)
+			}
+		}
+	}
+	return nil
+}
+
+// baseReferences returns the references of a kustomization that might be files, directories or git repositories.
+// Inline generators, transformers and validators are skipped.
+func baseReferences(kustomization *types.Kustomization) []string {
+	refs := make([]string, 0, len(kustomization.Resources)+len(kustomization.Bases)+len(kustomization.Components))
+	refs = append(refs, kustomization.Resources...)
+	refs = append(refs, kustomization.Bases...)
+	refs = append(refs, kustomization.Components...)
+	for _, plugins := range [][]string{kustomization.Generators, kustomization.Transformers, kustomization.Validators} {
+		for _, ref := range plugins {
+			if !strings.Contains(ref, "\n") {
+				refs = append(refs, ref)
+			}
+		}
+	}
+	return refs
+}
+
+// fileReferences returns the references of a kustomization that are read as files.
+func fileReferences(kustomization *types.Kustomization) []string {
+	refs := make([]string, 0)
+	refs = append(refs, kustomization.Crds...)
+	refs = append(refs, kustomization.Configurations...)
+	refs = append(refs, kustomization.OpenAPI["path"])
+	for _, patch := range kustomization.PatchesStrategicMerge {
+		if !strings.Contains(string(patch), "\n") {
+			refs = append(refs, string(patch))
+		}
+	}
+	for _, patch := range append(kustomization.Patches, kustomization.PatchesJson6902...) {
+		refs = append(refs, patch.Path)
+	}
+	for _, replacement := range kustomization.Replacements {
+		refs = append(refs, replacement.Path)
+	}
+	sources := make([]types.KvPairSources, 0, len(kustomization.ConfigMapGenerator)+len(kustomization.SecretGenerator))
+	for _, generator := range kustomization.ConfigMapGenerator {
+		sources = append(sources, generator.KvPairSources)
+	}
+	for _, generator := range kustomization.SecretGenerator {
+		sources = append(sources, generator.KvPairSources)
+	}
+	for _, source := range sources {
+		for _, file := range source.FileSources {
+			// file sources have the format [{key}=]{path}
+			if i := strings.Index(file, "="); i >= 0 {
+				file = file[i+1:]
+			}
+			refs = append(refs, file)
+		}
+		refs = append(refs, source.EnvSources...)
+		refs = append(refs, source.EnvSource)
+	}
+	return refs
+}
+
+// localPath returns the path of a reference relative to the given directory,
+// if the reference is a file or directory of the filesystem.
+func localPath(fs filesys.FileSystem, dir, ref string) (string, error) {
+	if isRemote(ref) {
+		return "", fmt.Errorf("remote reference %q is not supported", ref)
+	}
+	refPath := path.Join(dir, ref)
+	if !fs.Exists(refPath) {
+		// kustomize interprets references that do not exist locally as git repositories, e.g. github.com/org/repo
+		return "", fmt.Errorf("%q does not exist in the files of the kustomization, remote references are not supported", ref)
+	}
+	return refPath, nil
+}
+
+// isRemote returns whether a reference is an url or a git repository.
+func isRemote(ref string) bool {
+	return strings.Contains(ref, "://") || strings.HasPrefix(ref, "git@")
+}
@@ -0,0 +1,121 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package kustomize
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/mandelsoft/goutils/finalizer"
+	corev1 "k8s.io/api/core/v1"
+	"ocm.software/ocm/api/ocm"
+	"ocm.software/ocm/api/utils/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/pkg/components/model"
+	"github.com/gardener/landscaper/pkg/components/ocmlib"
+	"github.com/gardener/landscaper/pkg/deployer/lib"
+)
+
+// resourceFetcher returns a fetcher for the archives of resources of the component versions
+// that are accessible with the ocm configuration and the registry pull secrets of the context.
+func resourceFetcher(lsClient client.Client, lsCtx *lsv1alpha1.Context) ArchiveFetcher {
+	return func(ctx context.Context, resourceRef string) (io.ReadCloser, error) {
+		data, err := getResourceContent(ctx, lsClient, lsCtx, resourceRef)
+		if err != nil {
+			return nil, err
+		}
+		return io.NopCloser(bytes.NewReader(data)), nil
+	}
+}
+
+func getResourceContent(ctx context.Context, lsClient client.Client, lsCtx *lsv1alpha1.Context, resourceRef string) (_ []byte, err error) {
+	op := "getResourceContent"
+
+	if lsCtx == nil {
+		return nil, lserrors.NewError(op, "NoContext", "landscaper context cannot be nil", lsv1alpha1.ErrorForInfoOnly,
+			lsv1alpha1.ErrorConfigurationProblem)
+	}
+
+	var ocmConfig *corev1.ConfigMap
+	if lsCtx.OCMConfig != nil {
+		ocmConfig = &corev1.ConfigMap{}
+		if err := lsClient.Get(ctx, client.ObjectKey{
+			Namespace: lsCtx.Namespace,
+			Name:      lsCtx.OCMConfig.Name,
+		}, ocmConfig); err != nil {
+			return nil, err
+		}
+	}
+
+	octx := ocm.FromContext(ctx)
+	if err := ocmlib.ApplyOCMConfigMapToOCMContext(octx, ocmConfig); err != nil {
+		return nil, err
+	}
+
+	// resolve all credentials from registry pull secrets
+	registryPullSecrets, err := kutil.ResolveSecrets(ctx, lsClient, lib.GetRegistryPullSecretsFromContext(lsCtx))
+	if err != nil {
+		return nil, fmt.Errorf("error resolving secrets: %w", err)
+	}
+	if err := ocmlib.AddSecretCredsToCredContext(registryPullSecrets, octx); err != nil {
+		return nil, err
+	}
+
+	key, err := base64.StdEncoding.DecodeString(resourceRef)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode resource reference: %w", err)
+	}
+	globalId := model.GlobalResourceIdentity{}
+	if err := runtime.DefaultYAMLEncoding.Unmarshal(key, &globalId); err != nil {
+		return nil, fmt.Errorf("unable to decode resource reference: %w", err)
+	}
+
+	if lsCtx.RepositoryContext != nil && lsCtx.RepositoryContext.Raw != nil {
+		spec, err := octx.RepositorySpecForConfig(lsCtx.RepositoryContext.Raw, runtime.DefaultYAMLEncoding)
+		if err != nil {
+			return nil, err
+		}
+		octx.AddResolverRule("", spec, int(^uint(0)>>1))
+	}
+
+	var finalize finalizer.Finalizer
+	defer finalize.FinalizeWithErrorPropagation(&err)
+
+	resolver := octx.GetResolver()
+	if resolver == nil {
+		return nil, errors.New("no repository or ocm resolvers found")
+	}
+
+	compvers, err := resolver.LookupComponentVersion(globalId.ComponentIdentity.Name, globalId.ComponentIdentity.Version)
+	if err != nil {
+		return nil, err
+	}
+	finalize.Close(compvers)
+
+	res, err := compvers.GetResource(globalId.ResourceIdentity)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := res.AccessMethod()
+	if err != nil {
+		return nil, fmt.Errorf("unable to get access method of resource: %w", err)
+	}
+	finalize.Close(m)
+
+	data, err := m.Get()
+	if err != nil {
+		return nil, fmt.Errorf("unable to read resource content: %w", err)
+	}
+	return data, nil
+}
@@ -10,6 +10,7 @@ import (
 	goflag "flag"
 	"fmt"
 	"os"
+	"path/filepath"
 	"time"
 
 	flag "github.com/spf13/pflag"
@@ -22,6 +23,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	controllerruntimeMetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/yaml"
 
@@ -30,8 +32,10 @@ import (
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	"github.com/gardener/landscaper/pkg/api"
 	"github.com/gardener/landscaper/pkg/deployer/lib"
+	"github.com/gardener/landscaper/pkg/metrics"
 	lsutils "github.com/gardener/landscaper/pkg/utils"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+	"github.com/gardener/landscaper/pkg/utils/tracing"
 )
 
 // DefaultOptions defines all default deployer options.
@@ -41,8 +45,9 @@ type DefaultOptions struct {
 	HostUncachedClient client.Client
 	HostCachedClient   client.Client
 
-	configPath   string
-	LsKubeconfig string
+	configPath         string
+	LsKubeconfig       string
+	metricsBindAddress string
 
 	Log     logging.Logger
 	LsMgr   manager.Manager
@@ -63,6 +68,7 @@ func NewDefaultOptions(deployerScheme *runtime.Scheme) *DefaultOptions {
 func (o *DefaultOptions) AddFlags(fs *flag.FlagSet) {
 	fs.StringVar(&o.configPath, "config", "", "Specify the path to the configuration file")
 	fs.StringVar(&o.LsKubeconfig, "landscaper-kubeconfig", "", "Specify the path to the landscaper kubeconfig cluster")
+	fs.StringVar(&o.metricsBindAddress, "metrics-bind-address", "0", "Specify the address the metrics endpoint binds to (\"0\" disables the metrics serving)")
 	logging.InitFlags(fs)
 
 	flag.CommandLine.AddGoFlagSet(goflag.CommandLine)
@@ -89,13 +95,22 @@ func (o *DefaultOptions) Complete() error {
 		Cache:          cache.Options{SyncPeriod: ptr.To[time.Duration](time.Hour * 24 * 1000)},
 	}
 
+	// the metrics are only served by the host manager
+	hostOpts := opts
+	if len(o.metricsBindAddress) != 0 {
+		hostOpts.Metrics = metricsserver.Options{BindAddress: o.metricsBindAddress}
+	}
+	if hostOpts.Metrics.BindAddress != "0" {
+		metrics.RegisterLifecycleMetrics(controllerruntimeMetrics.Registry)
+	}
+
 	hostRestConfig, err := ctrl.GetConfig()
 	if err != nil {
 		return fmt.Errorf("unable to get host kubeconfig: %w", err)
 	}
 	hostRestConfig = lsutils.RestConfigWithModifiedClientRequestRestrictions(log, hostRestConfig, burst, qps)
 
-	o.HostMgr, err = ctrl.NewManager(hostRestConfig, opts)
+	o.HostMgr, err = ctrl.NewManager(hostRestConfig, hostOpts)
 	if err != nil {
 		return fmt.Errorf("unable to setup host manager")
 	}
@@ -175,6 +190,18 @@ func (o *DefaultOptions) prepareFinishedObjectCache(ctx context.Context) error {
 // StartManagers starts the host and landscaper managers.
 func (o *DefaultOptions) StartManagers(ctx context.Context, deployerJobs ...DeployerJob) error {
 	o.Log.Info("Starting the controllers")
+
+	// the service name could be overwritten with the standard environment variable OTEL_SERVICE_NAME
+	shutdownTracing, err := tracing.Setup(logging.NewContext(ctx, o.Log), filepath.Base(os.Args[0]))
+	if err != nil {
+		return fmt.Errorf("unable to setup tracing: %w", err)
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			o.Log.Error(err, "unable to shutdown tracing")
+		}
+	}()
+
 	eg, ctx := errgroup.WithContext(ctx)
 
 	if o.LsMgr != o.HostMgr {
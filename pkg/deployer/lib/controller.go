@@ -12,6 +12,8 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -39,6 +41,7 @@ import (
 	lsutil "github.com/gardener/landscaper/pkg/utils"
 	"github.com/gardener/landscaper/pkg/utils/lock"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+	"github.com/gardener/landscaper/pkg/utils/tracing"
 	"github.com/gardener/landscaper/pkg/version"
 )
 
@@ -317,7 +320,8 @@ func (c *controller) reconcilePrivate(ctx context.Context, metadata *metav1.Part
 			if di.Spec.UpdateOnChangeOnly &&
 				di.GetGeneration() == di.Status.ObservedGeneration &&
 				di.Status.Phase == lsv1alpha1.DeployItemPhases.Succeeded &&
-				!hasTestReconcileAnnotation {
+				!hasTestReconcileAnnotation &&
+				!hasDrift(di) {
 
 				// deployitem is unchanged and succeeded, and no reconcile desired in this case
 				c.initStatus(ctx, di)
@@ -328,16 +332,18 @@ func (c *controller) reconcilePrivate(ctx context.Context, metadata *metav1.Part
 			// initialize deployitem for reconcile
 			logger.Debug("Setting deployitem to phase 'Init'", "updateOnChangeOnly", di.Spec.UpdateOnChangeOnly, lc.KeyGeneration, di.GetGeneration(), lc.KeyObservedGeneration, di.Status.ObservedGeneration, lc.KeyDeployItemPhase, di.Status.Phase)
 			di.Status.Phase = lsv1alpha1.DeployItemPhases.Init
-			if err := c.initAndUpdateStatus(ctx, di); err != nil {
+			if err := c.initAndUpdateStatus(ctx, di, old.Status.Phase); err != nil {
 				return lsutil.LogHelper{}.LogStandardErrorAndGetReconcileResult(ctx, err)
 			}
 		} else {
 			// initialize deployitem for delete
 			di.Status.Phase = lsv1alpha1.DeployItemPhases.InitDelete
-			if err := c.initAndUpdateStatus(ctx, di); err != nil {
+			if err := c.initAndUpdateStatus(ctx, di, old.Status.Phase); err != nil {
 				return lsutil.LogHelper{}.LogStandardErrorAndGetReconcileResult(ctx, err)
 			}
 		}
+		// the init phase has already been written, so that the next phase transition starts from it
+		old.Status.Phase = di.Status.Phase
 	}
 
 	// Create OCM context
@@ -349,18 +355,30 @@ func (c *controller) reconcilePrivate(ctx context.Context, metadata *metav1.Part
 
 	// Deployitem has been initialized, proceed with reconcile/delete
 
+	ctx, span := tracing.StartSpan(ctx, tracing.KindDeployItem, di, di.Status.GetJobID(),
+		trace.WithAttributes(attribute.String("landscaper.deployer_type", string(c.deployerType))))
+
 	if di.DeletionTimestamp.IsZero() {
 		lsError := c.reconcile(ctx, di, rt)
+		tracing.EndSpan(span, lsError)
 		_ = c.handleReconcileResult(ctx, lsError, old, di)
 		return c.buildResult(ctx, di.Status.Phase, lsError)
 
 	} else {
 		lsError := c.delete(ctx, di, rt)
+		tracing.EndSpan(span, lsError)
 		_ = c.handleReconcileResult(ctx, lsError, old, di)
 		return c.buildResult(ctx, di.Status.Phase, lsError)
 	}
 }
 
+// hasDrift returns whether a drift of the managed resources has been detected since the last successful reconcile.
+// Such deploy items are reconciled even if they have not been changed, so that the drift is repaired.
+func hasDrift(di *lsv1alpha1.DeployItem) bool {
+	cond := lsv1alpha1helper.GetCondition(di.Status.Conditions, lsv1alpha1.DeployItemDriftCondition)
+	return cond != nil && cond.Status == lsv1alpha1.ConditionTrue
+}
+
 func (c *controller) handleReconcileResult(ctx context.Context, err lserrors.LsError, oldDeployItem, deployItem *lsv1alpha1.DeployItem) error {
 	return HandleReconcileResult(ctx, err, oldDeployItem, deployItem, c.lsUncachedClient, c.lsEventRecorder, c.finishedObjectCache)
 }
@@ -471,13 +489,14 @@ func (c *controller) Writer() *read_write_layer.Writer {
 	return read_write_layer.NewWriter(c.lsUncachedClient)
 }
 
-func (c *controller) initAndUpdateStatus(ctx context.Context, di *lsv1alpha1.DeployItem) error {
+func (c *controller) initAndUpdateStatus(ctx context.Context, di *lsv1alpha1.DeployItem, oldPhase lsv1alpha1.DeployItemPhase) error {
 	c.initStatus(ctx, di)
 
 	if err := c.Writer().UpdateDeployItemStatus(ctx, read_write_layer.W000004, di); err != nil {
 		return err
 	}
 
+	lsutil.RecordPhaseTransitionEvent(c.lsEventRecorder, di, string(oldPhase), string(di.Status.Phase), false, di.Status.GetJobID())
 	return nil
 }
 
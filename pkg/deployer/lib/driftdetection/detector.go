@@ -0,0 +1,495 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package driftdetection
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/deployer/lib"
+	"github.com/gardener/landscaper/pkg/deployer/lib/resourcemanager"
+	lsutil "github.com/gardener/landscaper/pkg/utils"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+const (
+	// ReasonNoDrift is the condition reason if no drift has been detected since the last reconcile.
+	ReasonNoDrift = "NoDriftDetected"
+	// ReasonResourceModified is the condition reason if a managed resource has been modified.
+	ReasonResourceModified = "ResourceModified"
+	// ReasonResourceDeleted is the condition reason if a managed resource has been deleted.
+	ReasonResourceDeleted = "ResourceDeleted"
+)
+
+// Detector watches the managed resources of deploy items on their target clusters
+// and handles modifications and deletions that have not been done by the deployer.
+// The informers are shared by all deploy items whose managed resources are of the same resource
+// in the same namespace of the same target cluster.
+type Detector struct {
+	lsUncachedClient client.Client
+
+	mux     sync.Mutex
+	watches map[types.NamespacedName]*deployItemWatch
+	targets map[string]*targetInformers
+}
+
+// deployItemWatch contains the watches of the managed resources of one deploy item.
+type deployItemWatch struct {
+	key    types.NamespacedName
+	policy managedresource.DriftPolicy
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	target    *targetInformers
+	resources []watchedResource
+}
+
+// watchedResource is a managed resource that is watched by a shared informer.
+type watchedResource struct {
+	informer informerKey
+	ref      corev1.ObjectReference
+}
+
+// targetInformers contains the shared informers of one target cluster.
+type targetInformers struct {
+	key           string
+	mapper        meta.RESTMapper
+	dynamicClient dynamic.Interface
+	informers     map[informerKey]*sharedInformer
+}
+
+// informerKey identifies the informer of a resource in a namespace.
+// The namespace is empty for cluster-scoped resources.
+type informerKey struct {
+	resource  schema.GroupVersionResource
+	namespace string
+}
+
+// sharedInformer is an informer together with the watches of the deploy items
+// that manage the objects observed by the informer.
+type sharedInformer struct {
+	informer cache.SharedIndexInformer
+	cancel   context.CancelFunc
+	watches  map[types.NamespacedName]map[*deployItemWatch]corev1.ObjectReference
+}
+
+// Drift describes a modification or deletion of a managed resource.
+type Drift struct {
+	Resource      corev1.ObjectReference
+	Reason        string
+	ChangedFields []string
+}
+
+// Message returns a human-readable description of the drift.
+func (d Drift) Message() string {
+	res := fmt.Sprintf("%s %s", d.Resource.Kind, types.NamespacedName{Namespace: d.Resource.Namespace, Name: d.Resource.Name}.String())
+	if d.Reason == ReasonResourceDeleted {
+		return res + " has been deleted"
+	}
+	if len(d.ChangedFields) == 0 {
+		return res + " has been modified"
+	}
+	return fmt.Sprintf("%s has been modified: %s", res, strings.Join(d.ChangedFields, ", "))
+}
+
+// NewDetector creates a new drift detector.
+func NewDetector(lsUncachedClient client.Client) *Detector {
+	return &Detector{
+		lsUncachedClient: lsUncachedClient,
+		watches:          map[types.NamespacedName]*deployItemWatch{},
+		targets:          map[string]*targetInformers{},
+	}
+}
+
+// Watch starts watching the managed resources of a successfully reconciled deploy item.
+// Existing watches of the deploy item are replaced.
+// The drift condition of the deploy item is reset, also if drift detection is disabled,
+// so that a drift detected before does not outlive the reconcile that has restored the resources.
+// The deploy item is not written, this has to be done by the caller.
+func (d *Detector) Watch(ctx context.Context, di *lsv1alpha1.DeployItem, spec *managedresource.DriftDetectionSpec,
+	targetRestConfig *rest.Config, managedResources managedresource.ManagedResourceStatusList) error {
+
+	key := client.ObjectKeyFromObject(di)
+	d.Stop(key)
+
+	policy := spec.GetPolicy()
+	if policy == managedresource.DriftPolicyIgnore || len(managedResources) == 0 {
+		if lsv1alpha1helper.GetCondition(di.Status.Conditions, lsv1alpha1.DeployItemDriftCondition) != nil {
+			di.Status.Conditions = lsv1alpha1helper.CreateOrUpdateConditions(di.Status.Conditions, lsv1alpha1.DeployItemDriftCondition,
+				lsv1alpha1.ConditionFalse, ReasonNoDrift, "The managed resources are not watched")
+		}
+		return nil
+	}
+
+	logger, _ := logging.FromContextOrNew(ctx, nil, lc.KeyMethod, "driftDetection")
+	watchCtx, cancel := context.WithCancel(logging.NewContext(context.Background(), logger))
+	w := &deployItemWatch{
+		key:    key,
+		policy: policy,
+		ctx:    watchCtx,
+		cancel: cancel,
+	}
+
+	if err := d.register(w, targetRestConfig, managedResources); err != nil {
+		cancel()
+		return fmt.Errorf("unable to watch managed resources of deploy item %s: %w", key.String(), err)
+	}
+
+	di.Status.Conditions = lsv1alpha1helper.CreateOrUpdateConditions(di.Status.Conditions, lsv1alpha1.DeployItemDriftCondition,
+		lsv1alpha1.ConditionFalse, ReasonNoDrift, "The managed resources have not been changed since the last reconcile")
+	logger.Debug("Watching managed resources for drifts", lc.KeyResource, key.String())
+	return nil
+}
+
+// Stop stops watching the managed resources of a deploy item.
+func (d *Detector) Stop(key types.NamespacedName) {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	if w, ok := d.watches[key]; ok {
+		d.unregister(w)
+	}
+}
+
+// HasSynced returns whether the informers of all managed resources of a deploy item have synced,
+// so that all subsequent changes of the resources are detected.
+func (d *Detector) HasSynced(key types.NamespacedName) bool {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	w, ok := d.watches[key]
+	if !ok {
+		return false
+	}
+	for _, res := range w.resources {
+		si, ok := w.target.informers[res.informer]
+		if !ok || !si.informer.HasSynced() {
+			return false
+		}
+	}
+	return true
+}
+
+// register adds the managed resources of the deploy item to the shared informers of its target.
+// Missing informers are created and started.
+func (d *Detector) register(w *deployItemWatch, restConfig *rest.Config, managedResources managedresource.ManagedResourceStatusList) error {
+	targetKey := targetKeyFromRestConfig(restConfig)
+
+	d.mux.Lock()
+	t, ok := d.targets[targetKey]
+	d.mux.Unlock()
+	if !ok {
+		var err error
+		t, err = newTargetInformers(targetKey, restConfig)
+		if err != nil {
+			return err
+		}
+	}
+
+	// the rest mappings may require discovery calls, so they are computed before the lock is acquired.
+	resources := make([]watchedResource, 0, len(managedResources))
+	for _, mr := range managedResources {
+		ref := mr.Resource
+		gvk := schema.FromAPIVersionAndKind(ref.APIVersion, ref.Kind)
+		mapping, err := t.mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+		if err != nil {
+			return fmt.Errorf("unable to get resource mapping for %s: %w", gvk.String(), err)
+		}
+		ik := informerKey{resource: mapping.Resource, namespace: ref.Namespace}
+		if mapping.Scope.Name() == meta.RESTScopeNameRoot {
+			ik.namespace = metav1.NamespaceAll
+		}
+		resources = append(resources, watchedResource{informer: ik, ref: ref})
+	}
+
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	if existing, ok := d.targets[targetKey]; ok {
+		t = existing
+	} else {
+		d.targets[targetKey] = t
+	}
+	w.target = t
+
+	for _, res := range resources {
+		si, ok := t.informers[res.informer]
+		if !ok {
+			var err error
+			si, err = d.newSharedInformer(t, res.informer)
+			if err != nil {
+				d.unregister(w)
+				return err
+			}
+			t.informers[res.informer] = si
+		}
+		objKey := types.NamespacedName{Namespace: res.ref.Namespace, Name: res.ref.Name}
+		if si.watches[objKey] == nil {
+			si.watches[objKey] = map[*deployItemWatch]corev1.ObjectReference{}
+		}
+		si.watches[objKey][w] = res.ref
+		w.resources = append(w.resources, res)
+	}
+	d.watches[w.key] = w
+	return nil
+}
+
+// unregister removes the managed resources of the deploy item from the shared informers.
+// Informers and targets that are no longer needed are stopped.
+// The lock of the detector has to be held by the caller.
+func (d *Detector) unregister(w *deployItemWatch) {
+	w.cancel()
+	if d.watches[w.key] == w {
+		delete(d.watches, w.key)
+	}
+	if w.target == nil {
+		return
+	}
+
+	for _, res := range w.resources {
+		si, ok := w.target.informers[res.informer]
+		if !ok {
+			continue
+		}
+		objKey := types.NamespacedName{Namespace: res.ref.Namespace, Name: res.ref.Name}
+		delete(si.watches[objKey], w)
+		if len(si.watches[objKey]) == 0 {
+			delete(si.watches, objKey)
+		}
+		if len(si.watches) == 0 {
+			si.cancel()
+			delete(w.target.informers, res.informer)
+		}
+	}
+	w.resources = nil
+	if len(w.target.informers) == 0 && d.targets[w.target.key] == w.target {
+		delete(d.targets, w.target.key)
+	}
+}
+
+func newTargetInformers(key string, restConfig *rest.Config) (*targetInformers, error) {
+	httpClient, err := rest.HTTPClientFor(restConfig)
+	if err != nil {
+		return nil, err
+	}
+	mapper, err := apiutil.NewDynamicRESTMapper(restConfig, httpClient)
+	if err != nil {
+		return nil, err
+	}
+	dynamicClient, err := dynamic.NewForConfigAndClient(restConfig, httpClient)
+	if err != nil {
+		return nil, err
+	}
+	return &targetInformers{
+		key:           key,
+		mapper:        mapper,
+		dynamicClient: dynamicClient,
+		informers:     map[informerKey]*sharedInformer{},
+	}, nil
+}
+
+// newSharedInformer creates and starts an informer for a resource in a namespace of a target.
+// Events are dispatched to the watches of the deploy items that manage the changed object.
+func (d *Detector) newSharedInformer(t *targetInformers, key informerKey) (*sharedInformer, error) {
+	informer := dynamicinformer.NewFilteredDynamicInformer(t.dynamicClient, key.resource, key.namespace, 0, cache.Indexers{}, nil).Informer()
+	ctx, cancel := context.WithCancel(context.Background())
+	si := &sharedInformer{
+		informer: informer,
+		cancel:   cancel,
+		watches:  map[types.NamespacedName]map[*deployItemWatch]corev1.ObjectReference{},
+	}
+
+	if _, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			for w, ref := range d.watchesOf(si, newObj) {
+				d.onUpdate(w, ref, oldObj, newObj)
+			}
+		},
+		DeleteFunc: func(obj interface{}) {
+			for w, ref := range d.watchesOf(si, obj) {
+				d.handleDrift(w, Drift{Resource: ref, Reason: ReasonResourceDeleted})
+			}
+		},
+	}); err != nil {
+		cancel()
+		return nil, err
+	}
+	go informer.Run(ctx.Done())
+	return si, nil
+}
+
+// watchesOf returns a copy of the watches of the deploy items that manage the given object.
+func (d *Detector) watchesOf(si *sharedInformer, obj interface{}) map[*deployItemWatch]corev1.ObjectReference {
+	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+	if err != nil {
+		return nil
+	}
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return nil
+	}
+
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	res := map[*deployItemWatch]corev1.ObjectReference{}
+	for w, ref := range si.watches[types.NamespacedName{Namespace: namespace, Name: name}] {
+		res[w] = ref
+	}
+	return res
+}
+
+// targetKeyFromRestConfig returns a key that identifies the target cluster and the credentials of a rest config.
+func targetKeyFromRestConfig(restConfig *rest.Config) string {
+	h := sha256.New()
+	for _, v := range []string{
+		restConfig.Host, restConfig.APIPath, restConfig.Username, restConfig.Password,
+		restConfig.BearerToken, restConfig.BearerTokenFile, restConfig.Impersonate.UserName,
+		restConfig.TLSClientConfig.ServerName, restConfig.TLSClientConfig.CertFile, restConfig.TLSClientConfig.KeyFile,
+		restConfig.TLSClientConfig.CAFile, string(restConfig.TLSClientConfig.CertData),
+		string(restConfig.TLSClientConfig.KeyData), string(restConfig.TLSClientConfig.CAData),
+		strconv.FormatBool(restConfig.TLSClientConfig.Insecure), fmt.Sprintf("%v", restConfig.ExecProvider),
+	} {
+		h.Write([]byte(v))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func (d *Detector) onUpdate(w *deployItemWatch, ref corev1.ObjectReference, oldObj, newObj interface{}) {
+	oldU, ok := oldObj.(*unstructured.Unstructured)
+	if !ok {
+		return
+	}
+	newU, ok := newObj.(*unstructured.Unstructured)
+	if !ok || oldU.GetResourceVersion() == newU.GetResourceVersion() {
+		return
+	}
+
+	// the objects of the informer cache must not be modified
+	oldU, newU = oldU.DeepCopy(), newU.DeepCopy()
+	removeReservedMetadata(oldU)
+	removeReservedMetadata(newU)
+	diff, err := resourcemanager.ComputeResourceDiff(oldU, newU)
+	if err != nil {
+		logger, _ := logging.FromContextOrNew(w.ctx, nil)
+		logger.Error(err, "unable to compute drift of managed resource", lc.KeyResource, ref.Name)
+		return
+	}
+	if diff.Action == managedresource.DiffActionUnchanged {
+		// only status or fields maintained by the api server or by controllers have been changed
+		return
+	}
+	d.handleDrift(w, Drift{Resource: ref, Reason: ReasonResourceModified, ChangedFields: diff.ChangedFields})
+}
+
+// removeReservedMetadata removes the annotations, labels and finalizers with the prefixes kubernetes.io and k8s.io.
+// They are reserved for Kubernetes components and are maintained by controllers,
+// e.g. deployment.kubernetes.io/revision, so their changes are no drift of the applied resource.
+func removeReservedMetadata(obj *unstructured.Unstructured) {
+	obj.SetAnnotations(withoutReservedKeys(obj.GetAnnotations()))
+	obj.SetLabels(withoutReservedKeys(obj.GetLabels()))
+
+	finalizers := make([]string, 0)
+	for _, f := range obj.GetFinalizers() {
+		if !isReservedKey(f) {
+			finalizers = append(finalizers, f)
+		}
+	}
+	obj.SetFinalizers(finalizers)
+}
+
+func withoutReservedKeys(values map[string]string) map[string]string {
+	res := map[string]string{}
+	for k, v := range values {
+		if !isReservedKey(k) {
+			res[k] = v
+		}
+	}
+	if len(res) == 0 {
+		return nil
+	}
+	return res
+}
+
+// isReservedKey returns whether the prefix of a qualified name is kubernetes.io, k8s.io or one of their subdomains.
+func isReservedKey(key string) bool {
+	prefix, _, ok := strings.Cut(key, "/")
+	if !ok {
+		return false
+	}
+	for _, domain := range []string{"kubernetes.io", "k8s.io"} {
+		if prefix == domain || strings.HasSuffix(prefix, "."+domain) {
+			return true
+		}
+	}
+	return false
+}
+
+// handleDrift records the drift at the deploy item and triggers a reconcile if configured.
+func (d *Detector) handleDrift(w *deployItemWatch, drift Drift) {
+	if w.ctx.Err() != nil {
+		// the watch has been stopped in the meantime
+		return
+	}
+
+	ctx := w.ctx
+	logger, ctx := logging.FromContextOrNew(ctx, nil, lc.KeyResource, w.key.String())
+	logger.Info("Drift of managed resource detected", "drift", drift.Message())
+
+	di := &lsv1alpha1.DeployItem{}
+	if err := read_write_layer.GetDeployItem(ctx, d.lsUncachedClient, w.key, di, read_write_layer.R000112); err != nil {
+		if apierrors.IsNotFound(err) {
+			d.mux.Lock()
+			d.unregister(w)
+			d.mux.Unlock()
+			return
+		}
+		logger.Error(err, "unable to get deploy item to record drift")
+		return
+	}
+
+	if !lib.IsDeployItemFinished(di) || !di.DeletionTimestamp.IsZero() {
+		// the deploy item is currently processed, so the managed resources are applied anyway
+		return
+	}
+
+	di.Status.Conditions = lsv1alpha1helper.CreateOrUpdateConditions(di.Status.Conditions, lsv1alpha1.DeployItemDriftCondition,
+		lsv1alpha1.ConditionTrue, drift.Reason, drift.Message())
+
+	if w.policy == managedresource.DriftPolicyReconcile {
+		logger.Info("Reconciling deploy item because of drift of managed resource")
+		di.Status.SetJobID(uuid.New().String())
+		di.Status.TransitionTimes = lsutil.NewTransitionTimes()
+	}
+
+	if err := read_write_layer.NewWriter(d.lsUncachedClient).UpdateDeployItemStatus(ctx, read_write_layer.W000152, di); err != nil {
+		logger.Error(err, "unable to record drift at deploy item")
+		return
+	}
+	// The watch is kept until the deployer starts the reconcile and replaces it after the managed resources have been applied.
+	// Until then, further drifts are ignored as the deploy item is not finished.
+}
@@ -0,0 +1,216 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package driftdetection_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/deployer/lib/driftdetection"
+	"github.com/gardener/landscaper/test/utils/envtest"
+)
+
+var _ = Describe("Detector", func() {
+
+	var (
+		state    *envtest.State
+		ctx      context.Context
+		detector *driftdetection.Detector
+		di       *lsv1alpha1.DeployItem
+		cm       *corev1.ConfigMap
+		mrs      managedresource.ManagedResourceStatusList
+	)
+
+	BeforeEach(func() {
+		var err error
+		ctx = logging.NewContextWithDiscard(context.TODO())
+		state, err = testenv.InitState(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		detector = driftdetection.NewDetector(testenv.Client)
+
+		cm = &corev1.ConfigMap{}
+		cm.Name = "my-cm"
+		cm.Namespace = state.Namespace
+		cm.Data = map[string]string{"key": "val"}
+		Expect(state.Create(ctx, cm)).To(Succeed())
+
+		di = &lsv1alpha1.DeployItem{}
+		di.Name = "my-di"
+		di.Namespace = state.Namespace
+		di.Spec.Type = "landscaper.gardener.cloud/kubernetes-manifest"
+		Expect(state.Create(ctx, di)).To(Succeed())
+		di.Status.SetJobID("job-1")
+		di.Status.JobIDFinished = "job-1"
+		di.Status.Phase = lsv1alpha1.DeployItemPhases.Succeeded
+		Expect(testenv.Client.Status().Update(ctx, di)).To(Succeed())
+
+		mrs = managedresource.ManagedResourceStatusList{
+			{
+				Policy: managedresource.ManagePolicy,
+				Resource: corev1.ObjectReference{
+					APIVersion: "v1",
+					Kind:       "ConfigMap",
+					Name:       cm.Name,
+					Namespace:  cm.Namespace,
+				},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		detector.Stop(client.ObjectKeyFromObject(di))
+		Expect(state.CleanupState(ctx)).To(Succeed())
+	})
+
+	getDriftCondition := func(g Gomega) *lsv1alpha1.Condition {
+		res := &lsv1alpha1.DeployItem{}
+		g.Expect(testenv.Client.Get(ctx, client.ObjectKeyFromObject(di), res)).To(Succeed())
+		di = res
+		return lsv1alpha1helper.GetCondition(res.Status.Conditions, lsv1alpha1.DeployItemDriftCondition)
+	}
+
+	It("should report a modified resource", func() {
+		Expect(detector.Watch(ctx, di, &managedresource.DriftDetectionSpec{Policy: managedresource.DriftPolicyReport},
+			testenv.Env.Config, mrs)).To(Succeed())
+		cond := lsv1alpha1helper.GetCondition(di.Status.Conditions, lsv1alpha1.DeployItemDriftCondition)
+		Expect(cond).ToNot(BeNil())
+		Expect(cond.Status).To(Equal(lsv1alpha1.ConditionFalse))
+		Expect(testenv.Client.Status().Update(ctx, di)).To(Succeed())
+
+		// wait until the informer has synced before the resource is modified
+		Eventually(detector.HasSynced, 10*time.Second, 100*time.Millisecond).WithArguments(client.ObjectKeyFromObject(di)).Should(BeTrue())
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(cm), cm)).To(Succeed())
+		cm.Data["key"] = "modified"
+		Expect(testenv.Client.Update(ctx, cm)).To(Succeed())
+
+		Eventually(func(g Gomega) {
+			cond := getDriftCondition(g)
+			g.Expect(cond).ToNot(BeNil())
+			g.Expect(cond.Status).To(Equal(lsv1alpha1.ConditionTrue))
+			g.Expect(cond.Reason).To(Equal(driftdetection.ReasonResourceModified))
+			g.Expect(cond.Message).To(ContainSubstring("data.key"))
+		}, 10*time.Second, time.Second).Should(Succeed())
+		Expect(di.Status.GetJobID()).To(Equal("job-1"))
+	})
+
+	It("should reconcile the deploy item if a resource has been deleted", func() {
+		Expect(detector.Watch(ctx, di, &managedresource.DriftDetectionSpec{Policy: managedresource.DriftPolicyReconcile},
+			testenv.Env.Config, mrs)).To(Succeed())
+
+		Eventually(detector.HasSynced, 10*time.Second, 100*time.Millisecond).WithArguments(client.ObjectKeyFromObject(di)).Should(BeTrue())
+		Expect(testenv.Client.Delete(ctx, cm)).To(Succeed())
+
+		Eventually(func(g Gomega) {
+			cond := getDriftCondition(g)
+			g.Expect(cond).ToNot(BeNil())
+			g.Expect(cond.Status).To(Equal(lsv1alpha1.ConditionTrue))
+			g.Expect(cond.Reason).To(Equal(driftdetection.ReasonResourceDeleted))
+		}, 10*time.Second, time.Second).Should(Succeed())
+		Expect(di.Status.GetJobID()).ToNot(Equal("job-1"))
+		Expect(di.Status.JobIDFinished).To(Equal("job-1"))
+		// the watch is kept until the deployer starts the reconcile
+		Expect(detector.HasSynced(client.ObjectKeyFromObject(di))).To(BeTrue())
+	})
+
+	It("should share the informers of deploy items with managed resources of the same kind", func() {
+		other := &corev1.ConfigMap{}
+		other.Name = "other-cm"
+		other.Namespace = state.Namespace
+		other.Data = map[string]string{"key": "val"}
+		Expect(state.Create(ctx, other)).To(Succeed())
+
+		otherDi := &lsv1alpha1.DeployItem{}
+		otherDi.Name = "other-di"
+		otherDi.Namespace = state.Namespace
+		otherDi.Spec.Type = di.Spec.Type
+		Expect(state.Create(ctx, otherDi)).To(Succeed())
+		otherDi.Status = *di.Status.DeepCopy()
+		Expect(testenv.Client.Status().Update(ctx, otherDi)).To(Succeed())
+		defer detector.Stop(client.ObjectKeyFromObject(otherDi))
+
+		otherMrs := managedresource.ManagedResourceStatusList{{
+			Policy:   managedresource.ManagePolicy,
+			Resource: corev1.ObjectReference{APIVersion: "v1", Kind: "ConfigMap", Name: other.Name, Namespace: other.Namespace},
+		}}
+		spec := &managedresource.DriftDetectionSpec{Policy: managedresource.DriftPolicyReport}
+		Expect(detector.Watch(ctx, di, spec, testenv.Env.Config, mrs)).To(Succeed())
+		Expect(detector.Watch(ctx, otherDi, spec, testenv.Env.Config, otherMrs)).To(Succeed())
+		Expect(testenv.Client.Status().Update(ctx, di)).To(Succeed())
+		Expect(testenv.Client.Status().Update(ctx, otherDi)).To(Succeed())
+
+		// the informer keeps running for the other deploy item
+		detector.Stop(client.ObjectKeyFromObject(di))
+		Eventually(detector.HasSynced, 10*time.Second, 100*time.Millisecond).WithArguments(client.ObjectKeyFromObject(otherDi)).Should(BeTrue())
+
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(other), other)).To(Succeed())
+		other.Data["key"] = "modified"
+		Expect(testenv.Client.Update(ctx, other)).To(Succeed())
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(cm), cm)).To(Succeed())
+		cm.Data["key"] = "modified"
+		Expect(testenv.Client.Update(ctx, cm)).To(Succeed())
+
+		Eventually(func(g Gomega) {
+			res := &lsv1alpha1.DeployItem{}
+			g.Expect(testenv.Client.Get(ctx, client.ObjectKeyFromObject(otherDi), res)).To(Succeed())
+			cond := lsv1alpha1helper.GetCondition(res.Status.Conditions, lsv1alpha1.DeployItemDriftCondition)
+			g.Expect(cond).ToNot(BeNil())
+			g.Expect(cond.Status).To(Equal(lsv1alpha1.ConditionTrue))
+			g.Expect(cond.Message).To(ContainSubstring(other.Name))
+		}, 10*time.Second, time.Second).Should(Succeed())
+
+		// the stopped deploy item is not notified about the change of its resource
+		Consistently(func(g Gomega) {
+			cond := getDriftCondition(g)
+			g.Expect(cond).ToNot(BeNil())
+			g.Expect(cond.Status).To(Equal(lsv1alpha1.ConditionFalse))
+		}, 2*time.Second, 500*time.Millisecond).Should(Succeed())
+	})
+
+	It("should not watch resources if drift detection is disabled", func() {
+		Expect(detector.Watch(ctx, di, nil, testenv.Env.Config, mrs)).To(Succeed())
+		Expect(lsv1alpha1helper.GetCondition(di.Status.Conditions, lsv1alpha1.DeployItemDriftCondition)).To(BeNil())
+	})
+
+	It("should reset a detected drift if drift detection has been disabled", func() {
+		di.Status.Conditions = lsv1alpha1helper.CreateOrUpdateConditions(di.Status.Conditions, lsv1alpha1.DeployItemDriftCondition,
+			lsv1alpha1.ConditionTrue, driftdetection.ReasonResourceModified, "modified")
+
+		Expect(detector.Watch(ctx, di, &managedresource.DriftDetectionSpec{Policy: managedresource.DriftPolicyIgnore},
+			testenv.Env.Config, mrs)).To(Succeed())
+		cond := lsv1alpha1helper.GetCondition(di.Status.Conditions, lsv1alpha1.DeployItemDriftCondition)
+		Expect(cond).ToNot(BeNil())
+		Expect(cond.Status).To(Equal(lsv1alpha1.ConditionFalse))
+		Expect(detector.HasSynced(client.ObjectKeyFromObject(di))).To(BeFalse())
+	})
+
+	It("should ignore changes of metadata that is maintained by controllers", func() {
+		Expect(detector.Watch(ctx, di, &managedresource.DriftDetectionSpec{Policy: managedresource.DriftPolicyReport},
+			testenv.Env.Config, mrs)).To(Succeed())
+		Expect(testenv.Client.Status().Update(ctx, di)).To(Succeed())
+
+		Eventually(detector.HasSynced, 10*time.Second, 100*time.Millisecond).WithArguments(client.ObjectKeyFromObject(di)).Should(BeTrue())
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(cm), cm)).To(Succeed())
+		cm.Annotations = map[string]string{"deployment.kubernetes.io/revision": "2"}
+		cm.Labels = map[string]string{"kubernetes.io/metadata.name": cm.Name}
+		Expect(testenv.Client.Update(ctx, cm)).To(Succeed())
+
+		Consistently(func(g Gomega) {
+			cond := getDriftCondition(g)
+			g.Expect(cond).ToNot(BeNil())
+			g.Expect(cond.Status).To(Equal(lsv1alpha1.ConditionFalse))
+		}, 2*time.Second, 500*time.Millisecond).Should(Succeed())
+	})
+
+})
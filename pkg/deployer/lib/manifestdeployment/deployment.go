@@ -0,0 +1,252 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package manifestdeployment contains the deployment flow of the deployers that apply a list of manifests to a
+// target cluster, i.e. of the manifest deployer and the kustomize deployer.
+package manifestdeployment
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
+	"github.com/gardener/landscaper/apis/deployer/utils/readinesschecks"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	deployerlib "github.com/gardener/landscaper/pkg/deployer/lib"
+	"github.com/gardener/landscaper/pkg/deployer/lib/interruption"
+	health "github.com/gardener/landscaper/pkg/deployer/lib/readinesscheck"
+	"github.com/gardener/landscaper/pkg/deployer/lib/resourcemanager"
+	"github.com/gardener/landscaper/pkg/deployer/lib/timeout"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// TimeoutCheckpoints are the names of the timeout checkpoints of a deployer.
+type TimeoutCheckpoints struct {
+	BeforeReadinessCheck      string
+	BeforeReadingExportValues string
+	DefaultReadinessChecks    string
+	CustomReadinessChecks     string
+}
+
+// Deployment applies manifests to a target cluster, checks the readiness of the resulting resources,
+// reads export values, and deletes the resources.
+type Deployment struct {
+	// Name is the name of the deployer, which is used in the names of the operations, e.g. "Manifest".
+	Name string
+
+	LsUncachedClient client.Client
+	LsRestConfig     *rest.Config
+	DeployItem       *lsv1alpha1.DeployItem
+	TargetAccess     *deployerlib.TargetAccess
+
+	// ProviderStatus is the provider status of the deploy item. It is encoded with the Scheme.
+	ProviderStatus runtime.Object
+	Scheme         *runtime.Scheme
+	// ManagedResources is the list of managed resources of the ProviderStatus.
+	ManagedResources *managedresource.ManagedResourceStatusList
+	// StatusWriteID is the id with which the status of the deploy item is written after the manifests are applied.
+	StatusWriteID read_write_layer.WriteID
+
+	ReadinessChecks readinesschecks.ReadinessCheckConfiguration
+	Exports         *managedresource.Exports
+	DeletionGroups  []managedresource.DeletionGroupDefinition
+
+	Checkpoints TimeoutCheckpoints
+}
+
+// Apply applies the manifests and stores the managed resources in the provider status of the deploy item.
+// Afterwards, the readiness of the managed resources is checked and the export values are read.
+// The given applier options define the deployer specific options; the options concerning the deploy item,
+// the target cluster and the Landscaper cluster are set by the deployment.
+func (d *Deployment) Apply(ctx context.Context, opts resourcemanager.ManifestApplierOptions) error {
+	currOp := "Reconcile" + d.Name
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, currOp})
+
+	opts.KubeClient = d.TargetAccess.TargetClient()
+	opts.Clientset = d.TargetAccess.TargetClientSet()
+	opts.DeployItemName = d.DeployItem.Name
+	opts.DeployItem = d.DeployItem
+	opts.ManagedResources = *d.ManagedResources
+	opts.InterruptionChecker = d.interruptionChecker()
+	opts.LsUncachedClient = d.LsUncachedClient
+	opts.LsRestConfig = d.LsRestConfig
+
+	applier := resourcemanager.NewManifestApplier(opts)
+	patchInfos, err := applier.Apply(ctx)
+	*d.ManagedResources = applier.GetManagedResourcesStatus()
+	if err != nil {
+		var err2 error
+		d.DeployItem.Status.ProviderStatus, err2 = kutil.ConvertToRawExtension(d.ProviderStatus, d.Scheme)
+		if err2 != nil {
+			logger.Error(err, "unable to encode status")
+		}
+		return err
+	}
+
+	d.DeployItem.Status.ProviderStatus, err = kutil.ConvertToRawExtension(d.ProviderStatus, d.Scheme)
+	if err != nil {
+		return lserrors.NewWrappedError(err,
+			currOp, "ProviderStatus", err.Error())
+	}
+	if err := read_write_layer.NewWriter(d.LsUncachedClient).UpdateDeployItemStatus(ctx, d.StatusWriteID, d.DeployItem); err != nil {
+		return lserrors.NewWrappedError(err,
+			currOp, "UpdateStatus", err.Error())
+	}
+
+	if opts.DiffOnly {
+		// nothing has been applied, so there is nothing to check or export
+		logger.Info("Computed diff of manifests without applying them")
+		d.DeployItem.Status.Phase = lsv1alpha1.DeployItemPhases.Succeeded
+		return nil
+	}
+
+	if _, err := timeout.TimeoutExceeded(ctx, d.DeployItem, d.Checkpoints.BeforeReadinessCheck); err != nil {
+		return err
+	}
+
+	if err := d.CheckResourcesReady(ctx, d.TargetAccess.TargetClient()); err != nil {
+		return err
+	}
+
+	if d.Exports != nil {
+		if _, err := timeout.TimeoutExceeded(ctx, d.DeployItem, d.Checkpoints.BeforeReadingExportValues); err != nil {
+			return err
+		}
+
+		exporter := resourcemanager.NewExporter(resourcemanager.ExporterOptions{
+			KubeClient:          d.TargetAccess.TargetClient(),
+			InterruptionChecker: d.interruptionChecker(),
+			LsClient:            d.LsUncachedClient,
+			DeployItem:          d.DeployItem,
+			LsRestConfig:        d.LsRestConfig,
+		})
+		exports, err := exporter.Export(ctx, d.Exports)
+		if err != nil {
+			return lserrors.NewWrappedError(err, currOp, "ReadExportValues", err.Error())
+		}
+
+		if err := deployerlib.CreateOrUpdateExport(ctx, read_write_layer.NewWriter(d.LsUncachedClient), d.LsUncachedClient, d.DeployItem, exports); err != nil {
+			return err
+		}
+	}
+
+	if err := applier.PatchAfterDeployment(ctx, patchInfos); err != nil {
+		return lserrors.NewWrappedError(err, currOp, "PatchAfterDeployment", err.Error())
+	}
+
+	d.DeployItem.Status.Phase = lsv1alpha1.DeployItemPhases.Succeeded
+	return nil
+}
+
+// CheckResourcesReady checks if the managed resources are Ready/Healthy.
+func (d *Deployment) CheckResourcesReady(ctx context.Context, client client.Client) error {
+	managedResources := d.ManagedResources.TypedObjectReferenceList()
+
+	if !d.ReadinessChecks.DisableDefault {
+		timeout, lserr := timeout.TimeoutExceeded(ctx, d.DeployItem, d.Checkpoints.DefaultReadinessChecks)
+		if lserr != nil {
+			return lserr
+		}
+
+		defaultReadinessCheck := health.DefaultReadinessCheck{
+			Context:             ctx,
+			Client:              client,
+			CurrentOp:           "DefaultCheckResourcesReadiness" + d.Name,
+			Timeout:             &lsv1alpha1.Duration{Duration: timeout},
+			ManagedResources:    managedResources,
+			FailOnMissingObject: true,
+			InterruptionChecker: d.interruptionChecker(),
+		}
+		if err := defaultReadinessCheck.CheckResourcesReady(); err != nil {
+			return err
+		}
+	}
+
+	for _, customReadinessCheckConfig := range d.ReadinessChecks.CustomReadinessChecks {
+		timeout, lserr := timeout.TimeoutExceeded(ctx, d.DeployItem, d.Checkpoints.CustomReadinessChecks)
+		if lserr != nil {
+			return lserr
+		}
+
+		customReadinessCheck := health.CustomReadinessCheck{
+			Client:              client,
+			CurrentOp:           "CustomCheckResourcesReadiness" + d.Name,
+			Timeout:             &lsv1alpha1.Duration{Duration: timeout},
+			ManagedResources:    managedResources,
+			Configuration:       customReadinessCheckConfig,
+			InterruptionChecker: d.interruptionChecker(),
+			LsClient:            d.LsUncachedClient,
+			DeployItem:          d.DeployItem,
+			LsRestConfig:        d.LsRestConfig,
+		}
+		if err := customReadinessCheck.CheckResourcesReady(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Delete deletes the managed resources in the order of the deletion groups.
+// Resources that are not managed by the deploy item according to their policy are kept.
+func (d *Deployment) Delete(ctx context.Context) error {
+	logger, ctx := logging.FromContextOrNew(ctx, nil, lc.KeyMethod, "Delete")
+
+	managedResources := []managedresource.ManagedResourceStatus{}
+	for i := range *d.ManagedResources {
+		mr := &(*d.ManagedResources)[i]
+
+		mrLogger, mrCtx := logger.WithValuesAndContext(ctx,
+			lc.KeyResource, types.NamespacedName{Namespace: mr.Resource.Namespace, Name: mr.Resource.Name}.String(),
+			lc.KeyResourceKind, mr.Resource.Kind)
+		mrLogger.Debug("Checking resource")
+
+		ok, err := resourcemanager.FilterByPolicy(mrCtx, mr, d.TargetAccess.TargetClient(), d.DeployItem.Name)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+
+		notFound, err := resourcemanager.AnnotateAndPatchBeforeDelete(ctx, mr, d.TargetAccess.TargetClient())
+		if err != nil {
+			return err
+		}
+		if notFound {
+			continue
+		}
+
+		mrLogger.Debug("Object will be deleted")
+		managedResources = append(managedResources, *mr)
+	}
+
+	err := resourcemanager.DeleteManagedResources(
+		ctx,
+		d.LsUncachedClient,
+		managedResources,
+		d.DeletionGroups,
+		d.TargetAccess.TargetClient(),
+		d.DeployItem,
+		d.interruptionChecker(),
+		d.LsRestConfig,
+	)
+	if err != nil {
+		return fmt.Errorf("failed deleting managed resources: %w", err)
+	}
+	return nil
+}
+
+func (d *Deployment) interruptionChecker() interruption.InterruptionChecker {
+	return interruption.NewStandardInterruptionChecker(d.DeployItem, d.LsUncachedClient)
+}
@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package resourcemanager
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
+	"github.com/gardener/landscaper/pkg/utils/jsondiff"
+)
+
+// ignoredDiffFields contains the fields that are maintained by the api server
+// and therefore do not describe a change of the resource.
+var ignoredDiffFields = [][]string{
+	{"metadata", "resourceVersion"},
+	{"metadata", "generation"},
+	{"metadata", "managedFields"},
+	{"status"},
+}
+
+// ComputeResourceDiff compares a resource before and after it has been applied.
+func ComputeResourceDiff(before, after *unstructured.Unstructured) (*managedresource.ResourceDiff, error) {
+	beforeObj := before.DeepCopy()
+	afterObj := after.DeepCopy()
+	for _, fields := range ignoredDiffFields {
+		unstructured.RemoveNestedField(beforeObj.Object, fields...)
+		unstructured.RemoveNestedField(afterObj.Object, fields...)
+	}
+
+	changedFields, err := jsondiff.ChangedPaths(beforeObj.Object, afterObj.Object)
+	if err != nil {
+		return nil, fmt.Errorf("unable to compute diff of resource %s: %w", client.ObjectKeyFromObject(before).String(), err)
+	}
+
+	if len(changedFields) == 0 {
+		return &managedresource.ResourceDiff{
+			Action: managedresource.DiffActionUnchanged,
+		}, nil
+	}
+	return &managedresource.ResourceDiff{
+		Action:        managedresource.DiffActionChanged,
+		ChangedFields: changedFields,
+	}, nil
+}
+
+func (a *ManifestApplier) createOptions() []client.CreateOption {
+	if a.diffOnly {
+		return []client.CreateOption{client.DryRunAll}
+	}
+	return nil
+}
+
+func (a *ManifestApplier) updateOptions() []client.UpdateOption {
+	if a.diffOnly {
+		return []client.UpdateOption{client.DryRunAll}
+	}
+	return nil
+}
+
+func (a *ManifestApplier) patchOptions() []client.PatchOption {
+	if a.diffOnly {
+		return []client.PatchOption{client.DryRunAll}
+	}
+	return nil
+}
@@ -71,6 +71,11 @@ type ManifestApplierOptions struct {
 	Labels                     map[string]string
 	DeletionGroupsDuringUpdate []managedresource.DeletionGroupDefinition
 	InterruptionChecker        interruption.InterruptionChecker
+	// DiffOnly defines that the manifests are not applied, but only the diff of the resources is computed
+	// using server-side dry runs.
+	DiffOnly bool
+	// ServerSideApply configures the server-side apply of the manifests if the update strategy is "serverSideApply".
+	ServerSideApply *managedresource.ServerSideApplyConfiguration
 
 	LsUncachedClient client.Client
 	LsRestConfig     *rest.Config
@@ -90,6 +95,8 @@ type ManifestApplier struct {
 	labels                     map[string]string
 	deletionGroupsDuringUpdate []managedresource.DeletionGroupDefinition
 	interruptionChecker        interruption.InterruptionChecker
+	diffOnly                   bool
+	serverSideApplyConfig      *managedresource.ServerSideApplyConfiguration
 	lsUncachedClient           client.Client
 	lsRestConfig               *rest.Config
 
@@ -141,6 +148,8 @@ func NewManifestApplier(opts ManifestApplierOptions) *ManifestApplier {
 		labels:                     opts.Labels,
 		deletionGroupsDuringUpdate: opts.DeletionGroupsDuringUpdate,
 		interruptionChecker:        opts.InterruptionChecker,
+		diffOnly:                   opts.DiffOnly,
+		serverSideApplyConfig:      opts.ServerSideApply,
 		apiResourceHandler:         CreateApiResourceHandler(opts.Clientset),
 		lsUncachedClient:           opts.LsUncachedClient,
 		lsRestConfig:               opts.LsRestConfig,
@@ -153,6 +162,8 @@ func (a *ManifestApplier) GetManagedResourcesStatus() managedresource.ManagedRes
 }
 
 // Apply creates or updates all configured manifests.
+// The managed resources contain the diff of each resource. In diff only mode, no resource is changed and
+// the resources that would be deleted are kept in the managed resources.
 func (a *ManifestApplier) Apply(ctx context.Context) ([]*PatchInfo, error) {
 	if err := a.prepareManifests(ctx); err != nil {
 		return nil, err
@@ -260,23 +271,36 @@ func (a *ManifestApplier) applyObject(ctx context.Context, manifest *Manifest) (
 			obj.SetAnnotations(objAnnotations)
 		}
 
-		if err := a.kubeClient.Create(ctx, obj); err != nil {
+		if a.updateStrategy == manifestv1alpha2.UpdateStrategyServerSideApply {
+			// a new object cannot have conflicts with other field managers.
+			if _, err := a.applyServerSide(ctx, obj); err != nil {
+				return nil, nil, err
+			}
+		} else if err := a.kubeClient.Create(ctx, obj, a.createOptions()...); err != nil {
 			return nil, nil, fmt.Errorf("unable to create resource %s: %w", key.String(), err)
 		}
 
 		var patchInfo *PatchInfo
-		if manifest.PatchAfterDeployment != nil {
+		if manifest.PatchAfterDeployment != nil && !a.diffOnly {
 			patchInfo = &PatchInfo{
 				Resource: obj,
 				Patch:    manifest.PatchAfterDeployment,
 			}
 		}
 
+		if a.diffOnly {
+			// the object was only created in a dry run, so it has no valid uid.
+			obj.SetUID("")
+		}
+
 		return &managedresource.ManagedResourceStatus{
 			AnnotateBeforeDelete: manifest.AnnotateBeforeDelete,
 			PatchBeforeDelete:    manifest.PatchBeforeDelete,
 			Policy:               manifest.Policy,
 			Resource:             *kutil.CoreObjectReferenceFromUnstructuredObject(obj),
+			Diff: &managedresource.ResourceDiff{
+				Action: managedresource.DiffActionCreated,
+			},
 		}, patchInfo, nil
 	}
 
@@ -296,9 +320,17 @@ func (a *ManifestApplier) applyObject(ctx context.Context, manifest *Manifest) (
 
 	if manifest.Policy == managedresource.ImmutablePolicy {
 		logger.Info("Resource is immutable, skip update", lc.KeyResource, key.String())
+		mr.Diff = &managedresource.ResourceDiff{
+			Action: managedresource.DiffActionUnchanged,
+		}
 		return mr, nil, nil
 	}
 
+	// remember the current state of the object to compute the diff.
+	// The applied object is updated with the response of the api server.
+	before := currObj.DeepCopy()
+	after := obj
+
 	switch a.updateStrategy {
 	case manifestv1alpha2.UpdateStrategyUpdate:
 		fallthrough
@@ -314,11 +346,11 @@ func (a *ManifestApplier) applyObject(ctx context.Context, manifest *Manifest) (
 		}
 
 		if a.updateStrategy == manifestv1alpha2.UpdateStrategyUpdate {
-			if err := a.kubeClient.Update(ctx, obj); err != nil {
+			if err := a.kubeClient.Update(ctx, obj, a.updateOptions()...); err != nil {
 				return mr, nil, fmt.Errorf("unable to update resource %s: %w", key.String(), err)
 			}
 		} else {
-			if err := a.kubeClient.Patch(ctx, obj, client.MergeFrom(&currObj)); err != nil {
+			if err := a.kubeClient.Patch(ctx, obj, client.MergeFrom(&currObj), a.patchOptions()...); err != nil {
 				return mr, nil, fmt.Errorf("unable to patch resource %s: %w", key.String(), err)
 			}
 		}
@@ -342,15 +374,38 @@ func (a *ManifestApplier) applyObject(ctx context.Context, manifest *Manifest) (
 		a.injectLabels(&currObj)
 		kutil.SetMetaDataLabel(&currObj, manifestv1alpha2.ManagedDeployItemLabel, a.deployItemName)
 
-		if err := a.kubeClient.Update(ctx, &currObj); err != nil {
+		if err := a.kubeClient.Update(ctx, &currObj, a.updateOptions()...); err != nil {
 			return mr, nil, fmt.Errorf("unable to update resource %s: %w", key.String(), err)
 		}
+		after = &currObj
+	case manifestv1alpha2.UpdateStrategyServerSideApply:
+		// inject manifest specific labels
+		a.injectLabels(obj)
+		kutil.SetMetaDataLabel(obj, manifestv1alpha2.ManagedDeployItemLabel, a.deployItemName)
+
+		conflicts, err := a.applyServerSide(ctx, obj)
+		if err != nil {
+			return mr, nil, err
+		}
+		if len(conflicts) != 0 {
+			logger.Info("Resource has conflicts with other field managers, skip update", lc.KeyResource, key.String())
+			mr.Conflicts = conflicts
+			mr.Diff = &managedresource.ResourceDiff{
+				Action: managedresource.DiffActionUnchanged,
+			}
+			return mr, nil, nil
+		}
 	default:
 		return mr, nil, fmt.Errorf("%s is not a valid update strategy", a.updateStrategy)
 	}
 
+	mr.Diff, err = ComputeResourceDiff(before, after)
+	if err != nil {
+		return mr, nil, err
+	}
+
 	var patchInfo *PatchInfo
-	if manifest.PatchAfterDeployment != nil {
+	if manifest.PatchAfterDeployment != nil && !a.diffOnly {
 		patchInfo = &PatchInfo{
 			Resource: &currObj,
 			Patch:    manifest.PatchAfterDeployment,
@@ -437,6 +492,18 @@ func (a *ManifestApplier) cleanupOrphanedResourcesInGroups(ctx context.Context,
 		orphanedManagedResources = append(orphanedManagedResources, *mr)
 	}
 
+	if a.diffOnly {
+		// the orphaned resources are not deleted and therefore still managed by the deployer.
+		for i := range orphanedManagedResources {
+			mr := orphanedManagedResources[i]
+			mr.Diff = &managedresource.ResourceDiff{
+				Action: managedresource.DiffActionDeleted,
+			}
+			a.managedResources = append(a.managedResources, mr)
+		}
+		return nil
+	}
+
 	for i := range orphanedManagedResources {
 		mr := &orphanedManagedResources[i]
 
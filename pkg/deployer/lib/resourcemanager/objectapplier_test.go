@@ -148,6 +148,146 @@ var _ = Describe("ObjectApplier", func() {
 		Expect(managedResources).To(HaveLen(0))
 	})
 
+	It("should record the diff of the applied resources", func() {
+		cm := &corev1.ConfigMap{}
+		cm.Name = "my-cm"
+		cm.Namespace = state.Namespace
+		cm.Data = map[string]string{
+			"key": "val",
+		}
+		cmRaw, err := kutil.ConvertToRawExtension(cm, scheme.Scheme)
+		Expect(err).ToNot(HaveOccurred())
+
+		opts := resourcemanager.ManifestApplierOptions{
+			Decoder:          api.NewDecoder(scheme.Scheme),
+			KubeClient:       testenv.Client,
+			Clientset:        clientset,
+			DefaultNamespace: state.Namespace,
+			UpdateStrategy:   manifestv1alpha2.UpdateStrategyUpdate,
+			Manifests: []managedresource.Manifest{
+				{
+					Manifest: cmRaw,
+				},
+			},
+			ManagedResources: managedresource.ManagedResourceStatusList{},
+		}
+		managedResources, err := resourcemanager.ApplyManifests(ctx, opts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(managedResources).To(HaveLen(1))
+		Expect(managedResources[0].Diff).To(Equal(&managedresource.ResourceDiff{
+			Action: managedresource.DiffActionCreated,
+		}))
+
+		opts.ManagedResources = managedResources
+		managedResources, err = resourcemanager.ApplyManifests(ctx, opts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(managedResources).To(HaveLen(1))
+		Expect(managedResources[0].Diff).To(Equal(&managedresource.ResourceDiff{
+			Action: managedresource.DiffActionUnchanged,
+		}))
+
+		cm.Data["key"] = "modified"
+		cmRaw, err = kutil.ConvertToRawExtension(cm, scheme.Scheme)
+		Expect(err).ToNot(HaveOccurred())
+		opts.Manifests = []managedresource.Manifest{
+			{
+				Manifest: cmRaw,
+			},
+		}
+		opts.ManagedResources = managedResources
+		managedResources, err = resourcemanager.ApplyManifests(ctx, opts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(managedResources).To(HaveLen(1))
+		Expect(managedResources[0].Diff).To(Equal(&managedresource.ResourceDiff{
+			Action:        managedresource.DiffActionChanged,
+			ChangedFields: []string{"data.key"},
+		}))
+	})
+
+	It("should only compute the diff of the resources in diff only mode", func() {
+		cm := &corev1.ConfigMap{}
+		cm.Name = "my-cm"
+		cm.Namespace = state.Namespace
+		cm.Data = map[string]string{
+			"key": "val",
+		}
+		cmRaw, err := kutil.ConvertToRawExtension(cm, scheme.Scheme)
+		Expect(err).ToNot(HaveOccurred())
+		secret := &corev1.Secret{}
+		secret.Name = "my-secret"
+		secret.Namespace = state.Namespace
+		secret.Data = map[string][]byte{
+			"key": []byte("val"),
+		}
+		secretRaw, err := kutil.ConvertToRawExtension(secret, scheme.Scheme)
+		Expect(err).ToNot(HaveOccurred())
+
+		opts := resourcemanager.ManifestApplierOptions{
+			Decoder:          api.NewDecoder(scheme.Scheme),
+			KubeClient:       testenv.Client,
+			Clientset:        clientset,
+			DefaultNamespace: state.Namespace,
+			UpdateStrategy:   manifestv1alpha2.UpdateStrategyUpdate,
+			Manifests: []managedresource.Manifest{
+				{
+					Manifest: cmRaw,
+				},
+				{
+					Manifest: secretRaw,
+				},
+			},
+			ManagedResources: managedresource.ManagedResourceStatusList{},
+		}
+		managedResources, err := resourcemanager.ApplyManifests(ctx, opts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(managedResources).To(HaveLen(2))
+
+		By("changing the configmap, removing the secret and adding a new configmap")
+		cm.Data["key"] = "modified"
+		cmRaw, err = kutil.ConvertToRawExtension(cm, scheme.Scheme)
+		Expect(err).ToNot(HaveOccurred())
+		newCm := &corev1.ConfigMap{}
+		newCm.Name = "my-new-cm"
+		newCm.Namespace = state.Namespace
+		newCmRaw, err := kutil.ConvertToRawExtension(newCm, scheme.Scheme)
+		Expect(err).ToNot(HaveOccurred())
+		opts.Manifests = []managedresource.Manifest{
+			{
+				Manifest: cmRaw,
+			},
+			{
+				Manifest: newCmRaw,
+			},
+		}
+		opts.ManagedResources = managedResources
+		opts.DiffOnly = true
+		managedResources, err = resourcemanager.ApplyManifests(ctx, opts)
+		Expect(err).ToNot(HaveOccurred())
+
+		diffs := map[string]*managedresource.ResourceDiff{}
+		for _, mr := range managedResources {
+			diffs[mr.Resource.Name] = mr.Diff
+		}
+		Expect(diffs).To(HaveLen(3))
+		Expect(diffs["my-cm"]).To(Equal(&managedresource.ResourceDiff{
+			Action:        managedresource.DiffActionChanged,
+			ChangedFields: []string{"data.key"},
+		}))
+		Expect(diffs["my-new-cm"]).To(Equal(&managedresource.ResourceDiff{
+			Action: managedresource.DiffActionCreated,
+		}))
+		Expect(diffs["my-secret"]).To(Equal(&managedresource.ResourceDiff{
+			Action: managedresource.DiffActionDeleted,
+		}))
+
+		By("verifying that no resource has been changed")
+		res := &corev1.ConfigMap{}
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(cm), res)).To(Succeed())
+		Expect(res.Data).To(HaveKeyWithValue("key", "val"))
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(newCm), res)).To(HaveOccurred())
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(secret), &corev1.Secret{})).To(Succeed())
+	})
+
 	It("should keep a sorted list of managed resources", func() {
 		cm := &corev1.ConfigMap{}
 		cm.Name = "my-cm"
@@ -697,4 +837,110 @@ var _ = Describe("ObjectApplier", func() {
 		Expect(cmRead.Data).To(HaveKeyWithValue("addedKey", "val1"))
 		Expect(cmRead.Annotations).To(HaveKeyWithValue("modified", "True"))
 	})
+
+	It("should update objects correctly when serverSideApply strategy is selected", func() {
+		// CREATE
+
+		cm := &corev1.ConfigMap{}
+		cm.Name = "my-cm"
+		cm.Namespace = state.Namespace
+		cm.Data = map[string]string{
+			"key": "val",
+		}
+		cmRaw, err := kutil.ConvertToRawExtension(cm, scheme.Scheme)
+		Expect(err).ToNot(HaveOccurred())
+
+		opts := resourcemanager.ManifestApplierOptions{
+			DeployItemName:   "test-di",
+			Decoder:          api.NewDecoder(scheme.Scheme),
+			KubeClient:       testenv.Client,
+			Clientset:        clientset,
+			DefaultNamespace: state.Namespace,
+			UpdateStrategy:   manifestv1alpha2.UpdateStrategyServerSideApply,
+			ServerSideApply: &managedresource.ServerSideApplyConfiguration{
+				FieldManager:   "test-manager",
+				ConflictPolicy: managedresource.ConflictPolicyFail,
+			},
+			Manifests: []managedresource.Manifest{
+				{
+					Manifest: cmRaw,
+					Policy:   managedresource.ManagePolicy,
+				},
+			},
+			ManagedResources: managedresource.ManagedResourceStatusList{},
+		}
+		managedResources, err := resourcemanager.ApplyManifests(ctx, opts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(managedResources).To(HaveLen(1))
+
+		cmRead := &corev1.ConfigMap{}
+		Expect(testenv.Client.Get(ctx, client.ObjectKeyFromObject(cm), cmRead)).ToNot(HaveOccurred())
+		Expect(cmRead.Labels).To(HaveKeyWithValue(manifestv1alpha2.ManagedDeployItemLabel, opts.DeployItemName))
+		Expect(cmRead.Data).To(HaveKeyWithValue("key", "val"))
+		managers := []string{}
+		for _, entry := range cmRead.ManagedFields {
+			managers = append(managers, entry.Manager)
+		}
+		Expect(managers).To(ContainElement("test-manager"))
+
+		// UPDATE
+
+		By("adding a field that is owned by another field manager")
+		modifiedCm := &corev1.ConfigMap{}
+		Expect(state.Client.Get(ctx, client.ObjectKeyFromObject(cm), modifiedCm)).To(Succeed())
+		modifiedCm.Data["addedKey"] = "val1"
+		Expect(state.Client.Update(ctx, modifiedCm)).To(Succeed())
+
+		cm.Data["key"] = "valUpdated"
+		cmRaw, err = kutil.ConvertToRawExtension(cm, scheme.Scheme)
+		Expect(err).ToNot(HaveOccurred())
+		opts.Manifests[0].Manifest = cmRaw
+		opts.ManagedResources = managedResources
+		managedResources, err = resourcemanager.ApplyManifests(ctx, opts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(managedResources).To(HaveLen(1))
+		Expect(managedResources[0].Conflicts).To(BeEmpty())
+		Expect(managedResources[0].Diff).To(Equal(&managedresource.ResourceDiff{
+			Action:        managedresource.DiffActionChanged,
+			ChangedFields: []string{"data.key"},
+		}))
+
+		Expect(testenv.Client.Get(ctx, client.ObjectKeyFromObject(cm), cmRead)).ToNot(HaveOccurred())
+		Expect(cmRead.Data).To(HaveKeyWithValue("key", "valUpdated"))
+		Expect(cmRead.Data).To(HaveKeyWithValue("addedKey", "val1"))
+
+		By("changing a field that is owned by the landscaper with another field manager")
+		Expect(state.Client.Get(ctx, client.ObjectKeyFromObject(cm), modifiedCm)).To(Succeed())
+		modifiedCm.Data["key"] = "valOther"
+		Expect(state.Client.Update(ctx, modifiedCm, client.FieldOwner("other-manager"))).To(Succeed())
+
+		cm.Data["key"] = "valUpdated2"
+		cmRaw, err = kutil.ConvertToRawExtension(cm, scheme.Scheme)
+		Expect(err).ToNot(HaveOccurred())
+		opts.Manifests[0].Manifest = cmRaw
+		opts.ManagedResources = managedResources
+		_, err = resourcemanager.ApplyManifests(ctx, opts)
+		Expect(err).To(HaveOccurred())
+
+		By("reporting the conflicts")
+		opts.ServerSideApply.ConflictPolicy = managedresource.ConflictPolicyReport
+		managedResources, err = resourcemanager.ApplyManifests(ctx, opts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(managedResources).To(HaveLen(1))
+		Expect(managedResources[0].Conflicts).To(HaveLen(1))
+		Expect(managedResources[0].Conflicts[0]).To(ContainSubstring("other-manager"))
+		Expect(testenv.Client.Get(ctx, client.ObjectKeyFromObject(cm), cmRead)).ToNot(HaveOccurred())
+		Expect(cmRead.Data).To(HaveKeyWithValue("key", "valOther"))
+
+		By("forcing the ownership of the conflicting fields")
+		opts.ServerSideApply.ConflictPolicy = managedresource.ConflictPolicyForce
+		opts.ManagedResources = managedResources
+		managedResources, err = resourcemanager.ApplyManifests(ctx, opts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(managedResources).To(HaveLen(1))
+		Expect(managedResources[0].Conflicts).To(BeEmpty())
+		Expect(testenv.Client.Get(ctx, client.ObjectKeyFromObject(cm), cmRead)).ToNot(HaveOccurred())
+		Expect(cmRead.Data).To(HaveKeyWithValue("key", "valUpdated2"))
+		Expect(cmRead.Data).To(HaveKeyWithValue("addedKey", "val1"))
+	})
 })
@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package resourcemanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
+)
+
+// applyServerSide applies the object with server-side apply.
+// The object is updated with the response of the api server.
+// If the apply fails because of conflicts and the conflict policy is "report", the conflicting fields are returned
+// and no error is returned.
+func (a *ManifestApplier) applyServerSide(ctx context.Context, obj *unstructured.Unstructured) ([]string, error) {
+	opts := []client.ApplyOption{
+		client.FieldOwner(a.serverSideApplyConfig.GetFieldManager()),
+	}
+	conflictPolicy := a.serverSideApplyConfig.GetConflictPolicy()
+	if conflictPolicy == managedresource.ConflictPolicyForce {
+		opts = append(opts, client.ForceOwnership)
+	}
+	if a.diffOnly {
+		opts = append(opts, client.DryRunAll)
+	}
+
+	// the resource version must not be set, otherwise the apply fails if the object has been changed in the meantime.
+	obj.SetResourceVersion("")
+	if err := a.kubeClient.Apply(ctx, client.ApplyConfigurationFromUnstructured(obj), opts...); err != nil {
+		if apierrors.IsConflict(err) && conflictPolicy == managedresource.ConflictPolicyReport {
+			return getApplyConflicts(err), nil
+		}
+		return nil, fmt.Errorf("unable to apply resource %s: %w", client.ObjectKeyFromObject(obj).String(), err)
+	}
+	return nil, nil
+}
+
+// getApplyConflicts returns a description of all conflicting fields of a server-side apply conflict error.
+func getApplyConflicts(err error) []string {
+	var statusErr apierrors.APIStatus
+	if !errors.As(err, &statusErr) || statusErr.Status().Details == nil {
+		return []string{err.Error()}
+	}
+
+	conflicts := make([]string, 0)
+	for _, cause := range statusErr.Status().Details.Causes {
+		if cause.Type != metav1.CauseTypeFieldManagerConflict {
+			continue
+		}
+		conflicts = append(conflicts, cause.Message)
+	}
+	if len(conflicts) == 0 {
+		return []string{err.Error()}
+	}
+	sort.Strings(conflicts)
+	return conflicts
+}
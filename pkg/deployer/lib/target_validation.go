@@ -22,6 +22,11 @@ func ValidateTarget(ctx context.Context, resolvedTarget *lsv1alpha1.ResolvedTarg
 		return fmt.Errorf("resolved target does not contain original target")
 	}
 
+	if len(resolvedTarget.Target.Spec.Type) != 0 && resolvedTarget.Target.Spec.Type != targettypes.KubernetesClusterTargetType {
+		// only kubernetes cluster targets are validated
+		return nil
+	}
+
 	targetConfig := &targettypes.KubernetesClusterTargetConfig{}
 	if err := yaml.Unmarshal([]byte(resolvedTarget.Content), targetConfig); err != nil {
 		return fmt.Errorf("unable to parse target confíguration: %w", err)
@@ -8,6 +8,7 @@ import (
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	lserrors "github.com/gardener/landscaper/apis/errors"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/metrics"
 )
 
 const (
@@ -48,6 +49,7 @@ func (t *standardTimeoutChecker) TimeoutExceeded(ctx context.Context, deployItem
 		msg := fmt.Sprintf("timeout at: %q", checkpoint)
 		err := lserrors.NewError(op, lsv1alpha1.ProgressingTimeoutReason, msg, lsv1alpha1.ErrorTimeout)
 		logger.Info(err.Error())
+		metrics.ObserveTimeout(metrics.TimeoutTypeProgressing)
 		return 0, err
 	} else {
 		remainingTime := endTime.Sub(currentTime)
@@ -26,6 +26,7 @@ import (
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
 	"github.com/gardener/landscaper/pkg/api"
 	"github.com/gardener/landscaper/pkg/deployer/lib/targetselector"
+	"github.com/gardener/landscaper/pkg/metrics"
 	lsutil "github.com/gardener/landscaper/pkg/utils"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 )
@@ -94,6 +95,7 @@ func HandleReconcileResult(ctx context.Context, err lserrors.LsError, oldDeployI
 
 	logger, ctx := logging.FromContextOrNew(ctx, nil)
 	lsutil.SetLastError(&deployItem.Status, lserrors.TryUpdateLsError(deployItem.Status.GetLastError(), err))
+	metrics.ObserveError(metrics.KindDeployItem, err)
 
 	if deployItem.Status.GetLastError() != nil {
 		if lserrors.ContainsAnyErrorCode(deployItem.Status.GetLastError().Codes, lsv1alpha1.UnrecoverableErrorCodes) {
@@ -104,10 +106,14 @@ func HandleReconcileResult(ctx context.Context, err lserrors.LsError, oldDeployI
 		lsEventRecorder.Event(deployItem, corev1.EventTypeWarning, lastErr.Reason, lastErr.Message)
 	}
 
+	lsutil.RecordPhaseTransitionEvent(lsEventRecorder, deployItem, string(oldDeployItem.Status.Phase), string(deployItem.Status.Phase),
+		deployItem.Status.Phase.IsFailed(), deployItem.Status.GetJobID())
+
 	// if a reconciliation ends in a final phase, the current job is done
 	if deployItem.Status.Phase.IsFinal() {
 		deployItem.Status.JobIDFinished = deployItem.Status.GetJobID()
 		deployItem.Status.TransitionTimes = lsutil.SetFinishedTransitionTime(deployItem.Status.TransitionTimes)
+		metrics.ObserveFinishedJob(metrics.KindDeployItem, string(deployItem.Status.Phase), deployItem.Status.TransitionTimes)
 	}
 
 	if !reflect.DeepEqual(&oldDeployItem.Status, &deployItem.Status) {
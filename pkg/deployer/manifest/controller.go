@@ -17,6 +17,7 @@ import (
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	deployerlib "github.com/gardener/landscaper/pkg/deployer/lib"
 	cr "github.com/gardener/landscaper/pkg/deployer/lib/continuousreconcile"
+	"github.com/gardener/landscaper/pkg/deployer/lib/driftdetection"
 	"github.com/gardener/landscaper/pkg/deployer/lib/extension"
 )
 
@@ -44,6 +45,7 @@ func NewDeployer(lsRestConfig *rest.Config,
 		log:                log,
 		config:             config,
 		hooks:              extension.ReconcileExtensionHooks{},
+		driftDetector:      driftdetection.NewDetector(lsUncachedClient),
 	}
 	dep.hooks.RegisterHookSetup(cr.ContinuousReconcileExtensionSetup(dep.NextReconcile))
 	return dep, nil
@@ -58,6 +60,7 @@ type deployer struct {
 	log                logging.Logger
 	config             manifestv1alpha2.Configuration
 	hooks              extension.ReconcileExtensionHooks
+	driftDetector      *driftdetection.Detector
 }
 
 func (d *deployer) Reconcile(ctx context.Context, _ *lsv1alpha1.Context, di *lsv1alpha1.DeployItem, rt *lsv1alpha1.ResolvedTarget) error {
@@ -66,7 +69,21 @@ func (d *deployer) Reconcile(ctx context.Context, _ *lsv1alpha1.Context, di *lsv
 		return err
 	}
 	manifest.SetLsRestConfig(d.lsRestConfig)
-	return manifest.Reconcile(ctx)
+
+	// the deployer modifies the managed resources itself, so they must not be watched during the reconcile.
+	d.driftDetector.Stop(client.ObjectKeyFromObject(di))
+	if err := manifest.Reconcile(ctx); err != nil {
+		return err
+	}
+	if manifest.ProviderConfiguration.DiffOnly {
+		return nil
+	}
+	if err := d.driftDetector.Watch(ctx, di, manifest.ProviderConfiguration.DriftDetection,
+		manifest.targetAccess.TargetRestConfig(), manifest.ProviderStatus.ManagedResources); err != nil {
+		logger, _ := logging.FromContextOrNew(ctx, nil)
+		logger.Error(err, "unable to start drift detection")
+	}
+	return nil
 }
 
 func (d deployer) Delete(ctx context.Context, _ *lsv1alpha1.Context, di *lsv1alpha1.DeployItem, rt *lsv1alpha1.ResolvedTarget) error {
@@ -75,6 +92,7 @@ func (d deployer) Delete(ctx context.Context, _ *lsv1alpha1.Context, di *lsv1alp
 		return err
 	}
 	manifest.SetLsRestConfig(d.lsRestConfig)
+	d.driftDetector.Stop(client.ObjectKeyFromObject(di))
 	return manifest.Delete(ctx)
 }
 
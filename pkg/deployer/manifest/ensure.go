@@ -6,11 +6,9 @@ package manifest
 
 import (
 	"context"
-	"fmt"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
-	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
@@ -18,12 +16,7 @@ import (
 	manifestv1alpha2 "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2"
 	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
 	lserrors "github.com/gardener/landscaper/apis/errors"
-	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
-	"github.com/gardener/landscaper/controller-utils/pkg/logging"
-	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
-	deployerlib "github.com/gardener/landscaper/pkg/deployer/lib"
-	"github.com/gardener/landscaper/pkg/deployer/lib/interruption"
-	health "github.com/gardener/landscaper/pkg/deployer/lib/readinesscheck"
+	"github.com/gardener/landscaper/pkg/deployer/lib/manifestdeployment"
 	"github.com/gardener/landscaper/pkg/deployer/lib/resourcemanager"
 	"github.com/gardener/landscaper/pkg/deployer/lib/timeout"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
@@ -31,7 +24,6 @@ import (
 
 func (m *Manifest) Reconcile(ctx context.Context) error {
 	currOp := "ReconcileManifests"
-	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, currOp})
 
 	if _, err := timeout.TimeoutExceeded(ctx, m.DeployItem, TimeoutCheckpointManifestStartReconcile); err != nil {
 		return err
@@ -53,146 +45,25 @@ func (m *Manifest) Reconcile(ctx context.Context) error {
 		}
 	}
 
-	applier := resourcemanager.NewManifestApplier(resourcemanager.ManifestApplierOptions{
-		Decoder:          serializer.NewCodecFactory(Scheme).UniversalDecoder(),
-		KubeClient:       m.targetAccess.TargetClient(),
-		Clientset:        m.targetAccess.TargetClientSet(),
-		DeployItemName:   m.DeployItem.Name,
-		DeployItem:       m.DeployItem,
-		UpdateStrategy:   m.ProviderConfiguration.UpdateStrategy,
-		Manifests:        m.ProviderConfiguration.Manifests,
-		ManagedResources: m.ProviderStatus.ManagedResources,
+	return m.deployment().Apply(ctx, resourcemanager.ManifestApplierOptions{
+		Decoder:        serializer.NewCodecFactory(Scheme).UniversalDecoder(),
+		UpdateStrategy: m.ProviderConfiguration.UpdateStrategy,
+		Manifests:      m.ProviderConfiguration.Manifests,
 		Labels: map[string]string{
 			manifestv1alpha2.ManagedDeployItemLabel: m.DeployItem.Name,
 		},
 		DeletionGroupsDuringUpdate: m.ProviderConfiguration.DeletionGroupsDuringUpdate,
-		InterruptionChecker:        interruption.NewStandardInterruptionChecker(m.DeployItem, m.lsUncachedClient),
-		LsUncachedClient:           m.lsUncachedClient,
-		LsRestConfig:               m.lsRestConfig,
+		DiffOnly:                   m.ProviderConfiguration.DiffOnly,
+		ServerSideApply:            m.ProviderConfiguration.ServerSideApply,
 	})
-
-	patchInfos, err := applier.Apply(ctx)
-	m.ProviderStatus.ManagedResources = applier.GetManagedResourcesStatus()
-	if err != nil {
-		var err2 error
-		m.DeployItem.Status.ProviderStatus, err2 = kutil.ConvertToRawExtension(m.ProviderStatus, Scheme)
-		if err2 != nil {
-			logger.Error(err, "unable to encode status")
-		}
-		return err
-	}
-
-	m.DeployItem.Status.ProviderStatus, err = kutil.ConvertToRawExtension(m.ProviderStatus, Scheme)
-	if err != nil {
-		return lserrors.NewWrappedError(err,
-			currOp, "ProviderStatus", err.Error())
-	}
-	if err := m.Writer().UpdateDeployItemStatus(ctx, read_write_layer.W000062, m.DeployItem); err != nil {
-		return lserrors.NewWrappedError(err,
-			currOp, "UpdateStatus", err.Error())
-	}
-
-	if _, err := timeout.TimeoutExceeded(ctx, m.DeployItem, TimeoutCheckpointManifestBeforeReadinessCheck); err != nil {
-		return err
-	}
-
-	if err := m.CheckResourcesReady(ctx, m.targetAccess.TargetClient()); err != nil {
-		return err
-	}
-
-	if m.ProviderConfiguration.Exports != nil {
-		if _, err := timeout.TimeoutExceeded(ctx, m.DeployItem, TimeoutCheckpointManifestBeforeReadingExportValues); err != nil {
-			return err
-		}
-
-		opts := resourcemanager.ExporterOptions{
-			KubeClient:          m.targetAccess.TargetClient(),
-			InterruptionChecker: interruption.NewStandardInterruptionChecker(m.DeployItem, m.lsUncachedClient),
-			LsClient:            m.lsUncachedClient,
-			DeployItem:          m.DeployItem,
-			LsRestConfig:        m.lsRestConfig,
-		}
-
-		exporter := resourcemanager.NewExporter(opts)
-		exports, err := exporter.Export(ctx, m.ProviderConfiguration.Exports)
-		if err != nil {
-			return lserrors.NewWrappedError(err, currOp, "ReadExportValues", err.Error())
-		}
-
-		if err := deployerlib.CreateOrUpdateExport(ctx, m.Writer(), m.lsUncachedClient, m.DeployItem, exports); err != nil {
-			return err
-		}
-	}
-
-	err = applier.PatchAfterDeployment(ctx, patchInfos)
-	if err != nil {
-		return lserrors.NewWrappedError(err, currOp, "PatchAfterDeployment", err.Error())
-	}
-
-	m.DeployItem.Status.Phase = lsv1alpha1.DeployItemPhases.Succeeded
-
-	return nil
 }
 
 // CheckResourcesReady checks if the managed resources are Ready/Healthy.
 func (m *Manifest) CheckResourcesReady(ctx context.Context, client client.Client) error {
-
-	managedresources := m.ProviderStatus.ManagedResources.TypedObjectReferenceList()
-	if !m.ProviderConfiguration.ReadinessChecks.DisableDefault {
-		timeout, lserr := timeout.TimeoutExceeded(ctx, m.DeployItem, TimeoutCheckpointManifestDefaultReadinessChecks)
-		if lserr != nil {
-			return lserr
-		}
-
-		defaultReadinessCheck := health.DefaultReadinessCheck{
-			Context:             ctx,
-			Client:              client,
-			CurrentOp:           "DefaultCheckResourcesReadinessManifest",
-			Timeout:             &lsv1alpha1.Duration{Duration: timeout},
-			ManagedResources:    managedresources,
-			FailOnMissingObject: true,
-			InterruptionChecker: interruption.NewStandardInterruptionChecker(m.DeployItem, m.lsUncachedClient),
-		}
-		err := defaultReadinessCheck.CheckResourcesReady()
-		if err != nil {
-			return err
-		}
-	}
-
-	if m.ProviderConfiguration.ReadinessChecks.CustomReadinessChecks != nil {
-		for _, customReadinessCheckConfig := range m.ProviderConfiguration.ReadinessChecks.CustomReadinessChecks {
-			timeout, lserr := timeout.TimeoutExceeded(ctx, m.DeployItem, TimeoutCheckpointManifestCustomReadinessChecks)
-			if lserr != nil {
-				return lserr
-			}
-
-			customReadinessCheck := health.CustomReadinessCheck{
-				Client:              client,
-				CurrentOp:           "CustomCheckResourcesReadinessManifest",
-				Timeout:             &lsv1alpha1.Duration{Duration: timeout},
-				ManagedResources:    managedresources,
-				Configuration:       customReadinessCheckConfig,
-				InterruptionChecker: interruption.NewStandardInterruptionChecker(m.DeployItem, m.lsUncachedClient),
-				LsClient:            m.lsUncachedClient,
-				DeployItem:          m.DeployItem,
-				LsRestConfig:        m.lsRestConfig,
-			}
-			err := customReadinessCheck.CheckResourcesReady(ctx)
-			if err != nil {
-				return err
-			}
-		}
-	}
-
-	return nil
+	return m.deployment().CheckResourcesReady(ctx, client)
 }
 
 func (m *Manifest) Delete(ctx context.Context) error {
-	return m.deleteManifestsInGroups(ctx)
-}
-
-func (m *Manifest) deleteManifestsInGroups(ctx context.Context) error {
-	logger, ctx := logging.FromContextOrNew(ctx, nil, lc.KeyMethod, "Delete")
 	op := "deleteManifestsInGroups"
 
 	m.DeployItem.Status.Phase = lsv1alpha1.DeployItemPhases.Deleting
@@ -210,49 +81,8 @@ func (m *Manifest) deleteManifestsInGroups(ctx context.Context) error {
 		return lserrors.NewWrappedError(err, op, "ensureTargetAccess", err.Error())
 	}
 
-	managedResources := []managedresource.ManagedResourceStatus{}
-	for i := range m.ProviderStatus.ManagedResources {
-		mr := &m.ProviderStatus.ManagedResources[i]
-
-		mrLogger, mrCtx := logger.WithValuesAndContext(ctx,
-			lc.KeyResource, types.NamespacedName{Namespace: mr.Resource.Namespace, Name: mr.Resource.Name}.String(),
-			lc.KeyResourceKind, mr.Resource.Kind)
-		mrLogger.Debug("Checking resource")
-
-		ok, err := resourcemanager.FilterByPolicy(mrCtx, mr, m.targetAccess.TargetClient(), m.DeployItem.Name)
-		if err != nil {
-			return err
-		}
-		if !ok {
-			continue
-		}
-
-		notFound, err := resourcemanager.AnnotateAndPatchBeforeDelete(ctx, mr, m.targetAccess.TargetClient())
-		if err != nil {
-			return err
-		}
-		if notFound {
-			continue
-		}
-
-		mrLogger.Debug("Object will be deleted")
-		managedResources = append(managedResources, *mr)
-	}
-
-	interruptionChecker := interruption.NewStandardInterruptionChecker(m.DeployItem, m.lsUncachedClient)
-
-	err := resourcemanager.DeleteManagedResources(
-		ctx,
-		m.lsUncachedClient,
-		managedResources,
-		m.ProviderConfiguration.DeletionGroups,
-		m.targetAccess.TargetClient(),
-		m.DeployItem,
-		interruptionChecker,
-		m.lsRestConfig,
-	)
-	if err != nil {
-		return fmt.Errorf("failed deleting managed resources: %w", err)
+	if err := m.deployment().Delete(ctx); err != nil {
+		return err
 	}
 
 	// remove finalizer
@@ -260,6 +90,30 @@ func (m *Manifest) deleteManifestsInGroups(ctx context.Context) error {
 	return m.Writer().UpdateDeployItem(ctx, read_write_layer.W000045, m.DeployItem)
 }
 
+// deployment returns the deployment of the manifests of the deploy item.
+func (m *Manifest) deployment() *manifestdeployment.Deployment {
+	return &manifestdeployment.Deployment{
+		Name:             "Manifest",
+		LsUncachedClient: m.lsUncachedClient,
+		LsRestConfig:     m.lsRestConfig,
+		DeployItem:       m.DeployItem,
+		TargetAccess:     m.targetAccess,
+		ProviderStatus:   m.ProviderStatus,
+		Scheme:           Scheme,
+		ManagedResources: &m.ProviderStatus.ManagedResources,
+		StatusWriteID:    read_write_layer.W000062,
+		ReadinessChecks:  m.ProviderConfiguration.ReadinessChecks,
+		Exports:          m.ProviderConfiguration.Exports,
+		DeletionGroups:   m.ProviderConfiguration.DeletionGroups,
+		Checkpoints: manifestdeployment.TimeoutCheckpoints{
+			BeforeReadinessCheck:      TimeoutCheckpointManifestBeforeReadinessCheck,
+			BeforeReadingExportValues: TimeoutCheckpointManifestBeforeReadingExportValues,
+			DefaultReadinessChecks:    TimeoutCheckpointManifestDefaultReadinessChecks,
+			CustomReadinessChecks:     TimeoutCheckpointManifestCustomReadinessChecks,
+		},
+	}
+}
+
 func (m *Manifest) Writer() *read_write_layer.Writer {
 	return read_write_layer.NewWriter(m.lsUncachedClient)
 }
@@ -36,6 +36,7 @@ func AddControllerToManager(lsUncachedClient, lsCachedClient client.Client,
 		lsUncachedClient, lsCachedClient,
 		log,
 		lsMgr.GetScheme(),
+		lsMgr.GetEventRecorderFor("Landscaper"),
 		deployItemPickupTimeout,
 		config.Workers,
 	)
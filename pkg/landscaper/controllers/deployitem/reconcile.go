@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -18,6 +19,7 @@ import (
 	lserrors "github.com/gardener/landscaper/apis/errors"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/metrics"
 	lsutil "github.com/gardener/landscaper/pkg/utils"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 )
@@ -110,6 +112,7 @@ func (con *controller) writePickupTimeoutExceeded(ctx context.Context, di *lsv1a
 	logger, ctx := logging.FromContextOrNew(ctx, nil)
 	logger = logger.WithValues(lc.KeyMethod, "writePickupTimeoutExceeded")
 	logger.Info("pickup timeout occurred", "reasonTargetNotFound", reasonTargetNotFound)
+	metrics.ObserveTimeout(metrics.TimeoutTypePickup)
 
 	di.Status.JobIDFinished = di.Status.GetJobID()
 	di.Status.TransitionTimes = lsutil.SetFinishedTransitionTime(di.Status.TransitionTimes)
@@ -131,5 +134,8 @@ func (con *controller) writePickupTimeoutExceeded(ctx context.Context, di *lsv1a
 		return err
 	}
 
+	lastErr := di.Status.GetLastError()
+	con.eventRecorder.Event(di, corev1.EventTypeWarning, lastErr.Reason, lastErr.Message)
+
 	return nil
 }
@@ -11,6 +11,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
@@ -27,13 +28,15 @@ var _ = Describe("Deploy Item Controller Reconcile Test", func() {
 	var (
 		state                *envtest.State
 		deployItemController reconcile.Reconciler
+		eventRecorder        *record.FakeRecorder
 	)
 
 	BeforeEach(func() {
 		var err error
 
+		eventRecorder = record.NewFakeRecorder(1024)
 		deployItemController, err = dictrl.NewController(testenv.Client, testenv.Client, logging.Discard(), api.LandscaperScheme,
-			&testPickupTimeoutDuration, 1000)
+			eventRecorder, &testPickupTimeoutDuration, 1000)
 		Expect(err).ToNot(HaveOccurred())
 	})
 
@@ -72,6 +75,7 @@ var _ = Describe("Deploy Item Controller Reconcile Test", func() {
 		Expect(utils2.IsDeployItemJobIDsIdentical(di)).To(BeTrue())
 		Expect(di.Status.LastError).ToNot(BeNil())
 		Expect(di.Status.LastError.Message).ToNot(ContainSubstring("Target"))
+		Expect(eventRecorder.Events).To(Receive(HavePrefix("Warning " + lsv1alpha1.PickupTimeoutReason)))
 	})
 
 	It("should detect if the reason for a pickup timeout is a missing target", func() {
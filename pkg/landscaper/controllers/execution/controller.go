@@ -24,9 +24,11 @@ import (
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	"github.com/gardener/landscaper/pkg/landscaper/execution"
 	"github.com/gardener/landscaper/pkg/landscaper/operation"
+	"github.com/gardener/landscaper/pkg/metrics"
 	lsutil "github.com/gardener/landscaper/pkg/utils"
 	"github.com/gardener/landscaper/pkg/utils/lock"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+	"github.com/gardener/landscaper/pkg/utils/tracing"
 )
 
 // NewController creates a new execution controller that reconcile Execution resources.
@@ -202,7 +204,9 @@ func (c *controller) reconcile(ctx context.Context, req reconcile.Request) (reco
 	if isDifferentJobIDs(exec) {
 		// Execution is unfinished
 
+		ctx, span := tracing.StartSpan(ctx, tracing.KindExecution, exec, exec.Status.JobID)
 		err := c.handleReconcilePhase(ctx, exec)
+		tracing.EndSpan(span, err)
 		return lsutil.LogHelper{}.LogErrorAndGetReconcileResult(ctx, err)
 	} else {
 		// Execution is finished; nothing to do
@@ -454,16 +458,25 @@ func (c *controller) setExecutionPhaseAndUpdate(ctx context.Context, exec *lsv1a
 	logger, ctx := logging.FromContextOrNew(ctx, nil)
 
 	exec.Status.LastError = lserrors.TryUpdateLsError(exec.Status.LastError, lsErr)
+	metrics.ObserveError(metrics.KindExecution, lsErr)
+
+	if lsErr != nil && exec.Status.LastError != nil {
+		lastErr := exec.Status.LastError
+		c.eventRecorder.Event(exec, corev1.EventTypeWarning, lastErr.Reason, lastErr.Message)
+	}
 
 	if phase != exec.Status.ExecutionPhase {
 		now := metav1.Now()
 		exec.Status.PhaseTransitionTime = &now
+		lsutil.RecordPhaseTransitionEvent(c.eventRecorder, exec, string(exec.Status.ExecutionPhase), string(phase),
+			phase.IsFailed(), exec.Status.JobID)
 	}
 	exec.Status.ExecutionPhase = phase
 
 	if exec.Status.ExecutionPhase.IsFinal() {
 		exec.Status.JobIDFinished = exec.Status.JobID
 		exec.Status.TransitionTimes = lsutil.SetFinishedTransitionTime(exec.Status.TransitionTimes)
+		metrics.ObserveFinishedJob(metrics.KindExecution, string(phase), exec.Status.TransitionTimes)
 	}
 
 	if err := c.Writer().UpdateExecutionStatus(ctx, writeID, exec); err != nil {
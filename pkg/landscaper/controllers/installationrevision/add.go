@@ -0,0 +1,29 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installationrevision
+
+import (
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+)
+
+// AddControllerToManager adds the installation revision controller to the manager.
+// The controller creates an InstallationRevision for every successfully finished job of a root installation.
+func AddControllerToManager(lsUncachedClient client.Client, logger logging.Logger, lsMgr manager.Manager) error {
+	log := logger.Reconciles("installationRevision", "Installation")
+	ctrl := NewController(lsUncachedClient, log)
+
+	return builder.ControllerManagedBy(lsMgr).
+		Named("installationrevision").
+		For(&lsv1alpha1.Installation{}, builder.OnlyMetadata).
+		WithLogConstructor(func(r *reconcile.Request) logr.Logger { return log.Logr() }).
+		Complete(ctrl)
+}
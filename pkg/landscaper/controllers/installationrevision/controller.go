@@ -0,0 +1,153 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installationrevision
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+	"github.com/gardener/landscaper/pkg/utils"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// Controller creates an InstallationRevision for every successfully finished job of a root installation
+// and deletes the revisions exceeding the revision history limit of the installation.
+type Controller struct {
+	lsUncachedClient client.Client
+	log              logging.Logger
+}
+
+// NewController returns a new installation revision controller.
+func NewController(lsUncachedClient client.Client, logger logging.Logger) reconcile.Reconciler {
+	return &Controller{
+		lsUncachedClient: lsUncachedClient,
+		log:              logger,
+	}
+}
+
+// Reconcile snapshots the last finished job of an installation and deletes the revisions exceeding the history limit.
+// Revisions are also deleted if no snapshot is required, so that reducing the limit, e.g. to 0, takes effect at once.
+func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (result reconcile.Result, err error) {
+	logger, ctx := c.log.StartReconcileAndAddToContext(ctx, req)
+
+	result = reconcile.Result{}
+	defer utils.HandlePanics(ctx, &result, nil)
+
+	inst := &lsv1alpha1.Installation{}
+	if err := read_write_layer.GetInstallation(ctx, c.lsUncachedClient, req.NamespacedName, inst, read_write_layer.R000121); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.Info(err.Error())
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	if !installations.IsRootInstallation(inst) || !inst.DeletionTimestamp.IsZero() {
+		return reconcile.Result{}, nil
+	}
+
+	revisions, err := installations.ListRevisions(ctx, c.lsUncachedClient, inst, read_write_layer.R000122)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if isSnapshotRequired(inst) && !hasRevisionOfJob(revisions, inst.Status.JobIDFinished) {
+		revision, err := c.createRevision(ctx, inst, revisions)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		logger.Info("created installation revision", "revision", revision.Spec.Revision, "jobID", revision.Spec.JobID)
+		revisions = append(revisions, *revision)
+	}
+
+	return reconcile.Result{}, c.deleteOldRevisions(ctx, inst, revisions)
+}
+
+// isSnapshotRequired returns true if the last job of the root installation has succeeded and revisions are enabled.
+// Installations whose spec has been changed after the start of the last job are not snapshotted,
+// because the spec would not describe the deployed state.
+func isSnapshotRequired(inst *lsv1alpha1.Installation) bool {
+	return installations.GetRevisionHistoryLimit(inst) > 0 &&
+		inst.Status.InstallationPhase == lsv1alpha1.InstallationPhases.Succeeded &&
+		len(inst.Status.JobIDFinished) != 0 &&
+		inst.Status.JobID == inst.Status.JobIDFinished &&
+		inst.GetGeneration() == inst.Status.ObservedGeneration
+}
+
+func hasRevisionOfJob(revisions []lsv1alpha1.InstallationRevision, jobID string) bool {
+	for i := range revisions {
+		if revisions[i].Spec.JobID == jobID {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Controller) createRevision(ctx context.Context, inst *lsv1alpha1.Installation,
+	revisions []lsv1alpha1.InstallationRevision) (*lsv1alpha1.InstallationRevision, error) {
+
+	var number int64 = 1
+	if len(revisions) > 0 {
+		number = revisions[len(revisions)-1].Spec.Revision + 1
+	}
+
+	revision := &lsv1alpha1.InstallationRevision{}
+	revision.Name = installations.RevisionName(inst, number)
+	revision.Namespace = inst.Namespace
+	revision.Labels = map[string]string{lsv1alpha1.InstallationRevisionOfLabel: inst.Name}
+	revision.Spec = lsv1alpha1.InstallationRevisionSpec{
+		InstallationName: inst.Name,
+		Revision:         number,
+		JobID:            inst.Status.JobIDFinished,
+		ImportsHash:      inst.Status.ImportsHash,
+		InstallationSpec: *inst.Spec.DeepCopy(),
+	}
+
+	if inst.Status.ExecutionReference != nil {
+		exec := &lsv1alpha1.Execution{}
+		if err := read_write_layer.GetExecution(ctx, c.lsUncachedClient, inst.Status.ExecutionReference.NamespacedName(),
+			exec, read_write_layer.R000123); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return nil, err
+			}
+		} else {
+			revision.Spec.DeployItems = exec.Spec.DeployItems
+		}
+	}
+
+	if err := controllerutil.SetOwnerReference(inst, revision, api.LandscaperScheme); err != nil {
+		return nil, err
+	}
+
+	writer := read_write_layer.NewWriter(c.lsUncachedClient)
+	if err := writer.CreateInstallationRevision(ctx, read_write_layer.W000154, revision); err != nil {
+		return nil, err
+	}
+	return revision, nil
+}
+
+// deleteOldRevisions deletes the oldest revisions exceeding the revision history limit of the installation.
+// The revisions must be sorted by ascending revision number.
+func (c *Controller) deleteOldRevisions(ctx context.Context, inst *lsv1alpha1.Installation,
+	revisions []lsv1alpha1.InstallationRevision) error {
+
+	writer := read_write_layer.NewWriter(c.lsUncachedClient)
+	for i := 0; i < len(revisions)-installations.GetRevisionHistoryLimit(inst); i++ {
+		if err := writer.DeleteInstallationRevision(ctx, read_write_layer.W000155, &revisions[i]); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return err
+			}
+		}
+	}
+	return nil
+}
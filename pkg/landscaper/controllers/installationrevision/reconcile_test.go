@@ -0,0 +1,121 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installationrevision_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/landscaper/controllers/installationrevision"
+	testutils "github.com/gardener/landscaper/test/utils"
+	"github.com/gardener/landscaper/test/utils/envtest"
+)
+
+var _ = Describe("Reconcile", func() {
+
+	var (
+		ctrl  reconcile.Reconciler
+		state *envtest.State
+	)
+
+	BeforeEach(func() {
+		ctrl = installationrevision.NewController(testenv.Client, logging.Discard())
+	})
+
+	AfterEach(func() {
+		if state != nil {
+			ctx := context.Background()
+			defer ctx.Done()
+			Expect(testenv.CleanupState(ctx, state)).To(Succeed())
+			state = nil
+		}
+	})
+
+	listRevisions := func(ctx context.Context, instName string) []lsv1alpha1.InstallationRevision {
+		revisions := &lsv1alpha1.InstallationRevisionList{}
+		testutils.ExpectNoError(testenv.Client.List(ctx, revisions, client.InNamespace(state.Namespace),
+			client.MatchingLabels{lsv1alpha1.InstallationRevisionOfLabel: instName}))
+		return revisions.Items
+	}
+
+	It("should create a revision for a succeeded job and delete the revisions exceeding the history limit", func() {
+		ctx := context.Background()
+
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/state/test1")
+		Expect(err).ToNot(HaveOccurred())
+
+		inst := &lsv1alpha1.Installation{}
+		inst.Name = "root"
+		inst.Namespace = state.Namespace
+		testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst))
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+
+		revisions := listRevisions(ctx, "root")
+		Expect(revisions).To(HaveLen(1))
+		revision := revisions[0]
+		Expect(revision.Name).To(Equal("root-2"))
+		Expect(revision.Spec.InstallationName).To(Equal("root"))
+		Expect(revision.Spec.Revision).To(Equal(int64(2)))
+		Expect(revision.Spec.JobID).To(Equal("job2"))
+		Expect(revision.Spec.ImportsHash).To(Equal("hash2"))
+		Expect(revision.Spec.InstallationSpec.Blueprint).To(Equal(inst.Spec.Blueprint))
+		Expect(revision.Spec.InstallationSpec.RevisionHistoryLimit).To(Equal(inst.Spec.RevisionHistoryLimit))
+		Expect(revision.Spec.DeployItems).To(HaveLen(1))
+		Expect(revision.Spec.DeployItems[0].Name).To(Equal("item"))
+		Expect(revision.OwnerReferences).To(HaveLen(1))
+		Expect(revision.OwnerReferences[0].UID).To(Equal(inst.UID))
+
+		// a second reconcile must not create another revision of the same job
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+		revisions = listRevisions(ctx, "root")
+		Expect(revisions).To(HaveLen(1))
+		Expect(revisions[0].Name).To(Equal("root-2"))
+	})
+
+	It("should delete all revisions if the history limit is set to 0", func() {
+		ctx := context.Background()
+
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/state/test1")
+		Expect(err).ToNot(HaveOccurred())
+
+		inst := &lsv1alpha1.Installation{}
+		inst.Name = "root"
+		inst.Namespace = state.Namespace
+		testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst))
+
+		inst.Spec.RevisionHistoryLimit = ptr.To[int32](0)
+		testutils.ExpectNoError(testenv.Client.Update(ctx, inst))
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+		Expect(listRevisions(ctx, "root")).To(BeEmpty())
+	})
+
+	It("should not create a revision for a failed job", func() {
+		ctx := context.Background()
+
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/state/test1")
+		Expect(err).ToNot(HaveOccurred())
+
+		inst := &lsv1alpha1.Installation{}
+		inst.Name = "failed"
+		inst.Namespace = state.Namespace
+
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+		Expect(listRevisions(ctx, "failed")).To(BeEmpty())
+	})
+
+})
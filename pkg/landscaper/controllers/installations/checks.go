@@ -13,6 +13,8 @@ func isInstFinished(inst *lsv1alpha1.Installation) bool {
 		needsFinalizer(inst) ||
 		hasDependentsToTrigger(inst) ||
 		hasInterruptOperation(inst) ||
+		hasPlanOperation(inst) ||
+		hasRollbackOperation(inst) ||
 		isNotRootWithReconcileOperation(inst) ||
 		isCreateNewJobID(inst) ||
 		isDifferentJobIDs(inst) {
@@ -31,7 +33,7 @@ func isAutomaticReconcileOnSpecChange(inst *lsv1alpha1.Installation) bool {
 }
 
 func isAutomaticReconcileConfigured(inst *lsv1alpha1.Installation) bool {
-	retryHelper := newRetryHelper(nil, nil)
+	retryHelper := newRetryHelper(nil, nil, nil)
 	return retryHelper.isRetryActivatedForSucceeded(inst) || retryHelper.isRetryActivatedForFailed(inst)
 }
 
@@ -48,6 +50,16 @@ func hasInterruptOperation(inst *lsv1alpha1.Installation) bool {
 	return lsv1alpha1helper.HasOperation(inst.ObjectMeta, lsv1alpha1.InterruptOperation)
 }
 
+func hasPlanOperation(inst *lsv1alpha1.Installation) bool {
+	return lsv1alpha1helper.HasOperation(inst.ObjectMeta, lsv1alpha1.PlanOperation) &&
+		inst.Status.JobID == inst.Status.JobIDFinished
+}
+
+func hasRollbackOperation(inst *lsv1alpha1.Installation) bool {
+	return lsv1alpha1helper.HasOperation(inst.ObjectMeta, lsv1alpha1.RollbackOperation) &&
+		inst.Status.JobID == inst.Status.JobIDFinished
+}
+
 func isNotRootWithReconcileOperation(inst *lsv1alpha1.Installation) bool {
 	return !installations.IsRootInstallation(inst) && lsv1alpha1helper.HasOperation(inst.ObjectMeta, lsv1alpha1.ReconcileOperation)
 }
@@ -32,10 +32,12 @@ import (
 	"github.com/gardener/landscaper/pkg/landscaper/installations"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions"
 	"github.com/gardener/landscaper/pkg/landscaper/operation"
+	"github.com/gardener/landscaper/pkg/metrics"
 	"github.com/gardener/landscaper/pkg/utils"
 	utilscache "github.com/gardener/landscaper/pkg/utils/cache"
 	"github.com/gardener/landscaper/pkg/utils/lock"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+	"github.com/gardener/landscaper/pkg/utils/tracing"
 	"github.com/gardener/landscaper/pkg/utils/verify"
 )
 
@@ -258,7 +260,7 @@ func (c *Controller) handleAutomaticReconcile(ctx context.Context, inst *lsv1alp
 		}
 	}
 
-	retryHelper := newRetryHelper(c.LsUncachedClient(), c.clock)
+	retryHelper := newRetryHelper(c.LsUncachedClient(), c.EventRecorder(), c.clock)
 
 	if err := retryHelper.preProcessRetry(ctx, inst); err != nil {
 		return utils.LogHelper{}.LogStandardErrorAndGetReconcileResult(ctx, err)
@@ -299,6 +301,22 @@ func (c *Controller) reconcileInstallation(ctx context.Context, inst *lsv1alpha1
 		return reconcile.Result{}, nil
 	}
 
+	if hasPlanOperation(inst) {
+		// a plan is only computed if no job is running, i.e. the deployed objects are in a stable state
+		if err := c.handlePlanOperation(ctx, inst); err != nil {
+			return reconcile.Result{}, err
+		}
+		return reconcile.Result{}, nil
+	}
+
+	if hasRollbackOperation(inst) {
+		// a rollback is only done if no job is running; only root installations have revisions
+		if err := c.handleRollbackOperation(ctx, inst); err != nil {
+			return reconcile.Result{}, err
+		}
+		return reconcile.Result{}, nil
+	}
+
 	if isNotRootWithReconcileOperation(inst) {
 		// only root installations could be triggered with operation annotation to prevent that end users interfere with overall
 		// algorithm
@@ -311,8 +329,13 @@ func (c *Controller) reconcileInstallation(ctx context.Context, inst *lsv1alpha1
 
 	// generate new jobID
 	if isCreateNewJobID(inst) {
+		if deferred, result, err := c.deferJobToMaintenanceWindow(ctx, inst); err != nil || deferred {
+			return result, err
+		}
+
 		inst.Status.JobID = uuid.New().String()
 		inst.Status.TransitionTimes = utils.NewTransitionTimes()
+		inst.Status.PendingJob = nil
 
 		if err := c.WriterToLsUncachedClient().UpdateInstallationStatus(ctx, read_write_layer.W000082, inst); err != nil {
 			return reconcile.Result{}, err
@@ -325,12 +348,23 @@ func (c *Controller) reconcileInstallation(ctx context.Context, inst *lsv1alpha1
 		return reconcile.Result{}, nil
 	}
 
+	if inst.Status.PendingJob != nil {
+		// the reconcile annotation of a deferred job was removed before the maintenance window opened
+		logger.Info("removing pending job, because the reconcile annotation was removed")
+		inst.Status.PendingJob = nil
+		if err := c.WriterToLsUncachedClient().UpdateInstallationStatus(ctx, read_write_layer.W000164, inst); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
 	// handle reconcile
 	if isDifferentJobIDs(inst) {
 		octx := utilscache.GetOCMContextCache().GetOrCreateOCMContext(ctx, inst.Status.JobID)
 		ctx = octx.BindTo(ctx)
 
+		ctx, span := tracing.StartSpan(ctx, tracing.KindInstallation, inst, inst.Status.JobID)
 		err := c.handleReconcilePhase(ctx, inst)
+		tracing.EndSpan(span, err)
 		return utils.LogHelper{}.LogErrorAndGetReconcileResult(ctx, err)
 	} else {
 		// job finished; nothing to do
@@ -388,6 +422,7 @@ func (c *Controller) initPrerequisites(ctx context.Context, inst *lsv1alpha1.Ins
 	instOp, err := installations.NewOperationBuilder(internalInstallation).
 		WithOperation(op).
 		WithContext(lsCtx).
+		WithTemplatePlugins(c.LsConfig.TemplatePlugins).
 		Build(ctx)
 	if err != nil {
 		err = fmt.Errorf("unable to create installation operation: %w", err)
@@ -463,7 +498,9 @@ func (c *Controller) setInstallationPhaseAndUpdate(ctx context.Context, inst *ls
 		[]interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(inst).String()},
 		lc.KeyMethod, op)
 
+	previousError := inst.Status.LastError
 	inst.Status.LastError = lserrors.TryUpdateLsError(inst.Status.LastError, lsError)
+	metrics.ObserveError(metrics.KindInstallation, lsError)
 
 	if inst.Status.LastError != nil {
 		lastErr := inst.Status.LastError
@@ -473,6 +510,8 @@ func (c *Controller) setInstallationPhaseAndUpdate(ctx context.Context, inst *ls
 	if phase != inst.Status.InstallationPhase {
 		now := metav1.Now()
 		inst.Status.PhaseTransitionTime = &now
+		utils.RecordPhaseTransitionEvent(c.EventRecorder(), inst, string(inst.Status.InstallationPhase), string(phase),
+			phase.IsFailed(), inst.Status.JobID)
 	}
 	inst.Status.InstallationPhase = phase
 
@@ -486,6 +525,7 @@ func (c *Controller) setInstallationPhaseAndUpdate(ctx context.Context, inst *ls
 
 		inst.Status.JobIDFinished = inst.Status.JobID
 		inst.Status.TransitionTimes = utils.SetFinishedTransitionTime(inst.Status.TransitionTimes)
+		metrics.ObserveFinishedJob(metrics.KindInstallation, string(phase), inst.Status.TransitionTimes)
 	}
 
 	if inst.Status.JobIDFinished == inst.Status.JobID && inst.DeletionTimestamp.IsZero() {
@@ -527,7 +567,10 @@ func (c *Controller) setInstallationPhaseAndUpdate(ctx context.Context, inst *ls
 		}
 
 		return lsError
-	} else if isInstFinished(inst) {
+	}
+
+	c.recordDeletionBlocked(inst, previousError)
+	if isInstFinished(inst) {
 		c.finishedObjectCache.AddSynchonized(&inst.ObjectMeta)
 	}
 
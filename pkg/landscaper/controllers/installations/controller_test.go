@@ -6,11 +6,16 @@ package installations_test
 
 import (
 	"context"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/clock"
+	clocktesting "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/gardener/landscaper/apis/config"
@@ -146,6 +151,202 @@ var _ = Describe("Installation Controller", func() {
 			testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(subinst), subinst))
 			Expect(subinst.Annotations).To(HaveKeyWithValue(lsv1alpha1.OperationAnnotation, string(lsv1alpha1.InterruptOperation)))
 		})
+
+		It("should compute the plan of an installation without applying it", func() {
+			// We consider a finished Installation with a plan annotation. The Installation has neither an Execution
+			// nor subinstallations yet. After a reconciliation the plan should contain the deploy item and the
+			// subinstallation of the blueprint, but no Execution or subinstallation should have been created.
+			ctx := context.Background()
+
+			var err error
+			state, err = testenv.InitResources(ctx, "./testdata/state/test12")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(testutils.CreateExampleDefaultContext(ctx, testenv.Client, state.Namespace)).To(Succeed())
+
+			inst := &lsv1alpha1.Installation{}
+			inst.Name = "root"
+			inst.Namespace = state.Namespace
+			testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst))
+			Expect(inst.Annotations).To(HaveKeyWithValue(lsv1alpha1.OperationAnnotation, string(lsv1alpha1.PlanOperation)))
+			jobID := inst.Status.JobID
+
+			testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+
+			testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst))
+			Expect(inst.Annotations).NotTo(HaveKey(lsv1alpha1.OperationAnnotation))
+			Expect(inst.Status.JobID).To(Equal(jobID))
+			Expect(inst.Status.JobIDFinished).To(Equal(jobID))
+			Expect(inst.Status.ExecutionReference).To(BeNil())
+
+			Expect(inst.Status.Plan).ToNot(BeNil())
+			Expect(inst.Status.Plan.Error).To(BeNil())
+			Expect(inst.Status.Plan.ObservedGeneration).To(Equal(inst.Generation))
+			Expect(inst.Status.Plan.DeployItems).To(HaveLen(1))
+			Expect(inst.Status.Plan.DeployItems[0].Name).To(Equal("subexec"))
+			Expect(inst.Status.Plan.DeployItems[0].Action).To(Equal(lsv1alpha1.PlannedActionCreate))
+			Expect(inst.Status.Plan.DeployItems[0].Template).ToNot(BeNil())
+			Expect(inst.Status.Plan.SubInstallations).To(HaveLen(1))
+			Expect(inst.Status.Plan.SubInstallations[0].Name).To(Equal("subinst"))
+			Expect(inst.Status.Plan.SubInstallations[0].Action).To(Equal(lsv1alpha1.PlannedActionCreate))
+
+			exec := &lsv1alpha1.Execution{}
+			exec.Name = inst.Name
+			exec.Namespace = state.Namespace
+			err = testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(exec), exec)
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+			subInsts := &lsv1alpha1.InstallationList{}
+			testutils.ExpectNoError(testenv.Client.List(ctx, subInsts, client.InNamespace(state.Namespace)))
+			Expect(subInsts.Items).To(HaveLen(1))
+		})
+
+		It("should roll back an installation to a previous revision", func() {
+			// We consider a failed root Installation with a rollback annotation and two revisions of earlier jobs.
+			// Without rollback-revision annotation, the Installation should be rolled back to the latest revision,
+			// because the last job has failed. Afterwards it is rolled back to the explicitly specified revision 1.
+			ctx := context.Background()
+
+			var err error
+			state, err = testenv.InitResources(ctx, "./testdata/state/test13")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(testutils.CreateExampleDefaultContext(ctx, testenv.Client, state.Namespace)).To(Succeed())
+
+			inst := &lsv1alpha1.Installation{}
+			inst.Name = "root"
+			inst.Namespace = state.Namespace
+			testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst))
+			jobID := inst.Status.JobID
+
+			testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+
+			testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst))
+			Expect(inst.Spec.Blueprint.Reference.ResourceName).To(Equal("root2"))
+			Expect(inst.Annotations).To(HaveKeyWithValue(lsv1alpha1.OperationAnnotation, string(lsv1alpha1.ReconcileOperation)))
+			Expect(inst.Status.JobID).To(Equal(jobID))
+
+			metav1.SetMetaDataAnnotation(&inst.ObjectMeta, lsv1alpha1.OperationAnnotation, string(lsv1alpha1.RollbackOperation))
+			metav1.SetMetaDataAnnotation(&inst.ObjectMeta, lsv1alpha1.RollbackRevisionAnnotation, "1")
+			testutils.ExpectNoError(testenv.Client.Update(ctx, inst))
+
+			testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+
+			testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst))
+			Expect(inst.Spec.Blueprint.Reference.ResourceName).To(Equal("root1"))
+			Expect(inst.Annotations).To(HaveKeyWithValue(lsv1alpha1.OperationAnnotation, string(lsv1alpha1.ReconcileOperation)))
+			Expect(inst.Annotations).NotTo(HaveKey(lsv1alpha1.RollbackRevisionAnnotation))
+		})
+
+		It("should remove the rollback annotations if the revision does not exist", func() {
+			ctx := context.Background()
+
+			var err error
+			state, err = testenv.InitResources(ctx, "./testdata/state/test13")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(testutils.CreateExampleDefaultContext(ctx, testenv.Client, state.Namespace)).To(Succeed())
+
+			inst := &lsv1alpha1.Installation{}
+			inst.Name = "root"
+			inst.Namespace = state.Namespace
+			testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst))
+			metav1.SetMetaDataAnnotation(&inst.ObjectMeta, lsv1alpha1.RollbackRevisionAnnotation, "7")
+			testutils.ExpectNoError(testenv.Client.Update(ctx, inst))
+
+			testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+
+			testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst))
+			Expect(inst.Spec.Blueprint.Reference.ResourceName).To(Equal("root3"))
+			Expect(inst.Annotations).NotTo(HaveKey(lsv1alpha1.OperationAnnotation))
+			Expect(inst.Annotations).NotTo(HaveKey(lsv1alpha1.RollbackRevisionAnnotation))
+		})
+
+		It("should refuse a rollback if the imports have changed since the revision was created", func() {
+			ctx := context.Background()
+
+			var err error
+			state, err = testenv.InitResources(ctx, "./testdata/state/test15")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(testutils.CreateExampleDefaultContext(ctx, testenv.Client, state.Namespace)).To(Succeed())
+
+			inst := &lsv1alpha1.Installation{}
+			inst.Name = "root"
+			inst.Namespace = state.Namespace
+			testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst))
+			generation := inst.Generation
+
+			testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+
+			testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst))
+			Expect(inst.Generation).To(Equal(generation))
+			Expect(inst.Annotations).NotTo(HaveKey(lsv1alpha1.OperationAnnotation))
+			Expect(inst.Annotations).NotTo(HaveKey(lsv1alpha1.RollbackRevisionAnnotation))
+			Expect(inst.Status.JobID).To(Equal("job2"))
+		})
+
+		It("should defer a new job until the maintenance window opens", func() {
+			ctx := context.Background()
+
+			var err error
+			state, err = testenv.InitResources(ctx, "./testdata/state/test14")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(testutils.CreateExampleDefaultContext(ctx, testenv.Client, state.Namespace)).To(Succeed())
+
+			// monday; the window opens on saturday at 22:00
+			fakeClock := clocktesting.NewFakePassiveClock(time.Date(2026, time.June, 8, 10, 0, 0, 0, time.UTC))
+			ctrl = installationsctl.NewTestActuator(testenv.Client, testenv.Client, testenv.Client,
+				*op, logging.Discard(), fakeClock, &config.LandscaperConfiguration{}, "test-inst5-"+testutils.GetNextCounter())
+
+			inst := &lsv1alpha1.Installation{}
+			inst.Name = "root"
+			inst.Namespace = state.Namespace
+
+			testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+
+			testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst))
+			Expect(inst.Status.JobID).To(Equal("job1"))
+			Expect(inst.Annotations).To(HaveKeyWithValue(lsv1alpha1.OperationAnnotation, string(lsv1alpha1.ReconcileOperation)))
+			Expect(inst.Status.PendingJob).NotTo(BeNil())
+			Expect(inst.Status.PendingJob.Generation).To(Equal(inst.Generation))
+			Expect(inst.Status.PendingJob.NextWindowStart.Time.Equal(time.Date(2026, time.June, 13, 22, 0, 0, 0, time.UTC))).To(BeTrue())
+
+			fakeClock.SetTime(time.Date(2026, time.June, 13, 23, 0, 0, 0, time.UTC))
+			testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+
+			testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst))
+			Expect(inst.Status.JobID).NotTo(Equal("job1"))
+			Expect(inst.Annotations).NotTo(HaveKey(lsv1alpha1.OperationAnnotation))
+			Expect(inst.Status.PendingJob).To(BeNil())
+		})
+
+		It("should remove a pending job if the reconcile annotation is removed", func() {
+			ctx := context.Background()
+
+			var err error
+			state, err = testenv.InitResources(ctx, "./testdata/state/test14")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(testutils.CreateExampleDefaultContext(ctx, testenv.Client, state.Namespace)).To(Succeed())
+
+			// monday; the window opens on saturday at 22:00
+			fakeClock := clocktesting.NewFakePassiveClock(time.Date(2026, time.June, 8, 10, 0, 0, 0, time.UTC))
+			ctrl = installationsctl.NewTestActuator(testenv.Client, testenv.Client, testenv.Client,
+				*op, logging.Discard(), fakeClock, &config.LandscaperConfiguration{}, "test-inst6-"+testutils.GetNextCounter())
+
+			inst := &lsv1alpha1.Installation{}
+			inst.Name = "root"
+			inst.Namespace = state.Namespace
+
+			testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+
+			testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst))
+			Expect(inst.Status.PendingJob).NotTo(BeNil())
+
+			delete(inst.Annotations, lsv1alpha1.OperationAnnotation)
+			testutils.ExpectNoError(testenv.Client.Update(ctx, inst))
+			testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+
+			testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst))
+			Expect(inst.Status.JobID).To(Equal("job1"))
+			Expect(inst.Status.PendingJob).To(BeNil())
+		})
 	})
 
 })
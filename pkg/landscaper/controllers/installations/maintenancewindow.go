@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+	lsutil "github.com/gardener/landscaper/pkg/utils"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// deferJobToMaintenanceWindow checks whether a requested job of a root installation must be deferred, because
+// it was requested outside of the maintenance window of the installation. In this case the pending job is recorded
+// in the status, and the installation is requeued at the start of the next window.
+// Deletions are never deferred.
+func (c *Controller) deferJobToMaintenanceWindow(ctx context.Context, inst *lsv1alpha1.Installation) (bool, reconcile.Result, error) {
+	currOp := "DeferJobToMaintenanceWindow"
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	if !inst.DeletionTimestamp.IsZero() {
+		return false, reconcile.Result{}, nil
+	}
+
+	window, err := installations.GetMaintenanceWindow(ctx, c.LsUncachedClient(), inst, read_write_layer.R000125)
+	if err != nil {
+		return false, reconcile.Result{}, lserrors.NewWrappedError(err, currOp, "GetMaintenanceWindow", err.Error())
+	}
+	if window == nil {
+		return false, reconcile.Result{}, nil
+	}
+
+	now := c.clock.Now()
+	inWindow, nextWindowStart, err := installations.IsInMaintenanceWindow(window, now)
+	if err != nil {
+		return false, reconcile.Result{}, lserrors.NewWrappedError(err, currOp, "IsInMaintenanceWindow", err.Error())
+	}
+	if inWindow {
+		return false, reconcile.Result{}, nil
+	}
+
+	pendingJob := inst.Status.PendingJob
+	if pendingJob == nil || pendingJob.Generation != inst.GetGeneration() || !pendingJob.NextWindowStart.Time.Equal(nextWindowStart) {
+		if pendingJob == nil {
+			c.EventRecorder().Eventf(inst, corev1.EventTypeNormal, lsutil.EventReasonJobDeferred,
+				"Job deferred until the next maintenance window opens at %s", nextWindowStart.UTC().Format(time.RFC3339))
+		}
+
+		requestTime := metav1.NewTime(now)
+		if pendingJob != nil {
+			requestTime = pendingJob.RequestTime
+		}
+
+		inst.Status.PendingJob = &lsv1alpha1.PendingJob{
+			Generation:      inst.GetGeneration(),
+			RequestTime:     requestTime,
+			NextWindowStart: metav1.NewTime(nextWindowStart),
+		}
+		if err := c.WriterToLsUncachedClient().UpdateInstallationStatus(ctx, read_write_layer.W000158, inst); err != nil {
+			return false, reconcile.Result{}, err
+		}
+	}
+
+	logger.Info("job deferred until next maintenance window", "nextWindowStart", nextWindowStart)
+	return true, reconcile.Result{RequeueAfter: nextWindowStart.Sub(now)}, nil
+}
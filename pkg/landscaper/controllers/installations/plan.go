@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/imports"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/plan"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// handlePlanOperation computes the plan of the installation, i.e. the deploy items and subinstallations that a
+// reconcile would create, update or delete, and writes it to the status of the installation.
+// No imports, deploy items, subinstallations or template state are modified.
+func (c *Controller) handlePlanOperation(ctx context.Context, inst *lsv1alpha1.Installation) error {
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(inst).String()})
+	logger.Info("compute plan of installation")
+
+	instPlan, importsHash, lsErr := c.computePlan(ctx, inst.DeepCopy())
+	if lsErr != nil {
+		logger.Info("unable to compute plan of installation", lc.KeyError, lsErr.Error())
+		instPlan = &lsv1alpha1.InstallationPlan{}
+		var lastPlanError *lsv1alpha1.Error
+		if inst.Status.Plan != nil {
+			lastPlanError = inst.Status.Plan.Error
+		}
+		instPlan.Error = lserrors.TryUpdateLsError(lastPlanError, lsErr)
+	}
+
+	instPlan.CreationTime = metav1.Now()
+	instPlan.ObservedGeneration = inst.GetGeneration()
+	instPlan.ImportsHash = importsHash
+
+	inst.Status.Plan = instPlan
+	if err := c.WriterToLsUncachedClient().UpdateInstallationStatus(ctx, read_write_layer.W000150, inst); err != nil {
+		return err
+	}
+
+	delete(inst.Annotations, lsv1alpha1.OperationAnnotation)
+	if err := c.WriterToLsUncachedClient().UpdateInstallation(ctx, read_write_layer.W000151, inst); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// computePlan computes the plan of the given installation. The installation object may be modified and should
+// therefore be a copy.
+func (c *Controller) computePlan(ctx context.Context, inst *lsv1alpha1.Installation) (*lsv1alpha1.InstallationPlan, string, lserrors.LsError) {
+	currOp := "ComputePlan"
+
+	// the signature is verified as for a reconcile, so that a plan is not computed for an unverified component version
+	instOp, imps, importsHash, _, fatalError, normalError := c.init(ctx, inst, true)
+	if fatalError != nil {
+		return nil, "", fatalError
+	} else if normalError != nil {
+		return nil, "", normalError
+	}
+
+	instOp.CurrentOperation = currOp
+	instOp.DryRun = true
+
+	constructor := imports.NewConstructor(instOp)
+	if err := constructor.Construct(ctx, imps); err != nil {
+		return nil, importsHash, lserrors.NewWrappedError(err, currOp, "ConstructImports", err.Error())
+	}
+	if err := constructor.RenderImportExecutions(); err != nil {
+		return nil, importsHash, lserrors.NewWrappedError(err, currOp, "RenderImportExecutions", err.Error())
+	}
+
+	instPlan, err := plan.New(instOp).Plan(ctx)
+	if err != nil {
+		return nil, importsHash, lserrors.NewWrappedError(err, currOp, "Plan", err.Error())
+	}
+
+	return instPlan, importsHash, nil
+}
@@ -7,9 +7,11 @@ package installations
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -28,6 +30,14 @@ var (
 	SiblingDeleteError = errors.New("deletion of a sibling failed")                //nolint:staticcheck
 )
 
+const (
+	// reasonSiblingImport is the error reason if the deletion of an installation is blocked by a sibling importing its exports.
+	reasonSiblingImport = "SiblingImport"
+	// reasonSiblingDeleteError is the error reason if the deletion of an installation fails,
+	// because the deletion of a sibling importing its exports has failed.
+	reasonSiblingDeleteError = "SiblingDeleteError"
+)
+
 func (c *Controller) handleDeletionPhaseInit(ctx context.Context, inst *lsv1alpha1.Installation) (fatalError lserrors.LsError, normalError lserrors.LsError) {
 	op := "handleDeletionPhaseInit"
 
@@ -212,6 +222,31 @@ func (c *Controller) deleteAllowed(ctx context.Context, inst *lsv1alpha1.Install
 	return checkIfSiblingImports(inst, installations.CreateInternalInstallationBases(siblings...))
 }
 
+// recordDeletionBlocked emits an event if the deletion of the installation has become blocked by a sibling,
+// or if it is blocked by another sibling than before.
+// A sibling that still imports the exports only delays the deletion, whereas a sibling whose deletion has failed
+// lets the deletion fail, so the latter is reported as warning.
+// It must only be called after the status with the current error has been written.
+func (c *Controller) recordDeletionBlocked(inst *lsv1alpha1.Installation, previousError *lsv1alpha1.Error) {
+	lastError := inst.Status.LastError
+	if lastError == nil {
+		return
+	}
+	var eventType string
+	switch lastError.Reason {
+	case reasonSiblingImport:
+		eventType = corev1.EventTypeNormal
+	case reasonSiblingDeleteError:
+		eventType = corev1.EventTypeWarning
+	default:
+		return
+	}
+	if previousError != nil && previousError.Reason == lastError.Reason && previousError.Message == lastError.Message {
+		return
+	}
+	c.EventRecorder().Event(inst, eventType, lsutil.EventReasonDeletionBlocked, lastError.Message)
+}
+
 // checkIfSiblingImports checks if a sibling imports any of the installations exports.
 func checkIfSiblingImports(inst *lsv1alpha1.Installation, siblings []*installations.InstallationAndImports) (fatalError lserrors.LsError, normalError lserrors.LsError) {
 	for _, sibling := range siblings {
@@ -237,14 +272,14 @@ func checkSuccessorSibling(inst *lsv1alpha1.Installation,
 	if inst.Status.JobID == sibling.GetInstallation().Status.JobIDFinished &&
 		sibling.GetInstallation().Status.InstallationPhase == lsv1alpha1.InstallationPhases.DeleteFailed {
 
-		err := lserrors.NewWrappedError(SiblingDeleteError, op, "SiblingDeleteError",
-			SiblingDeleteError.Error(), lsv1alpha1.ErrorForInfoOnly)
+		err := lserrors.NewWrappedError(SiblingDeleteError, op, reasonSiblingDeleteError,
+			fmt.Sprintf("%s: %s", SiblingDeleteError.Error(), sibling.GetInstallation().Name), lsv1alpha1.ErrorForInfoOnly)
 
 		return err, nil
 	}
 
-	err := lserrors.NewWrappedError(SiblingImportError, op, "SiblingImport", SiblingImportError.Error(),
-		lsv1alpha1.ErrorForInfoOnly)
+	err := lserrors.NewWrappedError(SiblingImportError, op, reasonSiblingImport,
+		fmt.Sprintf("%s: %s", SiblingImportError.Error(), sibling.GetInstallation().Name), lsv1alpha1.ErrorForInfoOnly)
 
 	return nil, err
 }
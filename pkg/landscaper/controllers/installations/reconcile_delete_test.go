@@ -13,6 +13,7 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/record"
@@ -39,14 +40,16 @@ var _ = Describe("Delete", func() {
 
 	Context("reconciler", func() {
 		var (
-			op   *lsoperation.Operation
-			ctrl reconcile.Reconciler
+			op       *lsoperation.Operation
+			ctrl     reconcile.Reconciler
+			recorder *record.FakeRecorder
 
 			state *envtest.State
 		)
 
 		BeforeEach(func() {
-			op = lsoperation.NewOperation(api.LandscaperScheme, record.NewFakeRecorder(1024), testenv.Client)
+			recorder = record.NewFakeRecorder(1024)
+			op = lsoperation.NewOperation(api.LandscaperScheme, recorder, testenv.Client)
 			ctrl = installationsctl.NewTestActuator(testenv.Client, testenv.Client, testenv.Client,
 				*op, logging.Discard(), clock.RealClock{},
 				&config.LandscaperConfiguration{}, "test-inst1-"+testutils.GetNextCounter())
@@ -110,6 +113,14 @@ var _ = Describe("Delete", func() {
 			testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst))
 			Expect(lsutils.IsInstallationPhase(inst, lsv1alpha1.InstallationPhases.DeleteFailed)).To(BeTrue())
 			Expect(lsutils.IsInstallationJobIDsIdentical(inst)).To(BeTrue())
+			events := make([]string, 0)
+			for len(recorder.Events) > 0 {
+				events = append(events, <-recorder.Events)
+			}
+			Expect(events).To(ContainElement(And(
+				HavePrefix(corev1.EventTypeWarning+" "+lsutils.EventReasonDeletionBlocked),
+				ContainSubstring(installationsctl.SiblingDeleteError.Error()),
+			)))
 
 			inst = state.Installations[state.Namespace+"/root"]
 			testutils.ShouldReconcileButRetry(ctx, ctrl, testutils.RequestFromObject(inst))
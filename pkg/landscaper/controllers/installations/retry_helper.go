@@ -12,13 +12,16 @@ import (
 
 	"k8s.io/utils/clock"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lsutil "github.com/gardener/landscaper/pkg/utils"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 )
 
@@ -32,16 +35,18 @@ var (
 )
 
 type retryHelper struct {
-	cl     client.Client
-	writer *read_write_layer.Writer
-	clock  clock.PassiveClock
+	cl            client.Client
+	writer        *read_write_layer.Writer
+	eventRecorder record.EventRecorder
+	clock         clock.PassiveClock
 }
 
-func newRetryHelper(cl client.Client, passiveClock clock.PassiveClock) *retryHelper {
+func newRetryHelper(cl client.Client, eventRecorder record.EventRecorder, passiveClock clock.PassiveClock) *retryHelper {
 	return &retryHelper{
-		cl:     cl,
-		writer: read_write_layer.NewWriter(cl),
-		clock:  passiveClock,
+		cl:            cl,
+		writer:        read_write_layer.NewWriter(cl),
+		eventRecorder: eventRecorder,
+		clock:         passiveClock,
 	}
 }
 
@@ -229,6 +234,16 @@ func (r *retryHelper) addReconcileAnnotation(ctx context.Context, inst *lsv1alph
 		return err
 	}
 
+	if r.eventRecorder != nil {
+		if r.isFailed(inst) {
+			r.eventRecorder.Eventf(inst, corev1.EventTypeNormal, lsutil.EventReasonAutomaticReconcile,
+				"Retry of failed installation triggered (phase %s)", inst.Status.InstallationPhase)
+		} else {
+			r.eventRecorder.Event(inst, corev1.EventTypeNormal, lsutil.EventReasonAutomaticReconcile,
+				"Periodic reconcile of succeeded installation triggered")
+		}
+	}
+
 	return nil
 }
 
@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+	lsutil "github.com/gardener/landscaper/pkg/utils"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+const (
+	reconcileReasonRollback = "rollback"
+)
+
+// handleRollbackOperation rolls back a root installation to a previous revision. The spec of the installation is
+// replaced by the spec stored in the revision, and the rollback annotation is replaced by a reconcile annotation,
+// so that the restored spec is deployed by the next job.
+// If no suitable revision exists, or if the import data has changed since the revision was created, the rollback
+// annotations are removed and a warning event is emitted.
+func (c *Controller) handleRollbackOperation(ctx context.Context, inst *lsv1alpha1.Installation) error {
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(inst).String()})
+
+	revisions, err := installations.ListRevisions(ctx, c.LsUncachedClient(), inst, read_write_layer.R000124)
+	if err != nil {
+		return err
+	}
+
+	revision, err := installations.GetRollbackRevision(inst, revisions)
+	if err == nil {
+		err = c.checkRollbackImports(ctx, inst, revision)
+	}
+	if err != nil {
+		logger.Info("unable to roll back installation", lc.KeyError, err.Error())
+		c.EventRecorder().Event(inst, corev1.EventTypeWarning, lsutil.EventReasonRollback, err.Error())
+
+		delete(inst.Annotations, lsv1alpha1.OperationAnnotation)
+		delete(inst.Annotations, lsv1alpha1.RollbackRevisionAnnotation)
+		return c.WriterToLsUncachedClient().UpdateInstallation(ctx, read_write_layer.W000157, inst)
+	}
+
+	logger.Info("roll back installation", "revision", revision.Spec.Revision)
+
+	inst.Spec = *revision.Spec.InstallationSpec.DeepCopy()
+	delete(inst.Annotations, lsv1alpha1.RollbackRevisionAnnotation)
+	lsv1alpha1helper.SetOperation(&inst.ObjectMeta, lsv1alpha1.ReconcileOperation)
+	metav1.SetMetaDataAnnotation(&inst.ObjectMeta, lsv1alpha1.ReconcileReasonAnnotation, reconcileReasonRollback)
+	if err := c.WriterToLsUncachedClient().UpdateInstallation(ctx, read_write_layer.W000156, inst); err != nil {
+		return err
+	}
+
+	c.EventRecorder().Eventf(inst, corev1.EventTypeNormal, lsutil.EventReasonRollback,
+		"Rolled back to revision %d (job %s)", revision.Spec.Revision, revision.Spec.JobID)
+	return nil
+}
+
+// checkRollbackImports checks that the imports of the installation, read with the spec of the revision from the current
+// data objects and targets, are the same as the imports with which the job of the revision has been processed.
+// Otherwise, the rollback would deploy a different state than the one recorded in the revision.
+func (c *Controller) checkRollbackImports(ctx context.Context, inst *lsv1alpha1.Installation, revision *lsv1alpha1.InstallationRevision) error {
+	if len(revision.Spec.ImportsHash) == 0 {
+		return nil
+	}
+
+	restored := inst.DeepCopy()
+	restored.Spec = *revision.Spec.InstallationSpec.DeepCopy()
+	_, _, importsHash, _, fatalError, normalError := c.init(ctx, restored, false)
+	if fatalError != nil {
+		return fmt.Errorf("unable to compute the imports of revision %d: %w", revision.Spec.Revision, fatalError)
+	} else if normalError != nil {
+		return fmt.Errorf("unable to compute the imports of revision %d: %w", revision.Spec.Revision, normalError)
+	}
+
+	if importsHash != revision.Spec.ImportsHash {
+		return fmt.Errorf("the imports of installation %s have changed since revision %d was created; "+
+			"a rollback would not restore the deployed state of the revision", inst.Name, revision.Spec.Revision)
+	}
+	return nil
+}
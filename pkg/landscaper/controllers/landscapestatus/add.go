@@ -0,0 +1,29 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package landscapestatus
+
+import (
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+)
+
+// AddControllerToManager adds the landscape status controller to the manager.
+// The controller periodically aggregates the status of the installation trees selected by a LandscapeStatus object.
+func AddControllerToManager(lsUncachedClient client.Client, logger logging.Logger, lsMgr manager.Manager) error {
+	log := logger.Reconciles("landscapeStatus", "LandscapeStatus")
+	ctrl := NewController(lsUncachedClient, log)
+
+	return builder.ControllerManagedBy(lsMgr).
+		For(&lsv1alpha1.LandscapeStatus{}, builder.WithPredicates(predicate.GenerationChangedPredicate{}), builder.OnlyMetadata).
+		WithLogConstructor(func(r *reconcile.Request) logr.Logger { return log.Logr() }).
+		Complete(ctrl)
+}
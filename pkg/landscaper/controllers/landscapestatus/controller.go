@@ -0,0 +1,342 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package landscapestatus
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/utils"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+const (
+	// DefaultMaxItems is the default maximal number of failed and longest running items in the status.
+	DefaultMaxItems = 10
+	// DefaultRefreshInterval is the default interval in which the status is aggregated.
+	DefaultRefreshInterval = time.Minute
+
+	// PhaseUnknown is the phase under which objects without a phase are counted.
+	PhaseUnknown = "Unknown"
+)
+
+// Controller aggregates the status of the installation trees selected by LandscapeStatus objects.
+type Controller struct {
+	lsUncachedClient client.Client
+	log              logging.Logger
+}
+
+// NewController returns a new landscape status controller.
+func NewController(lsUncachedClient client.Client, logger logging.Logger) reconcile.Reconciler {
+	return &Controller{
+		lsUncachedClient: lsUncachedClient,
+		log:              logger,
+	}
+}
+
+// Reconcile aggregates the status of the installation trees of a LandscapeStatus object.
+func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (result reconcile.Result, err error) {
+	logger, ctx := c.log.StartReconcileAndAddToContext(ctx, req)
+
+	result = reconcile.Result{}
+	defer utils.HandlePanics(ctx, &result, nil)
+
+	landscapeStatus := &lsv1alpha1.LandscapeStatus{}
+	if err := read_write_layer.GetLandscapeStatus(ctx, c.lsUncachedClient, req.NamespacedName, landscapeStatus, read_write_layer.R000116); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.Info(err.Error())
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	if !landscapeStatus.DeletionTimestamp.IsZero() {
+		return reconcile.Result{}, nil
+	}
+
+	status, err := c.aggregate(ctx, landscapeStatus)
+	if err != nil {
+		logger.Error(err, "aggregating landscape status failed")
+		landscapeStatus.Status.LastError = lserrors.UpdatedError(landscapeStatus.Status.LastError,
+			"Aggregate", "AggregationFailed", err.Error())
+	} else {
+		landscapeStatus.Status = *status
+	}
+
+	now := metav1.Now()
+	landscapeStatus.Status.ObservedGeneration = landscapeStatus.Generation
+	landscapeStatus.Status.LastUpdateTime = &now
+
+	writer := read_write_layer.NewWriter(c.lsUncachedClient)
+	if err := writer.UpdateLandscapeStatusStatus(ctx, read_write_layer.W000153, landscapeStatus); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{RequeueAfter: getRefreshInterval(landscapeStatus)}, nil
+}
+
+// aggregate walks the installation trees of the root installations selected by the LandscapeStatus object
+// and computes the aggregated status.
+func (c *Controller) aggregate(ctx context.Context, landscapeStatus *lsv1alpha1.LandscapeStatus) (*lsv1alpha1.LandscapeStatusStatus, error) {
+	selector := labels.Everything()
+	if landscapeStatus.Spec.InstallationSelector != nil {
+		var err error
+		selector, err = metav1.LabelSelectorAsSelector(landscapeStatus.Spec.InstallationSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid installation selector: %w", err)
+		}
+	}
+
+	t, err := c.loadTree(ctx, landscapeStatus.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	a := &aggregator{
+		tree:    t,
+		status:  &lsv1alpha1.LandscapeStatusStatus{},
+		visited: map[string]bool{},
+	}
+
+	for _, inst := range t.rootInstallations {
+		if !selector.Matches(labels.Set(inst.Labels)) {
+			continue
+		}
+		a.status.RootInstallations = append(a.status.RootInstallations, inst.Name)
+		a.walkInstallation(inst, inst.Name, "")
+	}
+
+	maxItems := getMaxItems(landscapeStatus)
+
+	sort.SliceStable(a.failedItems, func(i, j int) bool {
+		return a.failedItems[i].Path < a.failedItems[j].Path
+	})
+	a.status.FailedItemCount = int32(len(a.failedItems))
+	a.status.FailedItems = limitItems(a.failedItems, maxItems)
+
+	sort.SliceStable(a.runningItems, func(i, j int) bool {
+		return startedBefore(a.runningItems[i].StartTime, a.runningItems[j].StartTime)
+	})
+	a.status.LongestRunningItems = limitItems(a.runningItems, maxItems)
+
+	return a.status, nil
+}
+
+// tree contains the installations, executions and deploy items of a namespace indexed by their relationships.
+type tree struct {
+	rootInstallations []*lsv1alpha1.Installation
+	// subInstallations maps the name of an installation to its subinstallations
+	subInstallations map[string][]*lsv1alpha1.Installation
+	// executions maps the name of an execution to the execution
+	executions map[string]*lsv1alpha1.Execution
+	// deployItems maps the name of an execution to its deploy items
+	deployItems map[string][]*lsv1alpha1.DeployItem
+}
+
+func (c *Controller) loadTree(ctx context.Context, namespace string) (*tree, error) {
+	t := &tree{
+		subInstallations: map[string][]*lsv1alpha1.Installation{},
+		executions:       map[string]*lsv1alpha1.Execution{},
+		deployItems:      map[string][]*lsv1alpha1.DeployItem{},
+	}
+
+	instList := &lsv1alpha1.InstallationList{}
+	if err := read_write_layer.ListInstallations(ctx, c.lsUncachedClient, instList, read_write_layer.R000117,
+		client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("unable to list installations: %w", err)
+	}
+	for i := range instList.Items {
+		inst := &instList.Items[i]
+		if parent, ok := inst.Labels[lsv1alpha1.EncompassedByLabel]; ok {
+			t.subInstallations[parent] = append(t.subInstallations[parent], inst)
+		} else {
+			t.rootInstallations = append(t.rootInstallations, inst)
+		}
+	}
+
+	execList := &lsv1alpha1.ExecutionList{}
+	if err := read_write_layer.ListExecutions(ctx, c.lsUncachedClient, execList, read_write_layer.R000118,
+		client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("unable to list executions: %w", err)
+	}
+	for i := range execList.Items {
+		t.executions[execList.Items[i].Name] = &execList.Items[i]
+	}
+
+	diList := &lsv1alpha1.DeployItemList{}
+	if err := read_write_layer.ListDeployItems(ctx, c.lsUncachedClient, diList, read_write_layer.R000119,
+		client.InNamespace(namespace), client.HasLabels{lsv1alpha1.ExecutionManagedByLabel}); err != nil {
+		return nil, fmt.Errorf("unable to list deploy items: %w", err)
+	}
+	for i := range diList.Items {
+		di := &diList.Items[i]
+		execName := di.Labels[lsv1alpha1.ExecutionManagedByLabel]
+		t.deployItems[execName] = append(t.deployItems[execName], di)
+	}
+
+	sortByName(t.rootInstallations)
+	for _, subs := range t.subInstallations {
+		sortByName(subs)
+	}
+	return t, nil
+}
+
+// aggregator collects the status of installation trees.
+type aggregator struct {
+	tree         *tree
+	status       *lsv1alpha1.LandscapeStatusStatus
+	failedItems  []lsv1alpha1.LandscapeStatusItem
+	runningItems []lsv1alpha1.LandscapeStatusItem
+	// visited contains the names of the already visited installations to prevent endless loops
+	visited map[string]bool
+}
+
+// walkInstallation aggregates the status of an installation, its execution and deploy items and its subinstallations.
+// It returns whether the installation or one of its descendants is failed or running.
+func (a *aggregator) walkInstallation(inst *lsv1alpha1.Installation, root, parentPath string) (failed, running bool) {
+	if a.visited[inst.Name] {
+		return false, false
+	}
+	a.visited[inst.Name] = true
+
+	path := inst.Name
+	if len(parentPath) != 0 {
+		path = parentPath + "/" + inst.Name
+	}
+
+	childFailed, childRunning := false, false
+	for _, sub := range a.tree.subInstallations[inst.Name] {
+		f, r := a.walkInstallation(sub, root, path)
+		childFailed, childRunning = childFailed || f, childRunning || r
+	}
+	if ref := inst.Status.ExecutionReference; ref != nil {
+		if exec, ok := a.tree.executions[ref.Name]; ok {
+			f, r := a.walkExecution(exec, root, path)
+			childFailed, childRunning = childFailed || f, childRunning || r
+		}
+	}
+
+	phase := inst.Status.InstallationPhase
+	item := newItem(lsv1alpha1.LandscapeStatusItemKindInstallation, &inst.ObjectMeta, root, path, string(phase),
+		inst.Status.JobID, inst.Status.TransitionTimes, inst.Status.LastError)
+	return a.add(&a.status.Installations, item, phase.IsFailed(), isRunning(phase.IsEmpty(), phase.IsFinal()),
+		childFailed, childRunning)
+}
+
+// walkExecution aggregates the status of an execution and its deploy items.
+// It returns whether the execution or one of its deploy items is failed or running.
+func (a *aggregator) walkExecution(exec *lsv1alpha1.Execution, root, path string) (failed, running bool) {
+	childFailed, childRunning := false, false
+	for _, di := range a.tree.deployItems[exec.Name] {
+		phase := di.Status.Phase
+		item := newItem(lsv1alpha1.LandscapeStatusItemKindDeployItem, &di.ObjectMeta, root, path, string(phase),
+			di.Status.JobID, di.Status.TransitionTimes, di.Status.LastError)
+		f, r := a.add(&a.status.DeployItems, item, phase.IsFailed(), isRunning(phase.IsEmpty(), phase.IsFinal()),
+			false, false)
+		childFailed, childRunning = childFailed || f, childRunning || r
+	}
+
+	phase := exec.Status.ExecutionPhase
+	item := newItem(lsv1alpha1.LandscapeStatusItemKindExecution, &exec.ObjectMeta, root, path, string(phase),
+		exec.Status.JobID, exec.Status.TransitionTimes, exec.Status.LastError)
+	return a.add(&a.status.Executions, item, phase.IsFailed(), isRunning(phase.IsEmpty(), phase.IsFinal()),
+		childFailed, childRunning)
+}
+
+// add counts the item and records it as failed or running leaf if none of its child objects is failed or running.
+func (a *aggregator) add(counts *lsv1alpha1.PhaseCounts, item lsv1alpha1.LandscapeStatusItem,
+	failed, running, childFailed, childRunning bool) (bool, bool) {
+
+	phase := item.Phase
+	if len(phase) == 0 {
+		phase = PhaseUnknown
+	}
+	if counts.Phases == nil {
+		counts.Phases = map[string]int32{}
+	}
+	counts.Total++
+	counts.Phases[phase]++
+
+	if failed && !childFailed {
+		a.failedItems = append(a.failedItems, item)
+	}
+	if running && !childRunning {
+		a.runningItems = append(a.runningItems, item)
+	}
+	return failed || childFailed, running || childRunning
+}
+
+func newItem(kind string, obj *metav1.ObjectMeta, root, path, phase, jobID string,
+	transitionTimes *lsv1alpha1.TransitionTimes, lastError *lsv1alpha1.Error) lsv1alpha1.LandscapeStatusItem {
+
+	item := lsv1alpha1.LandscapeStatusItem{
+		Kind:             kind,
+		Name:             obj.Name,
+		Namespace:        obj.Namespace,
+		RootInstallation: root,
+		Path:             path,
+		Phase:            phase,
+		JobID:            jobID,
+		LastError:        lastError.DeepCopy(),
+	}
+	if transitionTimes != nil && transitionTimes.TriggerTime != nil {
+		item.StartTime = transitionTimes.TriggerTime.DeepCopy()
+	}
+	return item
+}
+
+// isRunning returns whether an object with a phase with the given properties is currently processed.
+func isRunning(isEmpty, isFinal bool) bool {
+	return !isEmpty && !isFinal
+}
+
+// startedBefore returns whether the first start time is before the second one. Unknown start times are sorted last.
+func startedBefore(t1, t2 *metav1.Time) bool {
+	if t1 == nil {
+		return false
+	}
+	if t2 == nil {
+		return true
+	}
+	return t1.Before(t2)
+}
+
+func limitItems(items []lsv1alpha1.LandscapeStatusItem, maxItems int) []lsv1alpha1.LandscapeStatusItem {
+	if len(items) > maxItems {
+		return items[:maxItems]
+	}
+	return items
+}
+
+func sortByName(installations []*lsv1alpha1.Installation) {
+	sort.Slice(installations, func(i, j int) bool {
+		return installations[i].Name < installations[j].Name
+	})
+}
+
+func getMaxItems(landscapeStatus *lsv1alpha1.LandscapeStatus) int {
+	if landscapeStatus.Spec.MaxItems == nil || *landscapeStatus.Spec.MaxItems < 0 {
+		return DefaultMaxItems
+	}
+	return int(*landscapeStatus.Spec.MaxItems)
+}
+
+func getRefreshInterval(landscapeStatus *lsv1alpha1.LandscapeStatus) time.Duration {
+	if landscapeStatus.Spec.RefreshInterval == nil || landscapeStatus.Spec.RefreshInterval.Duration <= 0 {
+		return DefaultRefreshInterval
+	}
+	return landscapeStatus.Spec.RefreshInterval.Duration
+}
@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package landscapestatus_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/landscaper/controllers/landscapestatus"
+	testutils "github.com/gardener/landscaper/test/utils"
+	"github.com/gardener/landscaper/test/utils/envtest"
+)
+
+var _ = Describe("Reconcile", func() {
+
+	var (
+		ctrl  reconcile.Reconciler
+		state *envtest.State
+	)
+
+	BeforeEach(func() {
+		ctrl = landscapestatus.NewController(testenv.Client, logging.Discard())
+	})
+
+	AfterEach(func() {
+		if state != nil {
+			ctx := context.Background()
+			defer ctx.Done()
+			Expect(testenv.CleanupState(ctx, state)).To(Succeed())
+			state = nil
+		}
+	})
+
+	createLandscapeStatus := func(ctx context.Context, spec lsv1alpha1.LandscapeStatusSpec) *lsv1alpha1.LandscapeStatus {
+		ls := &lsv1alpha1.LandscapeStatus{}
+		ls.Name = "landscape"
+		ls.Namespace = state.Namespace
+		ls.Spec = spec
+		Expect(state.Create(ctx, ls)).To(Succeed())
+		return ls
+	}
+
+	It("should aggregate the phases, failed leaves and longest running leaves of the installation trees", func() {
+		ctx := context.Background()
+
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/state/test1")
+		Expect(err).ToNot(HaveOccurred())
+
+		ls := createLandscapeStatus(ctx, lsv1alpha1.LandscapeStatusSpec{
+			RefreshInterval: &lsv1alpha1.Duration{Duration: 5 * time.Minute},
+		})
+
+		res, err := ctrl.Reconcile(ctx, testutils.RequestFromObject(ls))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.RequeueAfter).To(Equal(5 * time.Minute))
+
+		testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(ls), ls))
+		status := ls.Status
+		Expect(status.LastError).To(BeNil())
+		Expect(status.LastUpdateTime).ToNot(BeNil())
+		Expect(status.RootInstallations).To(ConsistOf("other", "root"))
+
+		Expect(status.Installations.Total).To(Equal(int32(4)))
+		Expect(status.Installations.Phases).To(Equal(map[string]int32{
+			string(lsv1alpha1.InstallationPhases.Progressing): 2,
+			string(lsv1alpha1.InstallationPhases.Failed):      1,
+			string(lsv1alpha1.InstallationPhases.Succeeded):   1,
+		}))
+		Expect(status.Executions.Total).To(Equal(int32(2)))
+		Expect(status.DeployItems.Total).To(Equal(int32(3)))
+		Expect(status.DeployItems.Phases).To(HaveKeyWithValue(string(lsv1alpha1.DeployItemPhases.Progressing), int32(2)))
+
+		Expect(status.FailedItemCount).To(Equal(int32(1)))
+		Expect(status.FailedItems).To(HaveLen(1))
+		failed := status.FailedItems[0]
+		Expect(failed.Kind).To(Equal(lsv1alpha1.LandscapeStatusItemKindDeployItem))
+		Expect(failed.Name).To(Equal("di-a"))
+		Expect(failed.RootInstallation).To(Equal("root"))
+		Expect(failed.Path).To(Equal("root/a"))
+		Expect(failed.JobID).To(Equal("job-di-a"))
+		Expect(failed.LastError).ToNot(BeNil())
+		Expect(failed.LastError.Message).To(Equal("unable to apply manifests"))
+
+		Expect(status.LongestRunningItems).To(HaveLen(2))
+		Expect(status.LongestRunningItems[0].Name).To(Equal("di-b"))
+		Expect(status.LongestRunningItems[1].Name).To(Equal("di-c"))
+		Expect(status.LongestRunningItems[0].StartTime.Time).To(BeTemporally("<", status.LongestRunningItems[1].StartTime.Time))
+	})
+
+	It("should only aggregate the selected root installations and limit the number of items", func() {
+		ctx := context.Background()
+
+		var err error
+		state, err = testenv.InitResources(ctx, "./testdata/state/test1")
+		Expect(err).ToNot(HaveOccurred())
+
+		ls := createLandscapeStatus(ctx, lsv1alpha1.LandscapeStatusSpec{
+			InstallationSelector: &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "team", Operator: metav1.LabelSelectorOpDoesNotExist},
+				},
+			},
+			MaxItems: ptr.To[int32](1),
+		})
+
+		res, err := ctrl.Reconcile(ctx, testutils.RequestFromObject(ls))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.RequeueAfter).To(Equal(landscapestatus.DefaultRefreshInterval))
+
+		testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(ls), ls))
+		Expect(ls.Status.RootInstallations).To(ConsistOf("root"))
+		Expect(ls.Status.Installations.Total).To(Equal(int32(3)))
+		Expect(ls.Status.LongestRunningItems).To(HaveLen(1))
+		Expect(ls.Status.LongestRunningItems[0].Name).To(Equal("di-b"))
+	})
+
+})
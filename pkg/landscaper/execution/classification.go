@@ -2,6 +2,7 @@ package execution
 
 import (
 	"fmt"
+	"strconv"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	lserrors "github.com/gardener/landscaper/apis/errors"
@@ -12,8 +13,11 @@ import (
 // - running items:   they have the same jobID as the execution, but are unfinished
 // - succeeded items: they have the same jobID as the execution, are finished and succeeded
 // - failed items:    they have the same jobID as the execution, are finished and not succeeded (=> failed)
-// - runnableItems:   they have an old jobID, which can be updated because there are no pending dependencies
-// - pending items:   they have an old jobID, which can not be updated because of pending dependencies
+// - runnableItems:   they have an old jobID, which can be updated because there are no pending dependencies or waves
+// - pending items:   they have an old jobID, which can not be updated because of pending dependencies or waves
+//
+// Waves are pending until all items of the earlier waves have succeeded.
+// Runnable items exceeding the maximal number of parallel items are moved to the pending items, see limitRunnableItems.
 type DeployItemClassification struct {
 	runningItems   []*executionItem
 	succeededItems []*executionItem
@@ -50,6 +54,22 @@ func (c *DeployItemClassification) GetRunnableItems() []*executionItem {
 	return c.runnableItems
 }
 
+// limitRunnableItems ensures that starting the runnable items does not exceed the given maximal number of
+// running items. Runnable items above the limit are moved to the pending items. A nil limit means no limit.
+func (c *DeployItemClassification) limitRunnableItems(maxParallel *int32) {
+	if maxParallel == nil {
+		return
+	}
+
+	capacity := max(int(*maxParallel)-len(c.runningItems), 0)
+	if len(c.runnableItems) <= capacity {
+		return
+	}
+
+	c.pendingItems = append(c.pendingItems, c.runnableItems[capacity:]...)
+	c.runnableItems = c.runnableItems[:capacity]
+}
+
 func newDeployItemClassification(executionJobID string, items []*executionItem) (*DeployItemClassification, lserrors.LsError) {
 	c := &DeployItemClassification{
 		runningItems:   []*executionItem{},
@@ -93,8 +113,13 @@ func newDeployItemClassification(executionJobID string, items []*executionItem)
 }
 
 func isItemRunnable(executionJobID string, item *executionItem, items []*executionItem) (bool, lserrors.LsError) {
-	if len(item.Info.DependsOn) == 0 {
-		return true, nil
+	// check that all items of earlier waves have succeeded in the current job
+	for _, otherItem := range items {
+		if otherItem.Info.Wave < item.Info.Wave &&
+			(otherItem.DeployItem == nil || otherItem.DeployItem.Status.JobIDFinished != executionJobID ||
+				otherItem.DeployItem.Status.Phase != lsv1alpha1.DeployItemPhases.Succeeded) {
+			return false, nil
+		}
 	}
 
 	for _, dependentItemName := range item.Info.DependsOn {
@@ -157,12 +182,17 @@ func newDeployItemClassificationForDelete(executionJobID string, items []*execut
 	return c, nil
 }
 
+// newDeployItemClassificationForOrphans classifies the orphaned deploy items for their deletion.
+// As the orphaned items are not part of the execution anymore, their waves are read from the wave annotation,
+// so that they are deleted in the opposite order of their waves.
 func newDeployItemClassificationForOrphans(executionJobID string, deployitems []*lsv1alpha1.DeployItem) (*DeployItemClassification, lserrors.LsError) {
 	items := make([]*executionItem, len(deployitems))
 
 	for i := range deployitems {
 		items[i] = &executionItem{
-			Info:       lsv1alpha1.DeployItemTemplate{},
+			Info: lsv1alpha1.DeployItemTemplate{
+				Wave: getWave(deployitems[i]),
+			},
 			DeployItem: deployitems[i],
 		}
 	}
@@ -170,10 +200,24 @@ func newDeployItemClassificationForOrphans(executionJobID string, deployitems []
 	return newDeployItemClassificationForDelete(executionJobID, items)
 }
 
+// getWave returns the wave of a deploy item from its wave annotation.
+// Deploy items without a valid annotation belong to the wave 0.
+func getWave(di *lsv1alpha1.DeployItem) int32 {
+	wave, err := strconv.ParseInt(di.Annotations[lsv1alpha1.ExecutionWaveAnnotation], 10, 32)
+	if err != nil {
+		return 0
+	}
+	return int32(wave)
+}
+
 func isItemDeletable(item *executionItem, items []*executionItem) bool {
-	// Check whether the item appears in the DependsOn list of a sibling item that is not yet deleted
+	// Check whether the item appears in the DependsOn list of a sibling item that is not yet deleted,
+	// or whether a sibling item of a later wave is not yet deleted
 	for _, siblingItem := range items {
 		if siblingItem.DeployItem != nil {
+			if siblingItem.Info.Wave > item.Info.Wave {
+				return false
+			}
 			for _, dependentItemName := range siblingItem.Info.DependsOn {
 				if dependentItemName == item.Info.Name {
 					return false
@@ -8,6 +8,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 )
@@ -112,4 +113,124 @@ var _ = Describe("DeployItem Classification", func() {
 		Expect(classification.runnableItems).To(ConsistOf(items[3], items[4]))
 		Expect(classification.pendingItems).To(ConsistOf(items[5], items[6]))
 	})
+
+	It("should only classify items as runnable if all items of earlier waves have finished", func() {
+		currJobID := "02"
+		prevJobID := "01"
+		items := []*executionItem{
+			buildExecutionItem("a", []string{}, currJobID, currJobID, lsv1alpha1.DeployItemPhases.Succeeded),
+			buildExecutionItem("b", []string{}, currJobID, prevJobID, lsv1alpha1.DeployItemPhases.Progressing),
+			buildExecutionItem("c", []string{}, prevJobID, prevJobID, lsv1alpha1.DeployItemPhases.Succeeded),
+			buildExecutionItem("d", []string{}, prevJobID, prevJobID, lsv1alpha1.DeployItemPhases.Succeeded),
+			buildExecutionItem("e", []string{}, prevJobID, prevJobID, lsv1alpha1.DeployItemPhases.Succeeded),
+		}
+		items[1].Info.Wave = 1
+		items[2].Info.Wave = 1
+		items[3].Info.Wave = 2
+		items[4].Info.Wave = 3
+
+		classification, err := newDeployItemClassification(currJobID, items)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(classification.succeededItems).To(ConsistOf(items[0]))
+		Expect(classification.runningItems).To(ConsistOf(items[1]))
+		Expect(classification.runnableItems).To(ConsistOf(items[2]))
+		Expect(classification.pendingItems).To(ConsistOf(items[3], items[4]))
+
+		items[1] = buildExecutionItem("b", []string{}, currJobID, currJobID, lsv1alpha1.DeployItemPhases.Succeeded)
+		items[1].Info.Wave = 1
+		items[2] = buildExecutionItem("c", []string{}, currJobID, currJobID, lsv1alpha1.DeployItemPhases.Succeeded)
+		items[2].Info.Wave = 1
+
+		classification, err = newDeployItemClassification(currJobID, items)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(classification.runnableItems).To(ConsistOf(items[3]))
+		Expect(classification.pendingItems).To(ConsistOf(items[4]))
+	})
+
+	It("should not classify items as runnable if an item of an earlier wave has failed", func() {
+		currJobID := "02"
+		prevJobID := "01"
+		items := []*executionItem{
+			buildExecutionItem("a", []string{}, currJobID, currJobID, lsv1alpha1.DeployItemPhases.Failed),
+			buildExecutionItem("b", []string{}, prevJobID, prevJobID, lsv1alpha1.DeployItemPhases.Succeeded),
+		}
+		items[1].Info.Wave = 1
+
+		classification, err := newDeployItemClassification(currJobID, items)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(classification.failedItems).To(ConsistOf(items[0]))
+		Expect(classification.runnableItems).To(BeEmpty())
+		Expect(classification.pendingItems).To(ConsistOf(items[1]))
+	})
+
+	It("should delete orphaned items in the opposite order of their waves", func() {
+		currJobID := "02"
+		prevJobID := "01"
+		newOrphan := func(name, wave string) *lsv1alpha1.DeployItem {
+			di := buildExecutionItem(name, nil, prevJobID, prevJobID, lsv1alpha1.DeployItemPhases.Succeeded).DeployItem
+			if len(wave) != 0 {
+				metav1.SetMetaDataAnnotation(&di.ObjectMeta, lsv1alpha1.ExecutionWaveAnnotation, wave)
+			}
+			return di
+		}
+		orphans := []*lsv1alpha1.DeployItem{
+			newOrphan("a", ""),
+			newOrphan("b", "1"),
+			newOrphan("c", "2"),
+		}
+
+		classification, err := newDeployItemClassificationForOrphans(currJobID, orphans)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(classification.runnableItems).To(HaveLen(1))
+		Expect(classification.runnableItems[0].DeployItem).To(Equal(orphans[2]))
+		Expect(classification.pendingItems).To(HaveLen(2))
+	})
+
+	It("should only classify items as deletable if all items of later waves are deleted", func() {
+		currJobID := "02"
+		prevJobID := "01"
+		items := []*executionItem{
+			buildExecutionItem("a", []string{}, prevJobID, prevJobID, lsv1alpha1.DeployItemPhases.Succeeded),
+			buildExecutionItem("b", []string{}, prevJobID, prevJobID, lsv1alpha1.DeployItemPhases.Succeeded),
+			buildExecutionItemWithoutDeployItem("c", nil),
+		}
+		items[1].Info.Wave = 1
+		items[2].Info.Wave = 2
+
+		classification, err := newDeployItemClassificationForDelete(currJobID, items)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(classification.succeededItems).To(ConsistOf(items[2]))
+		Expect(classification.runnableItems).To(ConsistOf(items[1]))
+		Expect(classification.pendingItems).To(ConsistOf(items[0]))
+	})
+
+	It("should limit the runnable items to the maximal number of parallel items", func() {
+		currJobID := "02"
+		prevJobID := "01"
+		items := []*executionItem{
+			buildExecutionItem("a", []string{}, currJobID, prevJobID, lsv1alpha1.DeployItemPhases.Progressing),
+			buildExecutionItem("b", []string{}, prevJobID, prevJobID, lsv1alpha1.DeployItemPhases.Succeeded),
+			buildExecutionItem("c", []string{}, prevJobID, prevJobID, lsv1alpha1.DeployItemPhases.Succeeded),
+			buildExecutionItem("d", []string{}, prevJobID, prevJobID, lsv1alpha1.DeployItemPhases.Succeeded),
+		}
+
+		classification, err := newDeployItemClassification(currJobID, items)
+		Expect(err).NotTo(HaveOccurred())
+		classification.limitRunnableItems(nil)
+		Expect(classification.runnableItems).To(ConsistOf(items[1], items[2], items[3]))
+
+		classification.limitRunnableItems(ptr.To[int32](3))
+		Expect(classification.runningItems).To(ConsistOf(items[0]))
+		Expect(classification.runnableItems).To(ConsistOf(items[1], items[2]))
+		Expect(classification.pendingItems).To(ConsistOf(items[3]))
+
+		classification.limitRunnableItems(ptr.To[int32](1))
+		Expect(classification.HasRunnableItems()).To(BeFalse())
+		Expect(classification.pendingItems).To(ConsistOf(items[1], items[2], items[3]))
+	})
 })
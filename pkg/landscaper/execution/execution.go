@@ -91,6 +91,7 @@ func (o *Operation) TriggerDeployItems(ctx context.Context) (*DeployItemClassifi
 	if lsErr != nil {
 		return nil, lsErr
 	}
+	classificationOfOrphans.limitRunnableItems(o.exec.Spec.MaxParallel)
 
 	if !classificationOfOrphans.AllSucceeded() {
 		// Start the runnable items, provided there are no failed items
@@ -123,6 +124,7 @@ func (o *Operation) TriggerDeployItems(ctx context.Context) (*DeployItemClassifi
 	if lsErr != nil {
 		return nil, lsErr
 	}
+	classification.limitRunnableItems(o.exec.Spec.MaxParallel)
 
 	// Start the runnable items, provided there are no failed items
 	if !classification.HasFailedItems() {
@@ -151,6 +153,7 @@ func (o *Operation) TriggerDeployItemsForDelete(ctx context.Context) (*DeployIte
 	if lsErr != nil {
 		return nil, lsErr
 	}
+	classification.limitRunnableItems(o.exec.Spec.MaxParallel)
 
 	// If all deploy items have been successfully deleted, remove the finalizer of the execution
 	if classification.AllSucceeded() {
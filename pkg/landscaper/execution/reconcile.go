@@ -22,6 +22,7 @@ import (
 	"github.com/gardener/landscaper/controller-utils/pkg/landscaper/targetresolver/secret"
 	"github.com/gardener/landscaper/pkg/utils/clusters"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+	"github.com/gardener/landscaper/pkg/utils/tracing"
 )
 
 const clusterNameAnnotation = "landscaper.gardener.cloud/clustername"
@@ -64,6 +65,7 @@ func (o *Operation) updateDeployItem(ctx context.Context, item executionItem) (*
 		if lsv1alpha1helper.HasCacheHelmChartsAnnotation(&o.exec.ObjectMeta) {
 			metav1.SetMetaDataAnnotation(&item.DeployItem.ObjectMeta, lsv1alpha1.CacheHelmChartsAnnotation, "true")
 		}
+		tracing.InjectTraceContext(ctx, item.DeployItem)
 
 		o.Scheme().Default(item.DeployItem)
 		return controllerutil.SetControllerReference(o.exec, item.DeployItem, o.Scheme())
@@ -8,6 +8,7 @@ import (
 	"context"
 	"errors"
 
+	"github.com/gardener/landscaper/apis/config"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	"github.com/gardener/landscaper/pkg/utils"
 
@@ -28,6 +29,7 @@ type OperationBuilder struct {
 	op                              *lsoperation.Operation
 	resolvedComponentDescriptorList *model.ComponentVersionList
 	context                         *Scope
+	templatePlugins                 []config.TemplatePluginConfiguration
 }
 
 // NewOperationBuilder creates a new operation builder.
@@ -70,6 +72,12 @@ func (b *OperationBuilder) WithContext(ctx *Scope) *OperationBuilder {
 	return b
 }
 
+// WithTemplatePlugins sets the template plugins that handle the additional template types.
+func (b *OperationBuilder) WithTemplatePlugins(plugins []config.TemplatePluginConfiguration) *OperationBuilder {
+	b.templatePlugins = plugins
+	return b
+}
+
 // operation builder wrapped options
 
 // Client sets the kubernetes client.
@@ -126,6 +134,7 @@ func (b *OperationBuilder) Build(ctx context.Context) (*Operation, error) {
 		Inst:                            b.inst,
 		ComponentVersion:                b.componentVersion,
 		ResolvedComponentDescriptorList: b.resolvedComponentDescriptorList,
+		TemplatePlugins:                 b.templatePlugins,
 	}
 
 	if b.context == nil {
@@ -25,9 +25,9 @@ import (
 	"github.com/gardener/landscaper/pkg/api"
 	"github.com/gardener/landscaper/pkg/landscaper/installations"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
-	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/gotemplate"
-	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/spiff"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/templaters"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+	"github.com/gardener/landscaper/pkg/utils/tracing"
 )
 
 const (
@@ -62,12 +62,14 @@ func (o *ExecutionOperation) RenderDeployItemTemplates(ctx context.Context,
 
 	cond := lsv1alpha1helper.GetOrInitCondition(inst.GetInstallation().Status.Conditions, lsv1alpha1.ReconcileExecutionCondition)
 
-	templateStateHandler := template.KubernetesStateHandler{
-		KubeClient: o.LsUncachedClient(),
-		Inst:       inst.GetInstallation(),
-	}
-	targetResolver := genericresolver.New(o.LsUncachedClient())
-	tmpl := template.New(gotemplate.New(templateStateHandler, targetResolver), spiff.New(templateStateHandler, targetResolver))
+	tmpl := templaters.New(templaters.Options{
+		State:          template.NewInstallationStateHandler(o.LsUncachedClient(), inst.GetInstallation(), o.DryRun),
+		TargetResolver: genericresolver.New(o.LsUncachedClient()),
+		Lookups:        o.Context().External.TemplateLookups,
+		Namespace:      inst.GetInstallation().Namespace,
+		Diagnostics:    template.NewInstallationDiagnosticsHandler(o.LsUncachedClient(), inst.GetInstallation(), o.DryRun),
+		Plugins:        o.TemplatePlugins,
+	})
 	executions, err := tmpl.TemplateDeployExecutions(
 		template.NewDeployExecutionOptions(
 			template.NewBlueprintExecutionOptions(
@@ -149,6 +151,7 @@ func (o *ExecutionOperation) RenderDeployItemTemplates(ctx context.Context,
 			Labels:             elem.Labels,
 			Configuration:      elem.Configuration,
 			DependsOn:          elem.DependsOn,
+			Wave:               elem.Wave,
 			Timeout:            timeout,
 			UpdateOnChangeOnly: elem.UpdateOnChangeOnly,
 			OnDelete:           elem.OnDelete,
@@ -188,6 +191,7 @@ func (o *ExecutionOperation) Ensure(ctx context.Context, inst *installations.Ins
 	if _, err := o.WriterToLsUncachedClient().CreateOrUpdateExecution(ctx, read_write_layer.W000022, exec, func() error {
 		exec.Spec.Context = inst.GetInstallation().Spec.Context
 		exec.Spec.DeployItems = versionedDeployItemTemplateList
+		exec.Spec.MaxParallel = inst.GetInstallation().Spec.MaxParallelDeployItems
 
 		if lsv1alpha1helper.HasOperation(inst.GetInstallation().ObjectMeta, lsv1alpha1.ForceReconcileOperation) {
 			metav1.SetMetaDataAnnotation(&exec.ObjectMeta, lsv1alpha1.OperationAnnotation, string(lsv1alpha1.ForceReconcileOperation))
@@ -199,6 +203,7 @@ func (o *ExecutionOperation) Ensure(ctx context.Context, inst *installations.Ins
 		if lsv1alpha1helper.HasCacheHelmChartsAnnotation(&inst.GetInstallation().ObjectMeta) {
 			metav1.SetMetaDataAnnotation(&exec.ObjectMeta, lsv1alpha1.CacheHelmChartsAnnotation, "true")
 		}
+		tracing.InjectTraceContext(ctx, exec)
 
 		if exec.CreationTimestamp.IsZero() && exec.DeletionTimestamp.IsZero() {
 			controllerutil.AddFinalizer(exec, lsv1alpha1.LandscaperFinalizer)
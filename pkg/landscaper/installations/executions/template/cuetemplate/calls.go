@@ -0,0 +1,219 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cuetemplate
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"cuelang.org/go/cue"
+
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/gotemplate"
+)
+
+const (
+	// CallsField is the top-level field of a CUE template that contains the function calls.
+	CallsField = "calls"
+	// callFunctionField is the field of a call that contains the name of the function.
+	callFunctionField = "function"
+	// callArgsField is the field of a call that contains the list of arguments.
+	callArgsField = "args"
+	// callResultField is the field of a call that is filled with the result of the function.
+	callResultField = "result"
+)
+
+// errorType is the reflection type of the error interface.
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// newCallFunctions returns the landscaper functions that can be called from a CUE template.
+// These are the same functions that are available in go templates, except for the ones that only make sense
+// within go templates.
+func newCallFunctions(opts gotemplate.FuncMapOptions) (map[string]interface{}, error) {
+	funcs, err := gotemplate.LandscaperTplFuncMap(opts)
+	if err != nil {
+		return nil, err
+	}
+	// CUE has its own yaml encoding, and file infos cannot be represented as CUE values
+	delete(funcs, "toYaml")
+	delete(funcs, "fromYaml")
+	delete(funcs, "readDir")
+	return funcs, nil
+}
+
+// resolveCalls evaluates the function calls of a CUE template.
+// A function call is a field of the top-level "calls" struct with a function name and a list of arguments:
+//
+//	calls: image: {function: "getResource", args: [cd, "name", "nginx"]}
+//
+// The result of the function is filled into the field "result" of the call. As the arguments of a call may depend on
+// the results of other calls, the calls are resolved in rounds until all calls with concrete arguments are resolved.
+func resolveCalls(cuectx *cue.Context, value cue.Value, funcs map[string]interface{}) (cue.Value, error) {
+	resolved := map[string]bool{}
+	for {
+		calls := value.LookupPath(cue.MakePath(cue.Str(CallsField)))
+		if !calls.Exists() {
+			return value, nil
+		}
+
+		names, err := getCallNames(calls)
+		if err != nil {
+			return value, err
+		}
+
+		progress := false
+		for _, name := range names {
+			if resolved[name] {
+				continue
+			}
+
+			call := calls.LookupPath(cue.MakePath(cue.Str(name)))
+			args := call.LookupPath(cue.MakePath(cue.Str(callArgsField)))
+			if args.Exists() && args.Validate(cue.Concrete(true)) != nil {
+				// the arguments depend on calls that are not yet resolved
+				continue
+			}
+
+			result, err := evaluateCall(call, funcs)
+			if err != nil {
+				return value, fmt.Errorf("unable to evaluate call %q: %w", name, err)
+			}
+
+			resultValue := cuectx.CompileBytes(result)
+			if resultValue.Err() != nil {
+				return value, fmt.Errorf("unable to compile result of call %q: %w", name, resultValue.Err())
+			}
+
+			value = value.FillPath(cue.MakePath(cue.Str(CallsField), cue.Str(name), cue.Str(callResultField)), resultValue)
+			if value.Err() != nil {
+				return value, value.Err()
+			}
+			resolved[name] = true
+			progress = true
+		}
+
+		if !progress {
+			return value, nil
+		}
+	}
+}
+
+func getCallNames(calls cue.Value) ([]string, error) {
+	iter, err := calls.Fields()
+	if err != nil {
+		return nil, fmt.Errorf("field %q must be a struct of function calls: %w", CallsField, err)
+	}
+
+	names := []string{}
+	for iter.Next() {
+		names = append(names, iter.Selector().Unquoted())
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// evaluateCall calls the function of a call with its arguments and returns the json encoded result.
+func evaluateCall(call cue.Value, funcs map[string]interface{}) ([]byte, error) {
+	name, err := call.LookupPath(cue.MakePath(cue.Str(callFunctionField))).String()
+	if err != nil {
+		return nil, fmt.Errorf("field %q must be a string: %w", callFunctionField, err)
+	}
+
+	fn, ok := funcs[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown function %q", name)
+	}
+
+	args := []interface{}{}
+	if argsValue := call.LookupPath(cue.MakePath(cue.Str(callArgsField))); argsValue.Exists() {
+		if err := argsValue.Decode(&args); err != nil {
+			return nil, fmt.Errorf("field %q must be a list: %w", callArgsField, err)
+		}
+	}
+
+	result, err := callFunction(fn, args)
+	if err != nil {
+		return nil, err
+	}
+
+	if data, ok := result.([]byte); ok {
+		result = string(data)
+	}
+	return json.Marshal(result)
+}
+
+// callFunction calls a go function with the given arguments.
+// The functions of the go templates signal errors by panics or by a returned error.
+func callFunction(fn interface{}, args []interface{}) (result interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	fnValue := reflect.ValueOf(fn)
+	fnType := fnValue.Type()
+
+	numIn := fnType.NumIn()
+	if fnType.IsVariadic() {
+		if len(args) < numIn-1 {
+			return nil, fmt.Errorf("expected at least %d arguments, but got %d", numIn-1, len(args))
+		}
+	} else if len(args) != numIn {
+		return nil, fmt.Errorf("expected %d arguments, but got %d", numIn, len(args))
+	}
+
+	in := make([]reflect.Value, len(args))
+	for i, arg := range args {
+		var argType reflect.Type
+		if fnType.IsVariadic() && i >= numIn-1 {
+			argType = fnType.In(numIn - 1).Elem()
+		} else {
+			argType = fnType.In(i)
+		}
+
+		in[i], err = convertArgument(arg, argType)
+		if err != nil {
+			return nil, fmt.Errorf("invalid argument %d: %w", i+1, err)
+		}
+	}
+
+	out := fnValue.Call(in)
+	if len(out) == 0 {
+		return nil, errors.New("function has no result")
+	}
+	if last := out[len(out)-1]; last.Type().Implements(errorType) && !last.IsNil() {
+		return nil, last.Interface().(error)
+	}
+	return out[0].Interface(), nil
+}
+
+func convertArgument(arg interface{}, argType reflect.Type) (reflect.Value, error) {
+	if arg == nil {
+		return reflect.Zero(argType), nil
+	}
+
+	argValue := reflect.ValueOf(arg)
+	if argValue.Type().AssignableTo(argType) {
+		return argValue, nil
+	}
+	if isNumber(argValue.Kind()) && isNumber(argType.Kind()) {
+		return argValue.Convert(argType), nil
+	}
+	return reflect.Value{}, fmt.Errorf("expected %s, but got %T", argType, arg)
+}
+
+func isNumber(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
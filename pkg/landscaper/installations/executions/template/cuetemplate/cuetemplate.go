@@ -0,0 +1,327 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cuetemplate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"cuelang.org/go/cue"
+	"cuelang.org/go/cue/cuecontext"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	"sigs.k8s.io/yaml"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/landscaper/targetresolver"
+	"github.com/gardener/landscaper/pkg/components/model"
+	lstmpl "github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/gotemplate"
+	"github.com/gardener/landscaper/pkg/utils/blueprints"
+)
+
+const (
+	// defaultTemplateFilename is the filename used in error messages for inline templates.
+	defaultTemplateFilename = "template.cue"
+	// inputFilename is the filename used in error messages for the template input.
+	inputFilename = "input.json"
+	// InputField is the name of the scope value that contains the complete template input.
+	// It is needed to access input values that are shadowed by top-level fields of the template, like the state.
+	InputField = "input"
+)
+
+// Templater is the CUE implementation for landscaper templating.
+// The template is evaluated with the template input (imports, cd, components, state, ...) as scope,
+// so that the template can reference the input without declaring it. The complete input is additionally
+// available as "input".
+type Templater struct {
+	state           lstmpl.GenericStateHandler
+	inputFormatter  *lstmpl.TemplateInputFormatter
+	targetResolver  targetresolver.TargetResolver
+	lookups         []lsv1alpha1.TemplateLookupResource
+	lookupNamespace string
+}
+
+// New creates a new CUE execution templater.
+func New(state lstmpl.GenericStateHandler, targetResolver targetresolver.TargetResolver) *Templater {
+	return &Templater{
+		state:          state,
+		inputFormatter: lstmpl.NewTemplateInputFormatter(false, "imports", "values", "state"),
+		targetResolver: targetResolver,
+	}
+}
+
+// WithInputFormatter ads a custom input formatter to this templater used for error messages.
+func (t *Templater) WithInputFormatter(inputFormatter *lstmpl.TemplateInputFormatter) *Templater {
+	t.inputFormatter = inputFormatter
+	return t
+}
+
+// WithTemplateLookups sets the kinds of objects that can be read from target clusters with the lookup functions,
+// and the namespace of the installation in which the targets of the lookup functions are resolved.
+func (t *Templater) WithTemplateLookups(namespace string, lookups []lsv1alpha1.TemplateLookupResource) *Templater {
+	t.lookupNamespace = namespace
+	t.lookups = lookups
+	return t
+}
+
+func (t Templater) Type() lsv1alpha1.TemplateType {
+	return lsv1alpha1.CUETemplateType
+}
+
+// StateTemplateResult describes the state in the result of a CUE template.
+type StateTemplateResult struct {
+	State json.RawMessage `json:"state"`
+}
+
+// TemplateExecution evaluates a CUE template with the given values and returns the result as JSON.
+func (t *Templater) TemplateExecution(rawTemplate []byte,
+	filename string,
+	blueprint *blueprints.Blueprint,
+	cd model.ComponentVersion,
+	cdList *model.ComponentVersionList,
+	values map[string]interface{}) ([]byte, error) {
+
+	funcs, err := newCallFunctions(gotemplate.FuncMapOptions{
+		Blueprint:         blueprint,
+		ComponentVersion:  cd,
+		ComponentVersions: cdList,
+		TargetResolver:    t.targetResolver,
+		Lookups:           t.lookups,
+		LookupNamespace:   t.lookupNamespace,
+		State:             t.state,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	scopeValues := make(map[string]interface{}, len(values)+1)
+	for key, val := range values {
+		scopeValues[key] = val
+	}
+	scopeValues[InputField] = values
+
+	cuectx := cuecontext.New()
+	valuesData, err := json.Marshal(scopeValues)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal template input: %w", err)
+	}
+	// the input is compiled from json to keep integers as integers
+	scope := cuectx.CompileBytes(valuesData, cue.Filename(inputFilename))
+	if scope.Err() != nil {
+		return nil, fmt.Errorf("unable to compile template input: %w", scope.Err())
+	}
+
+	value := cuectx.CompileBytes(rawTemplate, cue.Filename(filename), cue.Scope(scope))
+	if value.Err() != nil {
+		return nil, value.Err()
+	}
+
+	value, err = resolveCalls(cuectx, value, funcs)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := value.Validate(cue.Concrete(true)); err != nil {
+		return nil, err
+	}
+	return value.MarshalJSON()
+}
+
+func (t *Templater) TemplateSubinstallationExecutions(tmplExec lsv1alpha1.TemplateExecutor,
+	blueprint *blueprints.Blueprint,
+	cd model.ComponentVersion,
+	cdList *model.ComponentVersionList,
+	values map[string]interface{}) (*lstmpl.SubinstallationExecutorOutput, error) {
+
+	rawTemplate, filename, err := getTemplateFromExecution(tmplExec, blueprint)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+	defer ctx.Done()
+	state, err := t.getDeployExecutionState(ctx, tmplExec)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load state: %w", err)
+	}
+
+	values["state"] = state
+	data, err := t.TemplateExecution(rawTemplate, filename, blueprint, cd, cdList, values)
+	if err != nil {
+		return nil, TemplateErrorBuilder(err).WithInput(values, t.inputFormatter).Build()
+	}
+
+	if err := t.storeDeployExecutionState(ctx, tmplExec, data); err != nil {
+		return nil, fmt.Errorf("unable to store state: %w", err)
+	}
+	output := &lstmpl.SubinstallationExecutorOutput{}
+	if err := yaml.Unmarshal(data, output); err != nil {
+		return nil, fmt.Errorf("error while decoding templated execution: %w", err)
+	}
+	return output, nil
+}
+
+// TemplateImportExecutions is the CUE executor for an import execution.
+func (t *Templater) TemplateImportExecutions(tmplExec lsv1alpha1.TemplateExecutor,
+	blueprint *blueprints.Blueprint,
+	descriptor model.ComponentVersion,
+	cdList *model.ComponentVersionList,
+	values map[string]interface{}) (*lstmpl.ImportExecutorOutput, error) {
+
+	rawTemplate, filename, err := getTemplateFromExecution(tmplExec, blueprint)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := t.TemplateExecution(rawTemplate, filename, blueprint, descriptor, cdList, values)
+	if err != nil {
+		return nil, TemplateErrorBuilder(err).WithInput(values, t.inputFormatter).Build()
+	}
+
+	output := &lstmpl.ImportExecutorOutput{}
+	if err := yaml.Unmarshal(data, output); err != nil {
+		return nil, fmt.Errorf("error while decoding templated execution: %w", err)
+	}
+	return output, nil
+}
+
+// TemplateDeployExecutions is the CUE executor for a deploy execution.
+func (t *Templater) TemplateDeployExecutions(tmplExec lsv1alpha1.TemplateExecutor,
+	blueprint *blueprints.Blueprint,
+	descriptor model.ComponentVersion,
+	cdList *model.ComponentVersionList,
+	values map[string]interface{}) (*lstmpl.DeployExecutorOutput, error) {
+
+	rawTemplate, filename, err := getTemplateFromExecution(tmplExec, blueprint)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+	defer ctx.Done()
+	state, err := t.getDeployExecutionState(ctx, tmplExec)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load state: %w", err)
+	}
+
+	values["state"] = state
+	data, err := t.TemplateExecution(rawTemplate, filename, blueprint, descriptor, cdList, values)
+	if err != nil {
+		return nil, TemplateErrorBuilder(err).WithInput(values, t.inputFormatter).Build()
+	}
+
+	if err := t.storeDeployExecutionState(ctx, tmplExec, data); err != nil {
+		return nil, fmt.Errorf("unable to store state: %w", err)
+	}
+	output := &lstmpl.DeployExecutorOutput{}
+	if err := yaml.Unmarshal(data, output); err != nil {
+		return nil, fmt.Errorf("error while decoding templated execution: %w", err)
+	}
+	return output, nil
+}
+
+// TemplateExportExecutions is the CUE executor for an export execution.
+func (t *Templater) TemplateExportExecutions(tmplExec lsv1alpha1.TemplateExecutor,
+	blueprint *blueprints.Blueprint,
+	descriptor model.ComponentVersion,
+	cdList *model.ComponentVersionList,
+	values map[string]interface{}) (*lstmpl.ExportExecutorOutput, error) {
+
+	rawTemplate, filename, err := getTemplateFromExecution(tmplExec, blueprint)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+	defer ctx.Done()
+	state, err := t.getExportExecutionState(ctx, tmplExec)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load state: %w", err)
+	}
+
+	values["state"] = state
+	data, err := t.TemplateExecution(rawTemplate, filename, blueprint, descriptor, cdList, values)
+	if err != nil {
+		return nil, TemplateErrorBuilder(err).WithInput(values, t.inputFormatter).Build()
+	}
+
+	if err := t.storeExportExecutionState(ctx, tmplExec, data); err != nil {
+		return nil, fmt.Errorf("unable to store state: %w", err)
+	}
+	output := &lstmpl.ExportExecutorOutput{}
+	if err := yaml.Unmarshal(data, output); err != nil {
+		return nil, err
+	}
+	return output, nil
+}
+
+func (t *Templater) getDeployExecutionState(ctx context.Context, tmplExec lsv1alpha1.TemplateExecutor) (interface{}, error) {
+	return t.getState(ctx, "deploy", tmplExec)
+}
+
+func (t *Templater) storeDeployExecutionState(ctx context.Context, tmplExec lsv1alpha1.TemplateExecutor, data []byte) error {
+	return t.storeState(ctx, "deploy", tmplExec, data)
+}
+
+func (t *Templater) getExportExecutionState(ctx context.Context, tmplExec lsv1alpha1.TemplateExecutor) (interface{}, error) {
+	return t.getState(ctx, "export", tmplExec)
+}
+
+func (t *Templater) storeExportExecutionState(ctx context.Context, tmplExec lsv1alpha1.TemplateExecutor, data []byte) error {
+	return t.storeState(ctx, "export", tmplExec, data)
+}
+
+func (t *Templater) getState(ctx context.Context, prefix string, tmplExec lsv1alpha1.TemplateExecutor) (interface{}, error) {
+	if t.state == nil {
+		return map[string]interface{}{}, nil
+	}
+	data, err := t.state.Get(ctx, prefix+tmplExec.Name)
+	if err != nil {
+		if err == lstmpl.StateNotFoundErr {
+			return map[string]interface{}{}, nil
+		}
+		return nil, err
+	}
+
+	var state interface{}
+	if err := yaml.Unmarshal(data, &state); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+func (t *Templater) storeState(ctx context.Context, prefix string, tmplExec lsv1alpha1.TemplateExecutor, data []byte) error {
+	if t.state == nil {
+		return nil
+	}
+	res := &StateTemplateResult{}
+	if err := yaml.Unmarshal(data, res); err != nil {
+		return err
+	}
+	if len(res.State) == 0 {
+		return nil
+	}
+	return t.state.Store(ctx, prefix+tmplExec.Name, res.State)
+}
+
+// getTemplateFromExecution returns the CUE source of a template executor and the filename used in error messages.
+func getTemplateFromExecution(tmplExec lsv1alpha1.TemplateExecutor, blueprint *blueprints.Blueprint) ([]byte, string, error) {
+	if len(tmplExec.Template.RawMessage) != 0 {
+		var rawTemplate string
+		if err := json.Unmarshal(tmplExec.Template.RawMessage, &rawTemplate); err != nil {
+			return nil, "", fmt.Errorf("a CUE template must be provided as string: %w", err)
+		}
+		return []byte(rawTemplate), defaultTemplateFilename, nil
+	}
+	if len(tmplExec.File) != 0 {
+		rawTemplateBytes, err := vfs.ReadFile(blueprint.Fs, tmplExec.File)
+		if err != nil {
+			return nil, "", err
+		}
+		return rawTemplateBytes, tmplExec.File, nil
+	}
+	return nil, "", fmt.Errorf("no template found")
+}
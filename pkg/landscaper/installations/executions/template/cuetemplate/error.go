@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cuetemplate
+
+import (
+	"strings"
+
+	cueerrors "cuelang.org/go/cue/errors"
+
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
+)
+
+// TemplateError wraps a CUE templating error and adds more human-readable information.
+type TemplateError struct {
+	err            error
+	input          map[string]interface{}
+	inputFormatter *template.TemplateInputFormatter
+	message        string
+}
+
+// TemplateErrorBuilder creates a new TemplateError.
+func TemplateErrorBuilder(err error) *TemplateError {
+	return &TemplateError{
+		err: err,
+	}
+}
+
+// WithInput adds the template input with a formatter to the error.
+func (e *TemplateError) WithInput(input map[string]interface{}, inputFormatter *template.TemplateInputFormatter) *TemplateError {
+	e.input = input
+	e.inputFormatter = inputFormatter
+	return e
+}
+
+// Build builds the error message.
+// CUE errors are listed with the positions in the template at which they occurred.
+func (e *TemplateError) Build() *TemplateError {
+	builder := strings.Builder{}
+	builder.WriteString(strings.TrimSpace(cueerrors.Details(e.err, nil)))
+
+	if e.input != nil && e.inputFormatter != nil {
+		builder.WriteString("\ntemplate input:\n")
+		builder.WriteString(e.inputFormatter.Format(e.input, "\t"))
+	}
+
+	e.message = builder.String()
+	return e
+}
+
+// Error returns the error message.
+func (e *TemplateError) Error() string {
+	return e.message
+}
+
+// Diagnose adds the position in the template source and the path of the first CUE error to the diagnostics.
+// Positions in the template input are ignored.
+func (e *TemplateError) Diagnose(d *template.ExecutionDiagnostics) {
+	errs := cueerrors.Errors(e.err)
+	if len(errs) == 0 {
+		return
+	}
+	d.Field = strings.Join(errs[0].Path(), ".")
+	for _, pos := range cueerrors.Positions(errs[0]) {
+		if pos.Filename() == inputFilename {
+			continue
+		}
+		d.Line = pos.Line()
+		d.Column = pos.Column()
+		return
+	}
+}
+
+// Unwrap returns the wrapped error.
+func (e *TemplateError) Unwrap() error {
+	return e.err
+}
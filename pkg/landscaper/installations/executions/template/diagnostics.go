@@ -0,0 +1,319 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package template
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/yaml"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/utils/blueprints"
+)
+
+const (
+	// DiagnosticsJobIDAnnotation is the annotation of the diagnostics secret that contains the job id of the
+	// installation for which the diagnostics have been stored.
+	DiagnosticsJobIDAnnotation = lsv1alpha1.LandscaperDomain + "/diagnostics-job-id"
+)
+
+// ExecutionKind is the kind of a template execution, i.e. the field of the blueprint that contains it.
+type ExecutionKind string
+
+const (
+	ImportExecutionKind          ExecutionKind = "importExecutions"
+	DeployExecutionKind          ExecutionKind = "deployExecutions"
+	SubinstallationExecutionKind ExecutionKind = "subinstallationExecutions"
+	ExportExecutionKind          ExecutionKind = "exportExecutions"
+)
+
+// diagnosticsKeyRegexp matches all characters that are not allowed in the data keys of a secret.
+var diagnosticsKeyRegexp = regexp.MustCompile(`[^-._a-zA-Z0-9]`)
+
+// ExecutionDiagnostics describes a template execution for debugging.
+type ExecutionDiagnostics struct {
+	// Execution is the path of the template execution in the blueprint, e.g. "deployExecutions[0]".
+	Execution string `json:"execution"`
+	// Name is the name of the template execution.
+	Name string `json:"name"`
+	// Type is the template type of the template execution.
+	Type lsv1alpha1.TemplateType `json:"type"`
+	// File is the file of the blueprint that contains the template.
+	// Inline templates are contained in the blueprint.yaml.
+	File string `json:"file,omitempty"`
+	// Line is the line of the file at which the template execution failed.
+	Line int `json:"line,omitempty"`
+	// Column is the column of the file at which the template execution failed.
+	Column int `json:"column,omitempty"`
+	// Field is the field of the template at which the template execution failed.
+	// It is set for templates that are evaluated as structure, like spiff templates.
+	Field string `json:"field,omitempty"`
+	// Expression is the template expression that failed.
+	Expression string `json:"expression,omitempty"`
+	// Error is the error message of a failed template execution.
+	Error string `json:"error,omitempty"`
+	// Input is the template input. The values of sensitive keys, like the imports, are redacted.
+	Input map[string]interface{} `json:"input,omitempty"`
+	// Output is the rendered output of the template, if available.
+	Output string `json:"output,omitempty"`
+}
+
+// Location returns the position in the blueprint at which the template execution failed.
+// An empty string is returned if the position is not known.
+func (d *ExecutionDiagnostics) Location() string {
+	var location string
+	switch {
+	case len(d.File) != 0 && d.Line > 0:
+		location = fmt.Sprintf("%s:%d", d.File, d.Line)
+	case len(d.File) != 0:
+		location = d.File
+	case d.Line > 0:
+		location = fmt.Sprintf("template line %d", d.Line)
+	}
+	if d.Line > 0 && d.Column > 0 {
+		location = fmt.Sprintf("%s:%d", location, d.Column)
+	}
+	if len(d.Field) != 0 {
+		if len(location) != 0 {
+			location += " "
+		}
+		location += "field " + d.Field
+	}
+	return location
+}
+
+// key returns the data key of the diagnostics in the diagnostics secret.
+func (d *ExecutionDiagnostics) key() string {
+	return diagnosticsKeyRegexp.ReplaceAllString(fmt.Sprintf("%s.%s.yaml", d.Execution, d.Name), "_")
+}
+
+// DiagnosableError is implemented by the errors of templaters that know details about a failed template execution,
+// like the position in the template source at which the execution failed.
+type DiagnosableError interface {
+	error
+	// Diagnose adds the details of the error to the diagnostics.
+	// Positions are relative to the template source.
+	Diagnose(d *ExecutionDiagnostics)
+}
+
+// ExecutionError is the error of a failed template execution.
+// It describes where the template execution failed in the blueprint.
+type ExecutionError struct {
+	// Diagnostics describes the failed template execution.
+	Diagnostics ExecutionDiagnostics
+	// DiagnosticsRef references the secret that contains the diagnostics, if they have been stored.
+	DiagnosticsRef *lsv1alpha1.ObjectReference
+
+	err            error
+	diagnosticsErr error
+}
+
+// DebugSecretRef returns the reference to the secret that contains the diagnostics.
+// It is set as debugSecretRef of the last error of the installation.
+func (e *ExecutionError) DebugSecretRef() *lsv1alpha1.ObjectReference {
+	return e.DiagnosticsRef
+}
+
+// Error returns the error message.
+func (e *ExecutionError) Error() string {
+	builder := strings.Builder{}
+	builder.WriteString(fmt.Sprintf("template execution %s %q failed", e.Diagnostics.Execution, e.Diagnostics.Name))
+	if location := e.Diagnostics.Location(); len(location) != 0 {
+		builder.WriteString(" at " + location)
+	}
+	if len(e.Diagnostics.Expression) != 0 {
+		builder.WriteString(" in " + e.Diagnostics.Expression)
+	}
+	if e.diagnosticsErr != nil {
+		builder.WriteString(fmt.Sprintf(" (unable to store diagnostics: %s)", e.diagnosticsErr.Error()))
+	}
+	builder.WriteString(": ")
+	builder.WriteString(e.err.Error())
+	return builder.String()
+}
+
+// Unwrap returns the wrapped error.
+func (e *ExecutionError) Unwrap() error {
+	return e.err
+}
+
+// newExecutionDiagnostics creates the diagnostics of a template execution.
+func newExecutionDiagnostics(kind ExecutionKind, index int, tmplExec lsv1alpha1.TemplateExecutor,
+	values map[string]interface{}, inputFormatter *TemplateInputFormatter) ExecutionDiagnostics {
+	return ExecutionDiagnostics{
+		Execution: fmt.Sprintf("%s[%d]", kind, index),
+		Name:      tmplExec.Name,
+		Type:      tmplExec.Type,
+		Input:     inputFormatter.Redact(values),
+	}
+}
+
+// newExecutionError creates the error of a failed template execution.
+// The positions of the templater error are mapped to the file of the blueprint that contains the template.
+func newExecutionError(err error, diagnostics ExecutionDiagnostics, tmplExec lsv1alpha1.TemplateExecutor,
+	blueprint *blueprints.Blueprint) *ExecutionError {
+	diagnostics.Error = err.Error()
+	var diagnosableErr DiagnosableError
+	if errors.As(err, &diagnosableErr) {
+		diagnosableErr.Diagnose(&diagnostics)
+	}
+	mapSourcePosition(&diagnostics, tmplExec, blueprint)
+
+	return &ExecutionError{
+		Diagnostics: diagnostics,
+		err:         err,
+	}
+}
+
+// mapSourcePosition maps the line of the diagnostics from the template source to the file of the blueprint.
+// The line of an inline template is only kept if the template can be found in the blueprint.yaml.
+func mapSourcePosition(d *ExecutionDiagnostics, tmplExec lsv1alpha1.TemplateExecutor, blueprint *blueprints.Blueprint) {
+	if len(tmplExec.File) != 0 {
+		d.File = tmplExec.File
+		return
+	}
+	d.File = lsv1alpha1.BlueprintFileName
+	if d.Line == 0 {
+		return
+	}
+
+	offset := -1
+	var source string
+	if err := json.Unmarshal(tmplExec.Template.RawMessage, &source); err == nil && blueprint != nil && blueprint.Fs != nil {
+		if data, err := vfs.ReadFile(blueprint.Fs, lsv1alpha1.BlueprintFileName); err == nil {
+			offset = findSource(string(data), source)
+		}
+	}
+	if offset < 0 {
+		// the position is only known relative to the template
+		d.File = ""
+		return
+	}
+	d.Line += offset
+	// the indentation of the block scalar is not known, so that the column cannot be mapped
+	d.Column = 0
+}
+
+// findSource returns the number of lines before the given source in the file.
+// The lines are compared without indentation, as inline templates are usually defined as indented block scalars.
+// -1 is returned if the source is not contained in the file.
+func findSource(file, source string) int {
+	sourceLines := strings.Split(strings.TrimRight(source, "\n"), "\n")
+	fileLines := strings.Split(file, "\n")
+	for start := 0; start+len(sourceLines) <= len(fileLines); start++ {
+		found := true
+		for i, line := range sourceLines {
+			if strings.TrimSpace(fileLines[start+i]) != strings.TrimSpace(line) {
+				found = false
+				break
+			}
+		}
+		if found {
+			return start
+		}
+	}
+	return -1
+}
+
+// DiagnosticsHandler stores the diagnostics of template executions.
+type DiagnosticsHandler interface {
+	// CaptureOutput returns whether the diagnostics of successful template executions are stored, too.
+	CaptureOutput() bool
+	// Store stores the diagnostics of a template execution and returns a reference to the secret that contains them.
+	Store(ctx context.Context, diagnostics ExecutionDiagnostics) (*lsv1alpha1.ObjectReference, error)
+}
+
+// KubernetesDiagnosticsHandler implements the DiagnosticsHandler interface.
+// It stores the diagnostics of the template executions of an installation in a secret that is owned by the
+// installation. The diagnostics of every template execution are stored with their own key, and the secret is
+// cleared when a new job of the installation starts.
+type KubernetesDiagnosticsHandler struct {
+	KubeClient client.Client
+	Inst       *lsv1alpha1.Installation
+}
+
+var _ DiagnosticsHandler = &KubernetesDiagnosticsHandler{}
+
+var _ lserrors.DebuggableError = &ExecutionError{}
+
+// NewInstallationDiagnosticsHandler returns the diagnostics handler for the templates of the given installation.
+// In dry run mode no diagnostics are stored, so that nil is returned.
+func NewInstallationDiagnosticsHandler(kubeClient client.Client, inst *lsv1alpha1.Installation, dryRun bool) DiagnosticsHandler {
+	if dryRun {
+		return nil
+	}
+	return &KubernetesDiagnosticsHandler{
+		KubeClient: kubeClient,
+		Inst:       inst,
+	}
+}
+
+func (h *KubernetesDiagnosticsHandler) CaptureOutput() bool {
+	return lsv1alpha1helper.HasCaptureTemplateOutputAnnotation(&h.Inst.ObjectMeta)
+}
+
+func (h *KubernetesDiagnosticsHandler) Store(ctx context.Context, diagnostics ExecutionDiagnostics) (*lsv1alpha1.ObjectReference, error) {
+	data, err := yaml.Marshal(diagnostics)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal diagnostics: %w", err)
+	}
+
+	secret := &corev1.Secret{}
+	secret.Name = DiagnosticsSecretName(h.Inst)
+	secret.Namespace = h.Inst.Namespace
+	if err := h.KubeClient.Get(ctx, kutil.ObjectKey(secret.Name, secret.Namespace), secret); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+
+		metav1.SetMetaDataAnnotation(&secret.ObjectMeta, DiagnosticsJobIDAnnotation, h.Inst.Status.JobID)
+		secret.Data = map[string][]byte{
+			diagnostics.key(): data,
+		}
+		if err := controllerutil.SetControllerReference(h.Inst, secret, api.LandscaperScheme); err != nil {
+			return nil, fmt.Errorf("unable to set controller reference: %w", err)
+		}
+		if err := h.KubeClient.Create(ctx, secret); err != nil {
+			return nil, err
+		}
+		return &lsv1alpha1.ObjectReference{Name: secret.Name, Namespace: secret.Namespace}, nil
+	}
+
+	// the diagnostics of previous jobs are outdated
+	if secret.Data == nil || secret.Annotations[DiagnosticsJobIDAnnotation] != h.Inst.Status.JobID {
+		secret.Data = map[string][]byte{}
+	}
+	metav1.SetMetaDataAnnotation(&secret.ObjectMeta, DiagnosticsJobIDAnnotation, h.Inst.Status.JobID)
+	secret.Data[diagnostics.key()] = data
+	if err := h.KubeClient.Update(ctx, secret); err != nil {
+		return nil, err
+	}
+	return &lsv1alpha1.ObjectReference{Name: secret.Name, Namespace: secret.Namespace}, nil
+}
+
+// DiagnosticsSecretName returns the name of the secret that contains the template diagnostics of an installation.
+func DiagnosticsSecretName(inst *lsv1alpha1.Installation) string {
+	h := sha1.New()
+	_, _ = h.Write([]byte(fmt.Sprintf("%s/template-diagnostics", inst.Name)))
+	// we need base32 encoding as some base64 (even url safe base64) characters are not supported by k8s
+	return base32.NewEncoding(lsv1alpha1helper.Base32EncodeStdLowerCase).WithPadding(base32.NoPadding).EncodeToString(h.Sum(nil))
+}
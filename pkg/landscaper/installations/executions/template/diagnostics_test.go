@@ -0,0 +1,177 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package template_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/mandelsoft/vfs/pkg/memoryfs"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/gotemplate"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/spiff"
+	"github.com/gardener/landscaper/pkg/utils/blueprints"
+)
+
+// memoryDiagnosticsHandler stores the diagnostics of template executions in memory.
+type memoryDiagnosticsHandler struct {
+	capture bool
+	stored  []template.ExecutionDiagnostics
+}
+
+func (h *memoryDiagnosticsHandler) CaptureOutput() bool {
+	return h.capture
+}
+
+func (h *memoryDiagnosticsHandler) Store(_ context.Context, diagnostics template.ExecutionDiagnostics) (*lsv1alpha1.ObjectReference, error) {
+	h.stored = append(h.stored, diagnostics)
+	return &lsv1alpha1.ObjectReference{Name: "diagnostics", Namespace: "default"}, nil
+}
+
+var _ = Describe("Diagnostics", func() {
+
+	var (
+		stateHandler       template.GenericStateHandler
+		diagnosticsHandler *memoryDiagnosticsHandler
+	)
+
+	BeforeEach(func() {
+		stateHandler = template.NewMemoryStateHandler()
+		diagnosticsHandler = &memoryDiagnosticsHandler{}
+	})
+
+	renderDeployExecutions := func(blueprint string, files ...string) ([]template.DeployItemSpecification, error) {
+		fs := memoryfs.New()
+		Expect(vfs.WriteFile(fs, lsv1alpha1.BlueprintFileName, []byte(blueprint), 0644)).To(Succeed())
+		for i := 0; i < len(files); i += 2 {
+			Expect(vfs.WriteFile(fs, files[i], []byte(files[i+1]), 0644)).To(Succeed())
+		}
+		bp, err := blueprints.NewFromFs(fs)
+		Expect(err).ToNot(HaveOccurred())
+
+		tmpl := template.New(gotemplate.New(stateHandler, nil), spiff.New(stateHandler, nil)).
+			WithDiagnosticsHandler(diagnosticsHandler)
+		return tmpl.TemplateDeployExecutions(
+			template.NewDeployExecutionOptions(
+				template.NewBlueprintExecutionOptions(nil, bp, nil, nil, map[string]interface{}{
+					"config": map[string]interface{}{
+						"password": "secret",
+					},
+				})))
+	}
+
+	It("should report the line of an inline go template in the blueprint", func() {
+		_, err := renderDeployExecutions(`apiVersion: landscaper.gardener.cloud/v1alpha1
+kind: Blueprint
+jsonSchema: "https://json-schema.org/draft/2019-09/schema"
+imports:
+- name: config
+  type: data
+  schema:
+    type: object
+deployExecutions:
+- name: deploy
+  type: GoTemplate
+  template: |
+    deployItems:
+    - name: item
+      type: landscaper.gardener.cloud/mock
+      config:
+        replicas: {{ .imports.missing.replicas }}
+`)
+		Expect(err).To(HaveOccurred())
+
+		var execErr *template.ExecutionError
+		Expect(errors.As(err, &execErr)).To(BeTrue())
+		Expect(execErr.Diagnostics.Execution).To(Equal("deployExecutions[0]"))
+		Expect(execErr.Diagnostics.Name).To(Equal("deploy"))
+		Expect(execErr.Diagnostics.File).To(Equal(lsv1alpha1.BlueprintFileName))
+		Expect(execErr.Diagnostics.Line).To(Equal(17))
+		Expect(execErr.Diagnostics.Expression).To(Equal(".imports.missing.replicas"))
+		Expect(err.Error()).To(HavePrefix(`template execution deployExecutions[0] "deploy" failed at blueprint.yaml:17 in .imports.missing.replicas: `))
+
+		Expect(execErr.DiagnosticsRef).ToNot(BeNil())
+		Expect(diagnosticsHandler.stored).To(HaveLen(1))
+		Expect(diagnosticsHandler.stored[0].Input).To(HaveKeyWithValue("imports", map[string]interface{}{
+			"config": map[string]interface{}{
+				"password": "[...] (string)",
+			},
+		}))
+	})
+
+	It("should report the line and column of a go template file", func() {
+		_, err := renderDeployExecutions(`apiVersion: landscaper.gardener.cloud/v1alpha1
+kind: Blueprint
+jsonSchema: "https://json-schema.org/draft/2019-09/schema"
+deployExecutions:
+- name: deploy
+  type: GoTemplate
+  file: /deploy.yaml
+`, "/deploy.yaml", `deployItems:
+- name: item
+  type: landscaper.gardener.cloud/mock
+  config:
+    replicas: {{ .imports.missing.replicas }}
+`)
+		Expect(err).To(HaveOccurred())
+
+		var execErr *template.ExecutionError
+		Expect(errors.As(err, &execErr)).To(BeTrue())
+		Expect(execErr.Diagnostics.File).To(Equal("/deploy.yaml"))
+		Expect(execErr.Diagnostics.Line).To(Equal(5))
+		Expect(execErr.Diagnostics.Column).To(BeNumerically(">", 0))
+		Expect(execErr.Diagnostics.Location()).To(HavePrefix("/deploy.yaml:5:"))
+	})
+
+	It("should report the field and the expression of a spiff template", func() {
+		_, err := renderDeployExecutions(`apiVersion: landscaper.gardener.cloud/v1alpha1
+kind: Blueprint
+jsonSchema: "https://json-schema.org/draft/2019-09/schema"
+deployExecutions:
+- name: deploy
+  type: Spiff
+  template:
+    deployItems:
+    - name: item
+      type: landscaper.gardener.cloud/mock
+      config:
+        replicas: (( imports.missing ))
+`)
+		Expect(err).To(HaveOccurred())
+
+		var execErr *template.ExecutionError
+		Expect(errors.As(err, &execErr)).To(BeTrue())
+		Expect(execErr.Diagnostics.Field).To(HaveSuffix("config.replicas"))
+		Expect(execErr.Diagnostics.Expression).To(Equal("(( imports.missing ))"))
+	})
+
+	It("should capture the output of successful template executions", func() {
+		diagnosticsHandler.capture = true
+		res, err := renderDeployExecutions(`apiVersion: landscaper.gardener.cloud/v1alpha1
+kind: Blueprint
+jsonSchema: "https://json-schema.org/draft/2019-09/schema"
+deployExecutions:
+- name: deploy
+  type: GoTemplate
+  template: |
+    deployItems:
+    - name: item
+      type: landscaper.gardener.cloud/mock
+      config:
+        replicas: 3
+`)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res).To(HaveLen(1))
+		Expect(diagnosticsHandler.stored).To(HaveLen(1))
+		Expect(diagnosticsHandler.stored[0].Error).To(BeEmpty())
+		Expect(diagnosticsHandler.stored[0].Output).To(ContainSubstring("replicas: 3"))
+	})
+
+})
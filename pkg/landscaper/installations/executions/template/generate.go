@@ -0,0 +1,314 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package template
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"time"
+)
+
+const (
+	// generatedStatePrefix is the prefix of the state keys of generated values.
+	generatedStatePrefix = "generated/"
+
+	passwordCharacters = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+	// certificateKeyBits is the size of the rsa keys of generated certificates.
+	certificateKeyBits = 2048
+)
+
+// Generator generates passwords, keys and certificates for templates.
+// The generated values are stored in the template state with a name that is unique within an installation,
+// so that subsequent renderings of all templates of the installation return the same values.
+// A value is only generated again if its parameters change or if a certificate is about to expire.
+type Generator struct {
+	state GenericStateHandler
+}
+
+// NewGenerator creates a new generator that stores the generated values in the given state.
+func NewGenerator(state GenericStateHandler) *Generator {
+	return &Generator{state: state}
+}
+
+// generatedState describes a generated value in the template state.
+type generatedState struct {
+	// Parameters describes the parameters of the generation. The value is generated again if they change.
+	Parameters json.RawMessage `json:"parameters"`
+	// Value is the generated value.
+	Value json.RawMessage `json:"value"`
+}
+
+// Password returns a random alphanumeric password of the given length.
+func (g *Generator) Password(ctx context.Context, name string, length int64) (string, error) {
+	if length <= 0 {
+		return "", fmt.Errorf("the length of a password must be positive")
+	}
+
+	var password string
+	err := g.getOrGenerate(ctx, name, map[string]interface{}{"type": "password", "length": length}, &password, nil,
+		func() (interface{}, error) {
+			return generatePassword(length)
+		})
+	return password, err
+}
+
+// KeyPair returns a rsa key pair with the given number of bits.
+// The result contains the PEM encoded "privateKey" and "publicKey".
+func (g *Generator) KeyPair(ctx context.Context, name string, bits int64) (map[string]interface{}, error) {
+	if bits < 1024 {
+		return nil, fmt.Errorf("a key pair must have at least 1024 bits")
+	}
+
+	keyPair := map[string]interface{}{}
+	err := g.getOrGenerate(ctx, name, map[string]interface{}{"type": "keyPair", "bits": bits}, &keyPair, nil,
+		func() (interface{}, error) {
+			key, err := rsa.GenerateKey(rand.Reader, int(bits))
+			if err != nil {
+				return nil, err
+			}
+			publicKey, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+			if err != nil {
+				return nil, err
+			}
+			return map[string]interface{}{
+				"privateKey": encodePrivateKey(key),
+				"publicKey":  string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKey})),
+			}, nil
+		})
+	return keyPair, err
+}
+
+// CA returns a self-signed CA certificate with the given common name and validity.
+// The certificate is generated again if it expires within the rotateBefore duration.
+// The result contains the PEM encoded "certificate" and "privateKey", and the expiration time "notAfter".
+func (g *Generator) CA(ctx context.Context, name, commonName string, validity, rotateBefore time.Duration) (map[string]interface{}, error) {
+	if validity <= 0 {
+		return nil, fmt.Errorf("the validity of a certificate must be positive")
+	}
+
+	params := map[string]interface{}{"type": "ca", "commonName": commonName, "validity": validity.String()}
+	cert := map[string]interface{}{}
+	err := g.getOrGenerate(ctx, name, params, &cert, certificateValid(rotateBefore),
+		func() (interface{}, error) {
+			template, err := newCertificateTemplate(commonName, validity)
+			if err != nil {
+				return nil, err
+			}
+			template.IsCA = true
+			template.KeyUsage = x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature
+			template.BasicConstraintsValid = true
+			return createCertificate(template, nil, nil)
+		})
+	return cert, err
+}
+
+// Certificate returns a certificate with the given common name, alternative names and validity
+// that is signed by the given CA. The CA is the result of the CA function.
+// The certificate is generated again if the CA changes or if it expires within the rotateBefore duration.
+// The result contains the PEM encoded "certificate" and "privateKey", and the expiration time "notAfter".
+func (g *Generator) Certificate(ctx context.Context, name, commonName string, altNames []string, ca interface{}, validity, rotateBefore time.Duration) (map[string]interface{}, error) {
+	if validity <= 0 {
+		return nil, fmt.Errorf("the validity of a certificate must be positive")
+	}
+
+	caCert, caKey, err := parseCA(ca)
+	if err != nil {
+		return nil, err
+	}
+	if altNames == nil {
+		altNames = []string{}
+	}
+
+	caHash := sha256.Sum256(caCert.Raw)
+	params := map[string]interface{}{
+		"type":       "certificate",
+		"commonName": commonName,
+		"altNames":   altNames,
+		"validity":   validity.String(),
+		"ca":         hex.EncodeToString(caHash[:]),
+	}
+	cert := map[string]interface{}{}
+	err = g.getOrGenerate(ctx, name, params, &cert, certificateValid(rotateBefore),
+		func() (interface{}, error) {
+			template, err := newCertificateTemplate(commonName, validity)
+			if err != nil {
+				return nil, err
+			}
+			template.KeyUsage = x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment
+			template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth}
+			for _, altName := range altNames {
+				if ip := net.ParseIP(altName); ip != nil {
+					template.IPAddresses = append(template.IPAddresses, ip)
+				} else {
+					template.DNSNames = append(template.DNSNames, altName)
+				}
+			}
+			return createCertificate(template, caCert, caKey)
+		})
+	return cert, err
+}
+
+// getOrGenerate reads a generated value from the state into value.
+// A new value is generated and stored if there is no value with the same parameters, or if the value is not valid anymore.
+func (g *Generator) getOrGenerate(ctx context.Context, name string, params interface{}, value interface{},
+	isValid func(value []byte) bool, generate func() (interface{}, error)) error {
+
+	if g.state == nil {
+		return fmt.Errorf("no template state available to store generated values")
+	}
+	if len(name) == 0 {
+		return fmt.Errorf("the name of a generated value must not be empty")
+	}
+
+	paramsData, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+
+	data, err := g.state.Get(ctx, generatedStatePrefix+name)
+	if err != nil && !errors.Is(err, StateNotFoundErr) {
+		return fmt.Errorf("unable to load generated value %q: %w", name, err)
+	}
+	if err == nil {
+		stored := &generatedState{}
+		if err := json.Unmarshal(data, stored); err != nil {
+			return fmt.Errorf("unable to decode generated value %q: %w", name, err)
+		}
+		if bytes.Equal(stored.Parameters, paramsData) && (isValid == nil || isValid(stored.Value)) {
+			return json.Unmarshal(stored.Value, value)
+		}
+	}
+
+	generated, err := generate()
+	if err != nil {
+		return fmt.Errorf("unable to generate value %q: %w", name, err)
+	}
+	valueData, err := json.Marshal(generated)
+	if err != nil {
+		return err
+	}
+	data, err = json.Marshal(generatedState{Parameters: paramsData, Value: valueData})
+	if err != nil {
+		return err
+	}
+	if err := g.state.Store(ctx, generatedStatePrefix+name, data); err != nil {
+		return fmt.Errorf("unable to store generated value %q: %w", name, err)
+	}
+	return json.Unmarshal(valueData, value)
+}
+
+func generatePassword(length int64) (string, error) {
+	numCharacters := big.NewInt(int64(len(passwordCharacters)))
+	password := make([]byte, length)
+	for i := range password {
+		n, err := rand.Int(rand.Reader, numCharacters)
+		if err != nil {
+			return "", err
+		}
+		password[i] = passwordCharacters[n.Int64()]
+	}
+	return string(password), nil
+}
+
+// certificateValid returns a function that checks whether a stored certificate is still valid for
+// at least the rotateBefore duration.
+func certificateValid(rotateBefore time.Duration) func(value []byte) bool {
+	return func(value []byte) bool {
+		stored := struct {
+			NotAfter time.Time `json:"notAfter"`
+		}{}
+		if err := json.Unmarshal(value, &stored); err != nil {
+			return false
+		}
+		return time.Now().Add(rotateBefore).Before(stored.NotAfter)
+	}
+}
+
+func newCertificateTemplate(commonName string, validity time.Duration) (*x509.Certificate, error) {
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	return &x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    now.Add(-time.Minute).UTC(),
+		NotAfter:     now.Add(validity).UTC(),
+	}, nil
+}
+
+// createCertificate creates a new key and a certificate for the given template.
+// The certificate is self-signed if no parent is given.
+func createCertificate(template, parent *x509.Certificate, parentKey *rsa.PrivateKey) (map[string]interface{}, error) {
+	key, err := rsa.GenerateKey(rand.Reader, certificateKeyBits)
+	if err != nil {
+		return nil, err
+	}
+	if parent == nil {
+		parent = template
+		parentKey = key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		"certificate": string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		"privateKey":  encodePrivateKey(key),
+		"notAfter":    template.NotAfter.Format(time.RFC3339),
+	}, nil
+}
+
+func encodePrivateKey(key *rsa.PrivateKey) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}))
+}
+
+// parseCA parses the certificate and the private key of a CA that has been generated with the CA function.
+func parseCA(ca interface{}) (*x509.Certificate, *rsa.PrivateKey, error) {
+	data, err := json.Marshal(ca)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to marshal ca: %w", err)
+	}
+	caValues := struct {
+		Certificate string `json:"certificate"`
+		PrivateKey  string `json:"privateKey"`
+	}{}
+	if err := json.Unmarshal(data, &caValues); err != nil {
+		return nil, nil, fmt.Errorf("a ca must contain a certificate and a private key: %w", err)
+	}
+
+	certBlock, _ := pem.Decode([]byte(caValues.Certificate))
+	if certBlock == nil {
+		return nil, nil, fmt.Errorf("the certificate of the ca is not PEM encoded")
+	}
+	cert, err := x509.ParseCertificate(certBlock.Bytes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to parse the certificate of the ca: %w", err)
+	}
+
+	keyBlock, _ := pem.Decode([]byte(caValues.PrivateKey))
+	if keyBlock == nil {
+		return nil, nil, fmt.Errorf("the private key of the ca is not PEM encoded")
+	}
+	key, err := x509.ParsePKCS1PrivateKey(keyBlock.Bytes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to parse the private key of the ca: %w", err)
+	}
+	return cert, key, nil
+}
@@ -0,0 +1,125 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package template_test
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
+)
+
+var _ = Describe("Generator", func() {
+
+	const day = 24 * time.Hour
+
+	var (
+		ctx   context.Context
+		state template.MemoryStateHandler
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		state = template.NewMemoryStateHandler()
+	})
+
+	parseCertificate := func(cert map[string]interface{}) *x509.Certificate {
+		block, _ := pem.Decode([]byte(cert["certificate"].(string)))
+		Expect(block).ToNot(BeNil())
+		c, err := x509.ParseCertificate(block.Bytes)
+		Expect(err).ToNot(HaveOccurred())
+		return c
+	}
+
+	It("should return the same password on subsequent calls", func() {
+		password, err := template.NewGenerator(state).Password(ctx, "pw", 24)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(password).To(HaveLen(24))
+
+		password2, err := template.NewGenerator(state).Password(ctx, "pw", 24)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(password2).To(Equal(password))
+
+		other, err := template.NewGenerator(state).Password(ctx, "other", 24)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(other).ToNot(Equal(password))
+	})
+
+	It("should generate a new password if the parameters change", func() {
+		password, err := template.NewGenerator(state).Password(ctx, "pw", 24)
+		Expect(err).ToNot(HaveOccurred())
+
+		password2, err := template.NewGenerator(state).Password(ctx, "pw", 32)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(password2).To(HaveLen(32))
+		Expect(password2).ToNot(HavePrefix(password))
+	})
+
+	It("should return the same key pair on subsequent calls", func() {
+		keyPair, err := template.NewGenerator(state).KeyPair(ctx, "key", 2048)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(keyPair).To(HaveKey("privateKey"))
+		Expect(keyPair).To(HaveKey("publicKey"))
+
+		keyPair2, err := template.NewGenerator(state).KeyPair(ctx, "key", 2048)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(keyPair2).To(Equal(keyPair))
+	})
+
+	It("should generate a certificate signed by a generated ca", func() {
+		ca, err := template.NewGenerator(state).CA(ctx, "ca", "my-ca", 365*day, 30*day)
+		Expect(err).ToNot(HaveOccurred())
+		caCert := parseCertificate(ca)
+		Expect(caCert.IsCA).To(BeTrue())
+		Expect(caCert.Subject.CommonName).To(Equal("my-ca"))
+
+		cert, err := template.NewGenerator(state).Certificate(ctx, "cert", "my-server", []string{"example.com", "10.0.0.1"}, ca, 90*day, 10*day)
+		Expect(err).ToNot(HaveOccurred())
+		leafCert := parseCertificate(cert)
+		Expect(leafCert.DNSNames).To(ConsistOf("example.com"))
+		Expect(leafCert.IPAddresses).To(HaveLen(1))
+		Expect(leafCert.CheckSignatureFrom(caCert)).To(Succeed())
+
+		ca2, err := template.NewGenerator(state).CA(ctx, "ca", "my-ca", 365*day, 30*day)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ca2).To(Equal(ca))
+		cert2, err := template.NewGenerator(state).Certificate(ctx, "cert", "my-server", []string{"example.com", "10.0.0.1"}, ca2, 90*day, 10*day)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cert2).To(Equal(cert))
+	})
+
+	It("should rotate a certificate that expires within the rotation period", func() {
+		ca, err := template.NewGenerator(state).CA(ctx, "ca", "my-ca", 10*day, 20*day)
+		Expect(err).ToNot(HaveOccurred())
+
+		ca2, err := template.NewGenerator(state).CA(ctx, "ca", "my-ca", 10*day, 20*day)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ca2["certificate"]).ToNot(Equal(ca["certificate"]))
+	})
+
+	It("should generate a new certificate if the ca changes", func() {
+		ca, err := template.NewGenerator(state).CA(ctx, "ca", "my-ca", 365*day, 0)
+		Expect(err).ToNot(HaveOccurred())
+		cert, err := template.NewGenerator(state).Certificate(ctx, "cert", "my-server", nil, ca, 90*day, 0)
+		Expect(err).ToNot(HaveOccurred())
+
+		otherCA, err := template.NewGenerator(state).CA(ctx, "other-ca", "my-other-ca", 365*day, 0)
+		Expect(err).ToNot(HaveOccurred())
+		cert2, err := template.NewGenerator(state).Certificate(ctx, "cert", "my-server", nil, otherCA, 90*day, 0)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cert2["certificate"]).ToNot(Equal(cert["certificate"]))
+		Expect(parseCertificate(cert2).CheckSignatureFrom(parseCertificate(otherCA))).To(Succeed())
+	})
+
+	It("should fail without a state", func() {
+		_, err := template.NewGenerator(nil).Password(ctx, "pw", 24)
+		Expect(err).To(HaveOccurred())
+	})
+})
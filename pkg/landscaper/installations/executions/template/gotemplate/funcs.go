@@ -42,12 +42,36 @@ func LandscaperSprigFuncMap() gotmpl.FuncMap {
 	return gotmpl.FuncMap(fm)
 }
 
+// FuncMapOptions contains the inputs of the landscaper functions of a template execution.
+type FuncMapOptions struct {
+	// Blueprint is the blueprint whose templates are rendered.
+	Blueprint *blueprints.Blueprint
+	// ComponentVersion is the component version of the blueprint.
+	// +optional
+	ComponentVersion model.ComponentVersion
+	// ComponentVersions are the resolved component versions that are referenced by the component version.
+	// +optional
+	ComponentVersions *model.ComponentVersionList
+	// TargetResolver resolves the targets for the functions that access the clusters of targets.
+	// +optional
+	TargetResolver targetresolver.TargetResolver
+	// Lookups are the kinds of objects that can be read from target clusters with the lookup functions.
+	// +optional
+	Lookups []v1alpha1.TemplateLookupResource
+	// LookupNamespace is the namespace of the installation in which the targets of the lookup functions are resolved.
+	// +optional
+	LookupNamespace string
+	// State stores the values of the generate functions.
+	// +optional
+	State lstmpl.GenericStateHandler
+}
+
 // LandscaperTplFuncMap contains all additional landscaper functions that are
 // available in the executors templates.
-func LandscaperTplFuncMap(blueprint *blueprints.Blueprint,
-	componentVersion model.ComponentVersion,
-	componentVersions *model.ComponentVersionList,
-	targetResolver targetresolver.TargetResolver) (map[string]interface{}, error) {
+func LandscaperTplFuncMap(opts FuncMapOptions) (map[string]interface{}, error) {
+	blueprint := opts.Blueprint
+	componentVersion := opts.ComponentVersion
+	targetResolver := opts.TargetResolver
 
 	ocmSchemaVersion := common.DetermineOCMSchemaVersion(blueprint, componentVersion)
 
@@ -56,11 +80,15 @@ func LandscaperTplFuncMap(blueprint *blueprints.Blueprint,
 		return nil, fmt.Errorf("unable to get component descriptor to register go template functions: %w", err)
 	}
 
-	cdList, err := model.ConvertComponentVersionList(componentVersions)
+	cdList, err := model.ConvertComponentVersionList(opts.ComponentVersions)
 	if err != nil {
 		return nil, fmt.Errorf("unable to convert component descriptor list to register go template functions: %w", err)
 	}
 
+	// the lookup results are cached for the rendering of one template
+	targetLookup := lstmpl.NewTargetLookup(targetResolver, opts.LookupNamespace, opts.Lookups)
+	generator := lstmpl.NewGenerator(opts.State)
+
 	funcs := map[string]interface{}{
 		"readFile": readFileFunc(blueprint.Fs),
 		"readDir":  readDir(blueprint.Fs),
@@ -84,6 +112,14 @@ func LandscaperTplFuncMap(blueprint *blueprints.Blueprint,
 		"getServiceAccountKubeconfig":                        getServiceAccountKubeconfigGoFunc(targetResolver),
 		"getServiceAccountKubeconfigWithExpirationTimestamp": getServiceAccountKubeconfigWithExpirationTimestampGoFunc(targetResolver),
 		"getOidcKubeconfig":                                  getOidcKubeconfigGoFunc(targetResolver),
+
+		"lookup":     lookupGoFunc(targetLookup),
+		"lookupList": lookupListGoFunc(targetLookup),
+
+		"generatePassword":    generatePasswordGoFunc(generator),
+		"generateKeyPair":     generateKeyPairGoFunc(generator),
+		"generateCA":          generateCAGoFunc(generator),
+		"generateCertificate": generateCertificateGoFunc(generator),
 	}
 
 	return funcs, nil
@@ -529,6 +565,128 @@ func getOidcKubeconfigGoFunc(targetResolver targetresolver.TargetResolver) func(
 	}
 }
 
+// lookupGoFunc returns a function that reads an object from the cluster of a target.
+// An empty map is returned if the object does not exist.
+func lookupGoFunc(targetLookup *lstmpl.TargetLookup) func(target interface{}, apiVersion, kind, namespace, name string) (map[string]interface{}, error) {
+	return func(target interface{}, apiVersion, kind, namespace, name string) (map[string]interface{}, error) {
+		res, err := targetLookup.Lookup(context.Background(), target, apiVersion, kind, namespace, name)
+		if err != nil {
+			return nil, fmt.Errorf("templating function lookup: %w", err)
+		}
+		return res, nil
+	}
+}
+
+// lookupListGoFunc returns a function that lists objects from the cluster of a target.
+// The objects can optionally be filtered by a label selector.
+func lookupListGoFunc(targetLookup *lstmpl.TargetLookup) func(target interface{}, apiVersion, kind, namespace string, labelSelector ...string) ([]interface{}, error) {
+	return func(target interface{}, apiVersion, kind, namespace string, labelSelector ...string) ([]interface{}, error) {
+		if len(labelSelector) > 1 {
+			return nil, fmt.Errorf("templating function lookupList expects at most one label selector")
+		}
+		selector := ""
+		if len(labelSelector) == 1 {
+			selector = labelSelector[0]
+		}
+
+		res, err := targetLookup.LookupList(context.Background(), target, apiVersion, kind, namespace, selector)
+		if err != nil {
+			return nil, fmt.Errorf("templating function lookupList: %w", err)
+		}
+		return res, nil
+	}
+}
+
+// generatePasswordGoFunc returns a function that generates a password which is stored in the template state.
+func generatePasswordGoFunc(generator *lstmpl.Generator) func(name string, length interface{}) (string, error) {
+	return func(name string, length interface{}) (string, error) {
+		n, err := toInt64(length)
+		if err != nil {
+			return "", fmt.Errorf("templating function generatePassword expects an integer as 2nd argument, namely the length: %w", err)
+		}
+		return generator.Password(context.Background(), name, n)
+	}
+}
+
+// generateKeyPairGoFunc returns a function that generates a rsa key pair which is stored in the template state.
+func generateKeyPairGoFunc(generator *lstmpl.Generator) func(name string, bits interface{}) (map[string]interface{}, error) {
+	return func(name string, bits interface{}) (map[string]interface{}, error) {
+		n, err := toInt64(bits)
+		if err != nil {
+			return nil, fmt.Errorf("templating function generateKeyPair expects an integer as 2nd argument, namely the number of bits: %w", err)
+		}
+		return generator.KeyPair(context.Background(), name, n)
+	}
+}
+
+// generateCAGoFunc returns a function that generates a self-signed CA which is stored in the template state.
+func generateCAGoFunc(generator *lstmpl.Generator) func(name, commonName string, validityDays interface{}, rotateBeforeDays ...interface{}) (map[string]interface{}, error) {
+	return func(name, commonName string, validityDays interface{}, rotateBeforeDays ...interface{}) (map[string]interface{}, error) {
+		validity, rotateBefore, err := toValidity("generateCA", validityDays, rotateBeforeDays)
+		if err != nil {
+			return nil, err
+		}
+		return generator.CA(context.Background(), name, commonName, validity, rotateBefore)
+	}
+}
+
+// generateCertificateGoFunc returns a function that generates a certificate signed by a CA which is stored in the template state.
+func generateCertificateGoFunc(generator *lstmpl.Generator) func(name, commonName string, altNames interface{}, validityDays interface{}, ca interface{}, rotateBeforeDays ...interface{}) (map[string]interface{}, error) {
+	return func(name, commonName string, altNames interface{}, validityDays interface{}, ca interface{}, rotateBeforeDays ...interface{}) (map[string]interface{}, error) {
+		names, err := toStringList(altNames)
+		if err != nil {
+			return nil, fmt.Errorf("templating function generateCertificate expects a list of strings as 3rd argument, namely the alternative names: %w", err)
+		}
+		validity, rotateBefore, err := toValidity("generateCertificate", validityDays, rotateBeforeDays)
+		if err != nil {
+			return nil, err
+		}
+		return generator.Certificate(context.Background(), name, commonName, names, ca, validity, rotateBefore)
+	}
+}
+
+// toValidity converts the validity and the optional rotation period of a certificate from days into durations.
+func toValidity(funcName string, validityDays interface{}, rotateBeforeDays []interface{}) (time.Duration, time.Duration, error) {
+	const day = 24 * time.Hour
+
+	validity, err := toInt64(validityDays)
+	if err != nil {
+		return 0, 0, fmt.Errorf("templating function %s expects an integer as validity in days: %w", funcName, err)
+	}
+	if len(rotateBeforeDays) > 1 {
+		return 0, 0, fmt.Errorf("templating function %s expects at most one rotation period", funcName)
+	}
+	var rotateBefore int64
+	if len(rotateBeforeDays) == 1 {
+		rotateBefore, err = toInt64(rotateBeforeDays[0])
+		if err != nil {
+			return 0, 0, fmt.Errorf("templating function %s expects an integer as rotation period in days: %w", funcName, err)
+		}
+	}
+	return time.Duration(validity) * day, time.Duration(rotateBefore) * day, nil
+}
+
+func toStringList(value interface{}) ([]string, error) {
+	switch list := value.(type) {
+	case nil:
+		return []string{}, nil
+	case []string:
+		return list, nil
+	case []interface{}:
+		res := make([]string, len(list))
+		for i, item := range list {
+			str, ok := item.(string)
+			if !ok {
+				return nil, fmt.Errorf("unsupported list item of type %T", item)
+			}
+			res[i] = str
+		}
+		return res, nil
+	default:
+		return nil, fmt.Errorf("unsupported type %T", value)
+	}
+}
+
 func toInt64(value interface{}) (int64, error) {
 	switch n := value.(type) {
 	case int64:
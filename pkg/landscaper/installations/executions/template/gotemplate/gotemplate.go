@@ -28,9 +28,11 @@ const (
 
 // Templater is the go template implementation for landscaper templating.
 type Templater struct {
-	state          lstmpl.GenericStateHandler
-	inputFormatter *lstmpl.TemplateInputFormatter
-	targetResolver targetresolver.TargetResolver
+	state           lstmpl.GenericStateHandler
+	inputFormatter  *lstmpl.TemplateInputFormatter
+	targetResolver  targetresolver.TargetResolver
+	lookups         []lsv1alpha1.TemplateLookupResource
+	lookupNamespace string
 }
 
 // New creates a new go template execution templater.
@@ -48,25 +50,29 @@ func (t *Templater) WithInputFormatter(inputFormatter *lstmpl.TemplateInputForma
 	return t
 }
 
+// WithTemplateLookups sets the kinds of objects that can be read from target clusters with the lookup functions,
+// and the namespace of the installation in which the targets of the lookup functions are resolved.
+func (t *Templater) WithTemplateLookups(namespace string, lookups []lsv1alpha1.TemplateLookupResource) *Templater {
+	t.lookupNamespace = namespace
+	t.lookups = lookups
+	return t
+}
+
 type TemplateExecution struct {
 	funcMap       map[string]interface{}
 	blueprint     *blueprints.Blueprint
 	includedNames map[string]int
 }
 
-func NewTemplateExecution(blueprint *blueprints.Blueprint,
-	cd model.ComponentVersion,
-	cdList *model.ComponentVersionList,
-	targetResolver targetresolver.TargetResolver) (*TemplateExecution, error) {
-
-	funcs, err := LandscaperTplFuncMap(blueprint, cd, cdList, targetResolver)
+func NewTemplateExecution(opts FuncMapOptions) (*TemplateExecution, error) {
+	funcs, err := LandscaperTplFuncMap(opts)
 	if err != nil {
 		return nil, err
 	}
 
 	t := &TemplateExecution{
 		funcMap:       funcs,
-		blueprint:     blueprint,
+		blueprint:     opts.Blueprint,
 		includedNames: map[string]int{},
 	}
 	t.funcMap["include"] = t.include
@@ -108,6 +114,19 @@ func (te *TemplateExecution) Execute(template string, binding interface{}) ([]by
 	return data.Bytes(), nil
 }
 
+// funcMapOptions returns the options of the landscaper functions for a template execution of the templater.
+func (t *Templater) funcMapOptions(blueprint *blueprints.Blueprint, cd model.ComponentVersion, cdList *model.ComponentVersionList) FuncMapOptions {
+	return FuncMapOptions{
+		Blueprint:         blueprint,
+		ComponentVersion:  cd,
+		ComponentVersions: cdList,
+		TargetResolver:    t.targetResolver,
+		Lookups:           t.lookups,
+		LookupNamespace:   t.lookupNamespace,
+		State:             t.state,
+	}
+}
+
 // StateTemplateResult describes the result of go templating.
 type StateTemplateResult struct {
 	State json.RawMessage `json:"state"`
@@ -123,7 +142,7 @@ func (t *Templater) TemplateExecution(rawTemplate string,
 	cdList *model.ComponentVersionList,
 	values map[string]interface{}) ([]byte, error) {
 
-	te, err := NewTemplateExecution(blueprint, cd, cdList, t.targetResolver)
+	te, err := NewTemplateExecution(t.funcMapOptions(blueprint, cd, cdList))
 	if err != nil {
 		return nil, err
 	}
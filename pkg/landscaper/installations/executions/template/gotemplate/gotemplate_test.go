@@ -20,7 +20,7 @@ var _ = Describe("TemplateDeployExecutions", func() {
 		fs := memoryfs.New()
 		bp := blueprints.New(nil, fs)
 		tmpl := "{{ .values.test }}"
-		t, err := gotemplate.NewTemplateExecution(bp, nil, nil, nil)
+		t, err := gotemplate.NewTemplateExecution(gotemplate.FuncMapOptions{Blueprint: bp})
 		Expect(err).ToNot(HaveOccurred())
 		values := map[string]interface{}{
 			"values": map[string]interface{}{
@@ -37,7 +37,7 @@ var _ = Describe("TemplateDeployExecutions", func() {
 		Expect(vfs.WriteFile(fs, "template.include", []byte("{{ .values.test }}"), 0600)).To(Succeed())
 		bp := blueprints.New(nil, fs)
 		tmpl := `{{ include "template.include" . }}`
-		t, err := gotemplate.NewTemplateExecution(bp, nil, nil, nil)
+		t, err := gotemplate.NewTemplateExecution(gotemplate.FuncMapOptions{Blueprint: bp})
 		Expect(err).ToNot(HaveOccurred())
 		values := map[string]interface{}{
 			"values": map[string]interface{}{
@@ -55,7 +55,7 @@ var _ = Describe("TemplateDeployExecutions", func() {
 		bp := blueprints.New(nil, fs)
 		tmpl := `config:
 {{ include "template.include" . | indent 2 }}`
-		t, err := gotemplate.NewTemplateExecution(bp, nil, nil, nil)
+		t, err := gotemplate.NewTemplateExecution(gotemplate.FuncMapOptions{Blueprint: bp})
 		Expect(err).ToNot(HaveOccurred())
 		values := map[string]interface{}{
 			"values": map[string]interface{}{
@@ -70,7 +70,7 @@ var _ = Describe("TemplateDeployExecutions", func() {
 	It("should render a go template with a fromYaml function", func() {
 		bp := blueprints.New(nil, memoryfs.New())
 		tmpl := `{{ $yamlData := fromYaml .values.yamlString }}{{ $yamlData.foo }}`
-		t, err := gotemplate.NewTemplateExecution(bp, nil, nil, nil)
+		t, err := gotemplate.NewTemplateExecution(gotemplate.FuncMapOptions{Blueprint: bp})
 		Expect(err).ToNot(HaveOccurred())
 		values := map[string]interface{}{
 			"values": map[string]interface{}{
@@ -44,6 +44,11 @@ func (e *NoValueError) Error() string {
 	return e.message
 }
 
+// Diagnose adds the rendered output, which contains the fields with "no value", to the diagnostics.
+func (e *NoValueError) Diagnose(d *template.ExecutionDiagnostics) {
+	d.Output = e.templateResult
+}
+
 // buildErrorMessage creates the error message for this error.
 func (e *NoValueError) buildErrorMessage() {
 	var (
@@ -14,6 +14,7 @@ import (
 
 var (
 	errorLineColumnRegexp = regexp.MustCompile("(?m):([0-9]+)(:([0-9]+))?:")
+	errorExpressionRegexp = regexp.MustCompile(`(?m) at <(.+?)>: `)
 )
 
 // TemplateError wraps a go templating error and adds more human-readable information.
@@ -70,27 +71,52 @@ func (e *TemplateError) Error() string {
 	return e.message
 }
 
+// Unwrap returns the wrapped error.
+func (e *TemplateError) Unwrap() error {
+	return e.err
+}
+
+// Diagnose adds the position in the template source and the expression at which the template failed to the diagnostics.
+func (e *TemplateError) Diagnose(d *template.ExecutionDiagnostics) {
+	if errorLine, errorColumn, ok := e.position(); ok {
+		d.Line = errorLine
+		d.Column = errorColumn
+	}
+	if m := errorExpressionRegexp.FindStringSubmatch(e.err.Error()); m != nil {
+		d.Expression = m[1]
+	}
+}
+
 // formatSource extracts the significant template source code that was the reason of the template error.
 func (e *TemplateError) formatSource() string {
+	errorLine, errorColumn, ok := e.position()
+	if !ok {
+		return ""
+	}
+
+	formatted := strings.Builder{}
+	formatted.WriteString(CreateSourceSnippet(errorLine, errorColumn, strings.Split(*e.source, "\n")))
+	return formatted.String()
+}
+
+// position parses the line and the column of the template error.
+func (e *TemplateError) position() (int, int, bool) {
 	var (
 		err                    error
 		errorLine, errorColumn int
 	)
 
-	errStr := e.err.Error()
-	formatted := strings.Builder{}
-
 	// parse error line and column
-	m := errorLineColumnRegexp.FindStringSubmatch(errStr)
+	m := errorLineColumnRegexp.FindStringSubmatch(e.err.Error())
 	if m == nil {
-		return ""
+		return 0, 0, false
 	}
 
 	if len(m) >= 2 {
 		// error line
 		errorLine, err = strconv.Atoi(m[1])
 		if err != nil {
-			return ""
+			return 0, 0, false
 		}
 	}
 	if len(m) >= 4 {
@@ -100,7 +126,5 @@ func (e *TemplateError) formatSource() string {
 			errorColumn = 0
 		}
 	}
-
-	formatted.WriteString(CreateSourceSnippet(errorLine, errorColumn, strings.Split(*e.source, "\n")))
-	return formatted.String()
+	return errorLine, errorColumn, true
 }
@@ -60,18 +60,7 @@ func (f *TemplateInputFormatter) Format(input map[string]interface{}, prefix str
 	)
 
 	for k, v := range input {
-		// If the current key is contained in the list of sensitive keys, all values in each sub-tree will be removed.
-		if f.sensitiveKeys.Has(k) {
-			source, ok := v.(map[string]interface{})
-			if ok {
-				// The map is deep copied so that the original input value is not getting modified.
-				v, err = deepCopyMap(source)
-				if err != nil {
-					v = ""
-				}
-			}
-			v = removeValue(v, 1)
-		}
+		v = f.redact(k, v)
 
 		if f.prettyPrint {
 			marshaled, err = json.MarshalIndent(v, prefix, "  ")
@@ -94,6 +83,39 @@ func (f *TemplateInputFormatter) Format(input map[string]interface{}, prefix str
 	return formatted.String()
 }
 
+// Redact returns a copy of the template input in which the values of the sensitive keys are removed.
+// The values of all other keys are not copied.
+func (f *TemplateInputFormatter) Redact(input map[string]interface{}) map[string]interface{} {
+	if input == nil {
+		return nil
+	}
+
+	redacted := make(map[string]interface{}, len(input))
+	for k, v := range input {
+		redacted[k] = f.redact(k, v)
+	}
+	return redacted
+}
+
+// redact removes all values in each sub-tree of the value, if the key is contained in the list of sensitive keys.
+func (f *TemplateInputFormatter) redact(key string, val interface{}) interface{} {
+	if !f.sensitiveKeys.Has(key) {
+		return val
+	}
+
+	source, ok := val.(map[string]interface{})
+	if ok {
+		// The map is deep copied so that the original input value is not getting modified.
+		copied, err := deepCopyMap(source)
+		if err != nil {
+			val = ""
+		} else {
+			val = copied
+		}
+	}
+	return removeValue(val, 1)
+}
+
 // removeValue removes the value of the input parameter.
 // If the input is a map, all leaf values are removed until a certain depth.
 // When the maximum depth is reached, the current leaf of the map will be truncated.
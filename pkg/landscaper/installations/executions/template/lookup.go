@@ -0,0 +1,171 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package template
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/landscaper/targetresolver"
+	"github.com/gardener/landscaper/pkg/utils/clusters"
+)
+
+// ReaderFunc returns a read-only client for the cluster of a target.
+type ReaderFunc func(ctx context.Context, target *lsv1alpha1.Target) (client.Reader, error)
+
+// TargetLookup reads objects from the clusters of targets for the lookup template functions.
+// Only the kinds of the allowlist can be read. The clients and the results are cached,
+// so that a TargetLookup must only be used for one rendering of a template.
+// The targets are built by the templates, so they are only resolved in the namespace of the installation.
+type TargetLookup struct {
+	namespace string
+	allowed   []lsv1alpha1.TemplateLookupResource
+	newReader ReaderFunc
+
+	readers map[string]client.Reader
+	cache   map[string]interface{}
+}
+
+// NewTargetLookup creates a new TargetLookup that reads the objects of the allowed kinds from the cluster of a
+// resolved kubernetes cluster target. The targets are resolved in the given namespace of the installation.
+func NewTargetLookup(targetResolver targetresolver.TargetResolver, namespace string, allowed []lsv1alpha1.TemplateLookupResource) *TargetLookup {
+	return NewTargetLookupWithReader(func(ctx context.Context, target *lsv1alpha1.Target) (client.Reader, error) {
+		return clusters.NewReaderFromTarget(ctx, target, targetResolver)
+	}, namespace, allowed)
+}
+
+// NewTargetLookupWithReader creates a new TargetLookup that uses the given function to get the client for a target.
+func NewTargetLookupWithReader(newReader ReaderFunc, namespace string, allowed []lsv1alpha1.TemplateLookupResource) *TargetLookup {
+	return &TargetLookup{
+		namespace: namespace,
+		allowed:   allowed,
+		newReader: newReader,
+		readers:   map[string]client.Reader{},
+		cache:     map[string]interface{}{},
+	}
+}
+
+// Lookup returns the object with the given kind, namespace and name from the cluster of the target.
+// An empty map is returned if the object does not exist.
+func (l *TargetLookup) Lookup(ctx context.Context, target interface{}, apiVersion, kind, namespace, name string) (map[string]interface{}, error) {
+	if err := l.checkAllowed(apiVersion, kind); err != nil {
+		return nil, err
+	}
+
+	reader, targetKey, err := l.getReader(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+
+	cacheKey := strings.Join([]string{targetKey, "object", apiVersion, kind, namespace, name}, "/")
+	if res, ok := l.cache[cacheKey]; ok {
+		return res.(map[string]interface{}), nil
+	}
+
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind(kind)
+	if err := reader.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, obj); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("unable to get %s %q: %w", kind, client.ObjectKey{Namespace: namespace, Name: name}.String(), err)
+		}
+		obj.Object = map[string]interface{}{}
+	}
+
+	l.cache[cacheKey] = obj.Object
+	return obj.Object, nil
+}
+
+// LookupList returns the objects with the given kind from a namespace of the cluster of the target.
+// The objects of all namespaces are returned if the namespace is empty. The objects can optionally be
+// filtered by a label selector.
+func (l *TargetLookup) LookupList(ctx context.Context, target interface{}, apiVersion, kind, namespace, labelSelector string) ([]interface{}, error) {
+	if err := l.checkAllowed(apiVersion, kind); err != nil {
+		return nil, err
+	}
+
+	selector, err := labels.Parse(labelSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid label selector %q: %w", labelSelector, err)
+	}
+
+	reader, targetKey, err := l.getReader(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+
+	cacheKey := strings.Join([]string{targetKey, "list", apiVersion, kind, namespace, labelSelector}, "/")
+	if res, ok := l.cache[cacheKey]; ok {
+		return res.([]interface{}), nil
+	}
+
+	list := &unstructured.UnstructuredList{}
+	list.SetAPIVersion(apiVersion)
+	list.SetKind(kind + "List")
+	if err := reader.List(ctx, list, client.InNamespace(namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, fmt.Errorf("unable to list %s in namespace %q: %w", kind, namespace, err)
+	}
+
+	items := make([]interface{}, len(list.Items))
+	for i := range list.Items {
+		items[i] = list.Items[i].Object
+	}
+
+	l.cache[cacheKey] = items
+	return items, nil
+}
+
+func (l *TargetLookup) checkAllowed(apiVersion, kind string) error {
+	if len(l.allowed) == 0 {
+		return fmt.Errorf("lookup functions are disabled, because the context of the installation allows no kinds in its templateLookups")
+	}
+	for _, res := range l.allowed {
+		if res.APIVersion == apiVersion && res.Kind == kind {
+			return nil
+		}
+	}
+	return fmt.Errorf("lookup of kind %q with api version %q is not allowed by the templateLookups of the context", kind, apiVersion)
+}
+
+// getReader returns the client for the cluster of a target together with a key that identifies the target.
+func (l *TargetLookup) getReader(ctx context.Context, targetObj interface{}) (client.Reader, string, error) {
+	targetBytes, err := json.Marshal(targetObj)
+	if err != nil {
+		return nil, "", fmt.Errorf("expected a target object: error during marshaling: %w", err)
+	}
+
+	targetKey := string(targetBytes)
+	if reader, ok := l.readers[targetKey]; ok {
+		return reader, targetKey, nil
+	}
+
+	target := &lsv1alpha1.Target{}
+	if err := json.Unmarshal(targetBytes, target); err != nil {
+		return nil, "", fmt.Errorf("expected a target object: error during unmarshaling: %w", err)
+	}
+	// the secret references of a target are resolved in its namespace,
+	// so a target of another namespace could reference the kubeconfigs of other tenants.
+	if len(target.Namespace) == 0 {
+		target.Namespace = l.namespace
+	}
+	if target.Namespace != l.namespace {
+		return nil, "", fmt.Errorf("target %q must be in the namespace %q of the installation", target.Name, l.namespace)
+	}
+
+	reader, err := l.newReader(ctx, target)
+	if err != nil {
+		return nil, "", err
+	}
+	l.readers[targetKey] = reader
+	return reader, targetKey, nil
+}
@@ -0,0 +1,153 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package template_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
+)
+
+// countingReader counts the requests to the cluster to verify the caching of the lookups.
+type countingReader struct {
+	client.Reader
+	requests int
+}
+
+func (r *countingReader) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	r.requests++
+	return r.Reader.Get(ctx, key, obj, opts...)
+}
+
+func (r *countingReader) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	r.requests++
+	return r.Reader.List(ctx, list, opts...)
+}
+
+var _ = Describe("TargetLookup", func() {
+
+	var (
+		ctx     context.Context
+		reader  *countingReader
+		target  map[string]interface{}
+		allowed []lsv1alpha1.TemplateLookupResource
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		reader = &countingReader{
+			Reader: fake.NewClientBuilder().WithObjects(
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: "cm-a", Namespace: "default", Labels: map[string]string{"app": "a"}},
+					Data:       map[string]string{"key": "val-a"},
+				},
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: "cm-b", Namespace: "default", Labels: map[string]string{"app": "b"}},
+					Data:       map[string]string{"key": "val-b"},
+				},
+			).Build(),
+		}
+		target = map[string]interface{}{
+			"metadata": map[string]interface{}{"name": "my-cluster", "namespace": "default"},
+			"spec":     map[string]interface{}{"type": "landscaper.gardener.cloud/kubernetes-cluster"},
+		}
+		allowed = []lsv1alpha1.TemplateLookupResource{{APIVersion: "v1", Kind: "ConfigMap"}}
+	})
+
+	newLookup := func(allowed []lsv1alpha1.TemplateLookupResource) *template.TargetLookup {
+		return template.NewTargetLookupWithReader(func(_ context.Context, target *lsv1alpha1.Target) (client.Reader, error) {
+			Expect(target.Name).To(Equal("my-cluster"))
+			return reader, nil
+		}, "default", allowed)
+	}
+
+	It("should read an object of an allowed kind", func() {
+		res, err := newLookup(allowed).Lookup(ctx, target, "v1", "ConfigMap", "default", "cm-a")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res).To(HaveKeyWithValue("data", map[string]interface{}{"key": "val-a"}))
+	})
+
+	It("should return an empty map if the object does not exist", func() {
+		res, err := newLookup(allowed).Lookup(ctx, target, "v1", "ConfigMap", "default", "missing")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res).To(BeEmpty())
+	})
+
+	It("should list the objects matching a label selector", func() {
+		res, err := newLookup(allowed).LookupList(ctx, target, "v1", "ConfigMap", "default", "app=b")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res).To(HaveLen(1))
+		Expect(res[0]).To(HaveKeyWithValue("data", map[string]interface{}{"key": "val-b"}))
+
+		res, err = newLookup(allowed).LookupList(ctx, target, "v1", "ConfigMap", "default", "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res).To(HaveLen(2))
+	})
+
+	It("should cache the results", func() {
+		lookup := newLookup(allowed)
+		for i := 0; i < 3; i++ {
+			_, err := lookup.Lookup(ctx, target, "v1", "ConfigMap", "default", "cm-a")
+			Expect(err).ToNot(HaveOccurred())
+			_, err = lookup.LookupList(ctx, target, "v1", "ConfigMap", "default", "app=a")
+			Expect(err).ToNot(HaveOccurred())
+		}
+		Expect(reader.requests).To(Equal(2))
+	})
+
+	It("should resolve targets without a namespace in the namespace of the installation", func() {
+		var resolved *lsv1alpha1.Target
+		lookup := template.NewTargetLookupWithReader(func(_ context.Context, target *lsv1alpha1.Target) (client.Reader, error) {
+			resolved = target
+			return reader, nil
+		}, "default", allowed)
+
+		target["metadata"] = map[string]interface{}{"name": "my-cluster"}
+		_, err := lookup.Lookup(ctx, target, "v1", "ConfigMap", "default", "cm-a")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resolved.Namespace).To(Equal("default"))
+	})
+
+	It("should reject targets of other namespaces", func() {
+		target["metadata"] = map[string]interface{}{"name": "my-cluster", "namespace": "other-tenant"}
+		target["spec"] = map[string]interface{}{
+			"type":      "landscaper.gardener.cloud/kubernetes-cluster",
+			"secretRef": map[string]interface{}{"name": "other-kubeconfig", "key": "kubeconfig"},
+		}
+
+		_, err := newLookup(allowed).Lookup(ctx, target, "v1", "ConfigMap", "default", "cm-a")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(`must be in the namespace "default" of the installation`))
+
+		_, err = newLookup(allowed).LookupList(ctx, target, "v1", "ConfigMap", "default", "")
+		Expect(err).To(HaveOccurred())
+		Expect(reader.requests).To(Equal(0))
+	})
+
+	It("should reject kinds that are not allowed", func() {
+		_, err := newLookup(allowed).Lookup(ctx, target, "v1", "Secret", "default", "cm-a")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("not allowed"))
+
+		_, err = newLookup(allowed).LookupList(ctx, target, "apps/v1", "ConfigMap", "default", "")
+		Expect(err).To(HaveOccurred())
+		Expect(reader.requests).To(Equal(0))
+	})
+
+	It("should reject all lookups if no kind is allowed", func() {
+		_, err := newLookup(nil).Lookup(ctx, target, "v1", "ConfigMap", "default", "cm-a")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("disabled"))
+		Expect(reader.requests).To(Equal(0))
+	})
+})
@@ -0,0 +1,318 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/landscaper/apis/config"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/components/model"
+	lstmpl "github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
+	"github.com/gardener/landscaper/pkg/utils/blueprints"
+)
+
+const (
+	// DefaultTimeout is the default timeout of a template execution of a plugin.
+	DefaultTimeout = 30 * time.Second
+	// MaxOutputSize is the maximal size in bytes of the output of a template execution of a plugin.
+	MaxOutputSize = 16 << 20
+	// maxErrorOutputSize is the maximal size in bytes of the error output of a plugin that is added to error messages.
+	maxErrorOutputSize = 64 << 10
+)
+
+// Templater executes templates of a custom template type by calling an out-of-process plugin.
+type Templater struct {
+	config         config.TemplatePluginConfiguration
+	state          lstmpl.GenericStateHandler
+	inputFormatter *lstmpl.TemplateInputFormatter
+}
+
+// New creates a new templater for a template plugin.
+func New(pluginConfig config.TemplatePluginConfiguration, state lstmpl.GenericStateHandler) *Templater {
+	return &Templater{
+		config:         pluginConfig,
+		state:          state,
+		inputFormatter: lstmpl.NewTemplateInputFormatter(false, "imports", "targets", "values", "state"),
+	}
+}
+
+// WithInputFormatter ads a custom input formatter to this templater used for error messages.
+func (t *Templater) WithInputFormatter(inputFormatter *lstmpl.TemplateInputFormatter) *Templater {
+	t.inputFormatter = inputFormatter
+	return t
+}
+
+func (t *Templater) Type() lsv1alpha1.TemplateType {
+	return lsv1alpha1.TemplateType(t.config.Type)
+}
+
+// StateTemplateResult describes the state in the output of a template plugin.
+type StateTemplateResult struct {
+	State json.RawMessage `json:"state"`
+}
+
+// TemplateImportExecutions calls the plugin for an import execution.
+func (t *Templater) TemplateImportExecutions(tmplExec lsv1alpha1.TemplateExecutor,
+	blueprint *blueprints.Blueprint,
+	_ model.ComponentVersion,
+	_ *model.ComponentVersionList,
+	values map[string]interface{}) (*lstmpl.ImportExecutorOutput, error) {
+
+	data, err := t.execute(context.Background(), PurposeImport, tmplExec, blueprint, values)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &lstmpl.ImportExecutorOutput{}
+	if err := yaml.Unmarshal(data, output); err != nil {
+		return nil, fmt.Errorf("error while decoding templated execution: %w", err)
+	}
+	return output, nil
+}
+
+// TemplateSubinstallationExecutions calls the plugin for a subinstallation execution.
+func (t *Templater) TemplateSubinstallationExecutions(tmplExec lsv1alpha1.TemplateExecutor,
+	blueprint *blueprints.Blueprint,
+	_ model.ComponentVersion,
+	_ *model.ComponentVersionList,
+	values map[string]interface{}) (*lstmpl.SubinstallationExecutorOutput, error) {
+
+	ctx := context.Background()
+	state, err := t.getState(ctx, "deploy", tmplExec)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load state: %w", err)
+	}
+	values["state"] = state
+
+	data, err := t.execute(ctx, PurposeSubinstallation, tmplExec, blueprint, values)
+	if err != nil {
+		return nil, err
+	}
+	if err := t.storeState(ctx, "deploy", tmplExec, data); err != nil {
+		return nil, fmt.Errorf("unable to store state: %w", err)
+	}
+
+	output := &lstmpl.SubinstallationExecutorOutput{}
+	if err := yaml.Unmarshal(data, output); err != nil {
+		return nil, fmt.Errorf("error while decoding templated execution: %w", err)
+	}
+	return output, nil
+}
+
+// TemplateDeployExecutions calls the plugin for a deploy execution.
+func (t *Templater) TemplateDeployExecutions(tmplExec lsv1alpha1.TemplateExecutor,
+	blueprint *blueprints.Blueprint,
+	_ model.ComponentVersion,
+	_ *model.ComponentVersionList,
+	values map[string]interface{}) (*lstmpl.DeployExecutorOutput, error) {
+
+	ctx := context.Background()
+	state, err := t.getState(ctx, "deploy", tmplExec)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load state: %w", err)
+	}
+	values["state"] = state
+
+	data, err := t.execute(ctx, PurposeDeploy, tmplExec, blueprint, values)
+	if err != nil {
+		return nil, err
+	}
+	if err := t.storeState(ctx, "deploy", tmplExec, data); err != nil {
+		return nil, fmt.Errorf("unable to store state: %w", err)
+	}
+
+	output := &lstmpl.DeployExecutorOutput{}
+	if err := yaml.Unmarshal(data, output); err != nil {
+		return nil, fmt.Errorf("error while decoding templated execution: %w", err)
+	}
+	return output, nil
+}
+
+// TemplateExportExecutions calls the plugin for an export execution.
+func (t *Templater) TemplateExportExecutions(tmplExec lsv1alpha1.TemplateExecutor,
+	blueprint *blueprints.Blueprint,
+	_ model.ComponentVersion,
+	_ *model.ComponentVersionList,
+	values map[string]interface{}) (*lstmpl.ExportExecutorOutput, error) {
+
+	ctx := context.Background()
+	state, err := t.getState(ctx, "export", tmplExec)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load state: %w", err)
+	}
+	values["state"] = state
+
+	data, err := t.execute(ctx, PurposeExport, tmplExec, blueprint, values)
+	if err != nil {
+		return nil, err
+	}
+	if err := t.storeState(ctx, "export", tmplExec, data); err != nil {
+		return nil, fmt.Errorf("unable to store state: %w", err)
+	}
+
+	output := &lstmpl.ExportExecutorOutput{}
+	if err := yaml.Unmarshal(data, output); err != nil {
+		return nil, fmt.Errorf("error while decoding templated execution: %w", err)
+	}
+	return output, nil
+}
+
+// execute calls the plugin executable with the request on its standard input and returns its standard output.
+func (t *Templater) execute(ctx context.Context,
+	purpose Purpose,
+	tmplExec lsv1alpha1.TemplateExecutor,
+	blueprint *blueprints.Blueprint,
+	values map[string]interface{}) ([]byte, error) {
+
+	rawTemplate, err := getTemplateFromExecution(tmplExec, blueprint)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := json.Marshal(Request{
+		Purpose:  purpose,
+		Name:     tmplExec.Name,
+		Type:     tmplExec.Type,
+		Template: rawTemplate,
+		Input:    values,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal request for template plugin %q: %w", t.config.Type, err)
+	}
+
+	timeout := DefaultTimeout
+	if t.config.Timeout != nil && t.config.Timeout.Duration != 0 {
+		timeout = t.config.Timeout.Duration
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// the output is limited, so that a plugin cannot exhaust the memory of the landscaper
+	stdout := &limitedBuffer{limit: MaxOutputSize}
+	stderr := &limitedBuffer{limit: maxErrorOutputSize, truncate: true}
+	cmd := exec.CommandContext(ctx, t.config.Command, t.config.Args...)
+	cmd.Stdin = bytes.NewReader(request)
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	// do not wait for child processes of the plugin that still hold the output streams after it was killed
+	cmd.WaitDelay = time.Second
+
+	if err := cmd.Run(); err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			err = fmt.Errorf("template plugin %q timed out after %s", t.config.Type, timeout)
+		} else if stdout.exceeded {
+			err = fmt.Errorf("template plugin %q failed: output exceeds the maximal size of %d bytes", t.config.Type, MaxOutputSize)
+		} else if msg := strings.TrimSpace(stderr.String()); len(msg) != 0 {
+			err = fmt.Errorf("template plugin %q failed: %s", t.config.Type, msg)
+		} else {
+			err = fmt.Errorf("template plugin %q failed: %w", t.config.Type, err)
+		}
+		return nil, t.withInput(err, values)
+	}
+	return stdout.Bytes(), nil
+}
+
+// limitedBuffer is a buffer that accepts at most limit bytes.
+// Further writes fail, which stops the copying of the output and closes the output stream of the plugin.
+// If truncate is set, further writes are discarded instead.
+type limitedBuffer struct {
+	// buf is not embedded, as io.Copy would otherwise bypass the limit by using bytes.Buffer.ReadFrom.
+	buf      bytes.Buffer
+	limit    int
+	truncate bool
+	// exceeded is set if more than limit bytes have been written.
+	exceeded bool
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if b.buf.Len()+len(p) <= b.limit {
+		return b.buf.Write(p)
+	}
+	b.exceeded = true
+	if !b.truncate {
+		return 0, fmt.Errorf("output exceeds the maximal size of %d bytes", b.limit)
+	}
+	if remaining := b.limit - b.buf.Len(); remaining > 0 {
+		b.buf.Write(p[:remaining])
+	}
+	return len(p), nil
+}
+
+// Bytes returns the content of the buffer.
+func (b *limitedBuffer) Bytes() []byte {
+	return b.buf.Bytes()
+}
+
+// String returns the content of the buffer as string.
+func (b *limitedBuffer) String() string {
+	return b.buf.String()
+}
+
+// withInput adds the formatted template input to an error message.
+func (t *Templater) withInput(err error, values map[string]interface{}) error {
+	if t.inputFormatter == nil {
+		return err
+	}
+	return fmt.Errorf("%w\ntemplate input:\n%s", err, t.inputFormatter.Format(values, "\t"))
+}
+
+func (t *Templater) getState(ctx context.Context, prefix string, tmplExec lsv1alpha1.TemplateExecutor) (interface{}, error) {
+	if t.state == nil {
+		return map[string]interface{}{}, nil
+	}
+	data, err := t.state.Get(ctx, prefix+tmplExec.Name)
+	if err != nil {
+		if err == lstmpl.StateNotFoundErr {
+			return map[string]interface{}{}, nil
+		}
+		return nil, err
+	}
+
+	var state interface{}
+	if err := yaml.Unmarshal(data, &state); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+func (t *Templater) storeState(ctx context.Context, prefix string, tmplExec lsv1alpha1.TemplateExecutor, data []byte) error {
+	if t.state == nil {
+		return nil
+	}
+	res := &StateTemplateResult{}
+	if err := yaml.Unmarshal(data, res); err != nil {
+		return err
+	}
+	if len(res.State) == 0 || string(res.State) == "null" {
+		return nil
+	}
+	return t.state.Store(ctx, prefix+tmplExec.Name, res.State)
+}
+
+// getTemplateFromExecution returns the template of a template executor as json.
+func getTemplateFromExecution(tmplExec lsv1alpha1.TemplateExecutor, blueprint *blueprints.Blueprint) (json.RawMessage, error) {
+	if len(tmplExec.Template.RawMessage) != 0 {
+		return tmplExec.Template.RawMessage, nil
+	}
+	if len(tmplExec.File) != 0 {
+		rawTemplateBytes, err := vfs.ReadFile(blueprint.Fs, tmplExec.File)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(string(rawTemplateBytes))
+	}
+	return nil, fmt.Errorf("no template found")
+}
@@ -0,0 +1,179 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package plugin_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/landscaper/apis/config"
+	"github.com/gardener/landscaper/apis/core"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/gotemplate"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/plugin"
+	"github.com/gardener/landscaper/pkg/utils/blueprints"
+)
+
+// pluginScript writes the request to the file given as first argument and returns a static deploy item with a state.
+const pluginScript = `cat > "$0"
+cat <<EOF
+state:
+  counter: 1
+deployItems:
+- name: init
+  type: container
+  config:
+    apiVersion: example.test/v1
+    kind: Configuration
+EOF`
+
+var _ = Describe("Template Plugins", func() {
+
+	var (
+		ctx            context.Context
+		stateHandler   template.GenericStateHandler
+		inputFormatter *template.TemplateInputFormatter
+
+		newBlueprint = func(tmpl string) *blueprints.Blueprint {
+			blue := &lsv1alpha1.Blueprint{}
+			blue.DeployExecutions = []lsv1alpha1.TemplateExecutor{
+				{
+					Name:     "one",
+					Type:     "MyTemplate",
+					Template: lsv1alpha1.NewAnyJSON([]byte(tmpl)),
+				},
+			}
+			return &blueprints.Blueprint{Info: blue}
+		}
+
+		executeTemplate = func(pluginConfig config.TemplatePluginConfiguration, blueprint *blueprints.Blueprint, imports map[string]interface{}) ([]template.DeployItemSpecification, error) {
+			plugins := []config.TemplatePluginConfiguration{pluginConfig}
+			Expect(plugin.Validate(plugins)).To(Succeed())
+			op := template.New(gotemplate.New(stateHandler, nil)).WithTemplaters(plugin.NewTemplaters(plugins, stateHandler, inputFormatter)...)
+			return op.TemplateDeployExecutions(template.NewDeployExecutionOptions(
+				template.NewBlueprintExecutionOptions(nil, blueprint, nil, nil, imports)))
+		}
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		stateHandler = template.NewMemoryStateHandler()
+		inputFormatter = nil
+	})
+
+	Context("Validate", func() {
+		It("should not allow to overwrite a built-in template type", func() {
+			Expect(plugin.Validate([]config.TemplatePluginConfiguration{
+				{Type: core.GOTemplateType, Command: "my-plugin"},
+			})).ToNot(Succeed())
+		})
+
+		It("should not allow duplicate template types", func() {
+			Expect(plugin.Validate([]config.TemplatePluginConfiguration{
+				{Type: "MyTemplate", Command: "my-plugin"},
+				{Type: "MyTemplate", Command: "my-other-plugin"},
+			})).ToNot(Succeed())
+		})
+
+		It("should require a command", func() {
+			Expect(plugin.Validate([]config.TemplatePluginConfiguration{
+				{Type: "MyTemplate"},
+			})).ToNot(Succeed())
+		})
+	})
+
+	Context("TemplateDeployExecutions", func() {
+		It("should pass the request to the plugin and return its output", func() {
+			requestFile := filepath.Join(GinkgoT().TempDir(), "request.json")
+			pluginConfig := config.TemplatePluginConfiguration{
+				Type:    "MyTemplate",
+				Command: "sh",
+				Args:    []string{"-c", pluginScript, requestFile},
+			}
+
+			res, err := executeTemplate(pluginConfig, newBlueprint(`"my template"`), map[string]interface{}{"version": "0.0.1"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(HaveLen(1))
+			Expect(res[0].Name).To(Equal("init"))
+			Expect(res[0].Type).To(Equal(core.DeployItemType("container")))
+
+			data, err := os.ReadFile(requestFile)
+			Expect(err).ToNot(HaveOccurred())
+			request := &plugin.Request{}
+			Expect(json.Unmarshal(data, request)).To(Succeed())
+			Expect(request.Purpose).To(Equal(plugin.PurposeDeploy))
+			Expect(request.Name).To(Equal("one"))
+			Expect(request.Type).To(Equal(lsv1alpha1.TemplateType("MyTemplate")))
+			Expect(string(request.Template)).To(Equal(`"my template"`))
+			Expect(request.Input).To(HaveKeyWithValue("imports", map[string]interface{}{"version": "0.0.1"}))
+			Expect(request.Input).To(HaveKeyWithValue("state", map[string]interface{}{}))
+
+			state, err := stateHandler.Get(ctx, "deployone")
+			Expect(err).ToNot(HaveOccurred())
+			stateValue := map[string]interface{}{}
+			Expect(yaml.Unmarshal(state, &stateValue)).To(Succeed())
+			Expect(stateValue).To(HaveKeyWithValue("counter", BeNumerically("==", 1)))
+		})
+
+		It("should return the error output of a failed plugin", func() {
+			pluginConfig := config.TemplatePluginConfiguration{
+				Type:    "MyTemplate",
+				Command: "sh",
+				Args:    []string{"-c", `echo "invalid template" >&2; exit 1`},
+			}
+
+			_, err := executeTemplate(pluginConfig, newBlueprint(`"my template"`), nil)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("invalid template"))
+			Expect(err.Error()).To(ContainSubstring("template input:"))
+		})
+
+		It("should format the template input of a failed plugin with the configured input formatter", func() {
+			pluginConfig := config.TemplatePluginConfiguration{
+				Type:    "MyTemplate",
+				Command: "sh",
+				Args:    []string{"-c", "exit 1"},
+			}
+			// the default input formatter of the plugin removes the values of the imports
+			inputFormatter = template.NewTemplateInputFormatter(false)
+
+			_, err := executeTemplate(pluginConfig, newBlueprint(`"my template"`), map[string]interface{}{"version": "0.0.1"})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`imports: {"version":"0.0.1"}`))
+		})
+
+		It("should abort a plugin whose output exceeds the maximal size", func() {
+			pluginConfig := config.TemplatePluginConfiguration{
+				Type:    "MyTemplate",
+				Command: "yes",
+			}
+
+			_, err := executeTemplate(pluginConfig, newBlueprint(`"my template"`), nil)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("exceeds the maximal size"))
+		})
+
+		It("should abort a plugin that exceeds its timeout", func() {
+			pluginConfig := config.TemplatePluginConfiguration{
+				Type:    "MyTemplate",
+				Command: "sh",
+				Args:    []string{"-c", "exec sleep 10"},
+				Timeout: &core.Duration{Duration: 100 * time.Millisecond},
+			}
+
+			_, err := executeTemplate(pluginConfig, newBlueprint(`"my template"`), nil)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("timed out"))
+		})
+	})
+})
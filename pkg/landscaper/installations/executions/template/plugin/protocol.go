@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package plugin
+
+import (
+	"encoding/json"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+// Purpose describes for which kind of execution a template plugin is called.
+type Purpose string
+
+const (
+	// PurposeImport is the purpose of import executions.
+	PurposeImport Purpose = "import"
+	// PurposeSubinstallation is the purpose of subinstallation executions.
+	PurposeSubinstallation Purpose = "subinstallation"
+	// PurposeDeploy is the purpose of deploy executions.
+	PurposeDeploy Purpose = "deploy"
+	// PurposeExport is the purpose of export executions.
+	PurposeExport Purpose = "export"
+)
+
+// Request is the request that is written as json to the standard input of a template plugin.
+//
+// The plugin has to write the rendered template as json or yaml document to its standard output.
+// The document has the same structure as the output of the built-in template engines,
+// e.g. a "deployItems" list for deploy executions, and may contain a "state" that is stored for the next execution.
+// If the plugin exits with a non-zero exit code, the template execution fails with the standard error output of
+// the plugin as error message.
+type Request struct {
+	// Purpose defines for which kind of execution the plugin is called.
+	Purpose Purpose `json:"purpose"`
+	// Name is the name of the template execution.
+	Name string `json:"name"`
+	// Type is the template type of the execution.
+	Type lsv1alpha1.TemplateType `json:"type"`
+	// Template is the template of the execution.
+	// Inline templates are passed unchanged, templates from a blueprint file are passed as string.
+	Template json.RawMessage `json:"template"`
+	// Input is the template input, e.g. the imports, the component descriptor and the state.
+	Input map[string]interface{} `json:"input"`
+}
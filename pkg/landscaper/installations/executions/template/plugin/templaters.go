@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package plugin
+
+import (
+	"fmt"
+
+	"github.com/gardener/landscaper/apis/config"
+	lscore "github.com/gardener/landscaper/apis/core"
+	lstmpl "github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
+)
+
+// builtinTemplateTypes are the template types that cannot be handled by a plugin.
+var builtinTemplateTypes = map[lscore.TemplateType]bool{
+	lscore.GOTemplateType:    true,
+	lscore.SpiffTemplateType: true,
+	lscore.CUETemplateType:   true,
+}
+
+// Validate validates the configured template plugins.
+func Validate(plugins []config.TemplatePluginConfiguration) error {
+	types := map[lscore.TemplateType]bool{}
+	for i, plugin := range plugins {
+		if len(plugin.Type) == 0 {
+			return fmt.Errorf("template plugin %d: type must not be empty", i)
+		}
+		if builtinTemplateTypes[plugin.Type] {
+			return fmt.Errorf("template plugin %d: type %q is a built-in template type", i, plugin.Type)
+		}
+		if types[plugin.Type] {
+			return fmt.Errorf("template plugin %d: duplicate type %q", i, plugin.Type)
+		}
+		if len(plugin.Command) == 0 {
+			return fmt.Errorf("template plugin %d: command must not be empty", i)
+		}
+		if plugin.Timeout != nil && plugin.Timeout.Duration < 0 {
+			return fmt.Errorf("template plugin %d: timeout must not be negative", i)
+		}
+		types[plugin.Type] = true
+	}
+	return nil
+}
+
+// NewTemplaters creates a templater for every configured template plugin.
+// The templaters use their default input formatter if the given input formatter is nil.
+func NewTemplaters(plugins []config.TemplatePluginConfiguration, state lstmpl.GenericStateHandler,
+	inputFormatter *lstmpl.TemplateInputFormatter) []lstmpl.ExecutionTemplater {
+	templaters := make([]lstmpl.ExecutionTemplater, 0, len(plugins))
+	for _, plugin := range plugins {
+		templater := New(plugin, state)
+		if inputFormatter != nil {
+			templater.WithInputFormatter(inputFormatter)
+		}
+		templaters = append(templaters, templater)
+	}
+	return templaters
+}
@@ -5,8 +5,12 @@
 package spiff
 
 import (
+	"errors"
+	"fmt"
 	"strings"
 
+	"github.com/mandelsoft/spiff/dynaml"
+
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
 )
 
@@ -50,3 +54,30 @@ func (e *TemplateError) Build() *TemplateError {
 func (e *TemplateError) Error() string {
 	return e.message
 }
+
+// Unwrap returns the wrapped error.
+func (e *TemplateError) Unwrap() error {
+	return e.err
+}
+
+// Diagnose adds the field and the expression of the first unresolved node of the template to the diagnostics.
+// Nodes that could not be resolved because of an error are preferred over nodes that depend on other unresolved nodes.
+func (e *TemplateError) Diagnose(d *template.ExecutionDiagnostics) {
+	var unresolved dynaml.UnresolvedNodes
+	if !errors.As(e.err, &unresolved) || len(unresolved.Nodes) == 0 {
+		return
+	}
+
+	node := unresolved.Nodes[0]
+	for _, n := range unresolved.Nodes {
+		if n.HasError() {
+			node = n
+			break
+		}
+	}
+
+	d.Field = strings.Join(node.Context, ".")
+	if expr, ok := node.Value().(dynaml.Expression); ok {
+		d.Expression = fmt.Sprintf("(( %s ))", expr)
+	}
+}
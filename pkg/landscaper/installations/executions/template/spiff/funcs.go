@@ -32,7 +32,7 @@ import (
 	"github.com/gardener/landscaper/pkg/utils/clusters"
 )
 
-func LandscaperSpiffFuncs(blueprint *blueprints.Blueprint, functions spiffing.Functions, componentVersion model.ComponentVersion, componentVersions *model.ComponentVersionList, targetResolver targetresolver.TargetResolver) error {
+func LandscaperSpiffFuncs(blueprint *blueprints.Blueprint, functions spiffing.Functions, componentVersion model.ComponentVersion, componentVersions *model.ComponentVersionList, targetResolver targetresolver.TargetResolver, lookupNamespace string, lookups []lsv1alpha1.TemplateLookupResource, state template.GenericStateHandler) error {
 	ocmSchemaVersion := common.DetermineOCMSchemaVersion(blueprint, componentVersion)
 
 	cd, err := model.GetComponentDescriptor(componentVersion)
@@ -58,6 +58,17 @@ func LandscaperSpiffFuncs(blueprint *blueprints.Blueprint, functions spiffing.Fu
 	functions.RegisterFunction("getServiceAccountKubeconfigWithExpirationTimestamp", getServiceAccountKubeconfigSpiffFunc(targetResolver, true))
 	functions.RegisterFunction("getOidcKubeconfig", getOidcKubeconfigSpiffFunc(targetResolver))
 
+	// the lookup results are cached for the rendering of one template
+	targetLookup := template.NewTargetLookup(targetResolver, lookupNamespace, lookups)
+	functions.RegisterFunction("lookup", lookupSpiffFunc(targetLookup))
+	functions.RegisterFunction("lookupList", lookupListSpiffFunc(targetLookup))
+
+	generator := template.NewGenerator(state)
+	functions.RegisterFunction("generatePassword", generatePasswordSpiffFunc(generator))
+	functions.RegisterFunction("generateKeyPair", generateKeyPairSpiffFunc(generator))
+	functions.RegisterFunction("generateCA", generateCASpiffFunc(generator))
+	functions.RegisterFunction("generateCertificate", generateCertificateSpiffFunc(generator))
+
 	return nil
 }
 
@@ -507,6 +518,244 @@ func getOidcKubeconfigSpiffFunc(targetResolver targetresolver.TargetResolver) dy
 	}
 }
 
+func lookupSpiffFunc(targetLookup *template.TargetLookup) dynaml.Function {
+	return func(args []interface{}, binding dynaml.Binding) (interface{}, dynaml.EvaluationInfo, bool) {
+		info := dynaml.DefaultInfo()
+		if len(args) != 5 {
+			return info.Error("templating function lookup expects 5 arguments: target, api version, kind, namespace, and name")
+		}
+
+		target, err := spiffPlainArgument(args[0])
+		if err != nil {
+			return info.Error("templating function lookup expects a target object as 1st argument: %s", err)
+		}
+
+		strArgs, err := spiffStringArguments(args[1:])
+		if err != nil {
+			return info.Error("templating function lookup expects strings as api version, kind, namespace, and name: %s", err)
+		}
+
+		res, err := targetLookup.Lookup(context.Background(), target, strArgs[0], strArgs[1], strArgs[2], strArgs[3])
+		if err != nil {
+			return info.Error("templating function lookup: %s", err)
+		}
+		return spiffValue(res, info, binding)
+	}
+}
+
+func lookupListSpiffFunc(targetLookup *template.TargetLookup) dynaml.Function {
+	return func(args []interface{}, binding dynaml.Binding) (interface{}, dynaml.EvaluationInfo, bool) {
+		info := dynaml.DefaultInfo()
+		if len(args) != 4 && len(args) != 5 {
+			return info.Error("templating function lookupList expects 4 or 5 arguments: target, api version, kind, namespace, and an optional label selector")
+		}
+
+		target, err := spiffPlainArgument(args[0])
+		if err != nil {
+			return info.Error("templating function lookupList expects a target object as 1st argument: %s", err)
+		}
+
+		strArgs, err := spiffStringArguments(args[1:])
+		if err != nil {
+			return info.Error("templating function lookupList expects strings as api version, kind, namespace, and label selector: %s", err)
+		}
+		labelSelector := ""
+		if len(strArgs) == 4 {
+			labelSelector = strArgs[3]
+		}
+
+		res, err := targetLookup.LookupList(context.Background(), target, strArgs[0], strArgs[1], strArgs[2], labelSelector)
+		if err != nil {
+			return info.Error("templating function lookupList: %s", err)
+		}
+		return spiffValue(res, info, binding)
+	}
+}
+
+func generatePasswordSpiffFunc(generator *template.Generator) dynaml.Function {
+	return func(args []interface{}, binding dynaml.Binding) (interface{}, dynaml.EvaluationInfo, bool) {
+		info := dynaml.DefaultInfo()
+		if len(args) != 2 {
+			return info.Error("templating function generatePassword expects 2 arguments: name and length")
+		}
+
+		name, ok := args[0].(string)
+		if !ok {
+			return info.Error("templating function generatePassword expects a string as 1st argument, namely the name")
+		}
+
+		length, err := toInt64(args[1])
+		if err != nil {
+			return info.Error("templating function generatePassword expects an integer as 2nd argument, namely the length: %s", err)
+		}
+
+		password, err := generator.Password(context.Background(), name, length)
+		if err != nil {
+			return info.Error("templating function generatePassword: %s", err)
+		}
+		return password, info, true
+	}
+}
+
+func generateKeyPairSpiffFunc(generator *template.Generator) dynaml.Function {
+	return func(args []interface{}, binding dynaml.Binding) (interface{}, dynaml.EvaluationInfo, bool) {
+		info := dynaml.DefaultInfo()
+		if len(args) != 2 {
+			return info.Error("templating function generateKeyPair expects 2 arguments: name and number of bits")
+		}
+
+		name, ok := args[0].(string)
+		if !ok {
+			return info.Error("templating function generateKeyPair expects a string as 1st argument, namely the name")
+		}
+
+		bits, err := toInt64(args[1])
+		if err != nil {
+			return info.Error("templating function generateKeyPair expects an integer as 2nd argument, namely the number of bits: %s", err)
+		}
+
+		keyPair, err := generator.KeyPair(context.Background(), name, bits)
+		if err != nil {
+			return info.Error("templating function generateKeyPair: %s", err)
+		}
+		return spiffValue(keyPair, info, binding)
+	}
+}
+
+func generateCASpiffFunc(generator *template.Generator) dynaml.Function {
+	return func(args []interface{}, binding dynaml.Binding) (interface{}, dynaml.EvaluationInfo, bool) {
+		info := dynaml.DefaultInfo()
+		if len(args) != 3 && len(args) != 4 {
+			return info.Error("templating function generateCA expects 3 or 4 arguments: name, common name, validity in days, and an optional rotation period in days")
+		}
+
+		strArgs, err := spiffStringArguments(args[:2])
+		if err != nil {
+			return info.Error("templating function generateCA expects strings as name and common name: %s", err)
+		}
+
+		validity, rotateBefore, err := spiffValidity(args[2:])
+		if err != nil {
+			return info.Error("templating function generateCA: %s", err)
+		}
+
+		ca, err := generator.CA(context.Background(), strArgs[0], strArgs[1], validity, rotateBefore)
+		if err != nil {
+			return info.Error("templating function generateCA: %s", err)
+		}
+		return spiffValue(ca, info, binding)
+	}
+}
+
+func generateCertificateSpiffFunc(generator *template.Generator) dynaml.Function {
+	return func(args []interface{}, binding dynaml.Binding) (interface{}, dynaml.EvaluationInfo, bool) {
+		info := dynaml.DefaultInfo()
+		if len(args) != 5 && len(args) != 6 {
+			return info.Error("templating function generateCertificate expects 5 or 6 arguments: name, common name, alternative names, validity in days, ca, and an optional rotation period in days")
+		}
+
+		strArgs, err := spiffStringArguments(args[:2])
+		if err != nil {
+			return info.Error("templating function generateCertificate expects strings as name and common name: %s", err)
+		}
+
+		altNames, err := spiffPlainArgument(args[2])
+		if err != nil {
+			return info.Error("templating function generateCertificate expects a list as 3rd argument, namely the alternative names: %s", err)
+		}
+		names := []string{}
+		if altNames != nil {
+			list, ok := altNames.([]interface{})
+			if !ok {
+				return info.Error("templating function generateCertificate expects a list as 3rd argument, namely the alternative names")
+			}
+			if names, err = spiffStringArguments(list); err != nil {
+				return info.Error("templating function generateCertificate expects a list of strings as alternative names: %s", err)
+			}
+		}
+
+		ca, err := spiffPlainArgument(args[4])
+		if err != nil {
+			return info.Error("templating function generateCertificate expects a ca as 5th argument: %s", err)
+		}
+
+		validity, rotateBefore, err := spiffValidity(append([]interface{}{args[3]}, args[5:]...))
+		if err != nil {
+			return info.Error("templating function generateCertificate: %s", err)
+		}
+
+		cert, err := generator.Certificate(context.Background(), strArgs[0], strArgs[1], names, ca, validity, rotateBefore)
+		if err != nil {
+			return info.Error("templating function generateCertificate: %s", err)
+		}
+		return spiffValue(cert, info, binding)
+	}
+}
+
+// spiffValidity converts the validity and the optional rotation period of a certificate from days into durations.
+func spiffValidity(args []interface{}) (time.Duration, time.Duration, error) {
+	const day = 24 * time.Hour
+
+	validity, err := toInt64(args[0])
+	if err != nil {
+		return 0, 0, fmt.Errorf("expected an integer as validity in days: %w", err)
+	}
+	var rotateBefore int64
+	if len(args) > 1 {
+		rotateBefore, err = toInt64(args[1])
+		if err != nil {
+			return 0, 0, fmt.Errorf("expected an integer as rotation period in days: %w", err)
+		}
+	}
+	return time.Duration(validity) * day, time.Duration(rotateBefore) * day, nil
+}
+
+// spiffPlainArgument converts an argument of a spiff function into a plain value.
+func spiffPlainArgument(arg interface{}) (interface{}, error) {
+	data, err := spiffyaml.Marshal(spiffyaml.NewNode(arg, ""))
+	if err != nil {
+		return nil, fmt.Errorf("error during marshaling: %w", err)
+	}
+
+	var value interface{}
+	if err := yaml.Unmarshal(data, &value); err != nil {
+		return nil, fmt.Errorf("error during unmarshaling: %w", err)
+	}
+	return value, nil
+}
+
+func spiffStringArguments(args []interface{}) ([]string, error) {
+	res := make([]string, len(args))
+	for i, arg := range args {
+		str, ok := arg.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected a string, but got %T", arg)
+		}
+		res[i] = str
+	}
+	return res, nil
+}
+
+// spiffValue converts a plain value into a spiff value.
+func spiffValue(value interface{}, info dynaml.EvaluationInfo, binding dynaml.Binding) (interface{}, dynaml.EvaluationInfo, bool) {
+	data, err := yaml.Marshal(value)
+	if err != nil {
+		return info.Error(err.Error())
+	}
+
+	node, err := spiffyaml.Parse("", data)
+	if err != nil {
+		return info.Error(err.Error())
+	}
+
+	result, err := binding.Flow(node, false)
+	if err != nil {
+		return info.Error(err.Error())
+	}
+
+	return result.Value(), info, true
+}
+
 func toInt64(value interface{}) (int64, error) {
 	switch n := value.(type) {
 	case int64:
@@ -22,9 +22,11 @@ import (
 
 // Templater describes the spiff template implementation for execution templater.
 type Templater struct {
-	state          template.GenericStateHandler
-	inputFormatter *template.TemplateInputFormatter
-	targetResolver targetresolver.TargetResolver
+	state           template.GenericStateHandler
+	inputFormatter  *template.TemplateInputFormatter
+	targetResolver  targetresolver.TargetResolver
+	lookups         []lsv1alpha1.TemplateLookupResource
+	lookupNamespace string
 }
 
 // New creates a new spiff execution templater.
@@ -42,6 +44,14 @@ func (t *Templater) WithInputFormatter(inputFormatter *template.TemplateInputFor
 	return t
 }
 
+// WithTemplateLookups sets the kinds of objects that can be read from target clusters with the lookup functions,
+// and the namespace of the installation in which the targets of the lookup functions are resolved.
+func (t *Templater) WithTemplateLookups(namespace string, lookups []lsv1alpha1.TemplateLookupResource) *Templater {
+	t.lookupNamespace = namespace
+	t.lookups = lookups
+	return t
+}
+
 func (t Templater) Type() lsv1alpha1.TemplateType {
 	return lsv1alpha1.SpiffTemplateType
 }
@@ -64,7 +74,7 @@ func (t *Templater) TemplateSubinstallationExecutions(tmplExec lsv1alpha1.Templa
 	}
 
 	functions := spiffing.NewFunctions()
-	if err = LandscaperSpiffFuncs(blueprint, functions, cd, cdList, t.targetResolver); err != nil {
+	if err = LandscaperSpiffFuncs(blueprint, functions, cd, cdList, t.targetResolver, t.lookupNamespace, t.lookups, t.state); err != nil {
 		return nil, err
 	}
 
@@ -109,7 +119,7 @@ func (t *Templater) TemplateImportExecutions(tmplExec lsv1alpha1.TemplateExecuto
 	defer ctx.Done()
 
 	functions := spiffing.NewFunctions()
-	if err = LandscaperSpiffFuncs(blueprint, functions, descriptor, cdList, t.targetResolver); err != nil {
+	if err = LandscaperSpiffFuncs(blueprint, functions, descriptor, cdList, t.targetResolver, t.lookupNamespace, t.lookups, t.state); err != nil {
 		return nil, err
 	}
 
@@ -155,7 +165,7 @@ func (t *Templater) TemplateDeployExecutions(tmplExec lsv1alpha1.TemplateExecuto
 	}
 
 	functions := spiffing.NewFunctions()
-	if err = LandscaperSpiffFuncs(blueprint, functions, descriptor, cdList, t.targetResolver); err != nil {
+	if err = LandscaperSpiffFuncs(blueprint, functions, descriptor, cdList, t.targetResolver, t.lookupNamespace, t.lookups, t.state); err != nil {
 		return nil, err
 	}
 
@@ -204,7 +214,7 @@ func (t *Templater) TemplateExportExecutions(tmplExec lsv1alpha1.TemplateExecuto
 	}
 
 	functions := spiffing.NewFunctions()
-	if err = LandscaperSpiffFuncs(blueprint, functions, descriptor, cdList, t.targetResolver); err != nil {
+	if err = LandscaperSpiffFuncs(blueprint, functions, descriptor, cdList, t.targetResolver, t.lookupNamespace, t.lookups, t.state); err != nil {
 		return nil, err
 	}
 
@@ -119,3 +119,46 @@ func (m MemoryStateHandler) Get(_ context.Context, name string) ([]byte, error)
 	}
 	return data, nil
 }
+
+// DryRunStateHandler implements the GenericStateHandler interface.
+// It reads the state from an underlying state handler, but keeps stored state only in memory.
+// It is used to render templates without persisting their state, e.g. when an installation is planned.
+type DryRunStateHandler struct {
+	base   GenericStateHandler
+	stored MemoryStateHandler
+}
+
+var _ GenericStateHandler = &DryRunStateHandler{}
+
+// NewInstallationStateHandler returns the state handler for the templates of the given installation.
+// In dry run mode the state is read from the cluster but changes are only kept in memory.
+func NewInstallationStateHandler(kubeClient client.Client, inst *lsv1alpha1.Installation, dryRun bool) GenericStateHandler {
+	var stateHdlr GenericStateHandler = KubernetesStateHandler{
+		KubeClient: kubeClient,
+		Inst:       inst,
+	}
+	if dryRun {
+		stateHdlr = NewDryRunStateHandler(stateHdlr)
+	}
+	return stateHdlr
+}
+
+// NewDryRunStateHandler creates a new dry run state handler that reads the state from the given state handler.
+func NewDryRunStateHandler(base GenericStateHandler) *DryRunStateHandler {
+	return &DryRunStateHandler{
+		base:   base,
+		stored: NewMemoryStateHandler(),
+	}
+}
+
+func (s *DryRunStateHandler) Store(ctx context.Context, name string, data []byte) error {
+	return s.stored.Store(ctx, name, data)
+}
+
+func (s *DryRunStateHandler) Get(ctx context.Context, name string) ([]byte, error) {
+	data, err := s.stored.Get(ctx, name)
+	if err == nil {
+		return data, nil
+	}
+	return s.base.Get(ctx, name)
+}
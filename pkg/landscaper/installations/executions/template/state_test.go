@@ -63,4 +63,32 @@ var _ = Describe("State", func() {
 
 	})
 
+	Context("dry run handler", func() {
+
+		It("should read state from the underlying handler but not write to it", func() {
+			ctx := context.Background()
+			defer ctx.Done()
+			base := NewMemoryStateHandler()
+			Expect(base.Store(ctx, "existing", []byte("old data"))).To(Succeed())
+
+			stateHdlr := NewDryRunStateHandler(base)
+			res, err := stateHdlr.Get(ctx, "existing")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(Equal([]byte("old data")))
+
+			Expect(stateHdlr.Store(ctx, "existing", []byte("new data"))).To(Succeed())
+			res, err = stateHdlr.Get(ctx, "existing")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(Equal([]byte("new data")))
+
+			res, err = base.Get(ctx, "existing")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(Equal([]byte("old data")))
+
+			_, err = stateHdlr.Get(ctx, "unknown")
+			Expect(err).To(Equal(StateNotFoundErr))
+		})
+
+	})
+
 })
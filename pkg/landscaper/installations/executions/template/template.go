@@ -5,14 +5,17 @@
 package template
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	"ocm.software/ocm/api/ocm/compdesc"
 	_ "ocm.software/ocm/api/ocm/compdesc/versions/ocm.software/v3alpha1"
 	_ "ocm.software/ocm/api/ocm/compdesc/versions/v2"
 	ocmruntime "ocm.software/ocm/api/utils/runtime"
+	"sigs.k8s.io/yaml"
 
 	"github.com/gardener/landscaper/legacy-component-spec/bindings-go/codec"
 
@@ -21,19 +24,23 @@ import (
 	"github.com/gardener/landscaper/pkg/api"
 	"github.com/gardener/landscaper/pkg/components/model"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/common"
+	"github.com/gardener/landscaper/pkg/metrics"
 	"github.com/gardener/landscaper/pkg/utils"
 	"github.com/gardener/landscaper/pkg/utils/blueprints"
 )
 
 // Templater implements all available template executors.
 type Templater struct {
-	impl map[lsv1alpha1.TemplateType]ExecutionTemplater
+	impl           map[lsv1alpha1.TemplateType]ExecutionTemplater
+	diagnostics    DiagnosticsHandler
+	inputFormatter *TemplateInputFormatter
 }
 
 // New creates a new instance of a templater.
 func New(templaters ...ExecutionTemplater) *Templater {
 	t := &Templater{
-		impl: make(map[lsv1alpha1.TemplateType]ExecutionTemplater),
+		impl:           make(map[lsv1alpha1.TemplateType]ExecutionTemplater),
+		inputFormatter: NewTemplateInputFormatter(false, "imports", "targets", "values", "state"),
 	}
 	for _, templater := range templaters {
 		t.impl[templater.Type()] = templater
@@ -41,6 +48,24 @@ func New(templaters ...ExecutionTemplater) *Templater {
 	return t
 }
 
+// WithTemplaters adds additional templaters, like template plugins.
+// Templaters for an already known template type are ignored, so that the built-in templaters cannot be overwritten.
+func (o *Templater) WithTemplaters(templaters ...ExecutionTemplater) *Templater {
+	for _, templater := range templaters {
+		if _, ok := o.impl[templater.Type()]; !ok {
+			o.impl[templater.Type()] = templater
+		}
+	}
+	return o
+}
+
+// WithDiagnosticsHandler sets the handler that stores the diagnostics of failed template executions.
+// The diagnostics of successful template executions are only stored if the handler captures the output.
+func (o *Templater) WithDiagnosticsHandler(diagnostics DiagnosticsHandler) *Templater {
+	o.diagnostics = diagnostics
+	return o
+}
+
 // ExecutionTemplater describes a implementation for a template execution
 type ExecutionTemplater interface {
 	// Type returns the type of the templater.
@@ -147,6 +172,10 @@ type DeployItemSpecification struct {
 	// DependsOn lists deploy items that need to be executed before this one
 	DependsOn []string `json:"dependsOn,omitempty"`
 
+	// Wave defines the rollout wave of the deploy item.
+	// +optional
+	Wave int32 `json:"wave,omitempty"`
+
 	// Timeout specifies how long the deployer may take to apply the deploy item.
 	// When the time is exceeded, the deploy item fails.
 	// Value has to be parsable by time.ParseDuration (or 'none' to deactivate the timeout).
@@ -180,14 +209,16 @@ func (o *Templater) TemplateImportExecutions(opts BlueprintExecutionOptions) ([]
 	errorList := []string{}
 	bindings := map[string]interface{}{}
 
-	for _, tmplExec := range opts.Blueprint.Info.ImportExecutions {
+	for i, tmplExec := range opts.Blueprint.Info.ImportExecutions {
 		impl, ok := o.impl[tmplExec.Type]
 		if !ok {
 			return nil, nil, fmt.Errorf("unknown template type %s", tmplExec.Type)
 		}
 
+		start := time.Now()
 		output, err := impl.TemplateImportExecutions(tmplExec, opts.Blueprint, opts.ComponentVersion, opts.ComponentVersions, values)
-		if err != nil {
+		metrics.ObserveTemplateRendering(metrics.TemplateTypeImports, string(tmplExec.Type), start)
+		if err := o.handleDiagnostics(ImportExecutionKind, i, tmplExec, opts.Blueprint, values, output, err); err != nil {
 			return nil, nil, err
 		}
 		if output.Bindings != nil {
@@ -221,14 +252,21 @@ func (o *Templater) TemplateSubinstallationExecutions(opts DeployExecutionOption
 		return nil, err
 	}
 	installationTemplates := make([]*lsv1alpha1.InstallationTemplate, 0)
-	for _, tmplExec := range opts.Blueprint.Info.SubinstallationExecutions {
+	for i, tmplExec := range opts.Blueprint.Info.SubinstallationExecutions {
 		impl, ok := o.impl[tmplExec.Type]
 		if !ok {
 			return nil, fmt.Errorf("unknown template type %s", tmplExec.Type)
 		}
 
+		start := time.Now()
 		output, err := impl.TemplateSubinstallationExecutions(tmplExec, opts.Blueprint, opts.ComponentVersion, opts.ComponentVersions, values)
-		if err != nil {
+		metrics.ObserveTemplateRendering(metrics.TemplateTypeSubinstallations, string(tmplExec.Type), start)
+		var capturedOutput interface{}
+		if output != nil {
+			// the output is captured without its json marshaller, as the marshaller is not meant for encoding
+			capturedOutput = map[string]interface{}{"subinstallations": output.Subinstallations}
+		}
+		if err := o.handleDiagnostics(SubinstallationExecutionKind, i, tmplExec, opts.Blueprint, values, capturedOutput, err); err != nil {
 			return nil, err
 		}
 		if output.Subinstallations == nil {
@@ -249,14 +287,16 @@ func (o *Templater) TemplateDeployExecutions(opts DeployExecutionOptions) ([]Dep
 	}
 
 	deployItemTemplateList := []DeployItemSpecification{}
-	for _, tmplExec := range opts.Blueprint.Info.DeployExecutions {
+	for i, tmplExec := range opts.Blueprint.Info.DeployExecutions {
 		impl, ok := o.impl[tmplExec.Type]
 		if !ok {
 			return nil, fmt.Errorf("unknown template type %s", tmplExec.Type)
 		}
 
+		start := time.Now()
 		output, err := impl.TemplateDeployExecutions(tmplExec, opts.Blueprint, opts.ComponentVersion, opts.ComponentVersions, values)
-		if err != nil {
+		metrics.ObserveTemplateRendering(metrics.TemplateTypeDeployItems, string(tmplExec.Type), start)
+		if err := o.handleDiagnostics(DeployExecutionKind, i, tmplExec, opts.Blueprint, values, output, err); err != nil {
 			return nil, err
 		}
 		if output.DeployItems == nil {
@@ -275,15 +315,17 @@ func (o *Templater) TemplateExportExecutions(opts ExportExecutionOptions) (map[s
 		return nil, err
 	}
 	exportData := make(map[string]interface{})
-	for _, tmplExec := range opts.Blueprint.Info.ExportExecutions {
+	for i, tmplExec := range opts.Blueprint.Info.ExportExecutions {
 
 		impl, ok := o.impl[tmplExec.Type]
 		if !ok {
 			return nil, fmt.Errorf("unknown template type %s", tmplExec.Type)
 		}
 
+		start := time.Now()
 		output, err := impl.TemplateExportExecutions(tmplExec, opts.Blueprint, opts.ComponentVersion, opts.ComponentVersions, values)
-		if err != nil {
+		metrics.ObserveTemplateRendering(metrics.TemplateTypeExports, string(tmplExec.Type), start)
+		if err := o.handleDiagnostics(ExportExecutionKind, i, tmplExec, opts.Blueprint, values, output, err); err != nil {
 			return nil, err
 		}
 		exportData = utils.MergeMaps(exportData, output.Exports)
@@ -292,6 +334,39 @@ func (o *Templater) TemplateExportExecutions(opts ExportExecutionOptions) (map[s
 	return exportData, nil
 }
 
+// handleDiagnostics handles the result of a template execution.
+// The error of a failed template execution is returned as ExecutionError that describes where the execution failed.
+// The diagnostics of failed template executions, and of successful ones if the output is captured, are stored with
+// the diagnostics handler.
+func (o *Templater) handleDiagnostics(kind ExecutionKind, index int, tmplExec lsv1alpha1.TemplateExecutor,
+	blueprint *blueprints.Blueprint, values map[string]interface{}, output interface{}, err error) error {
+	if err == nil && (o.diagnostics == nil || !o.diagnostics.CaptureOutput()) {
+		return nil
+	}
+
+	ctx := context.Background()
+	defer ctx.Done()
+
+	diagnostics := newExecutionDiagnostics(kind, index, tmplExec, values, o.inputFormatter)
+	if err != nil {
+		execErr := newExecutionError(err, diagnostics, tmplExec, blueprint)
+		if o.diagnostics != nil {
+			execErr.DiagnosticsRef, execErr.diagnosticsErr = o.diagnostics.Store(ctx, execErr.Diagnostics)
+		}
+		return execErr
+	}
+
+	data, err := yaml.Marshal(output)
+	if err != nil {
+		return fmt.Errorf("unable to marshal the output of template execution %s: %w", diagnostics.Execution, err)
+	}
+	diagnostics.Output = string(data)
+	if _, err := o.diagnostics.Store(ctx, diagnostics); err != nil {
+		return fmt.Errorf("unable to store the diagnostics of template execution %s: %w", diagnostics.Execution, err)
+	}
+	return nil
+}
+
 func serializeComponentDescriptor(componentVersion model.ComponentVersion, ocmSchemaVersion string) (interface{}, error) {
 	if componentVersion == nil {
 		return nil, nil
@@ -33,6 +33,7 @@ import (
 	"github.com/gardener/landscaper/pkg/components/testutils"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/common"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/cuetemplate"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/gotemplate"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/spiff"
 	"github.com/gardener/landscaper/pkg/utils/blueprints"
@@ -79,6 +80,11 @@ var _ = Describe("TemplateDeployExecutions", func() {
 		})
 	})
 
+	Context("CUE", func() {
+		testdataDir := filepath.Join("./testdata", "cuetemplate")
+		runTestSuiteCUE(testdataDir)
+	})
+
 })
 
 func runTestSuite(testdataDir, sharedTestdataDir string) {
@@ -1032,6 +1038,24 @@ line 10:11
 13:   `))
 		})
 	})
+
+	Context("Generated Values", func() {
+		It("should return the same generated values on subsequent renderings", func() {
+			res, err := executeTemplate("template-36.yaml", map[string]interface{}{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(HaveLen(1))
+
+			config := map[string]interface{}{}
+			Expect(yaml.Unmarshal(res[0].Configuration.Raw, &config)).ToNot(HaveOccurred())
+			Expect(config["password"]).To(HaveLen(16))
+			Expect(config).To(HaveKey("caCert"))
+			Expect(config).To(HaveKey("cert"))
+
+			res2, err := executeTemplate("template-36.yaml", map[string]interface{}{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res2[0].Configuration.Raw).To(MatchJSON(res[0].Configuration.Raw))
+		})
+	})
 }
 
 func runTestSuiteSpiff(testdataDir string) {
@@ -1095,3 +1119,236 @@ func runTestSuiteSpiff(testdataDir string) {
 		})
 	})
 }
+
+func runTestSuiteCUE(testdataDir string) {
+	var (
+		stateHandler template.GenericStateHandler
+		ctx          context.Context
+
+		readTemplate = func(templateFile string) []lsv1alpha1.TemplateExecutor {
+			tmpl, err := os.ReadFile(filepath.Join(testdataDir, templateFile))
+			Expect(err).ToNot(HaveOccurred())
+			exec := make([]lsv1alpha1.TemplateExecutor, 0)
+			Expect(yaml.Unmarshal(tmpl, &exec)).ToNot(HaveOccurred())
+			return exec
+		}
+
+		newTemplater = func() *template.Templater {
+			return template.New(gotemplate.New(stateHandler, nil), spiff.New(stateHandler, nil), cuetemplate.New(stateHandler, nil))
+		}
+
+		executeTemplate = func(templateFile string, fs vfs.FileSystem, cd model.ComponentVersion, imports map[string]interface{}) ([]template.DeployItemSpecification, error) {
+			blue := &lsv1alpha1.Blueprint{}
+			blue.DeployExecutions = readTemplate(templateFile)
+
+			return newTemplater().TemplateDeployExecutions(
+				template.NewDeployExecutionOptions(
+					template.NewBlueprintExecutionOptions(
+						nil,
+						&blueprints.Blueprint{Info: blue, Fs: fs},
+						cd,
+						nil,
+						imports)))
+		}
+
+		getConfig = func(deployItem template.DeployItemSpecification) map[string]interface{} {
+			config := make(map[string]interface{})
+			Expect(yaml.Unmarshal(deployItem.Configuration.Raw, &config)).ToNot(HaveOccurred())
+			return config
+		}
+	)
+
+	BeforeEach(func() {
+		ctx = logging.NewContext(context.Background(), logging.Discard())
+		stateHandler = template.NewMemoryStateHandler()
+	})
+
+	Context("TemplateSubinstallationExecutions", func() {
+		It("should use imports to template installations", func() {
+			blue := &lsv1alpha1.Blueprint{}
+			blue.SubinstallationExecutions = readTemplate("template-20.yaml")
+
+			res, err := newTemplater().TemplateSubinstallationExecutions(template.NewDeployExecutionOptions(
+				template.NewBlueprintExecutionOptions(nil, &blueprints.Blueprint{Info: blue, Fs: nil}, nil, nil,
+					map[string]interface{}{"blueprintName": "some-blueprint-name"})))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(HaveLen(1))
+			Expect(res[0].Name).To(Equal("my-subinstallation"))
+			Expect(res[0].Blueprint).To(MatchFields(IgnoreExtras, Fields{
+				"Ref": Equal("cd://resources/some-blueprint-name"),
+			}))
+		})
+	})
+
+	Context("TemplateDeployExecutions", func() {
+		It("should return the raw template if no references are defined", func() {
+			res, err := executeTemplate("template-01.yaml", nil, nil, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(HaveLen(1))
+			Expect(res[0]).To(MatchFields(IgnoreExtras, Fields{
+				"Name": Equal("init"),
+				"Type": Equal(core.DeployItemType("container")),
+			}))
+		})
+
+		It("should use the import values to template", func() {
+			res, err := executeTemplate("template-02.yaml", nil, nil, map[string]interface{}{"version": "0.0.0"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(HaveLen(1))
+			Expect(getConfig(res[0])).To(HaveKeyWithValue("image", "my-custom-image:0.0.0"))
+		})
+
+		It("should call a function to read the content of a file", func() {
+			memFs := memoryfs.New()
+			Expect(vfs.WriteFile(memFs, "VERSION", []byte("0.0.0"), os.ModePerm)).To(Succeed())
+
+			res, err := executeTemplate("template-03.yaml", memFs, nil, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(HaveLen(1))
+			Expect(getConfig(res[0])).To(HaveKeyWithValue("image", "my-custom-image:0.0.0"))
+		})
+
+		It("should read the template from a file of the blueprint", func() {
+			cueTemplate, err := os.ReadFile(filepath.Join(testdataDir, "template-10.cue"))
+			Expect(err).ToNot(HaveOccurred())
+			memFs := memoryfs.New()
+			Expect(vfs.WriteFile(memFs, "deploy-execution.cue", cueTemplate, os.ModePerm)).To(Succeed())
+			Expect(vfs.WriteFile(memFs, "VERSION", []byte("0.0.0"), os.ModePerm)).To(Succeed())
+
+			res, err := executeTemplate("template-10.yaml", memFs, nil, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(HaveLen(1))
+			Expect(getConfig(res[0])).To(HaveKeyWithValue("image", "my-custom-image:0.0.0"))
+		})
+
+		It("should call functions to get resources from the component descriptor", func() {
+			imageAccess1, err := testutils.NewOCIRegistryAccess("quay.io/example/myimage:1.0.0")
+			Expect(err).ToNot(HaveOccurred())
+			imageAccess2, err := testutils.NewOCIRegistryAccess("quay.io/example/yourimage:1.0.0")
+			Expect(err).ToNot(HaveOccurred())
+			cd := &types.ComponentDescriptor{
+				Metadata: types.Metadata{Version: cdv2.SchemaVersion},
+				ComponentSpec: cdv2.ComponentSpec{
+					ObjectMeta: cdv2.ObjectMeta{
+						Name:    "example.com/mycomp",
+						Version: "1.0.0",
+					},
+					RepositoryContexts: []*types.UnstructuredTypedObject{},
+					Provider:           "internal",
+					Resources: []types.Resource{
+						{
+							IdentityObjectMeta: cdv2.IdentityObjectMeta{
+								Name:          "mycustomimage",
+								Version:       "1.0.0",
+								Type:          cdv2.OCIImageType,
+								ExtraIdentity: cdv2.Identity{"class": "image"},
+							},
+							Relation: cdv2.ExternalRelation,
+							Access:   &imageAccess1,
+						},
+						{
+							IdentityObjectMeta: cdv2.IdentityObjectMeta{
+								Name:          "yourcustomimage",
+								Version:       "1.0.0",
+								Type:          cdv2.OCIImageType,
+								ExtraIdentity: cdv2.Identity{"class": "image"},
+							},
+							Relation: cdv2.ExternalRelation,
+							Access:   &imageAccess2,
+						},
+					},
+				},
+			}
+			Expect(cdv2.DefaultComponent(cd)).To(Succeed())
+
+			res, err := executeTemplate("template-04.yaml", nil, testutils.NewTestComponentVersionFromReader(cd), nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(HaveLen(1))
+			config := getConfig(res[0])
+			Expect(config).To(HaveKeyWithValue("image", "quay.io/example/myimage:1.0.0"))
+			Expect(config).To(HaveKeyWithValue("images", []interface{}{
+				map[string]interface{}{"image": "quay.io/example/myimage:1.0.0"},
+				map[string]interface{}{"image": "quay.io/example/yourimage:1.0.0"},
+			}))
+		})
+
+		It("should call functions to parse oci references", func() {
+			res, err := executeTemplate("template-13.yaml", nil, nil, map[string]interface{}{
+				"ref1": "myimage:0.0.0",
+				"ref2": "myimage@sha256:66371f17cc61bbbed2667b0285a10981deba5eb969df9bfd4cf273706044ddcb",
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(HaveLen(1))
+			config := getConfig(res[0])
+			Expect(config).To(HaveKeyWithValue("image0", "myimage:0.0.0"))
+			Expect(config).To(HaveKeyWithValue("image1", "myimage:0.0.0"))
+			Expect(config).To(HaveKeyWithValue("image2", "myimage@sha256:66371f17cc61bbbed2667b0285a10981deba5eb969df9bfd4cf273706044ddcb"))
+		})
+
+		It("should use the state to template", func() {
+			res, err := executeTemplate("template-09.yaml", nil, nil, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(HaveLen(1))
+			Expect(getConfig(res[0])).To(HaveKeyWithValue("image", "my-custom-image:0.0.1"))
+
+			stateBytes, err := json.Marshal(map[string]string{"version": "0.0.2"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stateHandler.Store(ctx, "deployone", stateBytes)).To(Succeed())
+
+			res, err = executeTemplate("template-09.yaml", nil, nil, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(HaveLen(1))
+			Expect(getConfig(res[0])).To(HaveKeyWithValue("image", "my-custom-image:0.0.2"))
+		})
+
+		It("should throw an error when the template references an undefined value", func() {
+			_, err := executeTemplate("template-08.yaml", nil, nil, map[string]interface{}{"version": "0.0.0"})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`reference "nonexisting" not found`))
+			Expect(err.Error()).To(ContainSubstring("template.cue:7:"))
+		})
+
+		It("should throw an error when the imports violate a constraint of the template", func() {
+			imports := map[string]interface{}{
+				"config": map[string]interface{}{
+					"verbosity": 10,
+					"memory": map[string]interface{}{
+						"min": 128,
+						"max": 1024,
+					},
+					"image": map[string]interface{}{
+						"name":    "test",
+						"version": "0.0.1",
+					},
+				},
+			}
+			res, err := executeTemplate("template-22.yaml", nil, nil, imports)
+			Expect(err).To(HaveOccurred())
+			Expect(res).To(BeNil())
+
+			errstr := err.Error()
+			Expect(errstr).To(ContainSubstring("invalid value 10 (out of bound <=5)"))
+			Expect(errstr).To(ContainSubstring("imports:"))
+			Expect(errstr).To(ContainSubstring(`"verbosity":"[...] (int)"`))
+
+			imports["config"].(map[string]interface{})["verbosity"] = 1
+			res, err = executeTemplate("template-22.yaml", nil, nil, imports)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(HaveLen(1))
+			Expect(getConfig(res[0])).To(HaveKeyWithValue("image", "test:0.0.1"))
+		})
+	})
+
+	Context("TemplateExportExecutions", func() {
+		It("should use the export values to template", func() {
+			blue := &lsv1alpha1.Blueprint{}
+			blue.ExportExecutions = readTemplate("template-06.yaml")
+
+			res, err := newTemplater().TemplateExportExecutions(template.NewExportExecutionOptions(
+				template.NewBlueprintExecutionOptions(nil, &blueprints.Blueprint{Info: blue, Fs: nil}, nil, nil, nil),
+				map[string]interface{}{"version": "0.0.0"}))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(HaveKeyWithValue("image", "my-custom-image:0.0.0"))
+		})
+	})
+}
@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package templaters creates the templater that renders the template executions of blueprints
+// with the built-in template types and the template plugins.
+package templaters
+
+import (
+	"github.com/gardener/landscaper/apis/config"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/landscaper/targetresolver"
+	lstmpl "github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/cuetemplate"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/gotemplate"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/plugin"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/spiff"
+)
+
+// Options contains the options of the templaters of all template types.
+type Options struct {
+	// State stores the state of the templates.
+	State lstmpl.GenericStateHandler
+	// TargetResolver resolves the targets for the template functions that access the clusters of targets.
+	// +optional
+	TargetResolver targetresolver.TargetResolver
+	// Lookups are the kinds of objects that can be read from target clusters with the lookup functions.
+	// +optional
+	Lookups []lsv1alpha1.TemplateLookupResource
+	// Namespace is the namespace of the installation in which the targets of the lookup functions are resolved.
+	// +optional
+	Namespace string
+	// InputFormatter formats the input of failed templates in error messages.
+	// The templaters use their default formatter if it is not set.
+	// +optional
+	InputFormatter *lstmpl.TemplateInputFormatter
+	// Diagnostics stores the diagnostics of the template executions.
+	// +optional
+	Diagnostics lstmpl.DiagnosticsHandler
+	// Plugins are the template plugins that handle the additional template types.
+	// +optional
+	Plugins []config.TemplatePluginConfiguration
+}
+
+// New creates a templater for the go, spiff and CUE templates and for the configured template plugins.
+func New(opts Options) *lstmpl.Templater {
+	goTemplater := gotemplate.New(opts.State, opts.TargetResolver).WithTemplateLookups(opts.Namespace, opts.Lookups)
+	spiffTemplater := spiff.New(opts.State, opts.TargetResolver).WithTemplateLookups(opts.Namespace, opts.Lookups)
+	cueTemplater := cuetemplate.New(opts.State, opts.TargetResolver).WithTemplateLookups(opts.Namespace, opts.Lookups)
+	if opts.InputFormatter != nil {
+		goTemplater.WithInputFormatter(opts.InputFormatter)
+		spiffTemplater.WithInputFormatter(opts.InputFormatter)
+		cueTemplater.WithInputFormatter(opts.InputFormatter)
+	}
+
+	return lstmpl.New(goTemplater, spiffTemplater, cueTemplater).
+		WithTemplaters(plugin.NewTemplaters(opts.Plugins, opts.State, opts.InputFormatter)...).
+		WithDiagnosticsHandler(opts.Diagnostics)
+}
@@ -0,0 +1,15 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+calls: version: {function: "readFile", args: ["/VERSION"]}
+
+deployItems: [{
+	name: "init"
+	type: "container"
+	config: {
+		apiVersion: "example.test/v1"
+		kind:       "Configuration"
+		image:      "my-custom-image:\(calls.version.result)"
+	}
+}]
@@ -28,8 +28,7 @@ import (
 	"github.com/gardener/landscaper/pkg/landscaper/installations"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
-	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/gotemplate"
-	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/spiff"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/templaters"
 )
 
 // Constructor is a struct that contains all values
@@ -77,15 +76,17 @@ func (c *Constructor) Construct(ctx context.Context) ([]*dataobjects.DataObject,
 	}
 	internalExports["targets"] = targetsMap
 
-	stateHdlr := template.KubernetesStateHandler{
-		KubeClient: c.LsUncachedClient(),
-		Inst:       c.Inst.GetInstallation(),
-	}
-	targetResolver := genericresolver.New(c.LsUncachedClient())
-
-	tmpl := template.New(
-		gotemplate.New(stateHdlr, targetResolver),
-		spiff.New(stateHdlr, targetResolver))
+	tmpl := templaters.New(templaters.Options{
+		State: template.KubernetesStateHandler{
+			KubeClient: c.LsUncachedClient(),
+			Inst:       c.Inst.GetInstallation(),
+		},
+		TargetResolver: genericresolver.New(c.LsUncachedClient()),
+		Lookups:        c.Context().External.TemplateLookups,
+		Namespace:      c.Inst.GetInstallation().Namespace,
+		Diagnostics:    template.NewInstallationDiagnosticsHandler(c.LsUncachedClient(), c.Inst.GetInstallation(), false),
+		Plugins:        c.TemplatePlugins,
+	})
 	exports, err := tmpl.TemplateExportExecutions(
 		template.NewExportExecutionOptions(
 			template.NewBlueprintExecutionOptions(
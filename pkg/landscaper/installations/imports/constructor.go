@@ -21,8 +21,7 @@ import (
 	"github.com/gardener/landscaper/pkg/landscaper/dataobjects/jsonpath"
 	"github.com/gardener/landscaper/pkg/landscaper/installations"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
-	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/gotemplate"
-	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/spiff"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/templaters"
 )
 
 const (
@@ -344,14 +343,14 @@ func (c *Constructor) templateDataMappings(
 func (c *Constructor) RenderImportExecutions() error {
 	cond := lsv1alpha1helper.GetOrInitCondition(c.Inst.GetInstallation().Status.Conditions, lsv1alpha1.ValidateImportsCondition)
 
-	templateStateHandler := template.KubernetesStateHandler{
-		KubeClient: c.LsUncachedClient(),
-		Inst:       c.Inst.GetInstallation(),
-	}
-	targetResolver := genericresolver.New(c.LsUncachedClient())
-	tmpl := template.New(
-		gotemplate.New(templateStateHandler, targetResolver),
-		spiff.New(templateStateHandler, targetResolver))
+	tmpl := templaters.New(templaters.Options{
+		State:          template.NewInstallationStateHandler(c.LsUncachedClient(), c.Inst.GetInstallation(), c.DryRun),
+		TargetResolver: genericresolver.New(c.LsUncachedClient()),
+		Lookups:        c.Context().External.TemplateLookups,
+		Namespace:      c.Inst.GetInstallation().Namespace,
+		Diagnostics:    template.NewInstallationDiagnosticsHandler(c.LsUncachedClient(), c.Inst.GetInstallation(), c.DryRun),
+		Plugins:        c.TemplatePlugins,
+	})
 	errors, bindings, err := tmpl.TemplateImportExecutions(
 		template.NewBlueprintExecutionOptions(
 			c.Context().External.InjectComponentDescriptorRef(c.Inst.GetInstallation()),
@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// GetMaintenanceWindow returns the maintenance window of an installation. This is the maintenance window of the
+// installation itself or, if it has none, the maintenance window of the referenced context.
+// Nil is returned if no maintenance window is configured.
+func GetMaintenanceWindow(ctx context.Context, c client.Reader, inst *lsv1alpha1.Installation, readID read_write_layer.ReadID) (*lsv1alpha1.MaintenanceWindow, error) {
+	if inst.Spec.MaintenanceWindow != nil {
+		return inst.Spec.MaintenanceWindow, nil
+	}
+
+	if len(inst.Spec.Context) == 0 {
+		return nil, nil
+	}
+
+	lsCtx := &lsv1alpha1.Context{}
+	if err := read_write_layer.GetContext(ctx, c, kutil.ObjectKey(inst.Spec.Context, inst.Namespace), lsCtx, readID); err != nil {
+		return nil, err
+	}
+	return lsCtx.MaintenanceWindow, nil
+}
+
+// IsInMaintenanceWindow returns whether the given time lies within a maintenance window.
+// If not, the start time of the next window is returned in addition.
+func IsInMaintenanceWindow(window *lsv1alpha1.MaintenanceWindow, now time.Time) (bool, time.Time, error) {
+	// the time zone of the cron spec would take precedence over the time zone of the window
+	trimmedSpec := strings.TrimSpace(window.CronSpec)
+	if strings.HasPrefix(trimmedSpec, "CRON_TZ=") || strings.HasPrefix(trimmedSpec, "TZ=") {
+		return false, time.Time{}, fmt.Errorf("cron spec %q of maintenance window must not contain a time zone", window.CronSpec)
+	}
+	schedule, err := cron.ParseStandard(window.CronSpec)
+	if err != nil {
+		return false, time.Time{}, fmt.Errorf("invalid cron spec %q of maintenance window: %w", window.CronSpec, err)
+	}
+
+	location, err := time.LoadLocation(window.TimeZone)
+	if err != nil {
+		return false, time.Time{}, fmt.Errorf("invalid time zone %q of maintenance window: %w", window.TimeZone, err)
+	}
+
+	// The first window starting after now-duration is either the window containing now, or the next window.
+	start := schedule.Next(now.In(location).Add(-window.Duration.Duration))
+	if start.IsZero() {
+		// robfig/cron returns the zero time for schedules that never fire, e.g. "0 0 30 2 *"
+		return false, time.Time{}, fmt.Errorf("cron spec %q of maintenance window never fires", window.CronSpec)
+	}
+	if !start.After(now) {
+		return true, time.Time{}, nil
+	}
+	return false, start, nil
+}
@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+)
+
+var _ = Describe("MaintenanceWindow", func() {
+
+	// every saturday from 22:00 to 02:00 berlin time
+	window := &lsv1alpha1.MaintenanceWindow{
+		CronSpec: "0 22 * * 6",
+		Duration: lsv1alpha1.Duration{Duration: 4 * time.Hour},
+		TimeZone: "Europe/Berlin",
+	}
+
+	It("should detect a time within a window", func() {
+		// sunday, 00:30 berlin time
+		now := time.Date(2026, time.June, 6, 22, 30, 0, 0, time.UTC)
+		inWindow, _, err := installations.IsInMaintenanceWindow(window, now)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(inWindow).To(BeTrue())
+	})
+
+	It("should return the start of the next window for a time outside of a window", func() {
+		// sunday, 02:30 berlin time
+		now := time.Date(2026, time.June, 7, 0, 30, 0, 0, time.UTC)
+		inWindow, next, err := installations.IsInMaintenanceWindow(window, now)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(inWindow).To(BeFalse())
+		Expect(next.Equal(time.Date(2026, time.June, 13, 20, 0, 0, 0, time.UTC))).To(BeTrue())
+	})
+
+	It("should use UTC if no time zone is set", func() {
+		utcWindow := window.DeepCopy()
+		utcWindow.TimeZone = ""
+
+		now := time.Date(2026, time.June, 6, 21, 30, 0, 0, time.UTC)
+		inWindow, next, err := installations.IsInMaintenanceWindow(utcWindow, now)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(inWindow).To(BeFalse())
+		Expect(next.Equal(time.Date(2026, time.June, 6, 22, 0, 0, 0, time.UTC))).To(BeTrue())
+	})
+	It("should fail for a cron spec that never fires", func() {
+		never := window.DeepCopy()
+		never.CronSpec = "0 0 30 2 *"
+
+		_, _, err := installations.IsInMaintenanceWindow(never, time.Date(2026, time.June, 6, 22, 30, 0, 0, time.UTC))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("never fires"))
+	})
+
+	It("should fail for a cron spec with a time zone", func() {
+		withTZ := window.DeepCopy()
+		withTZ.CronSpec = "CRON_TZ=UTC 0 22 * * 6"
+
+		_, _, err := installations.IsInMaintenanceWindow(withTZ, time.Date(2026, time.June, 6, 22, 30, 0, 0, time.UTC))
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -18,6 +18,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
+	"github.com/gardener/landscaper/apis/config"
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
 	lserrors "github.com/gardener/landscaper/apis/errors"
@@ -49,6 +50,12 @@ type Operation struct {
 
 	// CurrentOperation is the name of the current operation that is used for the error reporting
 	CurrentOperation string
+
+	// DryRun defines that the operation must not persist any state, e.g. when the installation is planned.
+	DryRun bool
+
+	// TemplatePlugins are the template plugins that handle the additional template types of the blueprint executions.
+	TemplatePlugins []config.TemplatePluginConfiguration
 }
 
 // NewInstallationOperationFromOperation creates a new installation operation from an existing common operation.
@@ -117,6 +124,7 @@ func (o *Operation) JSONSchemaValidator(schema []byte) (*jsonschema.Validator, e
 		ComponentVersion:  o.ComponentVersion,
 		RegistryAccess:    o.ComponentsRegistry(),
 		RepositoryContext: o.context.External.RepositoryContext,
+		JSONSchemaVersion: o.Inst.GetBlueprint().Info.JSONSchemaVersion,
 	})
 	err := v.CompileSchema(schema)
 	if err != nil {
@@ -0,0 +1,192 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package plan
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/subinstallations"
+	"github.com/gardener/landscaper/pkg/utils/jsondiff"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// Planner computes the changes that a reconcile of an installation would apply to its deploy items and subinstallations.
+// The templates are rendered in dry run mode, so that no state is persisted.
+type Planner struct {
+	*installations.Operation
+}
+
+// New creates a new planner for the given installation operation.
+func New(op *installations.Operation) *Planner {
+	op.DryRun = true
+	return &Planner{
+		Operation: op,
+	}
+}
+
+// Plan renders the deploy items and subinstallations of the installation and compares them
+// with the currently deployed execution and subinstallations.
+// The imports of the installation have to be constructed before.
+func (p *Planner) Plan(ctx context.Context) (*lsv1alpha1.InstallationPlan, error) {
+	plannedDeployItems, err := p.planDeployItems(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	plannedSubInsts, err := p.planSubInstallations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lsv1alpha1.InstallationPlan{
+		DeployItems:      plannedDeployItems,
+		SubInstallations: plannedSubInsts,
+	}, nil
+}
+
+func (p *Planner) planDeployItems(ctx context.Context) ([]lsv1alpha1.PlannedDeployItem, error) {
+	inst := p.Inst.GetInstallation()
+
+	execTemplates, err := executions.New(p.Operation).RenderDeployItemTemplates(ctx, p.Inst)
+	if err != nil {
+		return nil, err
+	}
+
+	desired := &lsv1alpha1.Execution{}
+	if err := lsv1alpha1.Convert_core_DeployItemTemplateList_To_v1alpha1_DeployItemTemplateList(&execTemplates, &desired.Spec.DeployItems, nil); err != nil {
+		return nil, fmt.Errorf("error converting internal representation of deployitem templates to versioned one: %w", err)
+	}
+	p.Scheme().Default(desired)
+
+	current := lsv1alpha1.DeployItemTemplateList{}
+	exec, err := executions.GetExecutionForInstallation(ctx, p.LsUncachedClient(), inst)
+	if err != nil {
+		return nil, err
+	}
+	if exec != nil {
+		current = exec.Spec.DeployItems
+	}
+
+	currentByName := map[string]lsv1alpha1.DeployItemTemplate{}
+	for _, tmpl := range current {
+		currentByName[tmpl.Name] = tmpl
+	}
+
+	planned := []lsv1alpha1.PlannedDeployItem{}
+	for i := range desired.Spec.DeployItems {
+		tmpl := desired.Spec.DeployItems[i]
+		currentTmpl, ok := currentByName[tmpl.Name]
+		if !ok {
+			planned = append(planned, lsv1alpha1.PlannedDeployItem{
+				Name:     tmpl.Name,
+				Action:   lsv1alpha1.PlannedActionCreate,
+				Template: &tmpl,
+			})
+			continue
+		}
+		delete(currentByName, tmpl.Name)
+
+		changedFields, err := jsondiff.ChangedPaths(currentTmpl, tmpl)
+		if err != nil {
+			return nil, fmt.Errorf("unable to compare deploy item %q: %w", tmpl.Name, err)
+		}
+		if len(changedFields) == 0 {
+			planned = append(planned, lsv1alpha1.PlannedDeployItem{
+				Name:   tmpl.Name,
+				Action: lsv1alpha1.PlannedActionUnchanged,
+			})
+			continue
+		}
+		planned = append(planned, lsv1alpha1.PlannedDeployItem{
+			Name:          tmpl.Name,
+			Action:        lsv1alpha1.PlannedActionUpdate,
+			ChangedFields: changedFields,
+			Template:      &tmpl,
+		})
+	}
+
+	for name := range currentByName {
+		planned = append(planned, lsv1alpha1.PlannedDeployItem{
+			Name:   name,
+			Action: lsv1alpha1.PlannedActionDelete,
+		})
+	}
+
+	sort.Slice(planned, func(i, j int) bool {
+		return planned[i].Name < planned[j].Name
+	})
+	return planned, nil
+}
+
+func (p *Planner) planSubInstallations(ctx context.Context) ([]lsv1alpha1.PlannedSubInstallation, error) {
+	inst := p.Inst.GetInstallation()
+	subInstOp := subinstallations.New(p.Operation)
+
+	currentByName, err := subInstOp.GetSubInstallations(ctx, inst, inst.Status.SubInstCache, read_write_layer.R000111)
+	if err != nil {
+		return nil, err
+	}
+
+	installationTmpl, err := subInstOp.RenderInstallationTemplates()
+	if err != nil {
+		return nil, err
+	}
+
+	planned := []lsv1alpha1.PlannedSubInstallation{}
+	for _, subInstTmpl := range installationTmpl {
+		subInstSpec, err := subInstOp.SubInstallationSpec(inst, subInstTmpl)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get spec of subinstallation %q: %w", subInstTmpl.Name, err)
+		}
+		desired := &lsv1alpha1.Installation{Spec: *subInstSpec}
+		p.Scheme().Default(desired)
+
+		currentSubInst, ok := currentByName[subInstTmpl.Name]
+		if !ok {
+			planned = append(planned, lsv1alpha1.PlannedSubInstallation{
+				Name:   subInstTmpl.Name,
+				Action: lsv1alpha1.PlannedActionCreate,
+				Spec:   &desired.Spec,
+			})
+			continue
+		}
+		delete(currentByName, subInstTmpl.Name)
+
+		changedFields, err := jsondiff.ChangedPaths(currentSubInst.Spec, desired.Spec)
+		if err != nil {
+			return nil, fmt.Errorf("unable to compare subinstallation %q: %w", subInstTmpl.Name, err)
+		}
+		if len(changedFields) == 0 {
+			planned = append(planned, lsv1alpha1.PlannedSubInstallation{
+				Name:   subInstTmpl.Name,
+				Action: lsv1alpha1.PlannedActionUnchanged,
+			})
+			continue
+		}
+		planned = append(planned, lsv1alpha1.PlannedSubInstallation{
+			Name:          subInstTmpl.Name,
+			Action:        lsv1alpha1.PlannedActionUpdate,
+			ChangedFields: changedFields,
+			Spec:          &desired.Spec,
+		})
+	}
+
+	for name := range currentByName {
+		planned = append(planned, lsv1alpha1.PlannedSubInstallation{
+			Name:   name,
+			Action: lsv1alpha1.PlannedActionDelete,
+		})
+	}
+
+	sort.Slice(planned, func(i, j int) bool {
+		return planned[i].Name < planned[j].Name
+	})
+	return planned, nil
+}
@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// GetRevisionHistoryLimit returns the number of revisions that are kept for the given installation.
+func GetRevisionHistoryLimit(inst *lsv1alpha1.Installation) int {
+	if inst.Spec.RevisionHistoryLimit == nil {
+		return int(lsv1alpha1.DefaultRevisionHistoryLimit)
+	}
+	return int(*inst.Spec.RevisionHistoryLimit)
+}
+
+// RevisionName returns the name of the given revision of an installation.
+func RevisionName(inst *lsv1alpha1.Installation, revision int64) string {
+	return fmt.Sprintf("%s-%d", inst.Name, revision)
+}
+
+// ListRevisions returns the revisions of an installation sorted by ascending revision number.
+func ListRevisions(ctx context.Context, c client.Reader, inst *lsv1alpha1.Installation, readID read_write_layer.ReadID) ([]lsv1alpha1.InstallationRevision, error) {
+	revisionList := &lsv1alpha1.InstallationRevisionList{}
+	if err := read_write_layer.ListInstallationRevisions(ctx, c, revisionList, readID,
+		client.InNamespace(inst.Namespace),
+		client.MatchingLabels{lsv1alpha1.InstallationRevisionOfLabel: inst.Name}); err != nil {
+		return nil, err
+	}
+
+	revisions := revisionList.Items
+	sort.Slice(revisions, func(i, j int) bool {
+		return revisions[i].Spec.Revision < revisions[j].Spec.Revision
+	})
+	return revisions, nil
+}
+
+// GetRollbackRevision returns the revision to which the installation should be rolled back.
+// This is the revision specified by the rollback-revision annotation. If the annotation is not set, it is the
+// revision before the one of the last finished job, or the latest revision if the last finished job has no revision,
+// for example because it has failed. The revisions must be sorted by ascending revision number.
+func GetRollbackRevision(inst *lsv1alpha1.Installation, revisions []lsv1alpha1.InstallationRevision) (*lsv1alpha1.InstallationRevision, error) {
+	if value, ok := inst.GetAnnotations()[lsv1alpha1.RollbackRevisionAnnotation]; ok {
+		number, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value %q of annotation %s: %w", value, lsv1alpha1.RollbackRevisionAnnotation, err)
+		}
+		for i := range revisions {
+			if revisions[i].Spec.Revision == number {
+				return &revisions[i], nil
+			}
+		}
+		return nil, fmt.Errorf("revision %d of installation %s not found", number, inst.Name)
+	}
+
+	if len(revisions) == 0 {
+		return nil, fmt.Errorf("no revision of installation %s found", inst.Name)
+	}
+
+	latest := len(revisions) - 1
+	if revisions[latest].Spec.JobID != inst.Status.JobIDFinished {
+		return &revisions[latest], nil
+	}
+	if latest == 0 {
+		return nil, fmt.Errorf("no previous revision of installation %s found", inst.Name)
+	}
+	return &revisions[latest-1], nil
+}
@@ -21,10 +21,10 @@ import (
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
-	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/gotemplate"
-	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/spiff"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/templaters"
 	"github.com/gardener/landscaper/pkg/utils/dependencies"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+	"github.com/gardener/landscaper/pkg/utils/tracing"
 )
 
 // Ensure ensures that all referenced definitions are mapped to a sub-installation.
@@ -41,26 +41,8 @@ func (o *Operation) Ensure(ctx context.Context, subInstCache *lsv1alpha1.SubInst
 		return err
 	}
 
-	installationTmpl, err := o.getInstallationTemplates()
+	installationTmpl, err := o.RenderInstallationTemplates()
 	if err != nil {
-		err = fmt.Errorf("unable to get installation templates of blueprint: %w", err)
-		return o.NewError(err, "GetInstallationTemplates", err.Error())
-	}
-
-	for _, instT := range installationTmpl {
-		// remove imports based on optional and conditional imports which are not satisfied in the parent
-		imports := []lsv1alpha1.DataImport{}
-		for _, imp := range instT.Imports.Data {
-			_, ok := o.Inst.GetImports()[imp.DataRef]
-			if ok || !isOptionalParentImport(imp.DataRef, o.Inst.GetBlueprint().Info.Imports, false) {
-				imports = append(imports, imp)
-			}
-		}
-		instT.Imports.Data = imports
-	}
-
-	// validate all installation templates before do any follow up actions
-	if err := o.ValidateSubinstallations(installationTmpl); err != nil {
 		return err
 	}
 
@@ -86,6 +68,34 @@ func (o *Operation) Ensure(ctx context.Context, subInstCache *lsv1alpha1.SubInst
 	return o.UpdateInstallationStatus(ctx, inst, read_write_layer.W000018, cond)
 }
 
+// RenderInstallationTemplates returns the validated installation templates of all subinstallations
+// that are defined by the blueprint of the installation.
+func (o *Operation) RenderInstallationTemplates() ([]*lsv1alpha1.InstallationTemplate, error) {
+	installationTmpl, err := o.getInstallationTemplates()
+	if err != nil {
+		err = fmt.Errorf("unable to get installation templates of blueprint: %w", err)
+		return nil, o.NewError(err, "GetInstallationTemplates", err.Error())
+	}
+
+	for _, instT := range installationTmpl {
+		// remove imports based on optional and conditional imports which are not satisfied in the parent
+		imports := []lsv1alpha1.DataImport{}
+		for _, imp := range instT.Imports.Data {
+			_, ok := o.Inst.GetImports()[imp.DataRef]
+			if ok || !isOptionalParentImport(imp.DataRef, o.Inst.GetBlueprint().Info.Imports, false) {
+				imports = append(imports, imp)
+			}
+		}
+		instT.Imports.Data = imports
+	}
+
+	// validate all installation templates before do any follow up actions
+	if err := o.ValidateSubinstallations(installationTmpl); err != nil {
+		return nil, err
+	}
+	return installationTmpl, nil
+}
+
 // isOptionalParentImport returns true if the specified import data reference
 // - exists in the parents blueprint (= in the given import definition list) AND
 //   - is optional (required: false) OR
@@ -186,12 +196,14 @@ func (o *Operation) cleanupOrphanedSubInstallations(ctx context.Context,
 func (o *Operation) getInstallationTemplates() ([]*lsv1alpha1.InstallationTemplate, error) {
 	var instTmpls []*lsv1alpha1.InstallationTemplate
 	if len(o.Inst.GetBlueprint().Info.SubinstallationExecutions) != 0 {
-		templateStateHandler := template.KubernetesStateHandler{
-			KubeClient: o.LsUncachedClient(),
-			Inst:       o.Inst.GetInstallation(),
-		}
-		targetResolver := genericresolver.New(o.LsUncachedClient())
-		tmpl := template.New(gotemplate.New(templateStateHandler, targetResolver), spiff.New(templateStateHandler, targetResolver))
+		tmpl := templaters.New(templaters.Options{
+			State:          template.NewInstallationStateHandler(o.LsUncachedClient(), o.Inst.GetInstallation(), o.DryRun),
+			TargetResolver: genericresolver.New(o.LsUncachedClient()),
+			Lookups:        o.Context().External.TemplateLookups,
+			Namespace:      o.Inst.GetInstallation().Namespace,
+			Diagnostics:    template.NewInstallationDiagnosticsHandler(o.LsUncachedClient(), o.Inst.GetInstallation(), o.DryRun),
+			Plugins:        o.TemplatePlugins,
+		})
 		templatedTmpls, err := tmpl.TemplateSubinstallationExecutions(template.NewDeployExecutionOptions(
 			template.NewBlueprintExecutionOptions(
 				o.Context().External.InjectComponentDescriptorRef(o.Inst.GetInstallation().DeepCopy()),
@@ -271,11 +283,7 @@ func (o *Operation) createOrUpdateNewInstallation(ctx context.Context,
 		subInst.Namespace = inst.Namespace
 	}
 
-	subBlueprint, subCdDef, err := GetBlueprintDefinitionFromInstallationTemplate(inst,
-		subInstTmpl,
-		o.ComponentVersion,
-		o.Context().External.RepositoryContext,
-		o.Context().External.Overwriter)
+	subInstSpec, err := o.SubInstallationSpec(inst, subInstTmpl)
 	if err != nil {
 		return nil, err
 	}
@@ -288,9 +296,15 @@ func (o *Operation) createOrUpdateNewInstallation(ctx context.Context,
 		subInst.Labels = map[string]string{
 			lsv1alpha1.EncompassedByLabel: inst.Name,
 		}
+		// the capture annotation is set by users to debug the templates of a subinstallation, so it is kept
+		captureTemplateOutput := lsv1alpha1helper.HasCaptureTemplateOutputAnnotation(&subInst.ObjectMeta)
 		subInst.Annotations = map[string]string{
 			lsv1alpha1.SubinstallationNameAnnotation: subInstTmpl.Name,
 		}
+		if captureTemplateOutput {
+			metav1.SetMetaDataAnnotation(&subInst.ObjectMeta, lsv1alpha1.CaptureTemplateOutputAnnotation, "true")
+		}
+		tracing.InjectTraceContext(ctx, subInst)
 
 		lsv1alpha1helper.DeleteCacheHelmChartsAnnotation(&subInst.ObjectMeta)
 		if lsv1alpha1helper.HasCacheHelmChartsAnnotation(&inst.ObjectMeta) {
@@ -300,16 +314,7 @@ func (o *Operation) createOrUpdateNewInstallation(ctx context.Context,
 		if err := controllerutil.SetControllerReference(inst, subInst, o.Scheme()); err != nil {
 			return errors.Wrapf(err, "unable to set owner reference")
 		}
-		subInst.Spec = lsv1alpha1.InstallationSpec{
-			Context:             inst.Spec.Context,
-			ComponentDescriptor: subCdDef,
-			Blueprint:           *subBlueprint,
-			Imports:             subInstTmpl.Imports,
-			ImportDataMappings:  subInstTmpl.ImportDataMappings,
-			Exports:             subInstTmpl.Exports,
-			ExportDataMappings:  subInstTmpl.ExportDataMappings,
-			Optimization:        subInstTmpl.Optimization,
-		}
+		subInst.Spec = *subInstSpec
 
 		o.Scheme().Default(subInst)
 		return nil
@@ -325,3 +330,28 @@ func (o *Operation) createOrUpdateNewInstallation(ctx context.Context,
 
 	return subInst, nil
 }
+
+// SubInstallationSpec returns the spec of the subinstallation of the given installation
+// that is defined by the installation template.
+func (o *Operation) SubInstallationSpec(inst *lsv1alpha1.Installation,
+	subInstTmpl *lsv1alpha1.InstallationTemplate) (*lsv1alpha1.InstallationSpec, error) {
+	subBlueprint, subCdDef, err := GetBlueprintDefinitionFromInstallationTemplate(inst,
+		subInstTmpl,
+		o.ComponentVersion,
+		o.Context().External.RepositoryContext,
+		o.Context().External.Overwriter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lsv1alpha1.InstallationSpec{
+		Context:             inst.Spec.Context,
+		ComponentDescriptor: subCdDef,
+		Blueprint:           *subBlueprint,
+		Imports:             subInstTmpl.Imports,
+		ImportDataMappings:  subInstTmpl.ImportDataMappings,
+		Exports:             subInstTmpl.Exports,
+		ExportDataMappings:  subInstTmpl.ExportDataMappings,
+		Optimization:        subInstTmpl.Optimization,
+	}, nil
+}
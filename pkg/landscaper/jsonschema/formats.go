@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package jsonschema
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// Formats are the custom formats that can be used in the schemas of blueprints in addition to the formats
+// defined by the jsonschema specification, like "date-time", "hostname", "duration" or "semver".
+// The formats only validate strings, values of other types are ignored.
+var Formats = []*jsonschema.Format{
+	{Name: "quantity", Validate: stringFormat(validateQuantity)},
+	{Name: "go-duration", Validate: stringFormat(validateGoDuration)},
+	{Name: "dns1123-label", Validate: stringFormat(validateDNS1123Label)},
+	{Name: "dns1123-subdomain", Validate: stringFormat(validateDNS1123Subdomain)},
+}
+
+// stringFormat returns a format validation function that validates string values with the given function.
+func stringFormat(validate func(s string) error) func(v any) error {
+	return func(v any) error {
+		s, ok := v.(string)
+		if !ok {
+			return nil
+		}
+		return validate(s)
+	}
+}
+
+// validateQuantity validates a kubernetes resource quantity, like "500m" or "1Gi".
+func validateQuantity(s string) error {
+	_, err := resource.ParseQuantity(s)
+	return err
+}
+
+// validateGoDuration validates a duration as it is used in kubernetes resources, like "1h30m".
+func validateGoDuration(s string) error {
+	_, err := time.ParseDuration(s)
+	return err
+}
+
+// validateDNS1123Label validates a DNS label as defined in RFC 1123, like the names of most kubernetes resources.
+func validateDNS1123Label(s string) error {
+	return validationErrors(validation.IsDNS1123Label(s))
+}
+
+// validateDNS1123Subdomain validates a DNS subdomain as defined in RFC 1123.
+func validateDNS1123Subdomain(s string) error {
+	return validationErrors(validation.IsDNS1123Subdomain(s))
+}
+
+func validationErrors(errs []string) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return errors.New(strings.Join(errs, ", "))
+}
@@ -11,6 +11,8 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"strings"
@@ -104,6 +106,126 @@ var _ = Describe("jsonschema", func() {
 		Expect(jsonschema.ValidateBytes(schemaBytes, data, nil)).To(HaveOccurred())
 	})
 
+	Context("drafts", func() {
+		It("should use draft 2019-09 by default", func() {
+			schemaBytes := []byte(`{
+  "$defs": { "port": { "type": "integer" } },
+  "type": "object",
+  "properties": { "port": { "$ref": "#/$defs/port" } },
+  "unevaluatedProperties": false
+}`)
+			Expect(jsonschema.ValidateBytes(schemaBytes, []byte(`{"port": 80}`), nil)).To(Succeed())
+			err := jsonschema.ValidateBytes(schemaBytes, []byte(`{"port": 80, "other": true}`), nil)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("other"))
+		})
+
+		It("should validate dependent required properties", func() {
+			schemaBytes := []byte(`{ "type": "object", "dependentRequired": { "tls": ["certificate"] } }`)
+			Expect(jsonschema.ValidateBytes(schemaBytes, []byte(`{"tls": true, "certificate": "abc"}`), nil)).To(Succeed())
+			Expect(jsonschema.ValidateBytes(schemaBytes, []byte(`{"tls": true}`), nil)).To(HaveOccurred())
+		})
+
+		It("should use the draft defined by the schema", func() {
+			schemaBytes := []byte(`{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "type": "array",
+  "prefixItems": [ { "type": "string" } ],
+  "items": false
+}`)
+			Expect(jsonschema.ValidateBytes(schemaBytes, []byte(`["a"]`), nil)).To(Succeed())
+			Expect(jsonschema.ValidateBytes(schemaBytes, []byte(`["a", "b"]`), nil)).To(HaveOccurred())
+		})
+
+		It("should use the default draft of the reference context", func() {
+			schemaBytes := []byte(`{ "type": "integer", "exclusiveMaximum": true, "maximum": 10 }`)
+			config := &jsonschema.ReferenceContext{
+				JSONSchemaVersion: "http://json-schema.org/draft-04/schema#",
+			}
+			Expect(jsonschema.ValidateBytes(schemaBytes, []byte(`9`), config)).To(Succeed())
+			Expect(jsonschema.ValidateBytes(schemaBytes, []byte(`10`), config)).To(HaveOccurred())
+			Expect(jsonschema.ValidateBytes(schemaBytes, []byte(`9`), nil)).To(HaveOccurred())
+		})
+
+		It("should fail for unknown drafts", func() {
+			config := &jsonschema.ReferenceContext{
+				JSONSchemaVersion: "https://example.com/schema",
+			}
+			Expect(jsonschema.ValidateBytes([]byte(`{}`), []byte(`1`), config)).To(HaveOccurred())
+		})
+	})
+
+	Context("formats", func() {
+		DescribeTable("should validate formats", func(format, value string, valid bool) {
+			schemaBytes := []byte(fmt.Sprintf(`{ "type": "string", "format": %q }`, format))
+			err := jsonschema.ValidateBytes(schemaBytes, []byte(fmt.Sprintf("%q", value)), nil)
+			if valid {
+				Expect(err).ToNot(HaveOccurred())
+			} else {
+				Expect(err).To(HaveOccurred())
+			}
+		},
+			Entry("valid quantity", "quantity", "1Gi", true),
+			Entry("invalid quantity", "quantity", "1 Gi", false),
+			Entry("valid go duration", "go-duration", "1h30m", true),
+			Entry("invalid go duration", "go-duration", "P1D", false),
+			Entry("valid dns label", "dns1123-label", "my-name", true),
+			Entry("invalid dns label", "dns1123-label", "my.name", false),
+			Entry("valid dns subdomain", "dns1123-subdomain", "my.name", true),
+			Entry("invalid dns subdomain", "dns1123-subdomain", "My_Name", false),
+			Entry("valid semver", "semver", "1.2.3-rc.1", true),
+			Entry("invalid semver", "semver", "1.2", false),
+			Entry("standard format", "email", "foo", false),
+			Entry("unknown format", "unknown", "foo", true),
+		)
+
+		It("should ignore formats of values that are no strings", func() {
+			schemaBytes := []byte(`{ "format": "quantity" }`)
+			Expect(jsonschema.ValidateBytes(schemaBytes, []byte(`1`), nil)).To(Succeed())
+		})
+	})
+
+	Context("remote references", func() {
+		var server *httptest.Server
+		BeforeEach(func() {
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/string.json":
+					_, _ = w.Write([]byte(`{ "type": "string" }`))
+				case "/large.json":
+					_, _ = w.Write([]byte(`{ "description": "`))
+					_, _ = w.Write(bytes.Repeat([]byte("a"), 10<<20))
+					_, _ = w.Write([]byte(`" }`))
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+		})
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("should load a referenced schema via http", func() {
+			schemaBytes := []byte(fmt.Sprintf(`{ "$ref": "%s/string.json" }`, server.URL))
+			Expect(jsonschema.ValidateBytes(schemaBytes, []byte(`"string"`), nil)).To(Succeed())
+			Expect(jsonschema.ValidateBytes(schemaBytes, []byte(`7`), nil)).To(HaveOccurred())
+		})
+
+		It("should fail if a referenced schema exceeds the maximal size", func() {
+			schemaBytes := []byte(fmt.Sprintf(`{ "$ref": "%s/large.json" }`, server.URL))
+			err := jsonschema.ValidateBytes(schemaBytes, []byte(`"string"`), nil)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("exceeds the maximal size"))
+		})
+
+		It("should not load a referenced schema from a local file", func() {
+			schemaFile := filepath.Join(GinkgoT().TempDir(), "string.json")
+			Expect(os.WriteFile(schemaFile, []byte(`{ "type": "string" }`), 0600)).To(Succeed())
+			schemaBytes := []byte(fmt.Sprintf(`{ "$ref": "file://%s" }`, schemaFile))
+			Expect(jsonschema.ValidateBytes(schemaBytes, []byte(`"string"`), nil)).To(HaveOccurred())
+		})
+	})
+
 	Context("BlueprintReferenceTemplate", func() {
 		var config *jsonschema.ReferenceContext
 		BeforeEach(func() {
@@ -297,7 +419,7 @@ var _ = Describe("jsonschema", func() {
 			err := jsonschema.ValidateBytes(schemaBytes, data, config)
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("limits.cpu"))
-			Expect(err.Error()).To(ContainSubstring("Invalid value: 1: Invalid type. Expected: string, given: integer"))
+			Expect(err.Error()).To(ContainSubstring("Invalid value: 1: got number, want string"))
 		})
 
 		It("should fail with a schema from a blueprint file reference", func() {
@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package jsonschema
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+)
+
+const (
+	// httpLoaderTimeout is the timeout for loading a referenced schema via http.
+	httpLoaderTimeout = 30 * time.Second
+	// httpLoaderMaxSize is the maximal size in bytes of a referenced schema that is loaded via http.
+	httpLoaderMaxSize = 10 << 20
+)
+
+// newURLLoader returns the loader for references that are not resolved by the ReferenceResolver.
+// Schemas are only loaded via http(s), files of the landscaper are not accessible.
+func newURLLoader() jsonschema.URLLoader {
+	httpLoader := &httpLoader{
+		client: &http.Client{Timeout: httpLoaderTimeout},
+	}
+	return jsonschema.SchemeURLLoader{
+		"http":  httpLoader,
+		"https": httpLoader,
+	}
+}
+
+// httpLoader loads schemas via http.
+type httpLoader struct {
+	client *http.Client
+}
+
+func (l *httpLoader) Load(url string) (any, error) {
+	resp, err := l.client.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unable to load schema from %s: %s", url, resp.Status)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, httpLoaderMaxSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("unable to load schema from %s: %w", url, err)
+	}
+	if len(data) > httpLoaderMaxSize {
+		return nil, fmt.Errorf("unable to load schema from %s: schema exceeds the maximal size of %d bytes", url, httpLoaderMaxSize)
+	}
+	return jsonschema.UnmarshalJSON(bytes.NewReader(data))
+}
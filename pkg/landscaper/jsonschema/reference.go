@@ -17,7 +17,6 @@ import (
 	"github.com/gardener/landscaper/pkg/components/model/types"
 
 	"github.com/mandelsoft/vfs/pkg/vfs"
-	"github.com/xeipuuv/gojsonschema"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
@@ -25,6 +24,9 @@ import (
 	"github.com/gardener/landscaper/pkg/landscaper/registry/components/cdutils"
 )
 
+// refKey is the keyword of a reference in a json schema.
+const refKey = "$ref"
+
 // ReferenceContext describes the context of the current reference.
 type ReferenceContext struct {
 	// LocalTypes is a map of blueprint locally defined types.
@@ -39,6 +41,9 @@ type ReferenceContext struct {
 	// RepositoryContext can be used to overwrite the effective repository context of the component descriptor.
 	// If not set, the effective repository context of the ComponentDescriptor will be used.
 	RepositoryContext *types.UnstructuredTypedObject
+	// JSONSchemaVersion is the url of the jsonschema draft that is used for schemas which do not define a "$schema",
+	// e.g. the jsonSchemaVersion of the blueprint. Defaults to draft 2019-09.
+	JSONSchemaVersion string
 }
 
 type ReferenceResolver struct {
@@ -83,7 +88,7 @@ func (rr *ReferenceResolver) resolveMap(data map[string]interface{}, currentPath
 		// current map is a reference
 		sub, err := rr.resolveReference(uri, currentPath, alreadyResolved)
 		if err != nil {
-			return nil, fmt.Errorf("error resolving reference at %s: %w", currentPath.Child(gojsonschema.KEY_REF).String(), err)
+			return nil, fmt.Errorf("error resolving reference at %s: %w", currentPath.Child(refKey).String(), err)
 		}
 		return sub, nil
 	}
@@ -106,14 +111,14 @@ func (rr *ReferenceResolver) resolveMap(data map[string]interface{}, currentPath
 // If it is a reference, it returns true and the URL of the reference.
 // Otherwise, it returns false and an empty string.
 func checkForReference(data map[string]interface{}, currentPath *field.Path) (bool, string, error) {
-	value, ok := data[gojsonschema.KEY_REF]
+	value, ok := data[refKey]
 	if !ok {
 		// no reference
 		return false, "", nil
 	}
 	typedValue, ok := value.(string)
 	if !ok {
-		return true, "", fmt.Errorf("invalid reference value at %s: expected string, got %v", currentPath.Child(gojsonschema.KEY_REF).String(), value)
+		return true, "", fmt.Errorf("invalid reference value at %s: expected string, got %v", currentPath.Child(refKey).String(), value)
 	}
 	return true, typedValue, nil
 }
@@ -154,7 +159,7 @@ func (rr *ReferenceResolver) resolveReference(s string, currentPath *field.Path,
 	// unknown reference scheme
 	// rebuild reference because it is replaced in calling method
 	return map[string]interface{}{
-		gojsonschema.KEY_REF: s,
+		refKey: s,
 	}, nil
 }
 
@@ -5,15 +5,29 @@
 package jsonschema
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"strconv"
+	"strings"
 
-	"github.com/xeipuuv/gojsonschema"
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"github.com/santhosh-tekuri/jsonschema/v6/kind"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
+// schemaURL is the url under which the schema is added to the compiler.
+const schemaURL = "landscaper://schema.json"
+
+// errorPrinter prints the messages of validation errors.
+var errorPrinter = message.NewPrinter(language.English)
+
 type Validator struct {
 	Context *ReferenceContext
-	Schema  *gojsonschema.Schema
+	Schema  *jsonschema.Schema
 }
 
 // NewValidator returns a new Validator with the given reference context.
@@ -24,32 +38,30 @@ func NewValidator(context *ReferenceContext) *Validator {
 }
 
 // ValidateSchema validates a jsonschema schema definition.
+// Schemas without "$schema" are validated against the default draft 2019-09.
 func ValidateSchema(schemaBytes []byte) error {
-	_, err := gojsonschema.NewSchemaLoader().Compile(gojsonschema.NewBytesLoader(schemaBytes))
-	return err
-}
-
-// ValidateSchemaWithLoader validates a jsonschema schema definition by using the given loader.
-func ValidateSchemaWithLoader(loader gojsonschema.JSONLoader) error {
-	_, err := gojsonschema.NewSchemaLoader().Compile(loader)
+	data, err := decodeJSON(schemaBytes)
+	if err != nil {
+		return err
+	}
+	_, err = compile(data, "")
 	return err
 }
 
 func ValidateGoStruct(schemaBytes []byte, data interface{}, context *ReferenceContext) error {
-	return validate(schemaBytes, gojsonschema.NewGoLoader(data), context)
+	v := NewValidator(context)
+	if err := v.CompileSchema(schemaBytes); err != nil {
+		return err
+	}
+	return v.ValidateGoStruct(data)
 }
 
 func ValidateBytes(schemaBytes []byte, data []byte, context *ReferenceContext) error {
-	return validate(schemaBytes, gojsonschema.NewBytesLoader(data), context)
-}
-
-func validate(schemaBytes []byte, documentLoader gojsonschema.JSONLoader, context *ReferenceContext) error {
 	v := NewValidator(context)
-	err := v.CompileSchema(schemaBytes)
-	if err != nil {
+	if err := v.CompileSchema(schemaBytes); err != nil {
 		return err
 	}
-	return v.validate(documentLoader)
+	return v.ValidateBytes(data)
 }
 
 // CompileSchema compiles the given schema and sets it as schema for the validator
@@ -59,7 +71,7 @@ func (v *Validator) CompileSchema(schemaBytes []byte) error {
 	if err != nil {
 		return err
 	}
-	schema, err := gojsonschema.NewSchemaLoader().Compile(gojsonschema.NewGoLoader(resolved))
+	schema, err := compile(resolved, ref.JSONSchemaVersion)
 	if err != nil {
 		return err
 	}
@@ -68,28 +80,134 @@ func (v *Validator) CompileSchema(schemaBytes []byte) error {
 }
 
 func (v *Validator) ValidateGoStruct(data interface{}) error {
-	return v.validate(gojsonschema.NewGoLoader(data))
+	// the validator only supports the types of decoded json documents
+	dataBytes, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("unable to marshal data: %w", err)
+	}
+	return v.ValidateBytes(dataBytes)
 }
 
 func (v *Validator) ValidateBytes(data []byte) error {
-	return v.validate(gojsonschema.NewBytesLoader(data))
+	doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	return v.validate(doc)
 }
 
-func (v *Validator) validate(documentLoader gojsonschema.JSONLoader) error {
+func (v *Validator) validate(doc interface{}) error {
 	if v.Schema == nil {
 		return errors.New("internal error: schema has not been compiled")
 	}
-	res, err := v.Schema.Validate(documentLoader)
-	if err != nil {
+	err := v.Schema.Validate(doc)
+	if err == nil {
+		return nil
+	}
+	validationErr := &jsonschema.ValidationError{}
+	if !errors.As(err, &validationErr) {
 		return err
 	}
 
-	if !res.Valid() {
-		var allErrs field.ErrorList
-		for _, err := range res.Errors() {
-			allErrs = append(allErrs, field.Invalid(field.NewPath(err.Field()), err.Value(), err.Description()))
+	var allErrs field.ErrorList
+	for _, cause := range leafErrors(validationErr) {
+		allErrs = append(allErrs, field.Invalid(
+			field.NewPath(instancePath(cause.InstanceLocation)),
+			instanceValue(doc, cause.InstanceLocation),
+			cause.ErrorKind.LocalizedString(errorPrinter)))
+	}
+	return allErrs.ToAggregate()
+}
+
+// compile compiles the given decoded schema.
+// The version is the draft that is used if the schema does not define a "$schema".
+func compile(schema interface{}, version string) (*jsonschema.Schema, error) {
+	draft, err := draftForVersion(version)
+	if err != nil {
+		return nil, err
+	}
+	compiler := jsonschema.NewCompiler()
+	compiler.DefaultDraft(draft)
+	compiler.UseLoader(newURLLoader())
+	// formats are validated for all drafts, like it has been done by previous versions of the Landscaper
+	compiler.AssertFormat()
+	for _, format := range Formats {
+		compiler.RegisterFormat(format)
+	}
+	if err := compiler.AddResource(schemaURL, schema); err != nil {
+		return nil, err
+	}
+	return compiler.Compile(schemaURL)
+}
+
+// leafErrors returns the errors of a validation error that have no further causes.
+// These are the errors that describe the actual violations of the schema.
+func leafErrors(err *jsonschema.ValidationError) []*jsonschema.ValidationError {
+	if len(err.Causes) == 0 {
+		if _, ok := err.ErrorKind.(*kind.Schema); ok {
+			return nil
 		}
-		return allErrs.ToAggregate()
+		return []*jsonschema.ValidationError{err}
 	}
-	return nil
+	var res []*jsonschema.ValidationError
+	for _, cause := range err.Causes {
+		res = append(res, leafErrors(cause)...)
+	}
+	return res
+}
+
+// instancePath returns the path of a value in the validated document as dot separated string.
+func instancePath(location []string) string {
+	if len(location) == 0 {
+		return "(root)"
+	}
+	return strings.Join(location, ".")
+}
+
+// instanceValue returns the value at the given location of the validated document.
+func instanceValue(doc interface{}, location []string) interface{} {
+	current := doc
+	for _, token := range location {
+		switch typed := current.(type) {
+		case map[string]interface{}:
+			current = typed[token]
+		case []interface{}:
+			i, err := strconv.Atoi(token)
+			if err != nil || i < 0 || i >= len(typed) {
+				return nil
+			}
+			current = typed[i]
+		default:
+			return nil
+		}
+	}
+	// numbers are decoded as json.Number which would be printed as string
+	if number, ok := current.(json.Number); ok {
+		if i, err := number.Int64(); err == nil {
+			return i
+		}
+		if f, err := number.Float64(); err == nil {
+			return f
+		}
+	}
+	return current
+}
+
+// draftForVersion returns the draft for the url of a jsonschema version, like "https://json-schema.org/draft/2019-09/schema".
+// Draft 2019-09 is returned if no version is given.
+func draftForVersion(version string) (*jsonschema.Draft, error) {
+	if len(version) == 0 {
+		return jsonschema.Draft2019, nil
+	}
+	normalize := func(url string) string {
+		url = strings.TrimPrefix(url, "http://")
+		url = strings.TrimPrefix(url, "https://")
+		return strings.TrimSuffix(url, "#")
+	}
+	for _, draft := range []*jsonschema.Draft{jsonschema.Draft4, jsonschema.Draft6, jsonschema.Draft7, jsonschema.Draft2019, jsonschema.Draft2020} {
+		if normalize(draft.String()) == normalize(version) {
+			return draft, nil
+		}
+	}
+	return nil, fmt.Errorf("unsupported jsonschema version %q", version)
 }
@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+)
+
+const (
+	landscaperNamespaceName = "landscaper"
+
+	// KindInstallation is the value of the kind label for installations.
+	KindInstallation = "Installation"
+	// KindExecution is the value of the kind label for executions.
+	KindExecution = "Execution"
+	// KindDeployItem is the value of the kind label for deploy items.
+	KindDeployItem = "DeployItem"
+
+	// TimeoutTypePickup is the value of the type label for deploy items that have not been picked up by a deployer in time.
+	TimeoutTypePickup = "pickup"
+	// TimeoutTypeProgressing is the value of the type label for deploy items that have not been processed in time.
+	TimeoutTypeProgressing = "progressing"
+
+	// TemplateTypeImports is the value of the type label for import executions.
+	TemplateTypeImports = "imports"
+	// TemplateTypeSubinstallations is the value of the type label for subinstallation executions.
+	TemplateTypeSubinstallations = "subinstallations"
+	// TemplateTypeDeployItems is the value of the type label for deploy executions.
+	TemplateTypeDeployItems = "deployItems"
+	// TemplateTypeExports is the value of the type label for export executions.
+	TemplateTypeExports = "exports"
+
+	noErrorCode = "none"
+)
+
+var (
+	// ObjectPhases discloses the number of installations, executions and deploy items per namespace and phase.
+	ObjectPhases = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: landscaperNamespaceName,
+			Name:      "objects",
+			Help:      "Number of installations, executions and deploy items per namespace and phase.",
+		},
+		[]string{"kind", "namespace", "phase"},
+	)
+
+	// JobDuration discloses the duration from the trigger of a job until the object is in a final phase.
+	JobDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: landscaperNamespaceName,
+			Name:      "job_duration_seconds",
+			Help:      "Duration from the trigger of a job until the object reached a final phase.",
+			Buckets:   []float64{1, 5, 10, 30, 60, 120, 300, 600, 1200, 1800, 3600},
+		},
+		[]string{"kind", "phase"},
+	)
+
+	// JobPickupDuration discloses the duration from the trigger of a job until the responsible controller starts processing it.
+	JobPickupDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: landscaperNamespaceName,
+			Name:      "job_pickup_duration_seconds",
+			Help:      "Duration from the trigger of a job until the responsible controller started processing it.",
+			Buckets:   []float64{0.1, 0.5, 1, 5, 10, 30, 60, 120, 300},
+		},
+		[]string{"kind"},
+	)
+
+	// Errors discloses the number of reconciliations that failed, by error code.
+	Errors = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: landscaperNamespaceName,
+			Name:      "errors_total",
+			Help:      "Total number of failed reconciliations by kind and error code.",
+		},
+		[]string{"kind", "code"},
+	)
+
+	// Timeouts discloses the number of deploy items that exceeded the pickup or progressing timeout.
+	Timeouts = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: landscaperNamespaceName,
+			Name:      "deployitem_timeouts_total",
+			Help:      "Total number of deploy items that exceeded the pickup or progressing timeout.",
+		},
+		[]string{"type"},
+	)
+
+	// TemplateRenderingDuration discloses the duration of the rendering of templates.
+	TemplateRenderingDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: landscaperNamespaceName,
+			Name:      "template_rendering_duration_seconds",
+			Help:      "Duration of the rendering of deploy item, subinstallation, import and export templates.",
+			Buckets:   prometheus.ExponentialBuckets(0.001, 4, 9),
+		},
+		[]string{"type", "templater"},
+	)
+)
+
+// RegisterLifecycleMetrics allows to register the metrics of the lifecycle of installations, executions and deploy items.
+func RegisterLifecycleMetrics(reg prometheus.Registerer) {
+	reg.MustRegister(ObjectPhases)
+	reg.MustRegister(JobDuration)
+	reg.MustRegister(JobPickupDuration)
+	reg.MustRegister(Errors)
+	reg.MustRegister(Timeouts)
+	reg.MustRegister(TemplateRenderingDuration)
+}
+
+// ObserveFinishedJob records the durations of a job that has reached the given final phase.
+func ObserveFinishedJob(kind, phase string, transitionTimes *lsv1alpha1.TransitionTimes) {
+	if transitionTimes == nil || transitionTimes.TriggerTime == nil {
+		return
+	}
+	if transitionTimes.FinishedTime != nil {
+		JobDuration.WithLabelValues(kind, phase).Observe(transitionTimes.FinishedTime.Sub(transitionTimes.TriggerTime.Time).Seconds())
+	}
+	if transitionTimes.InitTime != nil {
+		JobPickupDuration.WithLabelValues(kind).Observe(transitionTimes.InitTime.Sub(transitionTimes.TriggerTime.Time).Seconds())
+	}
+}
+
+// ObserveError counts a failed reconciliation for each error code of the error.
+func ObserveError(kind string, err lserrors.LsError) {
+	if err == nil {
+		return
+	}
+	lsErr := err.LandscaperError()
+	if lsErr == nil || len(lsErr.Codes) == 0 {
+		Errors.WithLabelValues(kind, noErrorCode).Inc()
+		return
+	}
+	for _, code := range lsErr.Codes {
+		Errors.WithLabelValues(kind, string(code)).Inc()
+	}
+}
+
+// ObserveTimeout counts a deploy item that exceeded the timeout of the given type.
+func ObserveTimeout(timeoutType string) {
+	Timeouts.WithLabelValues(timeoutType).Inc()
+}
+
+// ObserveTemplateRendering records the duration of a template rendering that was started at the given time.
+func ObserveTemplateRendering(templateType, templater string, start time.Time) {
+	TemplateRenderingDuration.WithLabelValues(templateType, templater).Observe(time.Since(start).Seconds())
+}
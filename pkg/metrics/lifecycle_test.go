@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/metrics"
+)
+
+var _ = Describe("Lifecycle Metrics", func() {
+
+	It("should count errors by their error codes", func() {
+		metrics.Errors.Reset()
+
+		metrics.ObserveError(metrics.KindInstallation, lserrors.NewError("op", "reason", "msg", lsv1alpha1.ErrorTimeout, lsv1alpha1.ErrorConfigurationProblem))
+		metrics.ObserveError(metrics.KindInstallation, lserrors.NewError("op", "reason", "msg"))
+		metrics.ObserveError(metrics.KindInstallation, nil)
+
+		Expect(testutil.ToFloat64(metrics.Errors.WithLabelValues(metrics.KindInstallation, string(lsv1alpha1.ErrorTimeout)))).To(Equal(1.0))
+		Expect(testutil.ToFloat64(metrics.Errors.WithLabelValues(metrics.KindInstallation, string(lsv1alpha1.ErrorConfigurationProblem)))).To(Equal(1.0))
+		Expect(testutil.ToFloat64(metrics.Errors.WithLabelValues(metrics.KindInstallation, "none"))).To(Equal(1.0))
+	})
+
+	It("should observe the durations of finished jobs", func() {
+		metrics.JobDuration.Reset()
+		metrics.JobPickupDuration.Reset()
+
+		now := time.Now()
+		metrics.ObserveFinishedJob(metrics.KindExecution, string(lsv1alpha1.ExecutionPhases.Succeeded), &lsv1alpha1.TransitionTimes{
+			TriggerTime:  &metav1.Time{Time: now.Add(-time.Minute)},
+			InitTime:     &metav1.Time{Time: now.Add(-50 * time.Second)},
+			FinishedTime: &metav1.Time{Time: now},
+		})
+		metrics.ObserveFinishedJob(metrics.KindExecution, string(lsv1alpha1.ExecutionPhases.Succeeded), nil)
+
+		Expect(testutil.CollectAndCount(metrics.JobDuration)).To(Equal(1))
+		Expect(testutil.CollectAndCount(metrics.JobPickupDuration)).To(Equal(1))
+	})
+
+	It("should collect the phases of installations, executions and deploy items", func() {
+		ctx := context.Background()
+		inst1 := &lsv1alpha1.Installation{ObjectMeta: metav1.ObjectMeta{Name: "inst1", Namespace: "a"}}
+		inst1.Status.InstallationPhase = lsv1alpha1.InstallationPhases.Succeeded
+		inst2 := &lsv1alpha1.Installation{ObjectMeta: metav1.ObjectMeta{Name: "inst2", Namespace: "a"}}
+		inst2.Status.InstallationPhase = lsv1alpha1.InstallationPhases.Succeeded
+		exec := &lsv1alpha1.Execution{ObjectMeta: metav1.ObjectMeta{Name: "exec", Namespace: "b"}}
+		exec.Status.ExecutionPhase = lsv1alpha1.ExecutionPhases.Failed
+		di := &lsv1alpha1.DeployItem{ObjectMeta: metav1.ObjectMeta{Name: "di", Namespace: "b"}}
+		di.Status.Phase = lsv1alpha1.DeployItemPhases.Progressing
+
+		kubeClient := fake.NewClientBuilder().WithScheme(api.LandscaperScheme).WithObjects(inst1, inst2, exec, di).Build()
+		Expect(metrics.CollectObjectPhases(ctx, kubeClient)).To(Succeed())
+
+		Expect(testutil.ToFloat64(metrics.ObjectPhases.WithLabelValues(metrics.KindInstallation, "a", string(lsv1alpha1.InstallationPhases.Succeeded)))).To(Equal(2.0))
+		Expect(testutil.ToFloat64(metrics.ObjectPhases.WithLabelValues(metrics.KindExecution, "b", string(lsv1alpha1.ExecutionPhases.Failed)))).To(Equal(1.0))
+		Expect(testutil.ToFloat64(metrics.ObjectPhases.WithLabelValues(metrics.KindDeployItem, "b", string(lsv1alpha1.DeployItemPhases.Progressing)))).To(Equal(1.0))
+	})
+
+})
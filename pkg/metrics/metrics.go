@@ -18,4 +18,5 @@ import (
 // RegisterMetrics allows to register all landscaper exposed metrics
 func RegisterMetrics(reg prometheus.Registerer) {
 	componentcliMetrics.RegisterCacheMetrics(reg)
+	RegisterLifecycleMetrics(reg)
 }
@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// listPageSize is the maximal number of objects that are fetched with one list request.
+const listPageSize = 500
+
+// phaseKey identifies a time series of the object phase metric.
+type phaseKey struct {
+	kind      string
+	namespace string
+	phase     string
+}
+
+// CollectObjectPhasesPeriodically updates the object phase metric in the given interval until the context is cancelled.
+func CollectObjectPhasesPeriodically(ctx context.Context, lsUncachedClient client.Client, interval time.Duration) {
+	log, ctx := logging.FromContextOrNew(ctx, nil)
+
+	log.Info("Starting object phase metrics loop", "interval", interval.String())
+	for {
+		if err := CollectObjectPhases(ctx, lsUncachedClient); err != nil {
+			log.Error(err, "unable to collect object phase metrics")
+		}
+
+		select {
+		case <-ctx.Done():
+			log.Info("Object phase metrics loop was cancelled")
+			return
+		case <-time.After(interval):
+		}
+	}
+}
+
+// CollectObjectPhases counts the installations, executions and deploy items per namespace and phase
+// and updates the object phase metric.
+func CollectObjectPhases(ctx context.Context, lsUncachedClient client.Client) error {
+	counts := map[phaseKey]float64{}
+
+	var cont string
+	for {
+		list := &lsv1alpha1.InstallationList{}
+		if err := read_write_layer.ListInstallations(ctx, lsUncachedClient, list, read_write_layer.R000113,
+			client.Limit(listPageSize), client.Continue(cont)); err != nil {
+			return err
+		}
+		for _, inst := range list.Items {
+			counts[phaseKey{kind: KindInstallation, namespace: inst.Namespace, phase: string(inst.Status.InstallationPhase)}]++
+		}
+		if cont = list.Continue; len(cont) == 0 {
+			break
+		}
+	}
+
+	for {
+		list := &lsv1alpha1.ExecutionList{}
+		if err := read_write_layer.ListExecutions(ctx, lsUncachedClient, list, read_write_layer.R000114,
+			client.Limit(listPageSize), client.Continue(cont)); err != nil {
+			return err
+		}
+		for _, exec := range list.Items {
+			counts[phaseKey{kind: KindExecution, namespace: exec.Namespace, phase: string(exec.Status.ExecutionPhase)}]++
+		}
+		if cont = list.Continue; len(cont) == 0 {
+			break
+		}
+	}
+
+	for {
+		list := &lsv1alpha1.DeployItemList{}
+		if err := read_write_layer.ListDeployItems(ctx, lsUncachedClient, list, read_write_layer.R000115,
+			client.Limit(listPageSize), client.Continue(cont)); err != nil {
+			return err
+		}
+		for _, di := range list.Items {
+			counts[phaseKey{kind: KindDeployItem, namespace: di.Namespace, phase: string(di.Status.Phase)}]++
+		}
+		if cont = list.Continue; len(cont) == 0 {
+			break
+		}
+	}
+
+	// reset the metric, so that phases without objects are removed
+	ObjectPhases.Reset()
+	for key, count := range counts {
+		ObjectPhases.WithLabelValues(key.kind, key.namespace, key.phase).Set(count)
+	}
+	return nil
+}
@@ -0,0 +1,172 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package blueprintdoc_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/mandelsoft/vfs/pkg/osfs"
+	"github.com/mandelsoft/vfs/pkg/projectionfs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/landscaper/pkg/utils/blueprintdoc"
+	"github.com/gardener/landscaper/pkg/utils/blueprints"
+)
+
+func TestConfig(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Blueprint Doc Test Suite")
+}
+
+// readBlueprint reads a blueprint from the testdata directory.
+func readBlueprint(name string) *blueprints.Blueprint {
+	fs, err := projectionfs.New(osfs.New(), "./testdata/"+name)
+	Expect(err).ToNot(HaveOccurred())
+	bp, err := blueprints.NewFromFs(fs)
+	Expect(err).ToNot(HaveOccurred())
+	return bp
+}
+
+// testdataResolver resolves the blueprint references of the testdata blueprints.
+type testdataResolver map[string]string
+
+func (r testdataResolver) ResolveBlueprint(_ context.Context, ref string) (*blueprints.Blueprint, blueprintdoc.BlueprintResolver, error) {
+	name, ok := r[ref]
+	if !ok {
+		return nil, nil, fmt.Errorf("component reference %q not found", ref)
+	}
+	return readBlueprint(name), r, nil
+}
+
+var _ = Describe("Generate", func() {
+
+	var resolver = testdataResolver{
+		"cd://componentReferences/dns/resources/blueprint": "dns",
+	}
+
+	generate := func(opts blueprintdoc.Options) *blueprintdoc.Document {
+		doc, err := blueprintdoc.Generate(context.Background(), readBlueprint("root"), opts)
+		Expect(err).ToNot(HaveOccurred())
+		return doc
+	}
+
+	It("should document the imports and exports of a blueprint", func() {
+		doc := generate(blueprintdoc.Options{Title: "root", Resolver: resolver})
+		Expect(doc.Title).To(Equal("root"))
+		Expect(doc.Annotations).To(HaveKeyWithValue("owner", "platform-team"))
+
+		Expect(doc.Imports).To(HaveLen(5))
+		Expect(doc.Imports[0]).To(Equal(blueprintdoc.Field{
+			Name:       "cluster",
+			Type:       "target",
+			TargetType: "landscaper.gardener.cloud/kubernetes-cluster",
+			Required:   true,
+		}))
+		Expect(doc.Imports[1].Type).To(Equal("data"))
+		Expect(doc.Imports[1].Schema.Type).To(Equal("endpoint"))
+		Expect(doc.Imports[1].Schema.Description).To(Equal("Endpoint: address of a service"))
+		Expect(doc.Imports[1].Schema.Properties).To(Equal([]blueprintdoc.Property{
+			{Name: "host", Type: "string", Required: true, Description: "host name of the service"},
+			{Name: "port", Type: "integer", Default: "443"},
+		}))
+		Expect(doc.Imports[2].Required).To(BeFalse())
+		Expect(doc.Imports[2].Default).To(Equal("3"))
+		Expect(doc.Imports[4].Name).To(Equal("retention"))
+		Expect(doc.Imports[4].ConditionalOn).To(Equal("monitoring"))
+		Expect(doc.Imports[4].Schema.Type).To(Equal(`enum "7d", "30d"`))
+
+		Expect(doc.Exports).To(HaveLen(1))
+		Expect(doc.Exports[0].Schema.Description).To(Equal("url of the service"))
+
+		Expect(doc.LocalTypes).To(HaveLen(1))
+		Expect(doc.LocalTypes[0].Name).To(Equal("endpoint"))
+		Expect(doc.DeployExecutions).To(ConsistOf("deploy"))
+	})
+
+	It("should document the subinstallation tree", func() {
+		doc := generate(blueprintdoc.Options{Resolver: resolver})
+		Expect(doc.Subinstallations).To(HaveLen(2))
+
+		dns := doc.Subinstallations[0]
+		Expect(dns.Blueprint).To(Equal("cd://componentReferences/dns/resources/blueprint"))
+		Expect(dns.Imports).To(Equal([]blueprintdoc.Binding{
+			{Name: "host", Source: "endpoint"},
+			{Name: "cluster", Source: "cluster"},
+		}))
+		Expect(dns.Exports).To(Equal([]blueprintdoc.Binding{{Name: "zone", Source: "zone"}}))
+		Expect(dns.Error).To(BeEmpty())
+		Expect(dns.Document.Imports).To(HaveLen(2))
+		Expect(dns.Document.Subinstallations).To(HaveLen(1))
+		Expect(dns.Document.Subinstallations[0].Error).To(ContainSubstring("not found"))
+
+		config := doc.Subinstallations[1]
+		Expect(config.Blueprint).To(Equal("inline"))
+		Expect(config.Document.Imports[0].Name).To(Equal("replicas"))
+	})
+
+	It("should not resolve blueprint references without a resolver", func() {
+		doc := generate(blueprintdoc.Options{})
+		Expect(doc.Subinstallations[0].Document).To(BeNil())
+		Expect(doc.Subinstallations[0].Error).To(ContainSubstring("no component version"))
+		Expect(doc.Subinstallations[1].Document).ToNot(BeNil())
+	})
+
+	It("should stop at the maximal depth", func() {
+		doc := generate(blueprintdoc.Options{Resolver: resolver, MaxDepth: 2})
+		Expect(doc.Subinstallations[0].Document).ToNot(BeNil())
+		Expect(doc.Subinstallations[0].Document.Subinstallations[0].Error).To(Equal("maximal depth reached"))
+	})
+
+})
+
+var _ = Describe("Write", func() {
+
+	var doc *blueprintdoc.Document
+
+	BeforeEach(func() {
+		var err error
+		doc, err = blueprintdoc.Generate(context.Background(), readBlueprint("root"), blueprintdoc.Options{
+			Title:    "My Blueprint",
+			Resolver: testdataResolver{"cd://componentReferences/dns/resources/blueprint": "dns"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should write markdown", func() {
+		buf := &bytes.Buffer{}
+		Expect(doc.Write(buf, blueprintdoc.FormatMarkdown)).To(Succeed())
+		out := buf.String()
+		Expect(out).To(HavePrefix("# My Blueprint\n"))
+		Expect(out).To(ContainSubstring("| `cluster` | target | `landscaper.gardener.cloud/kubernetes-cluster` | yes |  |  |"))
+		Expect(out).To(ContainSubstring("| `replicas` | data (integer) |  | no | `3` | number of replicas \\| pods |"))
+		Expect(out).To(ContainSubstring("| `retention` (if `monitoring` is set) |"))
+		Expect(out).To(ContainSubstring("| `host` | string | yes |  | host name of the service |"))
+		Expect(out).To(ContainSubstring("- **dns** (`cd://componentReferences/dns/resources/blueprint`), see [dns](#subinstallation-dns)\n"))
+		Expect(out).To(ContainSubstring("  - imports: `host` ← `endpoint`, `cluster` ← `cluster`\n"))
+		Expect(out).To(ContainSubstring("  - **records** (`cd://componentReferences/records/resources/blueprint`): _not documented: "))
+		Expect(out).To(ContainSubstring("\n# Subinstallation dns\n"))
+		Expect(out).To(ContainSubstring("\n# Subinstallation config\n"))
+	})
+
+	It("should write html", func() {
+		buf := &bytes.Buffer{}
+		Expect(doc.Write(buf, blueprintdoc.FormatHTML)).To(Succeed())
+		out := buf.String()
+		Expect(out).To(HavePrefix("<!DOCTYPE html>"))
+		Expect(out).To(ContainSubstring("<title>My Blueprint</title>"))
+		Expect(out).To(ContainSubstring(`<section id="subinstallation-dns">`))
+		Expect(out).To(ContainSubstring(`<a href="#subinstallation-dns">dns</a>`))
+		Expect(out).To(ContainSubstring("<td>number of replicas | pods</td>"))
+	})
+
+	It("should fail for unknown formats", func() {
+		Expect(doc.Write(&bytes.Buffer{}, "pdf")).ToNot(Succeed())
+	})
+
+})
@@ -0,0 +1,446 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package blueprintdoc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/mandelsoft/vfs/pkg/memoryfs"
+	"github.com/mandelsoft/vfs/pkg/yamlfs"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/utils"
+	"github.com/gardener/landscaper/pkg/utils/blueprints"
+)
+
+// DefaultMaxDepth is the default maximal depth of the documented subinstallation tree.
+const DefaultMaxDepth = 10
+
+// BlueprintResolver resolves the blueprints of subinstallations that are referenced by a component descriptor uri,
+// like "cd://componentReferences/dns/resources/blueprint".
+type BlueprintResolver interface {
+	// ResolveBlueprint returns the referenced blueprint and the resolver for the references of that blueprint.
+	ResolveBlueprint(ctx context.Context, ref string) (*blueprints.Blueprint, BlueprintResolver, error)
+}
+
+// Options are the options of the documentation generator.
+type Options struct {
+	// Title is the title of the documentation, e.g. the name of the blueprint.
+	Title string
+	// Resolver resolves the blueprints of subinstallations that are referenced by component descriptor uris.
+	// If no resolver is given, these blueprints are not documented.
+	Resolver BlueprintResolver
+	// MaxDepth is the maximal depth of the documented subinstallation tree.
+	// Defaults to DefaultMaxDepth.
+	MaxDepth int
+}
+
+// Document is the documentation of a blueprint.
+type Document struct {
+	// Title is the title of the documentation.
+	Title string `json:"title"`
+	// Annotations are the annotations of the blueprint.
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// JSONSchemaVersion is the default jsonschema version of the blueprint.
+	JSONSchemaVersion string `json:"jsonSchemaVersion,omitempty"`
+	// Imports are the imports of the blueprint.
+	// Conditional imports follow the import they depend on.
+	Imports []Field `json:"imports,omitempty"`
+	// Exports are the exports of the blueprint.
+	Exports []Field `json:"exports,omitempty"`
+	// LocalTypes are the local types of the blueprint sorted by name.
+	LocalTypes []Type `json:"localTypes,omitempty"`
+	// Subinstallations are the subinstallations that are statically defined by the blueprint.
+	Subinstallations []Subinstallation `json:"subinstallations,omitempty"`
+	// SubinstallationExecutions are the names of the executions that render further subinstallations.
+	SubinstallationExecutions []string `json:"subinstallationExecutions,omitempty"`
+	// DeployExecutions are the names of the executions that render the deploy items of the blueprint.
+	DeployExecutions []string `json:"deployExecutions,omitempty"`
+}
+
+// Field describes an import or an export of a blueprint.
+type Field struct {
+	// Name is the name of the import or export.
+	Name string `json:"name"`
+	// Type is the type of the import or export, e.g. "data" or "target".
+	Type string `json:"type"`
+	// TargetType is the type of imported or exported targets.
+	TargetType string `json:"targetType,omitempty"`
+	// Required defines whether an import is required. Exports are never required.
+	Required bool `json:"required"`
+	// ConditionalOn is the name of the import that has to be satisfied for a conditional import.
+	ConditionalOn string `json:"conditionalOn,omitempty"`
+	// Default is the default value of an import as JSON.
+	Default string `json:"default,omitempty"`
+	// Schema describes the jsonschema of a data import or export.
+	Schema *Type `json:"schema,omitempty"`
+}
+
+// Type describes a jsonschema.
+type Type struct {
+	// Name is the name of a local type.
+	Name string `json:"name,omitempty"`
+	// Type is a short description of the type, e.g. "string", "array of string" or the name of a referenced type.
+	Type string `json:"type,omitempty"`
+	// Description is the title and the description of the schema.
+	Description string `json:"description,omitempty"`
+	// Properties are the properties of an object schema sorted by name.
+	Properties []Property `json:"properties,omitempty"`
+}
+
+// Property describes a property of an object schema.
+type Property struct {
+	// Name is the name of the property.
+	Name string `json:"name"`
+	// Type is a short description of the type of the property.
+	Type string `json:"type,omitempty"`
+	// Required defines whether the property is required.
+	Required bool `json:"required"`
+	// Default is the default value of the property as JSON.
+	Default string `json:"default,omitempty"`
+	// Description is the title and the description of the property.
+	Description string `json:"description,omitempty"`
+}
+
+// Subinstallation describes a subinstallation of a blueprint.
+type Subinstallation struct {
+	// Name is the name of the subinstallation.
+	Name string `json:"name"`
+	// Blueprint is the reference of the blueprint of the subinstallation, or "inline".
+	Blueprint string `json:"blueprint"`
+	// Imports describes where the imports of the subinstallation come from.
+	Imports []Binding `json:"imports,omitempty"`
+	// Exports describes where the exports of the subinstallation go to.
+	Exports []Binding `json:"exports,omitempty"`
+	// Document is the documentation of the blueprint of the subinstallation.
+	// It is nil if the blueprint could not be resolved.
+	Document *Document `json:"document,omitempty"`
+	// Error describes why the blueprint of the subinstallation has not been documented.
+	Error string `json:"error,omitempty"`
+}
+
+// Binding maps an import or an export of a subinstallation to a data object or target of the parent.
+type Binding struct {
+	// Name is the name of the import or export of the subinstallation.
+	Name string `json:"name"`
+	// Source is the data object or target of the parent installation.
+	Source string `json:"source"`
+}
+
+// Generate generates the documentation of a blueprint.
+func Generate(ctx context.Context, blueprint *blueprints.Blueprint, opts Options) (*Document, error) {
+	if opts.MaxDepth <= 0 {
+		opts.MaxDepth = DefaultMaxDepth
+	}
+	return generate(ctx, blueprint, opts.Title, opts.Resolver, opts.MaxDepth)
+}
+
+func generate(ctx context.Context, blueprint *blueprints.Blueprint, title string, resolver BlueprintResolver, depth int) (*Document, error) {
+	info := blueprint.Info
+	doc := &Document{
+		Title:             title,
+		Annotations:       info.Annotations,
+		JSONSchemaVersion: info.JSONSchemaVersion,
+	}
+
+	for name, def := range info.LocalTypes {
+		t := &Type{}
+		if described := newType(&def, info.LocalTypes); described != nil {
+			t = described
+		}
+		t.Name = name
+		doc.LocalTypes = append(doc.LocalTypes, *t)
+	}
+	sort.Slice(doc.LocalTypes, func(i, j int) bool {
+		return doc.LocalTypes[i].Name < doc.LocalTypes[j].Name
+	})
+
+	doc.Imports = importFields(info.Imports, "", info.LocalTypes)
+	for _, exp := range info.Exports {
+		doc.Exports = append(doc.Exports, Field{
+			Name:       exp.Name,
+			Type:       fieldType(string(exp.Type), exp.FieldValueDefinition),
+			TargetType: exp.TargetType,
+			Schema:     newType(exp.Schema, info.LocalTypes),
+		})
+	}
+
+	for _, exec := range info.SubinstallationExecutions {
+		doc.SubinstallationExecutions = append(doc.SubinstallationExecutions, exec.Name)
+	}
+	for _, exec := range info.DeployExecutions {
+		doc.DeployExecutions = append(doc.DeployExecutions, exec.Name)
+	}
+
+	templates, err := blueprint.GetSubinstallations()
+	if err != nil {
+		return nil, fmt.Errorf("unable to get subinstallations: %w", err)
+	}
+	for _, tmpl := range templates {
+		doc.Subinstallations = append(doc.Subinstallations, subinstallation(ctx, tmpl, resolver, depth))
+	}
+	return doc, nil
+}
+
+// importFields returns the fields of the imports and their conditional imports.
+func importFields(imports lsv1alpha1.ImportDefinitionList, conditionalOn string, localTypes map[string]lsv1alpha1.JSONSchemaDefinition) []Field {
+	var fields []Field
+	for _, imp := range imports {
+		field := Field{
+			Name:          imp.Name,
+			Type:          fieldType(string(imp.Type), imp.FieldValueDefinition),
+			TargetType:    imp.TargetType,
+			Required:      imp.Required == nil || *imp.Required,
+			ConditionalOn: conditionalOn,
+			Default:       compactJSON(imp.Default.Value.RawMessage),
+			Schema:        newType(imp.Schema, localTypes),
+		}
+		fields = append(fields, field)
+		fields = append(fields, importFields(imp.ConditionalImports, imp.Name, localTypes)...)
+	}
+	return fields
+}
+
+// fieldType returns the type of an import or export.
+// The type of fields without an explicit type is derived from their schema or target type.
+func fieldType(explicitType string, def lsv1alpha1.FieldValueDefinition) string {
+	switch {
+	case len(explicitType) != 0:
+		return explicitType
+	case def.Schema != nil:
+		return string(lsv1alpha1.ImportTypeData)
+	case len(def.TargetType) != 0:
+		return string(lsv1alpha1.ImportTypeTarget)
+	}
+	return ""
+}
+
+// subinstallation documents a subinstallation template including the blueprint of the subinstallation.
+func subinstallation(ctx context.Context, tmpl *lsv1alpha1.InstallationTemplate, resolver BlueprintResolver, depth int) Subinstallation {
+	sub := Subinstallation{
+		Name:      tmpl.Name,
+		Blueprint: tmpl.Blueprint.Ref,
+	}
+	for _, imp := range tmpl.Imports.Data {
+		sub.Imports = append(sub.Imports, Binding{Name: imp.Name, Source: dataImportSource(imp)})
+	}
+	for _, imp := range tmpl.Imports.Targets {
+		sub.Imports = append(sub.Imports, Binding{Name: imp.Name, Source: targetImportSource(imp)})
+	}
+	for _, exp := range tmpl.Exports.Data {
+		sub.Exports = append(sub.Exports, Binding{Name: exp.Name, Source: exp.DataRef})
+	}
+	for _, exp := range tmpl.Exports.Targets {
+		sub.Exports = append(sub.Exports, Binding{Name: exp.Name, Source: exp.Target})
+	}
+
+	if depth <= 1 {
+		sub.Error = "maximal depth reached"
+		return sub
+	}
+
+	var (
+		blueprint   *blueprints.Blueprint
+		subResolver BlueprintResolver
+		err         error
+	)
+	switch {
+	case len(tmpl.Blueprint.Filesystem.RawMessage) != 0:
+		sub.Blueprint = "inline"
+		// inline blueprints are part of the component of the parent blueprint
+		subResolver = resolver
+		blueprint, err = inlineBlueprint(tmpl.Blueprint.Filesystem)
+	case len(tmpl.Blueprint.Ref) == 0:
+		err = fmt.Errorf("no blueprint defined")
+	case resolver == nil:
+		err = fmt.Errorf("no component version given to resolve the blueprint reference")
+	default:
+		blueprint, subResolver, err = resolver.ResolveBlueprint(ctx, tmpl.Blueprint.Ref)
+	}
+	if err == nil {
+		sub.Document, err = generate(ctx, blueprint, tmpl.Name, subResolver, depth-1)
+	}
+	if err != nil {
+		sub.Error = err.Error()
+	}
+	return sub
+}
+
+// inlineBlueprint reads a blueprint that is defined as yaml filesystem.
+func inlineBlueprint(filesystem lsv1alpha1.AnyJSON) (*blueprints.Blueprint, error) {
+	inlineFs, err := yamlfs.New(filesystem.RawMessage)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create yamlfs for inline blueprint: %w", err)
+	}
+	fs := memoryfs.New()
+	if err := utils.CopyFS(inlineFs, fs, "/", "/"); err != nil {
+		return nil, fmt.Errorf("unable to copy yaml filesystem: %w", err)
+	}
+	return blueprints.NewFromFs(fs)
+}
+
+func dataImportSource(imp lsv1alpha1.DataImport) string {
+	switch {
+	case imp.SecretRef != nil:
+		return fmt.Sprintf("secret %s/%s", imp.SecretRef.Name, imp.SecretRef.Key)
+	case imp.ConfigMapRef != nil:
+		return fmt.Sprintf("configmap %s/%s", imp.ConfigMapRef.Name, imp.ConfigMapRef.Key)
+	}
+	return imp.DataRef
+}
+
+func targetImportSource(imp lsv1alpha1.TargetImport) string {
+	switch {
+	case len(imp.Target) != 0:
+		return imp.Target
+	case len(imp.TargetListReference) != 0:
+		return imp.TargetListReference
+	case len(imp.TargetMapReference) != 0:
+		return imp.TargetMapReference
+	case len(imp.TargetMap) != 0:
+		keys := make([]string, 0, len(imp.TargetMap))
+		for key := range imp.TargetMap {
+			keys = append(keys, fmt.Sprintf("%s: %s", key, imp.TargetMap[key]))
+		}
+		sort.Strings(keys)
+		return strings.Join(keys, ", ")
+	}
+	return strings.Join(imp.Targets, ", ")
+}
+
+// schema is the part of a jsonschema that is documented.
+type schema struct {
+	Ref         string             `json:"$ref"`
+	Type        interface{}        `json:"type"`
+	Title       string             `json:"title"`
+	Description string             `json:"description"`
+	Default     json.RawMessage    `json:"default"`
+	Enum        []json.RawMessage  `json:"enum"`
+	Items       *schema            `json:"items"`
+	Properties  map[string]*schema `json:"properties"`
+	Required    []string           `json:"required"`
+}
+
+// newType describes a jsonschema definition.
+// References to local types are resolved to describe the schema.
+func newType(definition *lsv1alpha1.JSONSchemaDefinition, localTypes map[string]lsv1alpha1.JSONSchemaDefinition) *Type {
+	if definition == nil || len(definition.RawMessage) == 0 {
+		return nil
+	}
+	s := &schema{}
+	if err := json.Unmarshal(definition.RawMessage, s); err != nil {
+		return &Type{Description: fmt.Sprintf("invalid schema: %s", err.Error())}
+	}
+
+	t := &Type{
+		Type:        typeName(s),
+		Description: description(s),
+	}
+	// describe the properties and the description of a referenced local type,
+	// if they are not defined by the referencing schema
+	if local := localTypeName(s.Ref); len(local) != 0 {
+		if def, ok := localTypes[local]; ok {
+			ref := &schema{}
+			if err := json.Unmarshal(def.RawMessage, ref); err == nil {
+				if len(t.Description) == 0 {
+					t.Description = description(ref)
+				}
+				s = ref
+			}
+		}
+	}
+
+	required := map[string]bool{}
+	for _, name := range s.Required {
+		required[name] = true
+	}
+	for name, prop := range s.Properties {
+		if prop == nil {
+			continue
+		}
+		t.Properties = append(t.Properties, Property{
+			Name:        name,
+			Type:        typeName(prop),
+			Required:    required[name],
+			Default:     compactJSON(prop.Default),
+			Description: description(prop),
+		})
+	}
+	sort.Slice(t.Properties, func(i, j int) bool {
+		return t.Properties[i].Name < t.Properties[j].Name
+	})
+	return t
+}
+
+// typeName returns a short description of the type of a schema.
+func typeName(s *schema) string {
+	if len(s.Ref) != 0 {
+		if local := localTypeName(s.Ref); len(local) != 0 {
+			return local
+		}
+		return s.Ref
+	}
+	if len(s.Enum) != 0 {
+		values := make([]string, len(s.Enum))
+		for i, value := range s.Enum {
+			values[i] = compactJSON(value)
+		}
+		return "enum " + strings.Join(values, ", ")
+	}
+
+	var name string
+	switch typed := s.Type.(type) {
+	case string:
+		name = typed
+	case []interface{}:
+		types := make([]string, len(typed))
+		for i, t := range typed {
+			types[i] = fmt.Sprint(t)
+		}
+		name = strings.Join(types, " or ")
+	}
+	if name == "array" && s.Items != nil {
+		if items := typeName(s.Items); len(items) != 0 {
+			return "array of " + items
+		}
+	}
+	return name
+}
+
+// localTypeName returns the name of the local type of a reference like "local://my-type".
+// An empty string is returned for other references.
+func localTypeName(ref string) string {
+	if !strings.HasPrefix(ref, "local://") {
+		return ""
+	}
+	return strings.TrimPrefix(ref, "local://")
+}
+
+func description(s *schema) string {
+	switch {
+	case len(s.Title) != 0 && len(s.Description) != 0:
+		return s.Title + ": " + s.Description
+	case len(s.Title) != 0:
+		return s.Title
+	}
+	return s.Description
+}
+
+// compactJSON returns the compact form of a JSON value.
+func compactJSON(data []byte) string {
+	if len(data) == 0 || string(data) == "null" {
+		return ""
+	}
+	buf := &bytes.Buffer{}
+	if err := json.Compact(buf, data); err != nil {
+		return string(data)
+	}
+	return buf.String()
+}
@@ -0,0 +1,386 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package blueprintdoc
+
+import (
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"strings"
+	"text/template"
+)
+
+// Format is the output format of the documentation.
+type Format string
+
+const (
+	// FormatMarkdown writes the documentation as Markdown.
+	FormatMarkdown Format = "markdown"
+	// FormatHTML writes the documentation as HTML page.
+	FormatHTML Format = "html"
+)
+
+// section is the documentation of a blueprint in the subinstallation tree.
+type section struct {
+	// Path is the path of the subinstallation in the tree, e.g. "dns/zone".
+	// It is empty for the root blueprint.
+	Path string
+	*Document
+}
+
+// page contains the sections of the documentation of a blueprint and its subinstallations.
+type page struct {
+	Title    string
+	Root     *Document
+	Sections []section
+}
+
+func newPage(doc *Document) *page {
+	p := &page{
+		Title: doc.Title,
+		Root:  doc,
+	}
+	p.addSections(doc, "")
+	return p
+}
+
+// addSections adds the section of the document and of all documented subinstallations depth-first.
+func (p *page) addSections(doc *Document, path string) {
+	p.Sections = append(p.Sections, section{Path: path, Document: doc})
+	for _, sub := range doc.Subinstallations {
+		if sub.Document != nil {
+			p.addSections(sub.Document, joinPath(path, sub.Name))
+		}
+	}
+}
+
+func joinPath(path, name string) string {
+	if len(path) == 0 {
+		return name
+	}
+	return path + "/" + name
+}
+
+// Write writes the documentation in the given format.
+func (d *Document) Write(w io.Writer, format Format) error {
+	switch format {
+	case FormatMarkdown:
+		return markdownTemplate.Execute(w, newPage(d))
+	case FormatHTML:
+		return htmlTemplate.Execute(w, newPage(d))
+	}
+	return fmt.Errorf("unknown format %q, must be %q or %q", format, FormatMarkdown, FormatHTML)
+}
+
+// markdownCell escapes a value for a cell of a Markdown table.
+func markdownCell(value string) string {
+	value = strings.ReplaceAll(value, "|", "\\|")
+	return strings.Join(strings.Fields(strings.ReplaceAll(value, "\n", " ")), " ")
+}
+
+// markdownCode formats a value as inline code.
+func markdownCode(value string) string {
+	if len(value) == 0 {
+		return ""
+	}
+	return "`" + strings.ReplaceAll(value, "|", "\\|") + "`"
+}
+
+// markdownTree returns the subinstallation tree of a document as nested Markdown list.
+func markdownTree(doc *Document) string {
+	builder := &strings.Builder{}
+	writeMarkdownTree(builder, doc, "", 0)
+	return builder.String()
+}
+
+func writeMarkdownTree(builder *strings.Builder, doc *Document, path string, depth int) {
+	indent := strings.Repeat("  ", depth)
+	for _, sub := range doc.Subinstallations {
+		subPath := joinPath(path, sub.Name)
+		builder.WriteString(fmt.Sprintf("%s- **%s** (%s)", indent, sub.Name, markdownCode(sub.Blueprint)))
+		if len(sub.Error) != 0 {
+			builder.WriteString(fmt.Sprintf(": _not documented: %s_", sub.Error))
+		} else if sub.Document != nil {
+			builder.WriteString(fmt.Sprintf(", see [%s](#%s)", subPath, markdownAnchor("Subinstallation "+subPath)))
+		}
+		builder.WriteString("\n")
+		writeMarkdownBindings(builder, indent+"  ", "imports", sub.Imports, "←")
+		writeMarkdownBindings(builder, indent+"  ", "exports", sub.Exports, "→")
+		if sub.Document != nil {
+			writeMarkdownTree(builder, sub.Document, subPath, depth+1)
+		}
+	}
+	if len(doc.SubinstallationExecutions) != 0 {
+		builder.WriteString(fmt.Sprintf("%s- _further subinstallations rendered by the executions %s_\n", indent,
+			strings.Join(mapStrings(doc.SubinstallationExecutions, markdownCode), ", ")))
+	}
+}
+
+func writeMarkdownBindings(builder *strings.Builder, indent, kind string, bindings []Binding, arrow string) {
+	if len(bindings) == 0 {
+		return
+	}
+	values := make([]string, len(bindings))
+	for i, binding := range bindings {
+		values[i] = fmt.Sprintf("%s %s %s", markdownCode(binding.Name), arrow, markdownCode(binding.Source))
+	}
+	builder.WriteString(fmt.Sprintf("%s- %s: %s\n", indent, kind, strings.Join(values, ", ")))
+}
+
+// markdownAnchor returns the anchor of a heading, as it is generated by GitHub.
+func markdownAnchor(heading string) string {
+	builder := &strings.Builder{}
+	for _, r := range strings.ToLower(heading) {
+		switch {
+		case r == ' ':
+			builder.WriteRune('-')
+		case r == '-' || r == '_' || ('a' <= r && r <= 'z') || ('0' <= r && r <= '9'):
+			builder.WriteRune(r)
+		}
+	}
+	return builder.String()
+}
+
+// htmlTree returns the subinstallation tree of a document as nested HTML list.
+func htmlTree(doc *Document) htmltemplate.HTML {
+	builder := &strings.Builder{}
+	writeHTMLTree(builder, doc, "")
+	return htmltemplate.HTML(builder.String()) //nolint:gosec // all values are escaped
+}
+
+func writeHTMLTree(builder *strings.Builder, doc *Document, path string) {
+	if len(doc.Subinstallations) == 0 && len(doc.SubinstallationExecutions) == 0 {
+		return
+	}
+	esc := htmltemplate.HTMLEscapeString
+	builder.WriteString("<ul>")
+	for _, sub := range doc.Subinstallations {
+		subPath := joinPath(path, sub.Name)
+		builder.WriteString(fmt.Sprintf("<li><strong>%s</strong> (<code>%s</code>)", esc(sub.Name), esc(sub.Blueprint)))
+		if len(sub.Error) != 0 {
+			builder.WriteString(fmt.Sprintf(": <em>not documented: %s</em>", esc(sub.Error)))
+		} else if sub.Document != nil {
+			builder.WriteString(fmt.Sprintf(", see <a href=\"#%s\">%s</a>", esc(htmlAnchor(subPath)), esc(subPath)))
+		}
+		writeHTMLBindings(builder, "imports", sub.Imports, "&larr;")
+		writeHTMLBindings(builder, "exports", sub.Exports, "&rarr;")
+		if sub.Document != nil {
+			writeHTMLTree(builder, sub.Document, subPath)
+		}
+		builder.WriteString("</li>")
+	}
+	if len(doc.SubinstallationExecutions) != 0 {
+		builder.WriteString(fmt.Sprintf("<li><em>further subinstallations rendered by the executions %s</em></li>",
+			strings.Join(mapStrings(doc.SubinstallationExecutions, func(s string) string {
+				return "<code>" + esc(s) + "</code>"
+			}), ", ")))
+	}
+	builder.WriteString("</ul>")
+}
+
+func writeHTMLBindings(builder *strings.Builder, kind string, bindings []Binding, arrow string) {
+	if len(bindings) == 0 {
+		return
+	}
+	esc := htmltemplate.HTMLEscapeString
+	values := make([]string, len(bindings))
+	for i, binding := range bindings {
+		values[i] = fmt.Sprintf("<code>%s</code> %s <code>%s</code>", esc(binding.Name), arrow, esc(binding.Source))
+	}
+	builder.WriteString(fmt.Sprintf("<br>%s: %s", kind, strings.Join(values, ", ")))
+}
+
+// htmlAnchor returns the id of the section of a subinstallation.
+func htmlAnchor(path string) string {
+	if len(path) == 0 {
+		return "root"
+	}
+	return "subinstallation-" + strings.ReplaceAll(path, "/", "-")
+}
+
+func mapStrings(values []string, f func(string) string) []string {
+	res := make([]string, len(values))
+	for i, value := range values {
+		res[i] = f(value)
+	}
+	return res
+}
+
+var markdownTemplate = template.Must(template.New("markdown").Funcs(template.FuncMap{
+	"cell": markdownCell,
+	"code": markdownCode,
+	"tree": markdownTree,
+}).Parse(`
+{{- define "properties" }}
+| Property | Type | Required | Default | Description |
+| -------- | ---- | -------- | ------- | ----------- |
+{{- range . }}
+| {{ code .Name }} | {{ cell .Type }} | {{ if .Required }}yes{{ else }}no{{ end }} | {{ code .Default }} | {{ cell .Description }} |
+{{- end }}
+{{ end }}
+
+{{- define "schemas" }}
+{{- range . }}{{ if and .Schema .Schema.Properties }}
+### {{ code .Name }}
+{{ if .Schema.Description }}
+{{ .Schema.Description }}
+{{ end }}
+{{- template "properties" .Schema.Properties }}
+{{- end }}{{ end }}
+{{- end }}
+
+{{- define "section" -}}
+{{ if .Path }}# Subinstallation {{ .Path }}{{ else }}# {{ .Title }}{{ end }}
+{{ if .Annotations }}{{ range $key, $value := .Annotations }}
+- {{ code $key }}: {{ $value }}
+{{- end }}
+{{ end }}
+{{- if .Imports }}
+## Imports
+
+| Name | Type | Target Type | Required | Default | Description |
+| ---- | ---- | ----------- | -------- | ------- | ----------- |
+{{- range .Imports }}
+| {{ code .Name }}{{ if .ConditionalOn }} (if {{ code .ConditionalOn }} is set){{ end }} | {{ .Type }}{{ if and .Schema .Schema.Type }} ({{ cell .Schema.Type }}){{ end }} | {{ code .TargetType }} | {{ if .Required }}yes{{ else }}no{{ end }} | {{ code .Default }} | {{ if .Schema }}{{ cell .Schema.Description }}{{ end }} |
+{{- end }}
+{{ template "schemas" .Imports }}
+{{- end }}
+{{- if .Exports }}
+## Exports
+
+| Name | Type | Target Type | Description |
+| ---- | ---- | ----------- | ----------- |
+{{- range .Exports }}
+| {{ code .Name }} | {{ .Type }}{{ if and .Schema .Schema.Type }} ({{ cell .Schema.Type }}){{ end }} | {{ code .TargetType }} | {{ if .Schema }}{{ cell .Schema.Description }}{{ end }} |
+{{- end }}
+{{ template "schemas" .Exports }}
+{{- end }}
+{{- if .LocalTypes }}
+## Local Types
+{{ range .LocalTypes }}
+### {{ code .Name }}
+{{ if .Type }}
+Type: {{ cell .Type }}
+{{ end }}
+{{- if .Description }}
+{{ .Description }}
+{{ end }}
+{{- if .Properties }}{{ template "properties" .Properties }}{{ end }}
+{{- end }}
+{{- end }}
+{{- if or .Subinstallations .SubinstallationExecutions }}
+## Subinstallations
+
+{{ tree .Document }}
+{{- end }}
+{{- if .DeployExecutions }}
+## Deploy Executions
+
+{{ range .DeployExecutions }}- {{ code . }}
+{{ end }}
+{{- end }}
+{{- end }}
+
+{{- range $i, $section := .Sections }}{{ if $i }}
+{{ end }}{{ template "section" $section }}{{ end -}}
+`))
+
+var htmlTemplate = htmltemplate.Must(htmltemplate.New("html").Funcs(htmltemplate.FuncMap{
+	"tree":   htmlTree,
+	"anchor": htmlAnchor,
+}).Parse(`
+{{- define "properties" }}
+<table>
+<tr><th>Property</th><th>Type</th><th>Required</th><th>Default</th><th>Description</th></tr>
+{{- range . }}
+<tr><td><code>{{ .Name }}</code></td><td>{{ .Type }}</td><td>{{ if .Required }}yes{{ else }}no{{ end }}</td><td>{{ if .Default }}<code>{{ .Default }}</code>{{ end }}</td><td>{{ .Description }}</td></tr>
+{{- end }}
+</table>
+{{- end }}
+
+{{- define "schemas" }}
+{{- range . }}{{ if and .Schema .Schema.Properties }}
+<h3><code>{{ .Name }}</code></h3>
+{{- if .Schema.Description }}
+<p>{{ .Schema.Description }}</p>
+{{- end }}
+{{- template "properties" .Schema.Properties }}
+{{- end }}{{ end }}
+{{- end }}
+
+{{- define "section" }}
+<section id="{{ anchor .Path }}">
+<h1>{{ if .Path }}Subinstallation {{ .Path }}{{ else }}{{ .Title }}{{ end }}</h1>
+{{- if .Annotations }}
+<ul>
+{{- range $key, $value := .Annotations }}
+<li><code>{{ $key }}</code>: {{ $value }}</li>
+{{- end }}
+</ul>
+{{- end }}
+{{- if .Imports }}
+<h2>Imports</h2>
+<table>
+<tr><th>Name</th><th>Type</th><th>Target Type</th><th>Required</th><th>Default</th><th>Description</th></tr>
+{{- range .Imports }}
+<tr><td><code>{{ .Name }}</code>{{ if .ConditionalOn }} (if <code>{{ .ConditionalOn }}</code> is set){{ end }}</td><td>{{ .Type }}{{ if and .Schema .Schema.Type }} ({{ .Schema.Type }}){{ end }}</td><td>{{ if .TargetType }}<code>{{ .TargetType }}</code>{{ end }}</td><td>{{ if .Required }}yes{{ else }}no{{ end }}</td><td>{{ if .Default }}<code>{{ .Default }}</code>{{ end }}</td><td>{{ if .Schema }}{{ .Schema.Description }}{{ end }}</td></tr>
+{{- end }}
+</table>
+{{- template "schemas" .Imports }}
+{{- end }}
+{{- if .Exports }}
+<h2>Exports</h2>
+<table>
+<tr><th>Name</th><th>Type</th><th>Target Type</th><th>Description</th></tr>
+{{- range .Exports }}
+<tr><td><code>{{ .Name }}</code></td><td>{{ .Type }}{{ if and .Schema .Schema.Type }} ({{ .Schema.Type }}){{ end }}</td><td>{{ if .TargetType }}<code>{{ .TargetType }}</code>{{ end }}</td><td>{{ if .Schema }}{{ .Schema.Description }}{{ end }}</td></tr>
+{{- end }}
+</table>
+{{- template "schemas" .Exports }}
+{{- end }}
+{{- if .LocalTypes }}
+<h2>Local Types</h2>
+{{- range .LocalTypes }}
+<h3><code>{{ .Name }}</code></h3>
+{{- if .Type }}
+<p>Type: {{ .Type }}</p>
+{{- end }}
+{{- if .Description }}
+<p>{{ .Description }}</p>
+{{- end }}
+{{- if .Properties }}{{ template "properties" .Properties }}{{ end }}
+{{- end }}
+{{- end }}
+{{- if or .Subinstallations .SubinstallationExecutions }}
+<h2>Subinstallations</h2>
+{{ tree .Document }}
+{{- end }}
+{{- if .DeployExecutions }}
+<h2>Deploy Executions</h2>
+<ul>
+{{- range .DeployExecutions }}
+<li><code>{{ . }}</code></li>
+{{- end }}
+</ul>
+{{- end }}
+</section>
+{{- end -}}
+
+<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{ .Title }}</title>
+<style>
+body { font-family: sans-serif; margin: 2em; }
+table { border-collapse: collapse; margin-bottom: 1em; }
+th, td { border: 1px solid #ccc; padding: 0.3em 0.6em; text-align: left; vertical-align: top; }
+</style>
+</head>
+<body>
+{{- range .Sections }}{{ template "section" . }}{{ end }}
+</body>
+</html>
+`))
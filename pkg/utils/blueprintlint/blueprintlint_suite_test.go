@@ -0,0 +1,269 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package blueprintlint_test
+
+import (
+	"testing"
+
+	"github.com/mandelsoft/vfs/pkg/memoryfs"
+	"github.com/mandelsoft/vfs/pkg/osfs"
+	"github.com/mandelsoft/vfs/pkg/projectionfs"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/utils/blueprintlint"
+	"github.com/gardener/landscaper/pkg/utils/blueprints"
+)
+
+func TestConfig(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Blueprint Lint Test Suite")
+}
+
+// lint lints a blueprint that consists of the given blueprint manifest and additional files.
+func lint(blueprint string, files ...string) []blueprintlint.Finding {
+	fs := memoryfs.New()
+	Expect(vfs.WriteFile(fs, lsv1alpha1.BlueprintFileName, []byte(blueprint), 0644)).To(Succeed())
+	for i := 0; i < len(files); i += 2 {
+		Expect(vfs.WriteFile(fs, files[i], []byte(files[i+1]), 0644)).To(Succeed())
+	}
+	bp, err := blueprints.NewFromFs(fs)
+	Expect(err).ToNot(HaveOccurred())
+	findings, err := blueprintlint.Lint(bp)
+	Expect(err).ToNot(HaveOccurred())
+	return findings
+}
+
+// rules returns the rule and the path of the findings.
+func rules(findings []blueprintlint.Finding) []string {
+	res := make([]string, len(findings))
+	for i, finding := range findings {
+		res[i] = string(finding.Rule) + " " + finding.Path
+	}
+	return res
+}
+
+var _ = Describe("Lint", func() {
+
+	It("should report no findings for a valid blueprint", func() {
+		fs, err := projectionfs.New(osfs.New(), "./testdata/valid")
+		Expect(err).ToNot(HaveOccurred())
+		bp, err := blueprints.NewFromFs(fs)
+		Expect(err).ToNot(HaveOccurred())
+
+		findings, err := blueprintlint.Lint(bp)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(findings).To(BeEmpty())
+	})
+
+	It("should report the findings of a blueprint with issues", func() {
+		fs, err := projectionfs.New(osfs.New(), "./testdata/issues")
+		Expect(err).ToNot(HaveOccurred())
+		bp, err := blueprints.NewFromFs(fs)
+		Expect(err).ToNot(HaveOccurred())
+
+		findings, err := blueprintlint.Lint(bp)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rules(findings)).To(Equal([]string{
+			"deprecated-function deployExecutions[0]",
+			"undefined-import deployExecutions[0]",
+			"unproduced-export exports[1]",
+			"permissive-schema imports[1].schema",
+			"unused-import imports[2]",
+			"unsatisfied-subinstallation-import subinstallations[sub].imports.data[1]",
+			"unsatisfied-subinstallation-import subinstallations[sub].imports.targets[0]",
+		}))
+		Expect(findings[1].Severity).To(Equal(blueprintlint.SeverityError))
+		Expect(findings[1].Message).To(ContainSubstring(`"replica"`))
+		Expect(blueprintlint.HasFindings(findings, blueprintlint.SeverityError)).To(BeTrue())
+	})
+
+	It("should not report unused imports if a template accesses all imports", func() {
+		findings := lint(`
+apiVersion: landscaper.gardener.cloud/v1alpha1
+kind: Blueprint
+jsonSchema: "https://json-schema.org/draft/2019-09/schema"
+imports:
+- name: a
+  type: data
+  schema:
+    type: string
+deployExecutions:
+- name: deploy
+  type: GoTemplate
+  template: |
+    deployItems:
+    - name: item
+      type: landscaper.gardener.cloud/mock
+      config:
+        {{- range $key, $value := .imports }}
+        {{ $key }}: {{ $value }}
+        {{- end }}
+`)
+		Expect(findings).To(BeEmpty())
+	})
+
+	It("should ignore import references in comments and strings of go templates", func() {
+		findings := lint(`
+apiVersion: landscaper.gardener.cloud/v1alpha1
+kind: Blueprint
+jsonSchema: "https://json-schema.org/draft/2019-09/schema"
+imports:
+- name: name
+  type: data
+  schema:
+    type: string
+- name: replicas
+  type: data
+  schema:
+    type: integer
+deployExecutions:
+- name: deploy
+  type: GoTemplate
+  template: |
+    {{/* .imports.old has been replaced by .imports.name */}}
+    deployItems:
+    - name: item
+      type: landscaper.gardener.cloud/mock
+      config:
+        name: {{ .imports.name }}
+        replicas: {{ index $.imports "replicas" }}
+        description: {{ printf "%s" ".imports.description" }}
+        missing: {{ index .imports "missing" }}
+`)
+		Expect(rules(findings)).To(Equal([]string{"undefined-import deployExecutions[0]"}))
+		Expect(findings[0].Severity).To(Equal(blueprintlint.SeverityError))
+		Expect(findings[0].Message).To(ContainSubstring(`"missing"`))
+	})
+
+	It("should report go templates that cannot be parsed", func() {
+		findings := lint(`
+apiVersion: landscaper.gardener.cloud/v1alpha1
+kind: Blueprint
+jsonSchema: "https://json-schema.org/draft/2019-09/schema"
+deployExecutions:
+- name: deploy
+  type: GoTemplate
+  template: |
+    deployItems: {{ if .imports.a }}
+`)
+		Expect(rules(findings)).To(Equal([]string{"invalid-blueprint deployExecutions[0]"}))
+	})
+
+	It("should analyze spiff templates and bindings of import executions", func() {
+		findings := lint(`
+apiVersion: landscaper.gardener.cloud/v1alpha1
+kind: Blueprint
+jsonSchema: "https://json-schema.org/draft/2019-09/schema"
+imports:
+- name: prefix
+  type: data
+  schema:
+    type: string
+- name: unused
+  type: data
+  schema:
+    type: string
+importExecutions:
+- name: compose
+  type: Spiff
+  template:
+    bindings:
+      name: (( imports.prefix "-name" ))
+deployExecutions:
+- name: deploy
+  type: Spiff
+  template:
+    deployItems:
+    - name: item
+      type: landscaper.gardener.cloud/mock
+      config:
+        name: (( imports.name ))
+        other: (( imports.missing ))
+`)
+		Expect(rules(findings)).To(Equal([]string{
+			"undefined-import deployExecutions[0]",
+			"unused-import imports[1]",
+		}))
+		// the references of spiff templates are found by pattern matching
+		Expect(findings[0].Severity).To(Equal(blueprintlint.SeverityWarning))
+		Expect(findings[0].Message).To(ContainSubstring(`"missing"`))
+	})
+
+	It("should analyze CUE templates read from files", func() {
+		findings := lint(`
+apiVersion: landscaper.gardener.cloud/v1alpha1
+kind: Blueprint
+jsonSchema: "https://json-schema.org/draft/2019-09/schema"
+imports:
+- name: version
+  type: data
+  schema:
+    type: string
+- name: cluster
+  type: target
+  targetType: landscaper.gardener.cloud/kubernetes-cluster
+exports:
+- name: image
+  type: data
+  schema:
+    type: string
+deployExecutions:
+- name: deploy
+  type: CUE
+  file: /deploy.cue
+exportExecutions:
+- name: export
+  type: CUE
+  template: |
+    exports: image: "my-image:\(imports["version"])"
+`, "/deploy.cue", `
+deployItems: [{
+	name: "item"
+	type: "landscaper.gardener.cloud/mock"
+	target: import: "cluster"
+	config: image: "my-image:\(imports.version)"
+}]
+`)
+		Expect(findings).To(BeEmpty())
+	})
+
+	It("should report templates that cannot be read", func() {
+		findings := lint(`
+apiVersion: landscaper.gardener.cloud/v1alpha1
+kind: Blueprint
+jsonSchema: "https://json-schema.org/draft/2019-09/schema"
+imports:
+- name: a
+  type: data
+  schema:
+    type: string
+deployExecutions:
+- name: deploy
+  type: GoTemplate
+  file: /missing.yaml
+`)
+		Expect(rules(findings)).To(Equal([]string{"invalid-blueprint deployExecutions[0]"}))
+	})
+
+	It("should report permissive local types", func() {
+		findings := lint(`
+apiVersion: landscaper.gardener.cloud/v1alpha1
+kind: Blueprint
+jsonSchema: "https://json-schema.org/draft/2019-09/schema"
+localTypes:
+  anything:
+    description: any value
+  name:
+    type: string
+`)
+		Expect(rules(findings)).To(Equal([]string{"permissive-schema localTypes[anything]"}))
+		Expect(blueprintlint.HasFindings(findings, blueprintlint.SeverityError)).To(BeFalse())
+		Expect(blueprintlint.HasFindings(findings, blueprintlint.SeverityWarning)).To(BeTrue())
+	})
+
+})
@@ -0,0 +1,379 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package blueprintlint
+
+import (
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/landscaper/apis/core"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/core/validation"
+	"github.com/gardener/landscaper/pkg/utils/blueprints"
+)
+
+// Severity describes how severe a finding is.
+type Severity string
+
+const (
+	// SeverityError marks findings that break the rendering or the deployment of the blueprint.
+	SeverityError Severity = "error"
+	// SeverityWarning marks findings that are likely mistakes, but do not break the blueprint.
+	SeverityWarning Severity = "warning"
+)
+
+// Rule identifies the check that has produced a finding.
+type Rule string
+
+const (
+	// RuleInvalidBlueprint reports structural errors of the blueprint and of its template files.
+	RuleInvalidBlueprint Rule = "invalid-blueprint"
+	// RuleUnusedImport reports imports that are not referenced by any template or subinstallation.
+	RuleUnusedImport Rule = "unused-import"
+	// RuleUndefinedImport reports template references to imports that are not defined by the blueprint.
+	// References of go templates are errors, as they are found by parsing the templates. Other references are
+	// found by pattern matching and are warnings.
+	RuleUndefinedImport Rule = "undefined-import"
+	// RuleUnproducedExport reports exports that are neither produced by an export execution nor by a subinstallation.
+	RuleUnproducedExport Rule = "unproduced-export"
+	// RuleUnsatisfiedSubinstallationImport reports imports of subinstallations that are neither imported by the
+	// blueprint nor exported by a sibling subinstallation.
+	RuleUnsatisfiedSubinstallationImport Rule = "unsatisfied-subinstallation-import"
+	// RuleDeprecatedFunction reports calls of deprecated template functions.
+	RuleDeprecatedFunction Rule = "deprecated-function"
+	// RulePermissiveSchema reports json schemas that accept any value.
+	RulePermissiveSchema Rule = "permissive-schema"
+)
+
+// Finding describes an issue of a blueprint.
+type Finding struct {
+	// Rule identifies the check that has produced the finding.
+	Rule Rule `json:"rule"`
+	// Severity describes how severe the finding is.
+	Severity Severity `json:"severity"`
+	// Path is the path of the affected field of the blueprint, e.g. "deployExecutions[0]".
+	Path string `json:"path"`
+	// Message describes the finding.
+	Message string `json:"message"`
+}
+
+// String returns a human-readable description of the finding.
+func (f Finding) String() string {
+	return fmt.Sprintf("%s %s: %s (%s)", f.Severity, f.Path, f.Message, f.Rule)
+}
+
+// Lint checks a blueprint for semantic issues, like imports that are never used or exports that are never produced.
+// The templates of the blueprint are analyzed statically. Accesses that cannot be analyzed, like iterating over
+// all imports, disable the affected checks instead of producing false findings.
+// The findings are sorted by their path.
+func Lint(blueprint *blueprints.Blueprint) ([]Finding, error) {
+	l := &linter{
+		blueprint: blueprint,
+		findings:  []Finding{},
+	}
+	if err := l.lint(); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(l.findings, func(i, j int) bool {
+		if l.findings[i].Path != l.findings[j].Path {
+			return l.findings[i].Path < l.findings[j].Path
+		}
+		return l.findings[i].Rule < l.findings[j].Rule
+	})
+	return l.findings, nil
+}
+
+// HasFindings returns whether the findings contain a finding with the given severity or a more severe one.
+func HasFindings(findings []Finding, severity Severity) bool {
+	for _, finding := range findings {
+		if finding.Severity == SeverityError || finding.Severity == severity {
+			return true
+		}
+	}
+	return false
+}
+
+type linter struct {
+	blueprint *blueprints.Blueprint
+	findings  []Finding
+}
+
+func (l *linter) report(rule Rule, severity Severity, path *field.Path, format string, args ...interface{}) {
+	l.findings = append(l.findings, Finding{
+		Rule:     rule,
+		Severity: severity,
+		Path:     path.String(),
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+func (l *linter) lint() error {
+	info := l.blueprint.Info
+
+	coreBlueprint := &core.Blueprint{}
+	if err := lsv1alpha1.Convert_v1alpha1_Blueprint_To_core_Blueprint(info, coreBlueprint, nil); err != nil {
+		return fmt.Errorf("unable to convert blueprint: %w", err)
+	}
+	for _, err := range validation.ValidateBlueprint(coreBlueprint) {
+		l.report(RuleInvalidBlueprint, SeverityError, field.NewPath(err.Field), "%s", err.ErrorBody())
+	}
+
+	subinstallations, err := l.blueprint.GetSubinstallations()
+	if err != nil {
+		l.report(RuleInvalidBlueprint, SeverityError, field.NewPath("subinstallations"), "%s", err.Error())
+		subinstallations = nil
+	}
+
+	executions := l.loadExecutions()
+	imports := flattenImports(info.Imports, field.NewPath("imports"))
+
+	l.lintImportReferences(imports, executions, subinstallations)
+	l.lintExports(executions, subinstallations)
+	l.lintSubinstallationImports(imports, executions, subinstallations)
+	l.lintDeprecatedFunctions(executions)
+	l.lintSchemas()
+	return nil
+}
+
+// importDefinition is an import definition of the blueprint together with its path.
+type importDefinition struct {
+	lsv1alpha1.ImportDefinition
+	path *field.Path
+}
+
+// flattenImports returns the import definitions including the nested conditional imports.
+func flattenImports(imports lsv1alpha1.ImportDefinitionList, fldPath *field.Path) []importDefinition {
+	res := make([]importDefinition, 0, len(imports))
+	for i, imp := range imports {
+		impPath := fldPath.Index(i)
+		res = append(res, importDefinition{ImportDefinition: imp, path: impPath})
+		res = append(res, flattenImports(imp.ConditionalImports, impPath.Child("imports"))...)
+	}
+	return res
+}
+
+// isDataImport returns whether an import definition imports a data object. Definitions without type are data imports,
+// if they define a schema.
+func isDataImport(imp lsv1alpha1.ImportDefinition) bool {
+	if len(imp.Type) != 0 {
+		return imp.Type == lsv1alpha1.ImportTypeData
+	}
+	return imp.Schema != nil
+}
+
+// lintImportReferences reports imports that are never used and references to imports that are not defined.
+func (l *linter) lintImportReferences(imports []importDefinition, executions []*execution, subinstallations []*lsv1alpha1.InstallationTemplate) {
+	defined := sets.New[string]()
+	for _, imp := range imports {
+		defined.Insert(imp.Name)
+	}
+
+	used := sets.New[string]()
+	dynamic := false
+	for _, exec := range executions {
+		dynamic = dynamic || exec.dynamicImports
+		used.Insert(exec.importReferences...)
+		used.Insert(exec.targetImportReferences...)
+		if exec.kind == subinstallationExecutionKind {
+			used.Insert(exec.installationReferences...)
+		}
+	}
+	for i, subinst := range subinstallations {
+		for _, ref := range subinstallationImportReferences(subinst, field.NewPath("subinstallations").Index(i)) {
+			used.Insert(ref.name)
+		}
+	}
+
+	// the names of the bindings added by import executions can be referenced like imports
+	bindings := sets.New[string]()
+	bindingsKnown := true
+	for _, exec := range executions {
+		if exec.kind != importExecutionKind {
+			continue
+		}
+		bindingsKnown = bindingsKnown && !exec.dynamicOutput
+		bindings.Insert(exec.outputKeys...)
+	}
+
+	if bindingsKnown {
+		for _, exec := range executions {
+			exact := sets.New[string]()
+			if exec.parsed {
+				exact.Insert(exec.importReferences...)
+			}
+			refs := sets.New(exec.importReferences...).Insert(exec.targetImportReferences...)
+			for _, ref := range sets.List(refs) {
+				if defined.Has(ref) || bindings.Has(ref) {
+					continue
+				}
+				// references that are found by pattern matching might occur in comments or strings
+				severity := SeverityWarning
+				if exact.Has(ref) {
+					severity = SeverityError
+				}
+				l.report(RuleUndefinedImport, severity, exec.path, "template references the import %q, which is not defined by the blueprint", ref)
+			}
+		}
+	}
+
+	if dynamic {
+		return
+	}
+	for _, imp := range imports {
+		// imports with conditional imports are used to enable the conditional imports
+		if used.Has(imp.Name) || len(imp.ConditionalImports) != 0 {
+			continue
+		}
+		l.report(RuleUnusedImport, SeverityWarning, imp.path, "import %q is not used by any template or subinstallation", imp.Name)
+	}
+}
+
+// lintExports reports exports of the blueprint that are neither produced by an export execution nor by a subinstallation.
+func (l *linter) lintExports(executions []*execution, subinstallations []*lsv1alpha1.InstallationTemplate) {
+	produced := sets.New[string]()
+	for _, exec := range executions {
+		switch exec.kind {
+		case exportExecutionKind:
+			if exec.dynamicOutput {
+				return
+			}
+			produced.Insert(exec.outputKeys...)
+		case subinstallationExecutionKind:
+			produced.Insert(exec.installationReferences...)
+		}
+	}
+	for _, subinst := range subinstallations {
+		for _, export := range subinst.Exports.Data {
+			produced.Insert(export.DataRef)
+		}
+		for _, export := range subinst.Exports.Targets {
+			produced.Insert(export.Target)
+		}
+	}
+
+	for i, export := range l.blueprint.Info.Exports {
+		if !produced.Has(export.Name) {
+			l.report(RuleUnproducedExport, SeverityWarning, field.NewPath("exports").Index(i),
+				"export %q is neither produced by an export execution nor by a subinstallation", export.Name)
+		}
+	}
+}
+
+// lintSubinstallationImports reports imports of subinstallations that are not satisfied by the blueprint imports
+// or by the exports of a sibling.
+func (l *linter) lintSubinstallationImports(imports []importDefinition, executions []*execution, subinstallations []*lsv1alpha1.InstallationTemplate) {
+	providedData := sets.New[string]()
+	providedTargets := sets.New[string]()
+	for _, imp := range imports {
+		if isDataImport(imp.ImportDefinition) {
+			providedData.Insert(imp.Name)
+		} else {
+			providedTargets.Insert(imp.Name)
+		}
+	}
+	for _, subinst := range subinstallations {
+		for _, export := range subinst.Exports.Data {
+			providedData.Insert(export.DataRef)
+		}
+		for _, export := range subinst.Exports.Targets {
+			providedTargets.Insert(export.Target)
+		}
+	}
+	// subinstallations rendered by templates can export data objects and targets, too
+	for _, exec := range executions {
+		if exec.kind == subinstallationExecutionKind {
+			providedData.Insert(exec.installationReferences...)
+			providedTargets.Insert(exec.installationReferences...)
+		}
+	}
+
+	fldPath := field.NewPath("subinstallations")
+	for i, subinst := range subinstallations {
+		instPath := fldPath.Index(i)
+		if len(subinst.Name) != 0 {
+			instPath = fldPath.Key(subinst.Name)
+		}
+		for _, ref := range subinstallationImportReferences(subinst, instPath) {
+			provided := providedTargets
+			if ref.data {
+				provided = providedData
+			}
+			if !provided.Has(ref.name) {
+				l.report(RuleUnsatisfiedSubinstallationImport, SeverityError, ref.path,
+					"%s %q is neither imported by the blueprint nor exported by another subinstallation", ref.kind(), ref.name)
+			}
+		}
+	}
+}
+
+// subinstallationImportReference is a reference of a subinstallation to a data object or target of its parent.
+type subinstallationImportReference struct {
+	name string
+	data bool
+	path *field.Path
+}
+
+func (r subinstallationImportReference) kind() string {
+	if r.data {
+		return "data object"
+	}
+	return "target"
+}
+
+func subinstallationImportReferences(subinst *lsv1alpha1.InstallationTemplate, instPath *field.Path) []subinstallationImportReference {
+	refs := make([]subinstallationImportReference, 0)
+	for i, imp := range subinst.Imports.Data {
+		// data imports can also reference secrets and config maps
+		if len(imp.DataRef) != 0 {
+			refs = append(refs, subinstallationImportReference{name: imp.DataRef, data: true, path: instPath.Child("imports", "data").Index(i)})
+		}
+	}
+	for i, imp := range subinst.Imports.Targets {
+		path := instPath.Child("imports", "targets").Index(i)
+		names := append([]string{imp.Target, imp.TargetListReference, imp.TargetMapReference}, imp.Targets...)
+		for _, name := range imp.TargetMap {
+			names = append(names, name)
+		}
+		for _, name := range names {
+			if len(name) != 0 {
+				refs = append(refs, subinstallationImportReference{name: name, path: path})
+			}
+		}
+	}
+	return refs
+}
+
+// lintDeprecatedFunctions reports calls of deprecated template functions.
+func (l *linter) lintDeprecatedFunctions(executions []*execution) {
+	for _, exec := range executions {
+		for _, call := range exec.deprecatedCalls {
+			l.report(RuleDeprecatedFunction, SeverityWarning, exec.path, "function %q is deprecated, use %q instead", call, deprecatedFunctions[exec.templateType][call])
+		}
+	}
+}
+
+// lintSchemas reports json schemas of imports, exports and local types that accept any value.
+func (l *linter) lintSchemas() {
+	info := l.blueprint.Info
+	for _, imp := range flattenImports(info.Imports, field.NewPath("imports")) {
+		if imp.Schema != nil && isPermissiveSchema(imp.Schema.RawMessage) {
+			l.report(RulePermissiveSchema, SeverityWarning, imp.path.Child("schema"), "the schema of import %q accepts any value", imp.Name)
+		}
+	}
+	for i, export := range info.Exports {
+		if export.Schema != nil && isPermissiveSchema(export.Schema.RawMessage) {
+			l.report(RulePermissiveSchema, SeverityWarning, field.NewPath("exports").Index(i).Child("schema"), "the schema of export %q accepts any value", export.Name)
+		}
+	}
+	for _, name := range sets.List(sets.KeySet(info.LocalTypes)) {
+		if isPermissiveSchema(info.LocalTypes[name].RawMessage) {
+			l.report(RulePermissiveSchema, SeverityWarning, field.NewPath("localTypes").Key(name), "the local type %q accepts any value", name)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package blueprintlint
+
+import (
+	"encoding/json"
+)
+
+// annotationKeywords are the json schema keywords that do not restrict the accepted values.
+var annotationKeywords = map[string]bool{
+	"$schema":     true,
+	"$id":         true,
+	"$comment":    true,
+	"title":       true,
+	"description": true,
+	"default":     true,
+	"examples":    true,
+	"deprecated":  true,
+	"readOnly":    true,
+	"writeOnly":   true,
+}
+
+// isPermissiveSchema returns whether a json schema accepts any value, i.e. whether it is "true" or
+// contains only annotations. Invalid schemas are not reported as permissive, they are reported by the validation.
+func isPermissiveSchema(schema json.RawMessage) bool {
+	var value interface{}
+	if err := json.Unmarshal(schema, &value); err != nil {
+		return false
+	}
+
+	switch v := value.(type) {
+	case bool:
+		return v
+	case map[string]interface{}:
+		for keyword := range v {
+			if !annotationKeywords[keyword] {
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,356 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package blueprintlint
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+	"text/template/parse"
+
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/yaml"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+// executionKind is the field of the blueprint that contains a template execution.
+type executionKind string
+
+const (
+	importExecutionKind          executionKind = "importExecutions"
+	deployExecutionKind          executionKind = "deployExecutions"
+	subinstallationExecutionKind executionKind = "subinstallationExecutions"
+	exportExecutionKind          executionKind = "exportExecutions"
+)
+
+// deprecatedFunctions maps the deprecated functions of the template types to their replacements.
+var deprecatedFunctions = map[lsv1alpha1.TemplateType]map[string]string{
+	lsv1alpha1.GOTemplateType: {
+		"trimall":          "trimAll",
+		"date_in_zone":     "dateInZone",
+		"date_modify":      "dateModify",
+		"must_date_modify": "mustDateModify",
+	},
+}
+
+// goDynamicOutputFunctions are the functions of a go template that produce output which cannot be analyzed.
+var goDynamicOutputFunctions = sets.New("toYaml", "toJson", "toPrettyJson", "toRawJson", "include")
+
+var (
+	// fieldRegexp matches the name of an accessed field at the beginning of a text.
+	fieldRegexp = regexp.MustCompile(`^\.(\w+)`)
+
+	// spiffExpressionRegexp matches the expressions of a spiff template.
+	spiffExpressionRegexp = regexp.MustCompile(`(?s)\(\((.*?)\)\)`)
+	// spiffImportsRegexp matches accesses of the imports in a spiff expression.
+	spiffImportsRegexp = regexp.MustCompile(`(?:^|[^\w.-])(imports)\b`)
+	// spiffFieldRegexp matches the name of an accessed field at the beginning of a text.
+	spiffFieldRegexp = regexp.MustCompile(`^\.([\w-]+)`)
+	// spiffDynamicOutputRegexp matches nodes of a spiff template whose keys are calculated by an expression.
+	spiffDynamicOutputRegexp = regexp.MustCompile(`(?m)^\s*(?:exports|bindings|<<)\s*:\s*["']?\(\(`)
+
+	// cueImportsRegexp matches accesses of the imports in a CUE template.
+	cueImportsRegexp = regexp.MustCompile(`(?:^|[^\w.#]|input\.)(imports)\b`)
+	// cueIndexRegexp matches a string index at the beginning of a text.
+	cueIndexRegexp = regexp.MustCompile(`^\["([^"]+)"\]`)
+	// cueDynamicOutputRegexp matches comprehensions and exports or bindings that are not defined as struct literal.
+	cueDynamicOutputRegexp = regexp.MustCompile(`(?m)\bfor\s+[\w,\s]+\s+in\b|^\s*(?:exports|bindings)\s*:\s*[^{\s][^:\n]*$`)
+
+	// keyRegexp matches keys of yaml documents or CUE structs.
+	keyRegexp = regexp.MustCompile(`([A-Za-z0-9_-]+)["']?[ \t]*:`)
+	// targetImportRegexp matches target import references of deploy items.
+	targetImportRegexp = regexp.MustCompile(`(?m)\bimport:[ \t]*["']?([A-Za-z0-9_-]+)["']?[ \t]*(?:$|[,}])`)
+	// installationReferenceRegexp matches references of installation templates to data objects and targets.
+	installationReferenceRegexp = regexp.MustCompile(`\b(?:dataRef|target|targetListRef|targetMapRef):[ \t]*["']?([A-Za-z0-9_.-]+)`)
+)
+
+// execution is a template execution of the blueprint together with the results of its static analysis.
+type execution struct {
+	kind         executionKind
+	path         *field.Path
+	templateType lsv1alpha1.TemplateType
+	source       string
+
+	// importReferences are the names of the imports that are accessed by the template.
+	importReferences []string
+	// parsed defines whether the import references have been determined by parsing the template.
+	// Otherwise, they are found by pattern matching, which also matches references in comments or strings.
+	parsed bool
+	// dynamicImports defines whether the template accesses the imports in a way that cannot be analyzed.
+	dynamicImports bool
+	// targetImportReferences are the names of the target imports referenced by the rendered deploy items.
+	targetImportReferences []string
+	// outputKeys contains all keys that occur in the output of the template.
+	outputKeys []string
+	// dynamicOutput defines whether the template produces keys that cannot be analyzed.
+	dynamicOutput bool
+	// installationReferences are the data objects and targets referenced by rendered subinstallations.
+	installationReferences []string
+	// deprecatedCalls are the names of the deprecated functions called by the template.
+	deprecatedCalls []string
+}
+
+// loadExecutions reads and analyzes all template executions of the blueprint.
+func (l *linter) loadExecutions() []*execution {
+	info := l.blueprint.Info
+	executionLists := []struct {
+		kind       executionKind
+		executions []lsv1alpha1.TemplateExecutor
+	}{
+		{kind: importExecutionKind, executions: info.ImportExecutions},
+		{kind: deployExecutionKind, executions: info.DeployExecutions},
+		{kind: subinstallationExecutionKind, executions: info.SubinstallationExecutions},
+		{kind: exportExecutionKind, executions: info.ExportExecutions},
+	}
+
+	executions := make([]*execution, 0)
+	for _, list := range executionLists {
+		for i, tmplExec := range list.executions {
+			exec := &execution{
+				kind:         list.kind,
+				path:         field.NewPath(string(list.kind)).Index(i),
+				templateType: tmplExec.Type,
+			}
+			source, err := l.readTemplate(tmplExec)
+			if err != nil {
+				l.report(RuleInvalidBlueprint, SeverityError, exec.path, "unable to read template: %s", err.Error())
+				// nothing is known about the template, so that the checks depending on it are skipped
+				exec.dynamicImports = true
+				exec.dynamicOutput = true
+			} else {
+				exec.source = source
+				if err := exec.analyze(); err != nil {
+					l.report(RuleInvalidBlueprint, SeverityError, exec.path, "unable to parse template: %s", err.Error())
+				}
+			}
+			executions = append(executions, exec)
+		}
+	}
+	return executions
+}
+
+// readTemplate returns the source of a template execution.
+// Templates that are defined as yaml structure, like spiff templates, are returned as yaml document.
+func (l *linter) readTemplate(tmplExec lsv1alpha1.TemplateExecutor) (string, error) {
+	if len(tmplExec.Template.RawMessage) != 0 {
+		var source string
+		if err := json.Unmarshal(tmplExec.Template.RawMessage, &source); err == nil {
+			return source, nil
+		}
+		data, err := yaml.JSONToYAML(tmplExec.Template.RawMessage)
+		if err != nil {
+			return "", err
+		}
+		return string(data), nil
+	}
+	if len(tmplExec.File) != 0 {
+		data, err := vfs.ReadFile(l.blueprint.Fs, tmplExec.File)
+		if err != nil {
+			return "", err
+		}
+		return string(data), nil
+	}
+	return "", nil
+}
+
+// analyze collects the references of the template.
+// An error is returned if the template cannot be parsed.
+func (e *execution) analyze() error {
+	switch e.templateType {
+	case lsv1alpha1.GOTemplateType:
+		if err := e.analyzeGoTemplate(); err != nil {
+			e.dynamicImports = true
+			e.dynamicOutput = true
+			return err
+		}
+	case lsv1alpha1.SpiffTemplateType:
+		for _, expression := range submatches(spiffExpressionRegexp, e.source) {
+			e.analyzeImports(spiffImportsRegexp, expression, func(rest string) (string, bool) {
+				return prefixSubmatch(spiffFieldRegexp, rest)
+			})
+		}
+		e.dynamicOutput = spiffDynamicOutputRegexp.MatchString(e.source)
+	case lsv1alpha1.CUETemplateType:
+		e.analyzeImports(cueImportsRegexp, e.source, func(rest string) (string, bool) {
+			if name, ok := prefixSubmatch(fieldRegexp, rest); ok {
+				return name, true
+			}
+			return prefixSubmatch(cueIndexRegexp, rest)
+		})
+		e.dynamicOutput = cueDynamicOutputRegexp.MatchString(e.source)
+	default:
+		// templates of plugins cannot be analyzed
+		e.dynamicImports = true
+		e.dynamicOutput = true
+		return nil
+	}
+
+	e.outputKeys = submatches(keyRegexp, e.source)
+	switch e.kind {
+	case deployExecutionKind:
+		e.targetImportReferences = submatches(targetImportRegexp, e.source)
+	case subinstallationExecutionKind:
+		e.installationReferences = submatches(installationReferenceRegexp, e.source)
+	}
+	return nil
+}
+
+// analyzeGoTemplate parses the go template and collects the accessed imports and the called functions.
+// Comments and strings are ignored, so that the import references are exact.
+func (e *execution) analyzeGoTemplate() error {
+	tree := parse.New(e.path.String())
+	// the functions are not known here, they are checked when the template is rendered
+	tree.Mode = parse.SkipFuncCheck
+	treeSet := map[string]*parse.Tree{}
+	if _, err := tree.Parse(e.source, "", "", treeSet); err != nil {
+		return err
+	}
+	// the set contains the template itself and the templates defined by it
+	for _, t := range treeSet {
+		e.analyzeGoNode(t.Root)
+	}
+	e.parsed = true
+	return nil
+}
+
+func (e *execution) analyzeGoNode(node parse.Node) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, child := range n.Nodes {
+			e.analyzeGoNode(child)
+		}
+	case *parse.ActionNode:
+		e.analyzeGoNode(n.Pipe)
+	case *parse.IfNode:
+		e.analyzeGoBranch(&n.BranchNode)
+	case *parse.RangeNode:
+		e.analyzeGoBranch(&n.BranchNode)
+	case *parse.WithNode:
+		e.analyzeGoBranch(&n.BranchNode)
+	case *parse.TemplateNode:
+		e.analyzeGoNode(n.Pipe)
+	case *parse.PipeNode:
+		if n == nil {
+			return
+		}
+		for _, cmd := range n.Cmds {
+			e.analyzeGoCommand(cmd)
+		}
+	case *parse.ChainNode:
+		e.analyzeGoNode(n.Node)
+	case *parse.FieldNode:
+		e.analyzeGoFields(n.Ident)
+	case *parse.VariableNode:
+		// $.imports accesses the imports of the root context
+		if len(n.Ident) > 1 && n.Ident[0] == "$" {
+			e.analyzeGoFields(n.Ident[1:])
+		}
+	case *parse.IdentifierNode:
+		e.analyzeGoFunction(n.Ident)
+	}
+}
+
+func (e *execution) analyzeGoBranch(branch *parse.BranchNode) {
+	e.analyzeGoNode(branch.Pipe)
+	e.analyzeGoNode(branch.List)
+	e.analyzeGoNode(branch.ElseList)
+}
+
+func (e *execution) analyzeGoCommand(cmd *parse.CommandNode) {
+	args := cmd.Args
+	// index .imports "name"
+	if len(args) >= 3 && isGoFunction(args[0], "index") && isGoImports(args[1]) {
+		if key, ok := args[2].(*parse.StringNode); ok {
+			e.importReferences = append(e.importReferences, key.Text)
+			args = append([]parse.Node{args[0]}, args[3:]...)
+		}
+	}
+	for _, arg := range args {
+		e.analyzeGoNode(arg)
+	}
+}
+
+// analyzeGoFields analyzes the fields of an accessed value of the template context, e.g. [imports name].
+func (e *execution) analyzeGoFields(ident []string) {
+	if len(ident) == 0 || ident[0] != "imports" {
+		return
+	}
+	if len(ident) == 1 {
+		e.dynamicImports = true
+		return
+	}
+	e.importReferences = append(e.importReferences, ident[1])
+}
+
+func (e *execution) analyzeGoFunction(name string) {
+	if goDynamicOutputFunctions.Has(name) {
+		e.dynamicOutput = true
+		// included templates can access the imports, too
+		if name == "include" {
+			e.dynamicImports = true
+		}
+	}
+	if _, ok := deprecatedFunctions[e.templateType][name]; ok {
+		e.deprecatedCalls = append(e.deprecatedCalls, name)
+	}
+}
+
+// isGoFunction returns whether the node is the identifier of the given function.
+func isGoFunction(node parse.Node, name string) bool {
+	identifier, ok := node.(*parse.IdentifierNode)
+	return ok && identifier.Ident == name
+}
+
+// isGoImports returns whether the node accesses all imports, i.e. whether it is .imports or $.imports.
+func isGoImports(node parse.Node) bool {
+	switch n := node.(type) {
+	case *parse.FieldNode:
+		return len(n.Ident) == 1 && n.Ident[0] == "imports"
+	case *parse.VariableNode:
+		return len(n.Ident) == 2 && n.Ident[0] == "$" && n.Ident[1] == "imports"
+	}
+	return false
+}
+
+// analyzeImports collects the accesses of the imports in the given text.
+// The static function returns the name of the accessed import from the text following an access.
+func (e *execution) analyzeImports(importsRegexp *regexp.Regexp, text string, static func(rest string) (string, bool)) {
+	for _, match := range importsRegexp.FindAllStringSubmatchIndex(text, -1) {
+		rest := text[match[3]:]
+		// a field named imports, e.g. of a rendered subinstallation, is no access
+		if strings.HasPrefix(strings.TrimLeft(rest, " \t"), ":") {
+			continue
+		}
+		if name, ok := static(rest); ok {
+			e.importReferences = append(e.importReferences, name)
+			continue
+		}
+		e.dynamicImports = true
+	}
+}
+
+// submatches returns the first submatches of all matches of the regular expression.
+func submatches(re *regexp.Regexp, text string) []string {
+	res := make([]string, 0)
+	for _, match := range re.FindAllStringSubmatch(text, -1) {
+		res = append(res, match[1])
+	}
+	return res
+}
+
+// prefixSubmatch returns the first submatch of a regular expression that matches the beginning of the text.
+func prefixSubmatch(re *regexp.Regexp, text string) (string, bool) {
+	match := re.FindStringSubmatch(text)
+	if match == nil {
+		return "", false
+	}
+	return match[1], true
+}
@@ -0,0 +1,133 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package blueprinttest_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/utils/blueprinttest"
+	"github.com/gardener/landscaper/pkg/utils/jsondiff"
+)
+
+func TestConfig(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Blueprint Test Suite")
+}
+
+var _ = Describe("Blueprint Tests", func() {
+
+	var (
+		ctx context.Context
+		tc  *blueprinttest.TestCase
+	)
+
+	BeforeEach(func() {
+		ctx = logging.NewContext(context.Background(), logging.Discard())
+
+		var err error
+		tc, err = blueprinttest.LoadTestCase("./testdata/default.yaml")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should load the test cases of a directory", func() {
+		testCases, err := blueprinttest.LoadTestCases("./testdata")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(testCases).To(HaveLen(1))
+		Expect(testCases[0].Name).To(Equal("default"))
+		Expect(testCases[0].Blueprint).To(Equal(filepath.Join("testdata", "blueprint")))
+		Expect(testCases[0].Golden).To(Equal(filepath.Join("testdata", "golden", "default")))
+	})
+
+	It("should pass if the rendered results match the golden files", func() {
+		result := blueprinttest.NewRunner().Run(ctx, tc)
+		Expect(result.Passed).To(BeTrue(), result.String())
+	})
+
+	It("should report the differences to the golden files", func() {
+		tc.Imports["replicas"] = 5
+		tc.DeployItemExports[0].Exports["url"] = "https://other.example.com"
+
+		result := blueprinttest.NewRunner().Run(ctx, tc)
+		Expect(result.Passed).To(BeFalse())
+		Expect(result.Error).To(BeEmpty())
+		Expect(result.Diffs).To(Equal([]blueprinttest.FileDiff{
+			{
+				File: blueprinttest.DeployItemsFile,
+				Changes: []jsondiff.Change{{
+					Path: "root/app.config.replicas",
+					Type: jsondiff.ChangeTypeChanged,
+					Old:  float64(3),
+					New:  float64(5),
+				}},
+			},
+			{
+				File: blueprinttest.ExportsFile,
+				Changes: []jsondiff.Change{{
+					Path: "dataObjects.url",
+					Type: jsondiff.ChangeTypeChanged,
+					Old:  "https://app.example.com",
+					New:  "https://other.example.com",
+				}},
+			},
+		}))
+		Expect(result.String()).To(ContainSubstring("changed root/app.config.replicas: expected 3, got 5"))
+	})
+
+	It("should ignore the differences of ignored paths", func() {
+		tc.Imports["replicas"] = 5
+		tc.Ignore.DeployItems = []string{"root/app.config"}
+
+		result := blueprinttest.NewRunner().Run(ctx, tc)
+		Expect(result.Passed).To(BeTrue(), result.String())
+	})
+
+	It("should write the golden files in update mode", func() {
+		tc.Golden = filepath.Join(GinkgoT().TempDir(), "golden")
+
+		result := blueprinttest.NewRunner().Run(ctx, tc)
+		Expect(result.Passed).To(BeFalse())
+		Expect(result.Diffs).To(HaveLen(3))
+		Expect(result.Diffs[0].Missing).To(BeTrue())
+
+		result = blueprinttest.NewRunner().WithUpdate(true).Run(ctx, tc)
+		Expect(result.Passed).To(BeTrue(), result.String())
+		Expect(result.Updated).To(BeTrue())
+		Expect(filepath.Join(tc.Golden, blueprinttest.DeployItemsFile)).To(BeARegularFile())
+
+		result = blueprinttest.NewRunner().Run(ctx, tc)
+		Expect(result.Passed).To(BeTrue(), result.String())
+	})
+
+	It("should fail if an expected error does not occur", func() {
+		tc.ExpectedError = "no such error"
+
+		result := blueprinttest.NewRunner().Run(ctx, tc)
+		Expect(result.Passed).To(BeFalse())
+		Expect(result.Error).To(ContainSubstring("but it succeeded"))
+	})
+
+	It("should pass if the expected error occurs", func() {
+		delete(tc.Imports, "replicas")
+		tc.ExpectedError = "replicas"
+
+		result := blueprinttest.NewRunner().Run(ctx, tc)
+		Expect(result.Passed).To(BeTrue(), result.String())
+	})
+
+	It("should fail to load a test case without blueprint", func() {
+		file := filepath.Join(GinkgoT().TempDir(), "invalid.yaml")
+		Expect(os.WriteFile(file, []byte("imports: {}"), 0644)).To(Succeed())
+		_, err := blueprinttest.LoadTestCase(file)
+		Expect(err).To(HaveOccurred())
+	})
+
+})
@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package blueprinttest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/gardener/landscaper/pkg/utils/jsondiff"
+)
+
+// Result is the result of a test case.
+type Result struct {
+	// Name is the name of the test case.
+	Name string `json:"name"`
+	// Path is the path of the test case file.
+	Path string `json:"path,omitempty"`
+	// Passed defines whether the test case has passed.
+	Passed bool `json:"passed"`
+	// Updated defines whether the golden files of the test case have been written.
+	Updated bool `json:"updated,omitempty"`
+	// Error describes why the test case could not be executed, or why the rendering failed unexpectedly.
+	Error string `json:"error,omitempty"`
+	// Diffs contains the differences between the golden files and the rendered results.
+	Diffs []FileDiff `json:"diffs,omitempty"`
+}
+
+// FileDiff describes the differences between a golden file and the rendered results.
+type FileDiff struct {
+	// File is the name of the golden file.
+	File string `json:"file"`
+	// Missing defines whether the golden file does not exist.
+	Missing bool `json:"missing,omitempty"`
+	// Changes contains the changed fields. The old values are the expected values of the golden file,
+	// the new values are the rendered values.
+	Changes []jsondiff.Change `json:"changes,omitempty"`
+}
+
+func (r *Result) complete() *Result {
+	r.Passed = len(r.Error) == 0 && len(r.Diffs) == 0
+	return r
+}
+
+// Write writes a human-readable description of the result.
+func (r *Result) Write(w io.Writer) error {
+	status := "PASS"
+	if r.Updated {
+		status = "UPDATED"
+	} else if !r.Passed {
+		status = "FAIL"
+	}
+
+	if _, err := fmt.Fprintf(w, "--- %s: %s (%s)\n", status, r.Name, r.Path); err != nil {
+		return err
+	}
+	if len(r.Error) != 0 {
+		if _, err := fmt.Fprintf(w, "    error: %s\n", r.Error); err != nil {
+			return err
+		}
+	}
+
+	for _, fileDiff := range r.Diffs {
+		if fileDiff.Missing {
+			if _, err := fmt.Fprintf(w, "    %s: golden file does not exist, run with update to create it\n", fileDiff.File); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if _, err := fmt.Fprintf(w, "    %s:\n", fileDiff.File); err != nil {
+			return err
+		}
+		for _, change := range fileDiff.Changes {
+			var line string
+			switch change.Type {
+			case jsondiff.ChangeTypeAdded:
+				line = fmt.Sprintf("unexpected %s: %s", change.Path, formatValue(change.New))
+			case jsondiff.ChangeTypeRemoved:
+				line = fmt.Sprintf("missing %s: expected %s", change.Path, formatValue(change.Old))
+			default:
+				line = fmt.Sprintf("changed %s: expected %s, got %s", change.Path, formatValue(change.Old), formatValue(change.New))
+			}
+			if _, err := fmt.Fprintf(w, "      %s\n", line); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// String returns a human-readable description of the result.
+func (r *Result) String() string {
+	buf := &bytes.Buffer{}
+	_ = r.Write(buf)
+	return buf.String()
+}
+
+// WriteResults writes a human-readable description of the results followed by a summary.
+func WriteResults(w io.Writer, results []*Result) error {
+	failed := 0
+	for _, result := range results {
+		if !result.Passed {
+			failed++
+		}
+		if err := result.Write(w); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintf(w, "%d test case(s), %d passed, %d failed\n", len(results), len(results)-failed, failed)
+	return err
+}
+
+// WriteResultsJSON writes the results as JSON.
+func WriteResultsJSON(w io.Writer, results []*Result) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(results)
+}
+
+func formatValue(value interface{}) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(data)
+}
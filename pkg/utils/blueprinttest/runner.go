@@ -0,0 +1,301 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package blueprinttest
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/mandelsoft/vfs/pkg/osfs"
+	"github.com/mandelsoft/vfs/pkg/projectionfs"
+	"ocm.software/ocm/api/datacontext"
+	"ocm.software/ocm/api/ocm"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/landscaper/apis/config"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/components/model"
+	"github.com/gardener/landscaper/pkg/components/model/types"
+	"github.com/gardener/landscaper/pkg/components/registries"
+	"github.com/gardener/landscaper/pkg/utils/blueprints"
+	"github.com/gardener/landscaper/pkg/utils/jsondiff"
+	lsutils "github.com/gardener/landscaper/pkg/utils/landscaper"
+)
+
+// Runner executes blueprint test cases.
+type Runner struct {
+	// update defines whether the golden files are written instead of compared.
+	update bool
+}
+
+// NewRunner creates a new runner that compares the rendered results with the golden files.
+func NewRunner() *Runner {
+	return &Runner{}
+}
+
+// WithUpdate configures the runner to write the rendered results into the golden files instead of comparing them.
+func (r *Runner) WithUpdate(update bool) *Runner {
+	r.update = update
+	return r
+}
+
+// Rendered contains the results of the rendering of a blueprint.
+type Rendered struct {
+	// DeployItems contains the specs of the rendered deploy items by their installation path and name.
+	DeployItems map[string]lsv1alpha1.DeployItemSpec
+	// Installations contains the specs of the rendered subinstallations by their installation path.
+	Installations map[string]lsv1alpha1.InstallationSpec
+	// Exports contains the exports of the blueprint.
+	Exports *lsutils.BlueprintExports
+}
+
+// RunAll executes the given test cases.
+func (r *Runner) RunAll(ctx context.Context, testCases []*TestCase) []*Result {
+	results := make([]*Result, len(testCases))
+	for i, tc := range testCases {
+		results[i] = r.Run(ctx, tc)
+	}
+	return results
+}
+
+// Run executes a test case.
+// The blueprint is rendered and the results are compared with the golden files, or written into them if the runner
+// is configured to update them.
+func (r *Runner) Run(ctx context.Context, tc *TestCase) *Result {
+	result := &Result{
+		Name: tc.Name,
+		Path: tc.Path(),
+	}
+
+	rendered, err := Render(ctx, tc)
+	if len(tc.ExpectedError) != 0 {
+		if err == nil {
+			result.Error = fmt.Sprintf("expected the rendering to fail with %q, but it succeeded", tc.ExpectedError)
+		} else if !strings.Contains(err.Error(), tc.ExpectedError) {
+			result.Error = fmt.Sprintf("expected the rendering to fail with %q, but it failed with: %s", tc.ExpectedError, err.Error())
+		}
+		return result.complete()
+	}
+	if err != nil {
+		result.Error = err.Error()
+		return result.complete()
+	}
+
+	golden := goldenFiles(rendered)
+	if r.update {
+		if err := writeGoldenFiles(tc.Golden, golden); err != nil {
+			result.Error = err.Error()
+			return result.complete()
+		}
+		result.Updated = true
+		return result.complete()
+	}
+
+	ignored := map[string][]string{
+		DeployItemsFile:   tc.Ignore.DeployItems,
+		InstallationsFile: tc.Ignore.Installations,
+		ExportsFile:       tc.Ignore.Exports,
+	}
+	for _, file := range goldenFileNames {
+		fileDiff, err := compareGoldenFile(filepath.Join(tc.Golden, file), golden[file], ignored[file])
+		if err != nil {
+			result.Error = err.Error()
+			return result.complete()
+		}
+		if fileDiff != nil {
+			fileDiff.File = file
+			result.Diffs = append(result.Diffs, *fileDiff)
+		}
+	}
+	return result.complete()
+}
+
+// Render renders the blueprint of a test case together with its subinstallations.
+func Render(ctx context.Context, tc *TestCase) (*Rendered, error) {
+	blueprintFs, err := projectionfs.New(osfs.New(), tc.Blueprint)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read blueprint from %s: %w", tc.Blueprint, err)
+	}
+	blueprint, err := blueprints.NewFromFs(blueprintFs)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read blueprint from %s: %w", tc.Blueprint, err)
+	}
+
+	exportTemplates, err := tc.exportTemplates()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		componentVersion  model.ComponentVersion
+		cdList            = &model.ComponentVersionList{}
+		registryAccess    model.RegistryAccess
+		repositoryContext *types.UnstructuredTypedObject
+	)
+	if tc.Component != nil {
+		// every test case uses its own ocm context, as the local registry is configured in the context
+		ctx = ocm.New(datacontext.MODE_EXTENDED).BindTo(ctx)
+		registryAccess, err = registries.GetFactory().NewRegistryAccess(ctx, &model.RegistryAccessOptions{
+			LocalRegistryConfig: &config.LocalRegistryConfiguration{RootPath: tc.Component.RegistryPath},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to create registry access for %s: %w", tc.Component.RegistryPath, err)
+		}
+
+		repositoryContext = &types.UnstructuredTypedObject{}
+		if err := repositoryContext.UnmarshalJSON([]byte(`{"type":"local"}`)); err != nil {
+			return nil, err
+		}
+
+		componentVersion, err = registryAccess.GetComponentVersion(ctx, &lsv1alpha1.ComponentDescriptorReference{
+			RepositoryContext: repositoryContext,
+			ComponentName:     tc.Component.Name,
+			Version:           tc.Component.Version,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to get component version %s:%s: %w", tc.Component.Name, tc.Component.Version, err)
+		}
+
+		cdList, err = model.GetTransitiveComponentReferences(ctx, componentVersion, repositoryContext, nil)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get the referenced component versions of %s:%s: %w", tc.Component.Name, tc.Component.Version, err)
+		}
+	}
+
+	simulator, err := lsutils.NewInstallationSimulator(cdList, registryAccess, repositoryContext, exportTemplates)
+	if err != nil {
+		return nil, err
+	}
+
+	rendered := &Rendered{
+		DeployItems:   map[string]lsv1alpha1.DeployItemSpec{},
+		Installations: map[string]lsv1alpha1.InstallationSpec{},
+	}
+	simulator.SetCallbacks(&renderCallbacks{rendered: rendered})
+
+	imports := tc.Imports
+	if imports == nil {
+		imports = map[string]interface{}{}
+	}
+	rendered.Exports, err = simulator.Run(componentVersion, blueprint, imports)
+	if err != nil {
+		return nil, err
+	}
+	return rendered, nil
+}
+
+// renderCallbacks collects the rendered deploy items and subinstallations of the installation simulator.
+type renderCallbacks struct {
+	rendered *Rendered
+}
+
+var _ lsutils.InstallationSimulatorCallbacks = &renderCallbacks{}
+
+func (c *renderCallbacks) OnInstallation(installationPath string, installation *lsv1alpha1.Installation) {
+	// the root installation is generated by the simulator and is not part of the results
+	if !strings.Contains(installationPath, "/") {
+		return
+	}
+	c.rendered.Installations[installationPath] = installation.Spec
+}
+
+func (c *renderCallbacks) OnDeployItem(installationPath string, deployItem *lsv1alpha1.DeployItem) {
+	c.rendered.DeployItems[path.Join(installationPath, deployItem.Name)] = deployItem.Spec
+}
+
+func (c *renderCallbacks) OnInstallationTemplateState(_ string, _ map[string][]byte) {}
+func (c *renderCallbacks) OnImports(_ string, _ map[string]interface{})              {}
+func (c *renderCallbacks) OnDeployItemTemplateState(_ string, _ map[string][]byte)   {}
+func (c *renderCallbacks) OnExports(_ string, _ map[string]interface{})              {}
+
+// goldenFileNames contains the names of all golden files in the order in which they are compared.
+var goldenFileNames = []string{DeployItemsFile, InstallationsFile, ExportsFile}
+
+// goldenFiles returns the content of the golden files for the rendered results.
+func goldenFiles(rendered *Rendered) map[string]interface{} {
+	exports := map[string]interface{}{
+		"dataObjects": map[string]interface{}{},
+		"targets":     map[string]interface{}{},
+	}
+	if rendered.Exports != nil {
+		if rendered.Exports.DataObjects != nil {
+			exports["dataObjects"] = rendered.Exports.DataObjects
+		}
+		if rendered.Exports.Targets != nil {
+			exports["targets"] = rendered.Exports.Targets
+		}
+	}
+
+	return map[string]interface{}{
+		DeployItemsFile:   rendered.DeployItems,
+		InstallationsFile: rendered.Installations,
+		ExportsFile:       exports,
+	}
+}
+
+func writeGoldenFiles(dir string, golden map[string]interface{}) error {
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return fmt.Errorf("unable to create golden file directory %s: %w", dir, err)
+	}
+	for _, file := range goldenFileNames {
+		data, err := yaml.Marshal(golden[file])
+		if err != nil {
+			return fmt.Errorf("unable to encode golden file %s: %w", file, err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, file), data, 0644); err != nil {
+			return fmt.Errorf("unable to write golden file %s: %w", file, err)
+		}
+	}
+	return nil
+}
+
+// compareGoldenFile compares the actual value with the content of a golden file.
+// Nil is returned if they are equal.
+func compareGoldenFile(goldenPath string, actual interface{}, ignored []string) (*FileDiff, error) {
+	data, err := os.ReadFile(goldenPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &FileDiff{Missing: true}, nil
+		}
+		return nil, fmt.Errorf("unable to read golden file %s: %w", goldenPath, err)
+	}
+
+	var expected interface{}
+	if err := yaml.Unmarshal(data, &expected); err != nil {
+		return nil, fmt.Errorf("unable to decode golden file %s: %w", goldenPath, err)
+	}
+	if expected == nil {
+		expected = map[string]interface{}{}
+	}
+
+	changes, err := jsondiff.Changes(expected, actual)
+	if err != nil {
+		return nil, fmt.Errorf("unable to compare golden file %s: %w", goldenPath, err)
+	}
+
+	fileDiff := &FileDiff{}
+	for _, change := range changes {
+		if !isIgnored(change.Path, ignored) {
+			fileDiff.Changes = append(fileDiff.Changes, change)
+		}
+	}
+	if len(fileDiff.Changes) == 0 {
+		return nil, nil
+	}
+	return fileDiff, nil
+}
+
+func isIgnored(changePath string, ignored []string) bool {
+	for _, ignoredPath := range ignored {
+		if changePath == ignoredPath || strings.HasPrefix(changePath, ignoredPath+".") {
+			return true
+		}
+	}
+	return false
+}
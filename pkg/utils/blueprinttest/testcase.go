@@ -0,0 +1,212 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package blueprinttest
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	lsutils "github.com/gardener/landscaper/pkg/utils/landscaper"
+)
+
+const (
+	// DeployItemsFile is the name of the golden file with the rendered deploy items.
+	DeployItemsFile = "deployitems.yaml"
+	// InstallationsFile is the name of the golden file with the rendered subinstallations.
+	InstallationsFile = "installations.yaml"
+	// ExportsFile is the name of the golden file with the exports of the blueprint.
+	ExportsFile = "exports.yaml"
+
+	// defaultGoldenDir is the directory of the golden files relative to the test case file,
+	// if the test case does not define one.
+	defaultGoldenDir = "golden"
+)
+
+// TestCase describes a test of a blueprint.
+// The blueprint is rendered with the given imports and the deploy items, subinstallations and exports of
+// the blueprint are compared with the golden files of the test case.
+type TestCase struct {
+	// Name is the name of the test case. Defaults to the file name of the test case without its extension.
+	Name string `json:"name,omitempty"`
+	// Blueprint is the path to the directory of the blueprint.
+	Blueprint string `json:"blueprint"`
+	// Component optionally describes the component version of the blueprint.
+	// It is required if the blueprint or its subinstallations reference component descriptors or other blueprints.
+	Component *ComponentReference `json:"component,omitempty"`
+	// Imports are the imports of the blueprint.
+	Imports map[string]interface{} `json:"imports,omitempty"`
+	// DeployItemExports are static exports of the deploy items whose path matches a selector.
+	DeployItemExports []DeployItemExports `json:"deployItemExports,omitempty"`
+	// ExportTemplates are templates that calculate the exports of deploy items and subinstallations.
+	ExportTemplates lsutils.ExportTemplates `json:"exportTemplates,omitempty"`
+	// Golden is the path to the directory of the golden files.
+	// Defaults to "golden/<name>" relative to the test case file.
+	Golden string `json:"golden,omitempty"`
+	// Ignore contains paths that are ignored when the rendered results are compared with the golden files,
+	// e.g. values that are generated during the rendering.
+	Ignore IgnoredPaths `json:"ignore,omitempty"`
+	// ExpectedError is a part of the error message that the rendering must fail with.
+	// The golden files are not compared if an error is expected.
+	ExpectedError string `json:"expectedError,omitempty"`
+
+	// path is the path of the test case file.
+	path string
+}
+
+// ComponentReference describes a component version in a local registry.
+type ComponentReference struct {
+	// RegistryPath is the path to the root directory of the local registry that contains the component descriptors.
+	RegistryPath string `json:"registryPath"`
+	// Name is the name of the component.
+	Name string `json:"name"`
+	// Version is the version of the component.
+	Version string `json:"version"`
+}
+
+// DeployItemExports describes the static exports of deploy items.
+type DeployItemExports struct {
+	// Selector is a regular expression that must match the installation path and the name of the deploy item,
+	// e.g. "root/my-subinstallation/my-deploy-item".
+	Selector string `json:"selector"`
+	// Exports are the exports of the deploy item.
+	Exports map[string]interface{} `json:"exports"`
+}
+
+// IgnoredPaths contains the paths that are ignored in the comparison with the golden files.
+// A path consists of the key in the golden file and the dot separated path of a field in the value,
+// e.g. "root/my-deploy-item.config.password". All nested fields of a path are ignored, too.
+type IgnoredPaths struct {
+	// DeployItems are the ignored paths of the deploy items golden file.
+	DeployItems []string `json:"deployItems,omitempty"`
+	// Installations are the ignored paths of the installations golden file.
+	Installations []string `json:"installations,omitempty"`
+	// Exports are the ignored paths of the exports golden file.
+	Exports []string `json:"exports,omitempty"`
+}
+
+// Path returns the path of the file the test case has been loaded from.
+func (tc *TestCase) Path() string {
+	return tc.path
+}
+
+// LoadTestCase reads a test case from a yaml file.
+// Relative paths in the test case are resolved relative to the directory of the file.
+func LoadTestCase(path string) (*TestCase, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read test case %s: %w", path, err)
+	}
+
+	tc := &TestCase{}
+	if err := yaml.UnmarshalStrict(data, tc); err != nil {
+		return nil, fmt.Errorf("unable to decode test case %s: %w", path, err)
+	}
+	tc.path = path
+
+	if len(tc.Name) == 0 {
+		tc.Name = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+	if len(tc.Blueprint) == 0 {
+		return nil, fmt.Errorf("test case %s defines no blueprint", path)
+	}
+	if tc.Component != nil && (len(tc.Component.RegistryPath) == 0 || len(tc.Component.Name) == 0 || len(tc.Component.Version) == 0) {
+		return nil, fmt.Errorf("the component of test case %s must define a registry path, a name and a version", path)
+	}
+	if len(tc.Golden) == 0 {
+		tc.Golden = filepath.Join(defaultGoldenDir, tc.Name)
+	}
+
+	dir := filepath.Dir(path)
+	tc.Blueprint = resolvePath(dir, tc.Blueprint)
+	tc.Golden = resolvePath(dir, tc.Golden)
+	if tc.Component != nil {
+		tc.Component.RegistryPath = resolvePath(dir, tc.Component.RegistryPath)
+	}
+	return tc, nil
+}
+
+// LoadTestCases reads the test cases from the given files and directories.
+// All files with a ".yaml" or ".yml" extension directly contained in a directory are read as test cases.
+func LoadTestCases(paths ...string) ([]*TestCase, error) {
+	testCases := make([]*TestCase, 0)
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read test cases from %s: %w", path, err)
+		}
+
+		files := []string{path}
+		if info.IsDir() {
+			files, err = listTestCaseFiles(path)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		for _, file := range files {
+			tc, err := LoadTestCase(file)
+			if err != nil {
+				return nil, err
+			}
+			testCases = append(testCases, tc)
+		}
+	}
+	return testCases, nil
+}
+
+// exportTemplates returns the export templates of the test case, including the templates of the static deploy item exports.
+// The static exports are added after the export templates, so that they take precedence if several selectors match.
+func (tc *TestCase) exportTemplates() (lsutils.ExportTemplates, error) {
+	templates := lsutils.ExportTemplates{
+		InstallationExports: tc.ExportTemplates.InstallationExports,
+	}
+	templates.DeployItemExports = append(templates.DeployItemExports, tc.ExportTemplates.DeployItemExports...)
+
+	for i, exports := range tc.DeployItemExports {
+		data, err := json.Marshal(exports.Exports)
+		if err != nil {
+			return templates, fmt.Errorf("unable to encode deploy item exports %d: %w", i, err)
+		}
+		templates.DeployItemExports = append(templates.DeployItemExports, &lsutils.ExportTemplate{
+			Name:     fmt.Sprintf("deployItemExports[%d]", i),
+			Selector: exports.Selector,
+			// the exports are quoted, so that they are not interpreted as a template
+			Template: "exports: {{ " + strconv.Quote(string(data)) + " }}",
+		})
+	}
+	return templates, nil
+}
+
+func listTestCaseFiles(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read test cases from %s: %w", dir, err)
+	}
+
+	files := make([]string, 0)
+	for _, entry := range entries {
+		ext := filepath.Ext(entry.Name())
+		if entry.IsDir() || (ext != ".yaml" && ext != ".yml") {
+			continue
+		}
+		files = append(files, filepath.Join(dir, entry.Name()))
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+func resolvePath(dir, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(dir, path)
+}
@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clusters
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/core/v1alpha1/targettypes"
+	"github.com/gardener/landscaper/controller-utils/pkg/landscaper/targetresolver"
+)
+
+// NewReaderFromTarget returns a read-only client for the cluster of a kubernetes cluster target.
+// The client is neither restricted to namespaces nor to kinds, it can read all objects the kubeconfig of the target
+// is permitted to read. Callers have to restrict the read objects, like the lookup template functions do with the
+// templateLookups of the context.
+func NewReaderFromTarget(ctx context.Context, target *v1alpha1.Target, targetResolver targetresolver.TargetResolver) (client.Reader, error) {
+	if targetResolver == nil {
+		return nil, fmt.Errorf("lookup client: no target resolver available")
+	}
+
+	resolvedTarget, err := targetResolver.Resolve(ctx, target)
+	if err != nil {
+		return nil, fmt.Errorf("lookup client: could not resolve target: %w", err)
+	}
+
+	targetConfig := &targettypes.KubernetesClusterTargetConfig{}
+	err = json.Unmarshal([]byte(resolvedTarget.Content), targetConfig)
+	if err != nil {
+		return nil, fmt.Errorf("lookup client: failed to unmarshal target config: %w", err)
+	}
+	if targetConfig.Kubeconfig.StrVal == nil {
+		return nil, fmt.Errorf("lookup client: target config contains no kubeconfig")
+	}
+
+	restConfig, err := clientcmd.RESTConfigFromKubeConfig([]byte(*targetConfig.Kubeconfig.StrVal))
+	if err != nil {
+		return nil, fmt.Errorf("lookup client: unable to get rest config: %w", err)
+	}
+
+	kubeClient, err := client.New(restConfig, client.Options{})
+	if err != nil {
+		return nil, fmt.Errorf("lookup client: unable to create client: %w", err)
+	}
+	return kubeClient, nil
+}
@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dependencies
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// LoadInstallationGraph builds the graph of the installations of a namespace.
+// If root installation names are given, only the installation trees of these root installations are contained in the graph.
+// The installations are identified by their names, subinstallations are found via the encompassed-by label.
+func LoadInstallationGraph(ctx context.Context, c client.Reader, namespace string, rootInstallations ...string) (*InstallationGraph, error) {
+	instList := &lsv1alpha1.InstallationList{}
+	if err := read_write_layer.ListInstallations(ctx, c, instList, read_write_layer.R000120, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("unable to list installations in namespace %s: %w", namespace, err)
+	}
+
+	roots := []*lsv1alpha1.Installation{}
+	subInstallations := map[string][]*lsv1alpha1.Installation{}
+	for i := range instList.Items {
+		inst := &instList.Items[i]
+		if parent, ok := inst.Labels[lsv1alpha1.EncompassedByLabel]; ok {
+			subInstallations[parent] = append(subInstallations[parent], inst)
+		} else {
+			roots = append(roots, inst)
+		}
+	}
+
+	if len(rootInstallations) != 0 {
+		selected := make([]*lsv1alpha1.Installation, 0, len(rootInstallations))
+		for _, name := range rootInstallations {
+			found := false
+			for _, root := range roots {
+				if root.Name == name {
+					selected = append(selected, root)
+					found = true
+					break
+				}
+			}
+			if !found {
+				return nil, fmt.Errorf("root installation %s not found in namespace %s", name, namespace)
+			}
+		}
+		roots = selected
+	}
+
+	builder := NewGraphBuilder()
+	var add func(inst *lsv1alpha1.Installation, parent string)
+	add = func(inst *lsv1alpha1.Installation, parent string) {
+		if _, ok := builder.installations[inst.Name]; ok {
+			return
+		}
+		builder.AddInstallation(inst.Name, parent, inst)
+		for _, sub := range subInstallations[inst.Name] {
+			add(sub, inst.Name)
+		}
+	}
+	for _, root := range roots {
+		add(root, "")
+	}
+
+	return builder.Build(), nil
+}
@@ -0,0 +1,452 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dependencies
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+// NodeKind is the kind of a node of an installation graph.
+type NodeKind string
+
+const (
+	// NodeKindInstallation is the kind of nodes describing installations.
+	NodeKindInstallation NodeKind = "Installation"
+	// NodeKindDataObject is the kind of nodes describing data objects.
+	NodeKindDataObject NodeKind = "DataObject"
+	// NodeKindTarget is the kind of nodes describing targets.
+	NodeKindTarget NodeKind = "Target"
+)
+
+// EdgeKind is the kind of an edge of an installation graph.
+type EdgeKind string
+
+const (
+	// EdgeKindSubInstallation connects an installation with one of its subinstallations.
+	EdgeKindSubInstallation EdgeKind = "SubInstallation"
+	// EdgeKindImport connects an imported data object or target with the importing installation.
+	EdgeKindImport EdgeKind = "Import"
+	// EdgeKindExport connects an installation with an exported data object or target.
+	EdgeKindExport EdgeKind = "Export"
+	// EdgeKindDependsOn connects an installation with a sibling installation that has to be processed before.
+	EdgeKindDependsOn EdgeKind = "DependsOn"
+)
+
+// InstallationGraph describes installations, their nesting and the data objects and targets flowing between them.
+type InstallationGraph struct {
+	Nodes []GraphNode `json:"nodes"`
+	Edges []GraphEdge `json:"edges"`
+}
+
+// GraphNode is an installation, data object or target of an installation graph.
+type GraphNode struct {
+	// ID is the unique identifier of the node in the graph.
+	ID string `json:"id"`
+	// Kind is the kind of the node.
+	Kind NodeKind `json:"kind"`
+	// Name is the name of the installation, or the name of the data object or target in the context of its parent.
+	Name string `json:"name"`
+	// Parent is the ID of the installation node that contains the node. It is empty for top level nodes.
+	Parent string `json:"parent,omitempty"`
+	// Phase is the phase of an installation.
+	Phase string `json:"phase,omitempty"`
+}
+
+// GraphEdge connects two nodes of an installation graph.
+type GraphEdge struct {
+	From string   `json:"from"`
+	To   string   `json:"to"`
+	Kind EdgeKind `json:"kind"`
+	// Name is the name of the import or export.
+	Name string `json:"name,omitempty"`
+}
+
+// GraphBuilder collects installations and builds the graph of their dependencies.
+type GraphBuilder struct {
+	installations map[string]*graphInstallation
+}
+
+type graphInstallation struct {
+	id           string
+	parent       string
+	installation *lsv1alpha1.Installation
+}
+
+// NewGraphBuilder creates a new builder for installation graphs.
+func NewGraphBuilder() *GraphBuilder {
+	return &GraphBuilder{
+		installations: map[string]*graphInstallation{},
+	}
+}
+
+// AddInstallation adds an installation to the graph.
+// The id has to be unique among all installations of the graph, parentID is the id of the parent installation
+// or empty for root installations.
+func (b *GraphBuilder) AddInstallation(id, parentID string, installation *lsv1alpha1.Installation) *GraphBuilder {
+	b.installations[id] = &graphInstallation{
+		id:           id,
+		parent:       parentID,
+		installation: installation,
+	}
+	return b
+}
+
+// Build computes the graph of the added installations.
+// The imports and exports of the installations are resolved in the context of their parent: an import that is not
+// exported by a sibling is resolved to the corresponding import of the parent installation, an export that is exported
+// by the parent is resolved to the export of the parent.
+func (b *GraphBuilder) Build() *InstallationGraph {
+	gb := &graphBuild{
+		builder:           b,
+		graph:             &InstallationGraph{Nodes: []GraphNode{}, Edges: []GraphEdge{}},
+		nodes:             sets.New[string](),
+		edges:             sets.New[string](),
+		siblings:          map[string][]*graphInstallation{},
+		dataExports:       map[string]sets.Set[string]{},
+		targetExports:     map[string]sets.Set[string]{},
+		dataExportNames:   map[string]map[string]string{},
+		targetExportNames: map[string]map[string]string{},
+	}
+
+	ids := make([]string, 0, len(b.installations))
+	for id := range b.installations {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		inst := b.installations[id]
+		gb.siblings[inst.parent] = append(gb.siblings[inst.parent], inst)
+		if _, ok := gb.dataExports[inst.parent]; !ok {
+			gb.dataExports[inst.parent] = sets.New[string]()
+			gb.targetExports[inst.parent] = sets.New[string]()
+		}
+		for _, exp := range inst.installation.Spec.Exports.Data {
+			gb.dataExports[inst.parent].Insert(exp.DataRef)
+		}
+		for _, exp := range inst.installation.Spec.Exports.Targets {
+			gb.targetExports[inst.parent].Insert(exp.Target)
+		}
+		gb.dataExportNames[id] = map[string]string{}
+		for _, exp := range inst.installation.Spec.Exports.Data {
+			gb.dataExportNames[id][exp.Name] = exp.DataRef
+		}
+		gb.targetExportNames[id] = map[string]string{}
+		for _, exp := range inst.installation.Spec.Exports.Targets {
+			gb.targetExportNames[id][exp.Name] = exp.Target
+		}
+	}
+
+	for _, id := range ids {
+		inst := b.installations[id]
+		gb.addNode(GraphNode{
+			ID:     installationNodeID(id),
+			Kind:   NodeKindInstallation,
+			Name:   inst.installation.Name,
+			Parent: gb.parentNodeID(inst.parent),
+			Phase:  string(inst.installation.Status.InstallationPhase),
+		})
+		if _, ok := b.installations[inst.parent]; ok {
+			gb.addEdge(GraphEdge{From: installationNodeID(inst.parent), To: installationNodeID(id), Kind: EdgeKindSubInstallation})
+		}
+	}
+
+	for _, id := range ids {
+		gb.addImportsAndExports(b.installations[id])
+	}
+	gb.addSiblingDependencies()
+
+	return gb.graph
+}
+
+// graphBuild contains the state during the computation of a graph.
+type graphBuild struct {
+	builder *GraphBuilder
+	graph   *InstallationGraph
+	nodes   sets.Set[string]
+	edges   sets.Set[string]
+
+	// siblings maps the id of an installation to its subinstallations
+	siblings map[string][]*graphInstallation
+	// dataExports maps the id of an installation to the data objects exported by its subinstallations
+	dataExports map[string]sets.Set[string]
+	// targetExports maps the id of an installation to the targets exported by its subinstallations
+	targetExports map[string]sets.Set[string]
+	// dataExportNames maps the id of an installation to a mapping of its export names to the exported data objects
+	dataExportNames map[string]map[string]string
+	// targetExportNames maps the id of an installation to a mapping of its export names to the exported targets
+	targetExportNames map[string]map[string]string
+}
+
+func (gb *graphBuild) addImportsAndExports(inst *graphInstallation) {
+	instNodeID := installationNodeID(inst.id)
+	imports := inst.installation.Spec.Imports
+	exports := inst.installation.Spec.Exports
+
+	for _, imp := range imports.Data {
+		if len(imp.DataRef) == 0 {
+			// secret and configmap imports are not part of the data flow between installations
+			continue
+		}
+		for _, from := range gb.resolveDataImport(inst.parent, imp.DataRef) {
+			gb.addEdge(GraphEdge{From: from, To: instNodeID, Kind: EdgeKindImport, Name: imp.Name})
+		}
+	}
+	for _, imp := range imports.Targets {
+		for _, ref := range targetImportRefs(imp) {
+			for _, from := range gb.resolveTargetImport(inst.parent, ref) {
+				gb.addEdge(GraphEdge{From: from, To: instNodeID, Kind: EdgeKindImport, Name: imp.Name})
+			}
+		}
+	}
+
+	for _, exp := range exports.Data {
+		gb.addEdge(GraphEdge{From: instNodeID, To: gb.resolveDataExport(inst.parent, exp.DataRef), Kind: EdgeKindExport, Name: exp.Name})
+	}
+	for _, exp := range exports.Targets {
+		gb.addEdge(GraphEdge{From: instNodeID, To: gb.resolveTargetExport(inst.parent, exp.Target), Kind: EdgeKindExport, Name: exp.Name})
+	}
+}
+
+// resolveDataImport returns the ids of the nodes of the data object with the given name in the context of the given installation.
+func (gb *graphBuild) resolveDataImport(scope, name string) []string {
+	if gb.dataExports[scope].Has(name) {
+		return []string{gb.resolveDataExport(scope, name)}
+	}
+	if parent, ok := gb.builder.installations[scope]; ok {
+		for _, imp := range parent.installation.Spec.Imports.Data {
+			if imp.Name == name && len(imp.DataRef) != 0 {
+				return gb.resolveDataImport(parent.parent, imp.DataRef)
+			}
+		}
+	}
+	return []string{gb.addObjectNode(NodeKindDataObject, scope, name)}
+}
+
+// resolveTargetImport returns the ids of the nodes of the targets with the given name in the context of the given installation.
+func (gb *graphBuild) resolveTargetImport(scope, name string) []string {
+	if gb.targetExports[scope].Has(name) {
+		return []string{gb.resolveTargetExport(scope, name)}
+	}
+	if parent, ok := gb.builder.installations[scope]; ok {
+		for _, imp := range parent.installation.Spec.Imports.Targets {
+			if imp.Name != name {
+				continue
+			}
+			res := []string{}
+			for _, ref := range targetImportRefs(imp) {
+				res = append(res, gb.resolveTargetImport(parent.parent, ref)...)
+			}
+			if len(res) != 0 {
+				return res
+			}
+		}
+	}
+	return []string{gb.addObjectNode(NodeKindTarget, scope, name)}
+}
+
+// resolveDataExport returns the id of the node of the exported data object with the given name in the context of the given installation.
+func (gb *graphBuild) resolveDataExport(scope, name string) string {
+	if parent, ok := gb.builder.installations[scope]; ok {
+		if ref, ok := gb.dataExportNames[scope][name]; ok {
+			return gb.resolveDataExport(parent.parent, ref)
+		}
+	}
+	return gb.addObjectNode(NodeKindDataObject, scope, name)
+}
+
+// resolveTargetExport returns the id of the node of the exported target with the given name in the context of the given installation.
+func (gb *graphBuild) resolveTargetExport(scope, name string) string {
+	if parent, ok := gb.builder.installations[scope]; ok {
+		if ref, ok := gb.targetExportNames[scope][name]; ok {
+			return gb.resolveTargetExport(parent.parent, ref)
+		}
+	}
+	return gb.addObjectNode(NodeKindTarget, scope, name)
+}
+
+// addSiblingDependencies adds the dependencies between sibling installations as computed for their ordering.
+func (gb *graphBuild) addSiblingDependencies() {
+	scopes := make([]string, 0, len(gb.siblings))
+	for scope := range gb.siblings {
+		scopes = append(scopes, scope)
+	}
+	sort.Strings(scopes)
+
+	for _, scope := range scopes {
+		siblings := gb.siblings[scope]
+		nodes := make([]*installationNode, 0, len(siblings))
+		ids := map[string]string{}
+		for _, sibling := range siblings {
+			nodes = append(nodes, newInstallationNodeFromInstallation(sibling.installation))
+			ids[sibling.installation.Name] = sibling.id
+		}
+		for i, node := range nodes {
+			predecessors, err := node.fetchPredecessors(nodes)
+			if err != nil {
+				// duplicate exports are already visible as multiple export edges into the same node
+				continue
+			}
+			for _, pred := range predecessors.List() {
+				gb.addEdge(GraphEdge{From: installationNodeID(siblings[i].id), To: installationNodeID(ids[pred]), Kind: EdgeKindDependsOn})
+			}
+		}
+	}
+}
+
+func (gb *graphBuild) addObjectNode(kind NodeKind, scope, name string) string {
+	id := objectNodeID(kind, scope, name)
+	gb.addNode(GraphNode{
+		ID:     id,
+		Kind:   kind,
+		Name:   name,
+		Parent: gb.parentNodeID(scope),
+	})
+	return id
+}
+
+func (gb *graphBuild) parentNodeID(scope string) string {
+	if _, ok := gb.builder.installations[scope]; !ok {
+		return ""
+	}
+	return installationNodeID(scope)
+}
+
+func (gb *graphBuild) addNode(node GraphNode) {
+	if gb.nodes.Has(node.ID) {
+		return
+	}
+	gb.nodes.Insert(node.ID)
+	gb.graph.Nodes = append(gb.graph.Nodes, node)
+}
+
+func (gb *graphBuild) addEdge(edge GraphEdge) {
+	key := strings.Join([]string{edge.From, edge.To, string(edge.Kind), edge.Name}, "\x00")
+	if gb.edges.Has(key) {
+		return
+	}
+	gb.edges.Insert(key)
+	gb.graph.Edges = append(gb.graph.Edges, edge)
+}
+
+// targetImportRefs returns the names of the targets referenced by a target import.
+func targetImportRefs(imp lsv1alpha1.TargetImport) []string {
+	switch {
+	case len(imp.Target) != 0:
+		return []string{imp.Target}
+	case len(imp.Targets) != 0:
+		return imp.Targets
+	case len(imp.TargetMap) != 0:
+		return sets.List(sets.New(mapValues(imp.TargetMap)...))
+	case len(imp.TargetListReference) != 0:
+		return []string{imp.TargetListReference}
+	case len(imp.TargetMapReference) != 0:
+		return []string{imp.TargetMapReference}
+	}
+	return nil
+}
+
+func mapValues(m map[string]string) []string {
+	res := make([]string, 0, len(m))
+	for _, v := range m {
+		res = append(res, v)
+	}
+	return res
+}
+
+func installationNodeID(id string) string {
+	return "installation:" + id
+}
+
+func objectNodeID(kind NodeKind, scope, name string) string {
+	if len(scope) == 0 {
+		return strings.ToLower(string(kind)) + ":" + name
+	}
+	return strings.ToLower(string(kind)) + ":" + scope + "/" + name
+}
+
+// WriteJSON writes the graph as JSON.
+func (g *InstallationGraph) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(g)
+}
+
+// WriteDOT writes the graph in the Graphviz DOT format.
+// Installations with subinstallations are rendered as clusters containing their subinstallations,
+// data objects and targets.
+func (g *InstallationGraph) WriteDOT(w io.Writer) error {
+	children := map[string][]GraphNode{}
+	for _, node := range g.Nodes {
+		children[node.Parent] = append(children[node.Parent], node)
+	}
+
+	b := &strings.Builder{}
+	b.WriteString("digraph installations {\n")
+	b.WriteString("  rankdir=LR;\n")
+	b.WriteString("  compound=true;\n")
+	writeDOTNodes(b, children, "", "  ")
+	for _, edge := range g.Edges {
+		if edge.Kind == EdgeKindSubInstallation {
+			// nesting is visualized by clusters
+			continue
+		}
+		fmt.Fprintf(b, "  %s -> %s [%s];\n", quoteDOT(edge.From), quoteDOT(edge.To), dotEdgeAttributes(edge))
+	}
+	b.WriteString("}\n")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func writeDOTNodes(b *strings.Builder, children map[string][]GraphNode, parent, indent string) {
+	for _, node := range children[parent] {
+		if node.Kind == NodeKindInstallation && len(children[node.ID]) != 0 {
+			fmt.Fprintf(b, "%ssubgraph %s {\n", indent, quoteDOT("cluster_"+node.ID))
+			fmt.Fprintf(b, "%s  label=%s;\n", indent, quoteDOT(node.Name))
+			fmt.Fprintf(b, "%s  %s [%s];\n", indent, quoteDOT(node.ID), dotNodeAttributes(node))
+			writeDOTNodes(b, children, node.ID, indent+"  ")
+			fmt.Fprintf(b, "%s}\n", indent)
+			continue
+		}
+		fmt.Fprintf(b, "%s%s [%s];\n", indent, quoteDOT(node.ID), dotNodeAttributes(node))
+	}
+}
+
+func dotNodeAttributes(node GraphNode) string {
+	label := node.Name
+	if len(node.Phase) != 0 {
+		label = fmt.Sprintf("%s\n(%s)", node.Name, node.Phase)
+	}
+	shape := "box"
+	switch node.Kind {
+	case NodeKindDataObject:
+		shape = "ellipse"
+	case NodeKindTarget:
+		shape = "hexagon"
+	}
+	return fmt.Sprintf("label=%s, shape=%s", quoteDOT(label), shape)
+}
+
+func dotEdgeAttributes(edge GraphEdge) string {
+	switch edge.Kind {
+	case EdgeKindDependsOn:
+		return "style=dashed, label=\"depends on\""
+	default:
+		return "label=" + quoteDOT(edge.Name)
+	}
+}
+
+func quoteDOT(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s) + `"`
+}
@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dependencies
+
+import (
+	"bytes"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+var _ = Describe("Installation Graph", func() {
+
+	newInstallation := func(name string, imports lsv1alpha1.InstallationImports, exports lsv1alpha1.InstallationExports) *lsv1alpha1.Installation {
+		return &lsv1alpha1.Installation{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: lsv1alpha1.InstallationSpec{
+				Imports: imports,
+				Exports: exports,
+			},
+		}
+	}
+
+	// buildTestGraph builds the following graph:
+	// root imports the data object "config" and the target "cluster" and exports the data object "endpoint".
+	// Its subinstallation "db" imports the parent imports and exports "db-url", which is imported by the sibling "app".
+	// "app" exports "app-url", which is exported by root as "endpoint".
+	buildTestGraph := func() *InstallationGraph {
+		root := newInstallation("root",
+			lsv1alpha1.InstallationImports{
+				Data:    []lsv1alpha1.DataImport{{Name: "rootConfig", DataRef: "config"}},
+				Targets: []lsv1alpha1.TargetImport{{Name: "rootCluster", Target: "cluster"}},
+			},
+			lsv1alpha1.InstallationExports{
+				Data: []lsv1alpha1.DataExport{{Name: "app-url", DataRef: "endpoint"}},
+			})
+		root.Status.InstallationPhase = lsv1alpha1.InstallationPhases.Succeeded
+		db := newInstallation("db",
+			lsv1alpha1.InstallationImports{
+				Data:    []lsv1alpha1.DataImport{{Name: "config", DataRef: "rootConfig"}},
+				Targets: []lsv1alpha1.TargetImport{{Name: "cluster", Target: "rootCluster"}},
+			},
+			lsv1alpha1.InstallationExports{
+				Data: []lsv1alpha1.DataExport{{Name: "url", DataRef: "db-url"}},
+			})
+		app := newInstallation("app",
+			lsv1alpha1.InstallationImports{
+				Data: []lsv1alpha1.DataImport{{Name: "db", DataRef: "db-url"}},
+			},
+			lsv1alpha1.InstallationExports{
+				Data: []lsv1alpha1.DataExport{{Name: "url", DataRef: "app-url"}},
+			})
+
+		return NewGraphBuilder().
+			AddInstallation("root", "", root).
+			AddInstallation("root/db", "root", db).
+			AddInstallation("root/app", "root", app).
+			Build()
+	}
+
+	It("should contain installations, data objects and targets", func() {
+		graph := buildTestGraph()
+		Expect(graph.Nodes).To(ConsistOf(
+			GraphNode{ID: "installation:root", Kind: NodeKindInstallation, Name: "root", Phase: "Succeeded"},
+			GraphNode{ID: "installation:root/db", Kind: NodeKindInstallation, Name: "db", Parent: "installation:root"},
+			GraphNode{ID: "installation:root/app", Kind: NodeKindInstallation, Name: "app", Parent: "installation:root"},
+			GraphNode{ID: "dataobject:config", Kind: NodeKindDataObject, Name: "config"},
+			GraphNode{ID: "dataobject:endpoint", Kind: NodeKindDataObject, Name: "endpoint"},
+			GraphNode{ID: "target:cluster", Kind: NodeKindTarget, Name: "cluster"},
+			GraphNode{ID: "dataobject:root/db-url", Kind: NodeKindDataObject, Name: "db-url", Parent: "installation:root"},
+		))
+	})
+
+	It("should resolve imports and exports via the parent installation", func() {
+		graph := buildTestGraph()
+		Expect(graph.Edges).To(ConsistOf(
+			GraphEdge{From: "installation:root", To: "installation:root/db", Kind: EdgeKindSubInstallation},
+			GraphEdge{From: "installation:root", To: "installation:root/app", Kind: EdgeKindSubInstallation},
+			GraphEdge{From: "dataobject:config", To: "installation:root", Kind: EdgeKindImport, Name: "rootConfig"},
+			GraphEdge{From: "target:cluster", To: "installation:root", Kind: EdgeKindImport, Name: "rootCluster"},
+			GraphEdge{From: "installation:root", To: "dataobject:endpoint", Kind: EdgeKindExport, Name: "app-url"},
+			GraphEdge{From: "dataobject:config", To: "installation:root/db", Kind: EdgeKindImport, Name: "config"},
+			GraphEdge{From: "target:cluster", To: "installation:root/db", Kind: EdgeKindImport, Name: "cluster"},
+			GraphEdge{From: "installation:root/db", To: "dataobject:root/db-url", Kind: EdgeKindExport, Name: "url"},
+			GraphEdge{From: "dataobject:root/db-url", To: "installation:root/app", Kind: EdgeKindImport, Name: "db"},
+			GraphEdge{From: "installation:root/app", To: "dataobject:endpoint", Kind: EdgeKindExport, Name: "url"},
+			GraphEdge{From: "installation:root/app", To: "installation:root/db", Kind: EdgeKindDependsOn},
+		))
+	})
+
+	It("should write the graph as JSON", func() {
+		graph := buildTestGraph()
+		buf := &bytes.Buffer{}
+		Expect(graph.WriteJSON(buf)).To(Succeed())
+
+		res := &InstallationGraph{}
+		Expect(json.Unmarshal(buf.Bytes(), res)).To(Succeed())
+		Expect(res).To(Equal(graph))
+	})
+
+	It("should write the graph in the DOT format with clusters for nested installations", func() {
+		graph := buildTestGraph()
+		buf := &bytes.Buffer{}
+		Expect(graph.WriteDOT(buf)).To(Succeed())
+
+		dot := buf.String()
+		Expect(dot).To(HavePrefix("digraph installations {\n"))
+		Expect(dot).To(ContainSubstring(`subgraph "cluster_installation:root" {`))
+		Expect(dot).To(ContainSubstring(`"installation:root" [label="root\n(Succeeded)", shape=box];`))
+		Expect(dot).To(ContainSubstring(`"target:cluster" [label="cluster", shape=hexagon];`))
+		Expect(dot).To(ContainSubstring(`"dataobject:root/db-url" -> "installation:root/app" [label="db"];`))
+		Expect(dot).To(ContainSubstring(`"installation:root/app" -> "installation:root/db" [style=dashed, label="depends on"];`))
+		Expect(dot).ToNot(ContainSubstring(`"installation:root" -> "installation:root/db"`))
+	})
+
+})
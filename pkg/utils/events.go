@@ -0,0 +1,48 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+)
+
+const (
+	// EventReasonPhaseTransition is the reason of the events that are emitted if the phase of an installation,
+	// execution or deploy item changes.
+	EventReasonPhaseTransition = "PhaseTransition"
+	// EventReasonAutomaticReconcile is the reason of the events that are emitted if an automatic reconcile
+	// of an installation is triggered.
+	EventReasonAutomaticReconcile = "AutomaticReconcile"
+	// EventReasonDeletionBlocked is the reason of the events that are emitted if the deletion of an installation
+	// has to wait for other installations or fails because the deletion of another installation has failed.
+	EventReasonDeletionBlocked = "DeletionBlocked"
+	// EventReasonRollback is the reason of the events that are emitted if an installation is rolled back
+	// to a previous revision.
+	EventReasonRollback = "Rollback"
+	// EventReasonJobDeferred is the reason of the events that are emitted if a new job of an installation
+	// is deferred until the next maintenance window.
+	EventReasonJobDeferred = "JobDeferred"
+)
+
+// RecordPhaseTransitionEvent emits an event if the phase of an object has changed.
+// Transitions into a failed phase are reported as warning, all other transitions as normal events.
+func RecordPhaseTransitionEvent(recorder record.EventRecorder, obj runtime.Object, oldPhase, newPhase string, failed bool, jobID string) {
+	if recorder == nil || oldPhase == newPhase {
+		return
+	}
+
+	eventType := corev1.EventTypeNormal
+	if failed {
+		eventType = corev1.EventTypeWarning
+	}
+
+	if len(oldPhase) == 0 {
+		recorder.Eventf(obj, eventType, EventReasonPhaseTransition, "Phase set to %s (job %s)", newPhase, jobID)
+		return
+	}
+	recorder.Eventf(obj, eventType, EventReasonPhaseTransition, "Phase changed from %s to %s (job %s)", oldPhase, newPhase, jobID)
+}
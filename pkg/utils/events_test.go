@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/client-go/tools/record"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsutil "github.com/gardener/landscaper/pkg/utils"
+)
+
+var _ = Describe("Events", func() {
+
+	var (
+		recorder *record.FakeRecorder
+		inst     *lsv1alpha1.Installation
+	)
+
+	BeforeEach(func() {
+		recorder = record.NewFakeRecorder(10)
+		inst = &lsv1alpha1.Installation{}
+	})
+
+	It("should emit a normal event for a phase transition", func() {
+		lsutil.RecordPhaseTransitionEvent(recorder, inst, string(lsv1alpha1.InstallationPhases.Init),
+			string(lsv1alpha1.InstallationPhases.Succeeded), false, "job1")
+		Expect(recorder.Events).To(Receive(Equal("Normal PhaseTransition Phase changed from Init to Succeeded (job job1)")))
+	})
+
+	It("should emit a warning event for a transition into a failed phase", func() {
+		lsutil.RecordPhaseTransitionEvent(recorder, inst, "", string(lsv1alpha1.InstallationPhases.Failed), true, "job1")
+		Expect(recorder.Events).To(Receive(Equal("Warning PhaseTransition Phase set to Failed (job job1)")))
+	})
+
+	It("should not emit an event if the phase has not changed", func() {
+		lsutil.RecordPhaseTransitionEvent(recorder, inst, string(lsv1alpha1.InstallationPhases.Succeeded),
+			string(lsv1alpha1.InstallationPhases.Succeeded), false, "job1")
+		lsutil.RecordPhaseTransitionEvent(nil, inst, "", string(lsv1alpha1.InstallationPhases.Succeeded), false, "job1")
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+})
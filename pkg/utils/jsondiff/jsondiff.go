@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package jsondiff
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// ChangeType describes how a field differs between two objects.
+type ChangeType string
+
+const (
+	// ChangeTypeAdded marks a field that only exists in the new object.
+	ChangeTypeAdded ChangeType = "added"
+	// ChangeTypeRemoved marks a field that only exists in the old object.
+	ChangeTypeRemoved ChangeType = "removed"
+	// ChangeTypeChanged marks a field whose value differs between the objects.
+	ChangeTypeChanged ChangeType = "changed"
+)
+
+// Change describes a field that differs between two objects.
+type Change struct {
+	// Path is the dot separated path of the field.
+	Path string `json:"path"`
+	// Type describes whether the field was added, removed or changed.
+	Type ChangeType `json:"type"`
+	// Old is the value of the field in the old object.
+	Old interface{} `json:"old,omitempty"`
+	// New is the value of the field in the new object.
+	New interface{} `json:"new,omitempty"`
+}
+
+// ChangedPaths returns the sorted paths of all fields that differ between the json representations of the given objects.
+// Nested objects are compared field by field, whereas arrays and scalar values are compared as a whole.
+// The paths are separated by dots, e.g. "spec.config.values".
+func ChangedPaths(oldObj, newObj interface{}) ([]string, error) {
+	changes, err := Changes(oldObj, newObj)
+	if err != nil {
+		return nil, err
+	}
+	paths := make([]string, len(changes))
+	for i, change := range changes {
+		paths[i] = change.Path
+	}
+	return paths, nil
+}
+
+// Changes returns all fields that differ between the json representations of the given objects, sorted by their path.
+// The objects are compared like in ChangedPaths, but the result additionally contains the old and the new values.
+func Changes(oldObj, newObj interface{}) ([]Change, error) {
+	oldValue, err := toJSONValue(oldObj)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert old object: %w", err)
+	}
+	newValue, err := toJSONValue(newObj)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert new object: %w", err)
+	}
+
+	changes := diff("", oldValue, newValue, nil)
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+	return changes, nil
+}
+
+func toJSONValue(obj interface{}) (interface{}, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+func diff(path string, oldValue, newValue interface{}, changes []Change) []Change {
+	oldMap, oldIsMap := oldValue.(map[string]interface{})
+	newMap, newIsMap := newValue.(map[string]interface{})
+	if !oldIsMap || !newIsMap {
+		if !reflect.DeepEqual(oldValue, newValue) {
+			changes = append(changes, Change{Path: path, Type: ChangeTypeChanged, Old: oldValue, New: newValue})
+		}
+		return changes
+	}
+
+	for key, oldField := range oldMap {
+		newField, ok := newMap[key]
+		if !ok {
+			if oldField != nil {
+				changes = append(changes, Change{Path: join(path, key), Type: ChangeTypeRemoved, Old: oldField})
+			}
+			continue
+		}
+		changes = diff(join(path, key), oldField, newField, changes)
+	}
+	for key, newField := range newMap {
+		if _, ok := oldMap[key]; !ok {
+			changes = append(changes, Change{Path: join(path, key), Type: ChangeTypeAdded, New: newField})
+		}
+	}
+	return changes
+}
+
+func join(path, key string) string {
+	if len(path) == 0 {
+		return key
+	}
+	return path + "." + key
+}
@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package jsondiff_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/landscaper/pkg/utils/jsondiff"
+)
+
+func TestConfig(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "JSON Diff Test Suite")
+}
+
+var _ = Describe("ChangedPaths", func() {
+
+	It("should return no paths for equal objects", func() {
+		obj := map[string]interface{}{
+			"a": "b",
+			"c": map[string]interface{}{"d": []interface{}{1, 2}},
+		}
+		paths, err := jsondiff.ChangedPaths(obj, obj)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(paths).To(BeEmpty())
+	})
+
+	It("should return the paths of changed, added and removed fields", func() {
+		oldObj := map[string]interface{}{
+			"name": "test",
+			"spec": map[string]interface{}{
+				"removed": true,
+				"value":   "old",
+				"list":    []interface{}{"a", "b"},
+			},
+		}
+		newObj := map[string]interface{}{
+			"name": "test",
+			"spec": map[string]interface{}{
+				"value": "new",
+				"list":  []interface{}{"a", "c"},
+				"added": 1,
+			},
+		}
+		paths, err := jsondiff.ChangedPaths(oldObj, newObj)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(paths).To(Equal([]string{"spec.added", "spec.list", "spec.removed", "spec.value"}))
+	})
+
+	It("should compare structs by their json representation", func() {
+		type obj struct {
+			Name  string `json:"name"`
+			Value string `json:"value,omitempty"`
+		}
+		paths, err := jsondiff.ChangedPaths(obj{Name: "a"}, &obj{Name: "a", Value: "b"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(paths).To(ConsistOf("value"))
+	})
+
+	It("should return the root path if a value changes its type", func() {
+		paths, err := jsondiff.ChangedPaths(map[string]interface{}{"a": 1}, "a")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(paths).To(ConsistOf(""))
+	})
+
+})
+
+var _ = Describe("Changes", func() {
+
+	It("should return the old and new values of changed, added and removed fields", func() {
+		oldObj := map[string]interface{}{
+			"spec": map[string]interface{}{
+				"removed": true,
+				"value":   "old",
+			},
+		}
+		newObj := map[string]interface{}{
+			"spec": map[string]interface{}{
+				"value": "new",
+				"added": 1,
+			},
+		}
+		changes, err := jsondiff.Changes(oldObj, newObj)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(changes).To(Equal([]jsondiff.Change{
+			{Path: "spec.added", Type: jsondiff.ChangeTypeAdded, New: float64(1)},
+			{Path: "spec.removed", Type: jsondiff.ChangeTypeRemoved, Old: true},
+			{Path: "spec.value", Type: jsondiff.ChangeTypeChanged, Old: "old", New: "new"},
+		}))
+	})
+
+})
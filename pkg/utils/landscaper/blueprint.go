@@ -21,8 +21,7 @@ import (
 	lsblueprints "github.com/gardener/landscaper/pkg/landscaper/blueprints"
 	"github.com/gardener/landscaper/pkg/landscaper/execution"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
-	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/gotemplate"
-	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/spiff"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/templaters"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/subinstallations"
 	"github.com/gardener/landscaper/pkg/landscaper/jsonschema"
 	"github.com/gardener/landscaper/pkg/utils/blueprints"
@@ -126,10 +125,7 @@ func (r *BlueprintRenderer) RenderImportExecutions(input *ResolvedInstallation,
 	}
 
 	templateStateHandler := template.NewMemoryStateHandler()
-	formatter := template.NewTemplateInputFormatter(true)
-	tmpl := template.New(
-		gotemplate.New(templateStateHandler, nil).WithInputFormatter(formatter),
-		spiff.New(templateStateHandler, nil).WithInputFormatter(formatter))
+	tmpl := newTemplater(templateStateHandler)
 	errorList, bindings, err := tmpl.TemplateImportExecutions(
 		template.NewBlueprintExecutionOptions(
 			input.Installation,
@@ -172,10 +168,7 @@ func (r *BlueprintRenderer) RenderExportExecutions(input *ResolvedInstallation,
 	}
 
 	templateStateHandler := template.NewMemoryStateHandler()
-	formatter := template.NewTemplateInputFormatter(true)
-	tmpl := template.New(
-		gotemplate.New(templateStateHandler, nil).WithInputFormatter(formatter),
-		spiff.New(templateStateHandler, nil).WithInputFormatter(formatter))
+	tmpl := newTemplater(templateStateHandler)
 	exports, err := tmpl.TemplateExportExecutions(
 		template.NewExportExecutionOptions(
 			template.NewBlueprintExecutionOptions(
@@ -198,10 +191,7 @@ func (r *BlueprintRenderer) renderDeployItems(input *ResolvedInstallation, impor
 	defer ctx.Done()
 
 	templateStateHandler := template.NewMemoryStateHandler()
-	formatter := template.NewTemplateInputFormatter(true)
-	tmpl := template.New(
-		gotemplate.New(templateStateHandler, nil).WithInputFormatter(formatter),
-		spiff.New(templateStateHandler, nil).WithInputFormatter(formatter))
+	tmpl := newTemplater(templateStateHandler)
 	executions, err := tmpl.TemplateDeployExecutions(
 		template.NewDeployExecutionOptions(
 			template.NewBlueprintExecutionOptions(
@@ -287,6 +277,7 @@ func (r *BlueprintRenderer) renderDeployItems(input *ResolvedInstallation, impor
 			Labels:        elem.Labels,
 			Configuration: elem.Configuration,
 			DependsOn:     elem.DependsOn,
+			Wave:          elem.Wave,
 		}
 	}
 
@@ -331,10 +322,7 @@ func (r *BlueprintRenderer) renderSubInstallations(input *ResolvedInstallation,
 	}
 
 	templateStateHandler := template.NewMemoryStateHandler()
-	formatter := template.NewTemplateInputFormatter(true)
-	tmpl := template.New(
-		gotemplate.New(templateStateHandler, nil).WithInputFormatter(formatter),
-		spiff.New(templateStateHandler, nil).WithInputFormatter(formatter))
+	tmpl := newTemplater(templateStateHandler)
 	subInstallationTemplates, err := tmpl.TemplateSubinstallationExecutions(
 		template.NewDeployExecutionOptions(
 			template.NewBlueprintExecutionOptions(
@@ -427,6 +415,7 @@ func (r *BlueprintRenderer) validateImports(input *ResolvedInstallation, imports
 		ComponentVersion:  input.ComponentVersion,
 		RegistryAccess:    r.registryAccess,
 		RepositoryContext: inputRepositoryContext,
+		JSONSchemaVersion: input.Info.JSONSchemaVersion,
 	}
 
 	var allErr field.ErrorList
@@ -560,3 +549,11 @@ func validateTargetMapImport(value interface{}, expectedTargetType string, fldPa
 func deployItemSpecificationError(name, message string, args ...interface{}) error {
 	return fmt.Errorf(fmt.Sprintf("invalid deployitem specification %q: ", name)+message, args...)
 }
+
+// newTemplater creates a templater that renders the templates without access to a cluster.
+func newTemplater(state template.GenericStateHandler) *template.Templater {
+	return templaters.New(templaters.Options{
+		State:          state,
+		InputFormatter: template.NewTemplateInputFormatter(true),
+	})
+}
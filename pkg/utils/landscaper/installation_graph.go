@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package landscaper
+
+import (
+	"path"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/utils/dependencies"
+)
+
+// InstallationGraphCallbacks are simulator callbacks that collect the simulated installations
+// in order to build the graph of their dependencies.
+type InstallationGraphCallbacks struct {
+	emptySimulatorCallbacks
+	builder *dependencies.GraphBuilder
+}
+
+// NewInstallationGraphCallbacks creates new simulator callbacks that collect the installation graph.
+func NewInstallationGraphCallbacks() *InstallationGraphCallbacks {
+	return &InstallationGraphCallbacks{
+		builder: dependencies.NewGraphBuilder(),
+	}
+}
+
+// OnInstallation adds the installation to the graph. Installations are identified by their installation path.
+func (c *InstallationGraphCallbacks) OnInstallation(installationPath string, installation *lsv1alpha1.Installation) {
+	parent := path.Dir(installationPath)
+	if parent == "." {
+		parent = ""
+	}
+	c.builder.AddInstallation(installationPath, parent, installation)
+}
+
+// Graph returns the graph of the installations found during the simulation.
+func (c *InstallationGraphCallbacks) Graph() *dependencies.InstallationGraph {
+	return c.builder.Build()
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/gardener/landscaper/pkg/components/model/types"
 	"github.com/gardener/landscaper/pkg/components/registries"
 	"github.com/gardener/landscaper/pkg/utils/blueprints"
+	"github.com/gardener/landscaper/pkg/utils/dependencies"
 	lsutils "github.com/gardener/landscaper/pkg/utils/landscaper"
 )
 
@@ -320,4 +321,33 @@ targetExports: []
 		Expect(callbacks.deployItemsState["root/subinst-a"]).To(HaveKey("deploydeploy-execution"))
 		Expect(callbacks.deployItemsState["root/subinst-a"]["deploydeploy-execution"]).To(ContainSubstring("stateval"))
 	})
+
+	It("should collect the installation graph of a simulation", func() {
+		simulator, err := lsutils.NewInstallationSimulator(componentVersionList, registryAccess, &repositoryContext, exportTemplates)
+		Expect(err).ToNot(HaveOccurred())
+		graphCallbacks := lsutils.NewInstallationGraphCallbacks()
+		simulator.SetCallbacks(graphCallbacks)
+
+		imports := map[string]interface{}{
+			"root-param-a": "valua-a",
+			"root-param-b": "value-b",
+			"cluster":      map[string]interface{}{},
+			"clusters":     []interface{}{map[string]interface{}{}},
+		}
+		_, err = simulator.Run(rootComponentVersion, blueprint, imports)
+		Expect(err).ToNot(HaveOccurred())
+
+		graph := graphCallbacks.Graph()
+		Expect(graph.Nodes).To(ContainElements(
+			dependencies.GraphNode{ID: "installation:root", Kind: dependencies.NodeKindInstallation, Name: "root"},
+			dependencies.GraphNode{ID: "installation:root/subinst-a", Kind: dependencies.NodeKindInstallation, Name: "subinst-a", Parent: "installation:root"},
+			dependencies.GraphNode{ID: "dataobject:root/subinst-a-export-b", Kind: dependencies.NodeKindDataObject, Name: "subinst-a-export-b", Parent: "installation:root"},
+		))
+		Expect(graph.Edges).To(ContainElements(
+			dependencies.GraphEdge{From: "installation:root", To: "installation:root/subinst-a", Kind: dependencies.EdgeKindSubInstallation},
+			dependencies.GraphEdge{From: "installation:root/subinst-a", To: "dataobject:root/subinst-a-export-b", Kind: dependencies.EdgeKindExport, Name: "subinst-a-export-b"},
+			dependencies.GraphEdge{From: "dataobject:root/subinst-a-export-b", To: "installation:root/subinst-b", Kind: dependencies.EdgeKindImport, Name: "importParamB"},
+			dependencies.GraphEdge{From: "installation:root/subinst-b", To: "installation:root/subinst-a", Kind: dependencies.EdgeKindDependsOn},
+		))
+	})
 })
@@ -86,6 +86,66 @@ func (w *Writer) logTargetUpdate(ctx context.Context, writeID WriteID, msg strin
 	}
 }
 
+func (w *Writer) logLandscapeStatusUpdate(ctx context.Context, writeID WriteID, msg string, landscapeStatus *lsv1alpha1.LandscapeStatus,
+	generationOld int64, resourceVersionOld string, err error) {
+
+	logger := w.getLogger(ctx, keyUpdatedResource, fmt.Sprintf("%s/%s", landscapeStatus.Namespace, landscapeStatus.Name))
+
+	if err == nil {
+		generationNew, resourceVersionNew := getGenerationAndResourceVersion(landscapeStatus)
+		logger.Log(historyLogLevel, msg,
+			lc.KeyWriteID, writeID,
+			lc.KeyGenerationOld, generationOld,
+			lc.KeyGenerationNew, generationNew,
+			lc.KeyResourceVersionOld, resourceVersionOld,
+			lc.KeyResourceVersionNew, resourceVersionNew,
+		)
+	} else if apierrors.IsConflict(err) {
+		message := msg + ": " + err.Error()
+		logger.Info(message,
+			lc.KeyWriteID, writeID,
+			lc.KeyGenerationOld, generationOld,
+			lc.KeyResourceVersionOld, resourceVersionOld,
+		)
+	} else {
+		logger.Error(err, msg,
+			lc.KeyWriteID, writeID,
+			lc.KeyGenerationOld, generationOld,
+			lc.KeyResourceVersionOld, resourceVersionOld,
+		)
+	}
+}
+
+func (w *Writer) logInstallationRevisionUpdate(ctx context.Context, writeID WriteID, msg string, revision *lsv1alpha1.InstallationRevision,
+	generationOld int64, resourceVersionOld string, err error) {
+
+	logger := w.getLogger(ctx, keyUpdatedResource, fmt.Sprintf("%s/%s", revision.Namespace, revision.Name))
+
+	if err == nil {
+		generationNew, resourceVersionNew := getGenerationAndResourceVersion(revision)
+		logger.Log(historyLogLevel, msg,
+			lc.KeyWriteID, writeID,
+			lc.KeyGenerationOld, generationOld,
+			lc.KeyGenerationNew, generationNew,
+			lc.KeyResourceVersionOld, resourceVersionOld,
+			lc.KeyResourceVersionNew, resourceVersionNew,
+		)
+	} else if apierrors.IsConflict(err) {
+		message := msg + ": " + err.Error()
+		logger.Info(message,
+			lc.KeyWriteID, writeID,
+			lc.KeyGenerationOld, generationOld,
+			lc.KeyResourceVersionOld, resourceVersionOld,
+		)
+	} else {
+		logger.Error(err, msg,
+			lc.KeyWriteID, writeID,
+			lc.KeyGenerationOld, generationOld,
+			lc.KeyResourceVersionOld, resourceVersionOld,
+		)
+	}
+}
+
 func (w *Writer) logSyncObjectUpdate(ctx context.Context, writeID WriteID, msg string, syncObject *lsv1alpha1.SyncObject,
 	generationOld int64, resourceVersionOld string, err error) {
 
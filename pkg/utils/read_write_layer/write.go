@@ -176,6 +176,31 @@ func (w *Writer) DeleteExecution(ctx context.Context, writeID WriteID, execution
 	return errorWithWriteID(err, writeID)
 }
 
+// methods for landscape status
+
+func (w *Writer) UpdateLandscapeStatusStatus(ctx context.Context, writeID WriteID, landscapeStatus *lsv1alpha1.LandscapeStatus) error {
+	generationOld, resourceVersionOld := getGenerationAndResourceVersion(landscapeStatus)
+	err := updateStatus(ctx, w.client.Status(), landscapeStatus, writeID, opLandscapeStatusStatus)
+	w.logLandscapeStatusUpdate(ctx, writeID, opLandscapeStatusStatus, landscapeStatus, generationOld, resourceVersionOld, err)
+	return errorWithWriteID(err, writeID)
+}
+
+// methods for installation revisions
+
+func (w *Writer) CreateInstallationRevision(ctx context.Context, writeID WriteID, revision *lsv1alpha1.InstallationRevision) error {
+	generationOld, resourceVersionOld := getGenerationAndResourceVersion(revision)
+	err := create(ctx, w.client, revision, writeID, opInstRevisionCreate)
+	w.logInstallationRevisionUpdate(ctx, writeID, opInstRevisionCreate, revision, generationOld, resourceVersionOld, err)
+	return errorWithWriteID(err, writeID)
+}
+
+func (w *Writer) DeleteInstallationRevision(ctx context.Context, writeID WriteID, revision *lsv1alpha1.InstallationRevision) error {
+	generationOld, resourceVersionOld := getGenerationAndResourceVersion(revision)
+	err := delete(ctx, w.client, revision, writeID, opInstRevisionDelete)
+	w.logInstallationRevisionUpdate(ctx, writeID, opInstRevisionDelete, revision, generationOld, resourceVersionOld, err)
+	return errorWithWriteID(err, writeID)
+}
+
 // methods for deploy items
 
 func (w *Writer) CreateOrUpdateDeployItem(ctx context.Context, writeID WriteID, deployItem *lsv1alpha1.DeployItem,
@@ -0,0 +1,185 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"os"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+)
+
+/*
+  This package contains the tracing of the jobs of installations, executions and deploy items.
+  All spans of one job belong to the same trace, whose id is derived from the job id. The span context in which a
+  parent object creates or updates a child object is stored in an annotation of the child object, so that the spans
+  of the child are linked to the spans of the parent, also across different processes.
+*/
+
+const (
+	tracerName = "github.com/gardener/landscaper"
+
+	// envOTLPEndpoint and envOTLPTracesEndpoint are the standard environment variables of the otlp exporter.
+	// Tracing is only enabled if one of them is set.
+	envOTLPEndpoint       = "OTEL_EXPORTER_OTLP_ENDPOINT"
+	envOTLPTracesEndpoint = "OTEL_EXPORTER_OTLP_TRACES_ENDPOINT"
+
+	// Kinds of the objects whose processing is traced.
+	KindInstallation = "Installation"
+	KindExecution    = "Execution"
+	KindDeployItem   = "DeployItem"
+
+	// Attribute keys of the spans.
+	AttributeKind      = attribute.Key("landscaper.kind")
+	AttributeName      = attribute.Key("landscaper.name")
+	AttributeNamespace = attribute.Key("landscaper.namespace")
+	AttributeJobID     = attribute.Key("landscaper.job_id")
+)
+
+// jobIDContextKey is the context key for the job id from which the trace id of root spans is derived.
+type jobIDContextKey struct{}
+
+// Setup configures the global tracer provider to export spans via otlp if an otlp endpoint is configured
+// by the standard environment variables.
+// The returned function flushes and stops the export and has to be called on shutdown.
+func Setup(ctx context.Context, serviceName string) (func(context.Context) error, error) {
+	if len(os.Getenv(envOTLPEndpoint)) == 0 && len(os.Getenv(envOTLPTracesEndpoint)) == 0 {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := otlptracegrpc.New(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := resource.New(ctx,
+		resource.WithAttributes(attribute.String("service.name", serviceName)),
+		resource.WithFromEnv(),
+		resource.WithTelemetrySDK())
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithIDGenerator(&jobIDGenerator{}))
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	logger, _ := logging.FromContextOrNew(ctx, nil)
+	logger.Info("Tracing enabled", "serviceName", serviceName)
+	return provider.Shutdown, nil
+}
+
+// StartSpan starts a span for the processing of the given object in the job with the given id.
+// If the object has been created or updated by its parent in the same job, the span is a child of the span of the parent.
+// Otherwise, it is a root span in the trace of the job.
+func StartSpan(ctx context.Context, kind string, obj metav1.Object, jobID string, spanOpts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	parent := ExtractTraceContext(obj)
+	if parent.IsValid() && parent.TraceID() == TraceIDFromJobID(jobID) {
+		ctx = trace.ContextWithRemoteSpanContext(ctx, parent)
+	} else {
+		ctx = trace.ContextWithSpanContext(ctx, trace.SpanContext{})
+		ctx = context.WithValue(ctx, jobIDContextKey{}, jobID)
+	}
+
+	spanOpts = append([]trace.SpanStartOption{
+		trace.WithSpanKind(trace.SpanKindInternal),
+		trace.WithAttributes(
+			AttributeKind.String(kind),
+			AttributeName.String(obj.GetName()),
+			AttributeNamespace.String(obj.GetNamespace()),
+			AttributeJobID.String(jobID),
+		),
+	}, spanOpts...)
+	return otel.Tracer(tracerName).Start(ctx, kind+" reconcile", spanOpts...)
+}
+
+// EndSpan records the error, if there is one, and ends the span.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// InjectTraceContext stores the span context of the given context in an annotation of the object.
+// It has to be called when a parent object creates or updates a child object.
+func InjectTraceContext(ctx context.Context, obj metav1.Object) {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return
+	}
+	carrier := propagation.MapCarrier{}
+	propagation.TraceContext{}.Inject(ctx, carrier)
+	if traceParent := carrier.Get("traceparent"); len(traceParent) != 0 {
+		annotations := obj.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[lsv1alpha1.TraceParentAnnotation] = traceParent
+		obj.SetAnnotations(annotations)
+	}
+}
+
+// ExtractTraceContext returns the span context that is stored in the annotation of the object.
+// The returned span context is invalid if the object has no such annotation.
+func ExtractTraceContext(obj metav1.Object) trace.SpanContext {
+	traceParent, ok := obj.GetAnnotations()[lsv1alpha1.TraceParentAnnotation]
+	if !ok {
+		return trace.SpanContext{}
+	}
+	carrier := propagation.MapCarrier{"traceparent": traceParent}
+	return trace.SpanContextFromContext(propagation.TraceContext{}.Extract(context.Background(), carrier))
+}
+
+// TraceIDFromJobID returns the trace id of a job.
+func TraceIDFromJobID(jobID string) trace.TraceID {
+	if id, err := uuid.Parse(jobID); err == nil {
+		return trace.TraceID(id)
+	}
+	hash := sha256.Sum256([]byte(jobID))
+	var traceID trace.TraceID
+	copy(traceID[:], hash[:])
+	return traceID
+}
+
+// jobIDGenerator generates the trace ids of root spans from the job id in the context.
+type jobIDGenerator struct{}
+
+var _ sdktrace.IDGenerator = &jobIDGenerator{}
+
+// NewIDs implements the sdktrace.IDGenerator interface.
+func (g *jobIDGenerator) NewIDs(ctx context.Context) (trace.TraceID, trace.SpanID) {
+	var traceID trace.TraceID
+	if jobID, ok := ctx.Value(jobIDContextKey{}).(string); ok && len(jobID) != 0 {
+		traceID = TraceIDFromJobID(jobID)
+	} else {
+		_, _ = rand.Read(traceID[:])
+	}
+	return traceID, g.NewSpanID(ctx, traceID)
+}
+
+// NewSpanID implements the sdktrace.IDGenerator interface.
+func (g *jobIDGenerator) NewSpanID(_ context.Context, _ trace.TraceID) trace.SpanID {
+	var spanID trace.SpanID
+	_, _ = rand.Read(spanID[:])
+	return spanID
+}
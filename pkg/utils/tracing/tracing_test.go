@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+var _ = Describe("Tracing", func() {
+
+	var (
+		recorder *tracetest.SpanRecorder
+		previous trace.TracerProvider
+	)
+
+	BeforeEach(func() {
+		previous = otel.GetTracerProvider()
+		recorder = tracetest.NewSpanRecorder()
+		otel.SetTracerProvider(sdktrace.NewTracerProvider(
+			sdktrace.WithSpanProcessor(recorder),
+			sdktrace.WithIDGenerator(&jobIDGenerator{})))
+	})
+
+	AfterEach(func() {
+		otel.SetTracerProvider(previous)
+	})
+
+	It("should derive the trace id from the job id", func() {
+		jobID := uuid.New().String()
+		Expect(TraceIDFromJobID(jobID)).To(Equal(TraceIDFromJobID(jobID)))
+		Expect(TraceIDFromJobID(jobID).IsValid()).To(BeTrue())
+		Expect(TraceIDFromJobID("no-uuid").IsValid()).To(BeTrue())
+		Expect(TraceIDFromJobID(jobID)).ToNot(Equal(TraceIDFromJobID(uuid.New().String())))
+	})
+
+	It("should link the span of a child object to the span of its parent in the same job", func() {
+		ctx := context.Background()
+		jobID := uuid.New().String()
+
+		parent := &lsv1alpha1.Installation{ObjectMeta: metav1.ObjectMeta{Name: "parent", Namespace: "default"}}
+		parentCtx, parentSpan := StartSpan(ctx, KindInstallation, parent, jobID)
+		Expect(parentSpan.SpanContext().TraceID()).To(Equal(TraceIDFromJobID(jobID)))
+
+		exec := &lsv1alpha1.Execution{ObjectMeta: metav1.ObjectMeta{Name: "exec", Namespace: "default"}}
+		InjectTraceContext(parentCtx, exec)
+		Expect(exec.Annotations).To(HaveKey(lsv1alpha1.TraceParentAnnotation))
+		EndSpan(parentSpan, nil)
+
+		_, childSpan := StartSpan(ctx, KindExecution, exec, jobID)
+		EndSpan(childSpan, nil)
+
+		spans := recorder.Ended()
+		Expect(spans).To(HaveLen(2))
+		Expect(spans[1].Parent().SpanID()).To(Equal(spans[0].SpanContext().SpanID()))
+		Expect(spans[1].SpanContext().TraceID()).To(Equal(TraceIDFromJobID(jobID)))
+	})
+
+	It("should start a root span if the trace context of the object belongs to another job", func() {
+		ctx := context.Background()
+		oldJobID := uuid.New().String()
+		newJobID := uuid.New().String()
+
+		parent := &lsv1alpha1.Installation{ObjectMeta: metav1.ObjectMeta{Name: "parent", Namespace: "default"}}
+		parentCtx, parentSpan := StartSpan(ctx, KindInstallation, parent, oldJobID)
+		di := &lsv1alpha1.DeployItem{ObjectMeta: metav1.ObjectMeta{Name: "di", Namespace: "default"}}
+		InjectTraceContext(parentCtx, di)
+		EndSpan(parentSpan, nil)
+
+		_, span := StartSpan(ctx, KindDeployItem, di, newJobID)
+		EndSpan(span, nil)
+
+		spans := recorder.Ended()
+		Expect(spans).To(HaveLen(2))
+		Expect(spans[1].Parent().IsValid()).To(BeFalse())
+		Expect(spans[1].SpanContext().TraceID()).To(Equal(TraceIDFromJobID(newJobID)))
+	})
+
+	It("should not add an annotation without a valid span", func() {
+		di := &lsv1alpha1.DeployItem{}
+		InjectTraceContext(context.Background(), di)
+		Expect(di.Annotations).To(BeEmpty())
+		Expect(ExtractTraceContext(di).IsValid()).To(BeFalse())
+	})
+
+})
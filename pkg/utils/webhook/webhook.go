@@ -113,3 +113,23 @@ var TargetWebhookLogic webhooklib.WebhookLogic = func(ctx context.Context, req a
 
 	return admission.Allowed("Target is valid")
 }
+
+// CONTEXT
+
+var ContextWebhookLogic webhooklib.WebhookLogic = func(ctx context.Context, req admission.Request, dec runtime.Decoder) admission.Response {
+	logger, _ := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, "ContextWebhookLogic"})
+
+	c := &lscore.Context{}
+	if _, _, err := dec.Decode(req.Object.Raw, nil, c); err != nil {
+		logger.Debug("Decoding failed: " + err.Error())
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if errs := validation.ValidateContext(c); len(errs) > 0 {
+		aggErr := errs.ToAggregate().Error()
+		logger.Debug("Validation failed: " + aggErr)
+		return admission.Denied(aggErr)
+	}
+
+	return admission.Allowed("Context is valid")
+}
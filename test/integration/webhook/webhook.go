@@ -6,6 +6,7 @@ package webhook
 
 import (
 	"context"
+	"time"
 
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 
@@ -176,5 +177,22 @@ func WebhookTest(f *framework.Framework) {
 			Expect(err).To(HaveOccurred()) // validation webhook should have denied this
 			Expect(err.Error()).To(ContainSubstring("admission webhook \"targets.validation.landscaper.gardener.cloud\" denied the request"))
 		})
+
+		It("should block Context resources with an invalid maintenance window", func() {
+			lsContext := &lsv1alpha1.Context{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-context",
+					Namespace: state.Namespace,
+				},
+			}
+			lsContext.MaintenanceWindow = &lsv1alpha1.MaintenanceWindow{
+				CronSpec: "every saturday",
+				Duration: lsv1alpha1.Duration{Duration: time.Hour},
+			}
+
+			err := state.Create(ctx, lsContext)
+			Expect(err).To(HaveOccurred()) // validation webhook should have denied this
+			Expect(err.Error()).To(ContainSubstring("admission webhook \"contexts.validation.landscaper.gardener.cloud\" denied the request"))
+		})
 	})
 }